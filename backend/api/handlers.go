@@ -2,10 +2,16 @@ package main
 
 import (
 	"backend/ent"
+	"backend/ent/deadletter"
 	"backend/ent/event"
+	"backend/ent/eventpass"
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/pendingevent"
+	"backend/ent/sale"
+	"backend/ent/transfer"
 	"backend/ent/user"
 	"backend/transactions"
 	"backend/utils"
@@ -16,10 +22,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/onflow/flow-go-sdk/access/grpc"
 )
 
 // Handler adalah struct kustom yang akan kita gunakan
@@ -27,6 +35,12 @@ import (
 // ke dalam fungsi-fungsi API kita.
 type Handler struct {
 	DB *ent.Client
+
+	// Access node Flow, dipakai untuk membandingkan posisi indexer dengan chain
+	Flow *grpc.Client
+
+	// Pengirim transaksi admin (mint); nil jika signer admin tidak tersedia
+	Tx *transactions.TxService
 }
 
 type Pagination struct {
@@ -69,6 +83,8 @@ func getPagination(c echo.Context) (limit, offset, page, pageSize int) {
 // GET /moments -> Mengambil SEMUA (untuk 'Explore')
 // GET /moments?owner_address=0x123 -> Mengambil HANYA milik '0x123'
 // GET /moments?owner_address=0x123&page=2 -> Pagination
+// GET /moments?tier=community -> Filter tier (dari MetadataViews)
+// GET /moments?event_id=7 (atau /events/7/moments) -> Momen yang di-mint dengan EventPass event 7
 func (h *Handler) getMoments(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -88,6 +104,22 @@ func (h *Handler) getMoments(c echo.Context) error {
 			nftmoment.HasOwnerWith(user.AddressEQ(ownerAddress)),
 		)
 	}
+	if tier := c.QueryParam("tier"); tier != "" {
+		query = query.Where(nftmoment.TierEqualFold(tier))
+	}
+	eventIDParam := c.QueryParam("event_id")
+	if id := c.Param("id"); id != "" {
+		eventIDParam = id
+	}
+	if eventIDParam != "" {
+		eventID, err := strconv.ParseUint(eventIDParam, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "event_id tidak valid"})
+		}
+		query = query.Where(nftmoment.HasMintedWithPassWith(
+			eventpass.HasEventWith(event.EventIDEQ(eventID)),
+		))
+	}
 	// ---
 
 	// 4. Hitung total item (setelah filter diterapkan)
@@ -109,7 +141,9 @@ func (h *Handler) getMoments(c echo.Context) error {
 	moments, err := query.
 		WithOwner().
 		WithEquippedAccessories(). // <-- 'Preload' data aksesoris yang terpasang
-		WithMintedWithPass().      // <-- 'Preload' data EventPass yang digunakan
+		WithMintedWithPass(func(q *ent.EventPassQuery) {
+			q.WithEvent() // <-- 'Preload' EventPass yang digunakan beserta event-nya
+		}).
 		Limit(limit).
 		Offset(offset).
 		Order(ent.Desc("id")). // Urutkan dari yang terbaru
@@ -134,6 +168,7 @@ func (h *Handler) getMoments(c echo.Context) error {
 //	GET /accessories -> Mengambil SEMUA (untuk halaman 'Explore')
 //	GET /accessories?owner_address=0x123 -> Mengambil HANYA milik '0x123'
 //	GET /accessories?owner_address=0x123&page=2 -> Pagination
+//	GET /accessories?rarity=Rare -> Filter deskripsi rarity (dari MetadataViews)
 func (h *Handler) getAccessories(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -153,6 +188,9 @@ func (h *Handler) getAccessories(c echo.Context) error {
 			nftaccessory.HasOwnerWith(user.AddressEQ(ownerAddress)),
 		)
 	}
+	if rarity := c.QueryParam("rarity"); rarity != "" {
+		query = query.Where(nftaccessory.RarityDescriptionEqualFold(rarity))
+	}
 	// --- AKHIR LOGIKA BARU ---
 
 	// 4. Hitung total item (setelah filter diterapkan)
@@ -252,6 +290,10 @@ func (h *Handler) handleUGCUpload(c echo.Context) (string, error) {
 }
 
 func (h *Handler) freeMintMoment(c echo.Context) error {
+	if h.Tx == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "transaksi admin tidak tersedia"})
+	}
+
 	// 1. Ambil data TEKS
 	recipient := c.FormValue("recipient")
 	name := c.FormValue("name")
@@ -270,7 +312,8 @@ func (h *Handler) freeMintMoment(c echo.Context) error {
 	}
 
 	// 4. Panggil transaksi
-	err = transactions.FreeMintNFTMoment(
+	txID, err := h.Tx.FreeMintNFTMoment(
+		c.Request().Context(),
 		recipient,
 		name,
 		description,
@@ -287,10 +330,15 @@ func (h *Handler) freeMintMoment(c echo.Context) error {
 		"recipient": recipient,
 		"name":      name,
 		"thumbnail": thumbnailUrl,
+		"txId":      txID.String(),
 	})
 }
 
 func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
+	if h.Tx == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "transaksi admin tidak tersedia"})
+	}
+
 	// 1. Ambil data TEKS
 	recipient := c.FormValue("recipient")
 	eventPassID := c.FormValue("eventPassID")
@@ -310,7 +358,8 @@ func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
 	}
 
 	// 4. Panggil transaksi
-	err = transactions.MintNFTMomentWithEventPass(
+	txID, err := h.Tx.MintNFTMomentWithEventPass(
+		c.Request().Context(),
 		recipient,
 		eventPassID,
 		name,
@@ -329,6 +378,7 @@ func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
 		"recipient": recipient,
 		"name":      name,
 		"thumbnail": thumbnailUrl,
+		"txId":      txID.String(),
 	})
 }
 
@@ -336,6 +386,8 @@ func (h *Handler) mintMomentWithEventPass(c echo.Context) error {
 // Mengambil daftar penjualan (listings) dari marketplace
 // Mendukung Pagination: ?page=1&pageSize=30
 // Mendukung Filter: ?seller_address=0x...
+// Mendukung Filter: ?nft_type=moment|accessory
+// Mendukung Filter: ?status=active|sold|cancelled|expired|ghost|all (default: active)
 func (h *Handler) getListings(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -353,6 +405,29 @@ func (h *Handler) getListings(c echo.Context) error {
 		)
 	}
 
+	if nftType := c.QueryParam("nft_type"); nftType != "" {
+		if err := listing.NftTypeValidator(listing.NftType(nftType)); err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+		}
+		query = query.Where(listing.NftTypeEQ(listing.NftType(nftType)))
+	}
+
+	// Default hanya listing yang masih aktif & belum lewat expiry
+	// (sweeper indexer mungkin belum sempat menandainya 'expired')
+	switch status := c.QueryParam("status"); status {
+	case "":
+		query = query.Where(
+			listing.StatusEQ(listing.StatusActive),
+			listing.ExpiryGT(time.Now()),
+		)
+	case "all":
+	default:
+		if err := listing.StatusValidator(listing.Status(status)); err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+		}
+		query = query.Where(listing.StatusEQ(listing.Status(status)))
+	}
+
 	// 4. HITUNG TOTAL ITEM (PENTING!)
 	// Jalankan query COUNT() SEBELUM Limit/Offset
 	totalItems, err := query.Count(ctx)
@@ -373,6 +448,7 @@ func (h *Handler) getListings(c echo.Context) error {
 	listings, err := query.
 		WithSeller().
 		WithNftAccessory().
+		WithNftMoment().
 		Limit(limit).
 		Offset(offset).
 		Order(ent.Desc("id")).
@@ -394,6 +470,7 @@ func (h *Handler) getListings(c echo.Context) error {
 // Mengambil daftar event (seperti Luma)
 // Mendukung Pagination: ?page=1&pageSize=10
 // Mendukung Filter: ?type=0 (0=online, 1=offline)
+// Mendukung Filter: ?verified=true (hanya event dengan host terverifikasi)
 func (h *Handler) getEvents(c echo.Context) error {
 	ctx := c.Request().Context()
 
@@ -411,7 +488,14 @@ func (h *Handler) getEvents(c echo.Context) error {
 			query = query.Where(event.EventTypeEQ(uint8(eventType)))
 		}
 	}
-	// (Anda bisa menambahkan filter lain di sini)
+	// Filter host terverifikasi: ?verified=true|false
+	if verifiedParam := c.QueryParam("verified"); verifiedParam != "" {
+		verified, err := strconv.ParseBool(verifiedParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "verified harus true atau false"})
+		}
+		query = query.Where(event.HasHostWith(user.IsVerifiedEQ(verified)))
+	}
 
 	// 4. HITUNG TOTAL ITEM (PENTING!)
 	// Jalankan query COUNT() SEBELUM Limit/Offset
@@ -451,6 +535,49 @@ func (h *Handler) getEvents(c echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// --- HANDLER BARU: GET /profiles ---
+// Mengambil daftar profil pengguna
+// Mendukung Pagination: ?page=1&pageSize=10
+// Mendukung Filter: ?verified=true|false
+func (h *Handler) getUserProfiles(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	limit, offset, page, pageSize := getPagination(c)
+
+	query := h.DB.User.Query()
+	if verifiedParam := c.QueryParam("verified"); verifiedParam != "" {
+		verified, err := strconv.ParseBool(verifiedParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "verified harus true atau false"})
+		}
+		query = query.Where(user.IsVerifiedEQ(verified))
+	}
+
+	totalItems, err := query.Count(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+	pagination := &Pagination{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+	}
+
+	users, err := query.
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Asc(user.FieldID)).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{Data: users, Pagination: pagination})
+}
+
 // --- HANDLER BARU: GET /profiles/:address ---
 // Mengambil semua data untuk satu halaman profil pengguna
 func (h *Handler) getUserProfile(c echo.Context) error {
@@ -465,9 +592,14 @@ func (h *Handler) getUserProfile(c echo.Context) error {
 	user, err := h.DB.User.Query().
 		Where(user.AddressEQ(address)).
 		// Eager load semua data yang terkait dengan User ini
-		WithMoments().      // Ambil 10 momen terakhir (contoh pagination)
-		WithAccessories().  // Ambil 10 aksesoris terakhir
-		WithEventPasses().  // Ambil 10 pass terakhir
+		WithMoments(func(q *ent.NFTMomentQuery) {
+			// Momen yang di-mint dengan EventPass: sertakan pass & event asalnya
+			q.WithMintedWithPass(func(q *ent.EventPassQuery) { q.WithEvent() })
+		}).
+		WithAccessories(). // Ambil 10 aksesoris terakhir
+		WithEventPasses(func(q *ent.EventPassQuery) {
+			q.WithEvent().WithMoment() // Event asal & momen hasil pass
+		}).
 		WithHostedEvents(). // Ambil 10 event yang di-host
 		WithListings().     // Ambil 10 listing terakhir
 		Only(ctx)
@@ -484,3 +616,345 @@ func (h *Handler) getUserProfile(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, APIResponse{Data: user})
 }
+
+// Status receipt gacha (dihitung dari commit block & block terakhir yang di-indeks)
+const (
+	receiptPending    = "pending"    // commit block belum lewat, belum bisa di-reveal
+	receiptRevealable = "revealable" // siap di-reveal
+	receiptRevealed   = "revealed"   // sudah di-reveal
+)
+
+// receiptResponse adalah GachaReceipt beserta status-nya
+type receiptResponse struct {
+	*ent.GachaReceipt
+	Status string `json:"status"`
+}
+
+// --- HANDLER BARU: GET /profiles/:address/receipts ---
+// Mengambil receipt gacha milik satu user
+// Mendukung Pagination: ?page=1&pageSize=10
+// Mendukung Filter: ?status=pending|revealable|revealed
+func (h *Handler) getUserReceipts(c echo.Context) error {
+	ctx := c.Request().Context()
+	address := c.Param("address")
+
+	// 1. Dapatkan parameter pagination
+	limit, offset, page, pageSize := getPagination(c)
+
+	// 2. Block terakhir yang sudah di-indeks dipakai sebagai "block saat ini"
+	var currentHeight uint64
+	cp, err := utils.GetCheckpoint(ctx, h.DB, utils.IndexerCheckpoint)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if cp != nil {
+		currentHeight = cp.BlockHeight
+	}
+
+	// 3. Siapkan query dasar & filter status
+	query := h.DB.GachaReceipt.Query().
+		Where(gachareceipt.OpenerEQ(address))
+
+	switch c.QueryParam("status") {
+	case "":
+	case receiptPending:
+		query = query.Where(gachareceipt.RevealedAtIsNil(), gachareceipt.CommitBlockGT(currentHeight))
+	case receiptRevealable:
+		query = query.Where(gachareceipt.RevealedAtIsNil(), gachareceipt.CommitBlockLTE(currentHeight))
+	case receiptRevealed:
+		query = query.Where(gachareceipt.RevealedAtNotNil())
+	default:
+		return c.JSON(http.StatusBadRequest, APIResponse{Error: "status harus pending, revealable, atau revealed"})
+	}
+
+	// 4. Hitung total item
+	totalItems, err := query.Count(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 5. Buat Metadata Pagination
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+	pagination := &Pagination{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+	}
+
+	// 6. Jalankan Query UTAMA dengan Limit/Offset
+	receipts, err := query.
+		WithAccessory().
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc("id")).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 7. Tambahkan status ke setiap receipt
+	data := make([]receiptResponse, 0, len(receipts))
+	for _, r := range receipts {
+		status := receiptPending
+		switch {
+		case r.RevealedAt != nil:
+			status = receiptRevealed
+		case r.CommitBlock <= currentHeight:
+			status = receiptRevealable
+		}
+		data = append(data, receiptResponse{GachaReceipt: r, Status: status})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{
+		Data:       data,
+		Pagination: pagination,
+	})
+}
+
+// --- HANDLER BARU: GET /moments/:id/history & GET /accessories/:id/history ---
+// Mengambil riwayat kepemilikan (provenance) satu NFT, dari mint sampai pemilik terakhir
+// ':id' adalah ID NFT on-chain
+// Mendukung Pagination: ?page=1&pageSize=10
+func (h *Handler) getNFTHistory(kind transfer.NftType) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		nftID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: "id NFT tidak valid"})
+		}
+
+		// 1. Dapatkan parameter pagination
+		limit, offset, page, pageSize := getPagination(c)
+
+		// 2. Siapkan query dasar
+		query := h.DB.Transfer.Query().
+			Where(
+				transfer.NftTypeEQ(kind),
+				transfer.NftIDEQ(nftID),
+			)
+
+		// 3. Hitung total item
+		totalItems, err := query.Count(ctx)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+
+		// 4. Buat Metadata Pagination
+		totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+		pagination := &Pagination{
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+			CurrentPage: page,
+			PageSize:    pageSize,
+		}
+
+		// 5. Urutkan sesuai urutan di chain (mint lebih dulu)
+		transfers, err := query.
+			Limit(limit).
+			Offset(offset).
+			Order(
+				ent.Asc(transfer.FieldBlockHeight),
+				ent.Asc(transfer.FieldEventIndex),
+			).
+			All(ctx)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+		}
+
+		return c.JSON(http.StatusOK, APIResponse{
+			Data:       transfers,
+			Pagination: pagination,
+		})
+	}
+}
+
+// --- HANDLER BARU: GET /sales ---
+// Mengambil riwayat penjualan marketplace (activity feed), terbaru lebih dulu
+// Mendukung Pagination: ?page=1&pageSize=10
+// Mendukung Filter: ?seller_address=0x... ?buyer_address=0x... ?nft_type=moment|accessory
+func (h *Handler) getSales(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	// 1. Dapatkan parameter pagination
+	limit, offset, page, pageSize := getPagination(c)
+
+	// 2. Siapkan query dasar
+	query := h.DB.Sale.Query()
+
+	// 3. Terapkan Filter (jika ada)
+	if sellerAddress := c.QueryParam("seller_address"); sellerAddress != "" {
+		query = query.Where(sale.HasSellerWith(user.AddressEQ(sellerAddress)))
+	}
+	if buyerAddress := c.QueryParam("buyer_address"); buyerAddress != "" {
+		query = query.Where(sale.HasBuyerWith(user.AddressEQ(buyerAddress)))
+	}
+	if nftType := c.QueryParam("nft_type"); nftType != "" {
+		if err := sale.NftTypeValidator(sale.NftType(nftType)); err != nil {
+			return c.JSON(http.StatusBadRequest, APIResponse{Error: err.Error()})
+		}
+		query = query.Where(sale.NftTypeEQ(sale.NftType(nftType)))
+	}
+
+	// 4. Hitung total item
+	totalItems, err := query.Count(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	// 5. Buat Metadata Pagination
+	totalPages := int(math.Ceil(float64(totalItems) / float64(pageSize)))
+	pagination := &Pagination{
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+		CurrentPage: page,
+		PageSize:    pageSize,
+	}
+
+	// 6. Jalankan Query UTAMA dengan Limit/Offset
+	sales, err := query.
+		WithSeller().
+		WithBuyer().
+		WithListing(func(q *ent.ListingQuery) {
+			q.WithNftAccessory().WithNftMoment()
+		}).
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(sale.FieldTimestamp), ent.Desc("id")).
+		All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, APIResponse{
+		Data:       sales,
+		Pagination: pagination,
+	})
+}
+
+// handlerStatus adalah jumlah event per tipe (per handler) di indexer
+type handlerStatus struct {
+	Type      string `json:"type"`
+	Processed int    `json:"processed"`   // event yang sudah dijurnal
+	Failed    int    `json:"dead_letter"` // event yang masih di dead letter
+	Pending   int    `json:"pending"`     // event yang menunggu dependensi
+}
+
+// indexerStatusResponse adalah kondisi indexer dibanding chain
+type indexerStatusResponse struct {
+	CheckpointHeight   uint64          `json:"checkpoint_height"`
+	CheckpointTime     *time.Time      `json:"checkpoint_time,omitempty"`
+	CheckpointUpdated  *time.Time      `json:"checkpoint_updated_at,omitempty"`
+	LatestSealedHeight uint64          `json:"latest_sealed_height,omitempty"`
+	LatestSealedTime   *time.Time      `json:"latest_sealed_time,omitempty"`
+	LagBlocks          *uint64         `json:"lag_blocks,omitempty"`
+	LagSeconds         *float64        `json:"lag_seconds,omitempty"`
+	LastError          string          `json:"last_error,omitempty"`
+	LastErrorAt        *time.Time      `json:"last_error_at,omitempty"`
+	ChainError         string          `json:"chain_error,omitempty"`
+	Handlers           []handlerStatus `json:"handlers"`
+}
+
+// typeCount adalah hasil GROUP BY type
+type typeCount struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+// --- HANDLER BARU: GET /status/indexer ---
+// Posisi indexer (checkpoint) dibanding block sealed terbaru,
+// error terakhir, dan jumlah event per handler.
+// Jika access node tidak bisa dihubungi, lag dikosongkan dan 'chain_error' diisi.
+func (h *Handler) getIndexerStatus(c echo.Context) error {
+	ctx := c.Request().Context()
+	var resp indexerStatusResponse
+
+	// 1. Checkpoint indexer
+	cp, err := utils.GetCheckpoint(ctx, h.DB, utils.IndexerCheckpoint)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if cp != nil {
+		resp.CheckpointHeight = cp.BlockHeight
+		resp.CheckpointUpdated = &cp.UpdatedAt
+		if !cp.BlockTime.IsZero() {
+			resp.CheckpointTime = &cp.BlockTime
+		}
+		resp.LastError = cp.LastError
+		resp.LastErrorAt = cp.LastErrorAt
+	}
+
+	// 2. Block sealed terbaru dari access node
+	if h.Flow == nil {
+		resp.ChainError = "access node tidak dikonfigurasi"
+	} else if header, err := h.Flow.GetLatestBlockHeader(ctx, true); err != nil {
+		resp.ChainError = err.Error()
+	} else {
+		resp.LatestSealedHeight = header.Height
+		resp.LatestSealedTime = &header.Timestamp
+
+		// 3. Lag (hanya jika indexer sudah punya checkpoint)
+		if cp != nil {
+			var lagBlocks uint64
+			if header.Height > cp.BlockHeight {
+				lagBlocks = header.Height - cp.BlockHeight
+			}
+			resp.LagBlocks = &lagBlocks
+			if resp.CheckpointTime != nil {
+				lagSeconds := math.Max(0, header.Timestamp.Sub(cp.BlockTime).Seconds())
+				resp.LagSeconds = &lagSeconds
+			}
+		}
+	}
+
+	// 4. Jumlah event per tipe: dijurnal, dead letter, menunggu dependensi
+	// Jumlah yang dijurnal dibaca dari 'event_counts' (ditambah setiap block),
+	// bukan dihitung ulang dari 'raw_events' yang terus bertambah
+	var failed, pending []typeCount
+	processed, err := h.DB.EventCount.Query().All(ctx)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := h.DB.DeadLetter.Query().
+		GroupBy(deadletter.FieldType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &failed); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+	if err := h.DB.PendingEvent.Query().
+		GroupBy(pendingevent.FieldType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &pending); err != nil {
+		return c.JSON(http.StatusInternalServerError, APIResponse{Error: err.Error()})
+	}
+
+	byType := make(map[string]*handlerStatus)
+	status := func(eventType string) *handlerStatus {
+		s, ok := byType[eventType]
+		if !ok {
+			s = &handlerStatus{Type: eventType}
+			byType[eventType] = s
+		}
+		return s
+	}
+	for _, row := range processed {
+		status(row.Type).Processed = row.Count
+	}
+	for _, row := range failed {
+		status(row.Type).Failed = row.Count
+	}
+	for _, row := range pending {
+		status(row.Type).Pending = row.Count
+	}
+
+	resp.Handlers = make([]handlerStatus, 0, len(byType))
+	for _, s := range byType {
+		resp.Handlers = append(resp.Handlers, *s)
+	}
+	sort.Slice(resp.Handlers, func(i, j int) bool {
+		return resp.Handlers[i].Type < resp.Handlers[j].Type
+	})
+
+	return c.JSON(http.StatusOK, APIResponse{Data: resp})
+}
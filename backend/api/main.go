@@ -1,28 +1,48 @@
 package main
 
 import (
+	"backend/ent/transfer"
+	"backend/transactions"
 	"backend/utils"
 	"context"
+	"errors"
 	"log"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/onflow/flow-go-sdk/access/grpc"
 )
 
+// Batas waktu menunggu request yang sedang berjalan saat shutdown
+const shutdownTimeout = 90 * time.Second
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("Warning: .env file not found, using environment variables from system:", err)
 	}
 
+	// Profil jaringan (FLOW_NETWORK) dari flow.json, dipakai oleh transaksi admin
+	network, err := utils.LoadNetwork()
+	if err != nil {
+		log.Fatalf("gagal memuat profil jaringan: %v", err)
+	}
+
 	client := utils.Open(os.Getenv("DATABASE_URL"))
 	defer client.Close()
 
-	ctx := context.Background()
-	if err := client.Schema.Create(ctx); err != nil {
-		log.Fatalf("gagal membuat skema: %v", err)
+	// SIGINT/SIGTERM memulai graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := utils.Migrate(ctx, client); err != nil {
+		log.Fatal(err)
 	}
 
 	e := echo.New()
@@ -33,17 +53,60 @@ func main() {
 	// Tambahkan CORS (PENTING untuk frontend React Anda)
 	e.Use(middleware.CORS())
 
-	h := &Handler{DB: client}
+	// Access node untuk status indexer & transaksi admin; gagal di sini tidak menghentikan API
+	flowClient, err := grpc.NewClient(network.AccessHost)
+	if err != nil {
+		log.Printf("gagal membuat client access node: %v", err)
+		flowClient = nil
+	} else {
+		defer flowClient.Close()
+	}
+
+	// Signer admin dibuat sekali dan dipakai semua transaksi admin (mint).
+	// Akun admin baru dimuat saat mint pertama, jadi access node yang belum
+	// siap saat start tidak mematikan mint sampai restart.
+	var txService *transactions.TxService
+	if flowClient != nil {
+		txService, err = transactions.NewTxService(flowClient, network, os.Getenv("PRIVATE_KEY"))
+		if err != nil {
+			log.Printf("transaksi admin tidak aktif: %v", err)
+		}
+	}
+
+	h := &Handler{DB: client, Flow: flowClient, Tx: txService}
 
 	e.GET("/listings", h.getListings)
+	e.GET("/sales", h.getSales)
 	e.GET("/events", h.getEvents)
+	e.GET("/events/:id/moments", h.getMoments)
+	e.GET("/profiles", h.getUserProfiles)
 	e.GET("/profiles/:address", h.getUserProfile)
+	e.GET("/profiles/:address/receipts", h.getUserReceipts)
 	e.GET("/accessories", h.getAccessories)
+	e.GET("/accessories/:id/history", h.getNFTHistory(transfer.NftTypeAccessory))
 	e.GET("/moments", h.getMoments)
+	e.GET("/moments/:id/history", h.getNFTHistory(transfer.NftTypeMoment))
+	e.GET("/status/indexer", h.getIndexerStatus)
 
 	e.POST("/moment/free", h.freeMintMoment)
 	e.POST("/moment/with-event-pass", h.mintMomentWithEventPass)
 
-	log.Println("Server API dimulai di http://localhost:8000")
-	e.Logger.Fatal(e.Start(":8000"))
+	// Server berjalan di goroutine terpisah; main menunggu SIGINT/SIGTERM
+	go func() {
+		log.Println("Server API dimulai di http://localhost:8000")
+		if err := e.Start(":8000"); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("server API berhenti: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Println("Menghentikan server API, menunggu request yang sedang berjalan...")
+
+	// Beri waktu cukup untuk mint yang sedang menunggu WaitForSeal (timeout 60 detik)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(shutdownCtx); err != nil {
+		log.Printf("gagal menghentikan server API dengan bersih: %v", err)
+	}
+	log.Println("Server API berhenti.")
 }
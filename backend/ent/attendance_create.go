@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +21,7 @@ type AttendanceCreate struct {
 	config
 	mutation *AttendanceMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCheckedIn sets the "checked_in" field.
@@ -157,6 +159,7 @@ func (_c *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 		_node = &Attendance{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(attendance.Table, sqlgraph.NewFieldSpec(attendance.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.CheckedIn(); ok {
 		_spec.SetField(attendance.FieldCheckedIn, field.TypeBool, value)
 		_node.CheckedIn = value
@@ -202,11 +205,186 @@ func (_c *AttendanceCreate) createSpec() (*Attendance, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Attendance.Create().
+//		SetCheckedIn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceUpsert) {
+//			SetCheckedIn(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttendanceCreate) OnConflict(opts ...sql.ConflictOption) *AttendanceUpsertOne {
+	_c.conflict = opts
+	return &AttendanceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttendanceCreate) OnConflictColumns(columns ...string) *AttendanceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AttendanceUpsertOne is the builder for "upsert"-ing
+	//  one Attendance node.
+	AttendanceUpsertOne struct {
+		create *AttendanceCreate
+	}
+
+	// AttendanceUpsert is the "OnConflict" setter.
+	AttendanceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCheckedIn sets the "checked_in" field.
+func (u *AttendanceUpsert) SetCheckedIn(v bool) *AttendanceUpsert {
+	u.Set(attendance.FieldCheckedIn, v)
+	return u
+}
+
+// UpdateCheckedIn sets the "checked_in" field to the value that was provided on create.
+func (u *AttendanceUpsert) UpdateCheckedIn() *AttendanceUpsert {
+	u.SetExcluded(attendance.FieldCheckedIn)
+	return u
+}
+
+// SetRegistrationTime sets the "registration_time" field.
+func (u *AttendanceUpsert) SetRegistrationTime(v time.Time) *AttendanceUpsert {
+	u.Set(attendance.FieldRegistrationTime, v)
+	return u
+}
+
+// UpdateRegistrationTime sets the "registration_time" field to the value that was provided on create.
+func (u *AttendanceUpsert) UpdateRegistrationTime() *AttendanceUpsert {
+	u.SetExcluded(attendance.FieldRegistrationTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceUpsertOne) UpdateNewValues() *AttendanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AttendanceUpsertOne) Ignore() *AttendanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceUpsertOne) DoNothing() *AttendanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceCreate.OnConflict
+// documentation for more info.
+func (u *AttendanceUpsertOne) Update(set func(*AttendanceUpsert)) *AttendanceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCheckedIn sets the "checked_in" field.
+func (u *AttendanceUpsertOne) SetCheckedIn(v bool) *AttendanceUpsertOne {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.SetCheckedIn(v)
+	})
+}
+
+// UpdateCheckedIn sets the "checked_in" field to the value that was provided on create.
+func (u *AttendanceUpsertOne) UpdateCheckedIn() *AttendanceUpsertOne {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.UpdateCheckedIn()
+	})
+}
+
+// SetRegistrationTime sets the "registration_time" field.
+func (u *AttendanceUpsertOne) SetRegistrationTime(v time.Time) *AttendanceUpsertOne {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.SetRegistrationTime(v)
+	})
+}
+
+// UpdateRegistrationTime sets the "registration_time" field to the value that was provided on create.
+func (u *AttendanceUpsertOne) UpdateRegistrationTime() *AttendanceUpsertOne {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.UpdateRegistrationTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AttendanceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AttendanceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AttendanceCreateBulk is the builder for creating many Attendance entities in bulk.
 type AttendanceCreateBulk struct {
 	config
 	err      error
 	builders []*AttendanceCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Attendance entities in the database.
@@ -236,6 +414,7 @@ func (_c *AttendanceCreateBulk) Save(ctx context.Context) ([]*Attendance, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -289,3 +468,135 @@ func (_c *AttendanceCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Attendance.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AttendanceUpsert) {
+//			SetCheckedIn(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AttendanceCreateBulk) OnConflict(opts ...sql.ConflictOption) *AttendanceUpsertBulk {
+	_c.conflict = opts
+	return &AttendanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AttendanceCreateBulk) OnConflictColumns(columns ...string) *AttendanceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AttendanceUpsertBulk{
+		create: _c,
+	}
+}
+
+// AttendanceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Attendance nodes.
+type AttendanceUpsertBulk struct {
+	create *AttendanceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AttendanceUpsertBulk) UpdateNewValues() *AttendanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Attendance.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AttendanceUpsertBulk) Ignore() *AttendanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AttendanceUpsertBulk) DoNothing() *AttendanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AttendanceCreateBulk.OnConflict
+// documentation for more info.
+func (u *AttendanceUpsertBulk) Update(set func(*AttendanceUpsert)) *AttendanceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AttendanceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCheckedIn sets the "checked_in" field.
+func (u *AttendanceUpsertBulk) SetCheckedIn(v bool) *AttendanceUpsertBulk {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.SetCheckedIn(v)
+	})
+}
+
+// UpdateCheckedIn sets the "checked_in" field to the value that was provided on create.
+func (u *AttendanceUpsertBulk) UpdateCheckedIn() *AttendanceUpsertBulk {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.UpdateCheckedIn()
+	})
+}
+
+// SetRegistrationTime sets the "registration_time" field.
+func (u *AttendanceUpsertBulk) SetRegistrationTime(v time.Time) *AttendanceUpsertBulk {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.SetRegistrationTime(v)
+	})
+}
+
+// UpdateRegistrationTime sets the "registration_time" field to the value that was provided on create.
+func (u *AttendanceUpsertBulk) UpdateRegistrationTime() *AttendanceUpsertBulk {
+	return u.Update(func(s *AttendanceUpsert) {
+		s.UpdateRegistrationTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AttendanceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AttendanceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AttendanceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AttendanceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
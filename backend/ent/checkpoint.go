@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkpoint"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Checkpoint is the model entity for the Checkpoint schema.
+type Checkpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// BlockHeight holds the value of the "block_height" field.
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// BlockID holds the value of the "block_id" field.
+	BlockID string `json:"block_id,omitempty"`
+	// BlockTime holds the value of the "block_time" field.
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// LastErrorAt holds the value of the "last_error_at" field.
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checkpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID, checkpoint.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case checkpoint.FieldName, checkpoint.FieldBlockID, checkpoint.FieldLastError:
+			values[i] = new(sql.NullString)
+		case checkpoint.FieldBlockTime, checkpoint.FieldLastErrorAt, checkpoint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checkpoint fields.
+func (_m *Checkpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkpoint.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case checkpoint.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case checkpoint.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = uint64(value.Int64)
+			}
+		case checkpoint.FieldBlockID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_id", values[i])
+			} else if value.Valid {
+				_m.BlockID = value.String
+			}
+		case checkpoint.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case checkpoint.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case checkpoint.FieldLastErrorAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error_at", values[i])
+			} else if value.Valid {
+				_m.LastErrorAt = new(time.Time)
+				*_m.LastErrorAt = value.Time
+			}
+		case checkpoint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checkpoint.
+// This includes values selected through modifiers, order, etc.
+func (_m *Checkpoint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Checkpoint.
+// Note that you need to call Checkpoint.Unwrap() before calling this method if this Checkpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Checkpoint) Update() *CheckpointUpdateOne {
+	return NewCheckpointClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Checkpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Checkpoint) Unwrap() *Checkpoint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Checkpoint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Checkpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checkpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("block_id=")
+	builder.WriteString(_m.BlockID)
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	if v := _m.LastErrorAt; v != nil {
+		builder.WriteString("last_error_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Checkpoints is a parsable slice of Checkpoint.
+type Checkpoints []*Checkpoint
@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the checkpoint type in the database.
+	Label = "checkpoint"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldBlockID holds the string denoting the block_id field in the database.
+	FieldBlockID = "block_id"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastErrorAt holds the string denoting the last_error_at field in the database.
+	FieldLastErrorAt = "last_error_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the checkpoint in the database.
+	Table = "checkpoints"
+)
+
+// Columns holds all SQL columns for checkpoint fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldBlockHeight,
+	FieldBlockID,
+	FieldBlockTime,
+	FieldLastError,
+	FieldLastErrorAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Checkpoint queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByBlockID orders the results by the block_id field.
+func ByBlockID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockID, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastErrorAt orders the results by the last_error_at field.
+func ByLastErrorAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastErrorAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkpoint
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockID applies equality check predicate on the "block_id" field. It's identical to BlockIDEQ.
+func BlockID(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorAt applies equality check predicate on the "last_error_at" field. It's identical to LastErrorAtEQ.
+func LastErrorAt(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldLastErrorAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldName, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// BlockIDEQ applies the EQ predicate on the "block_id" field.
+func BlockIDEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockIDNEQ applies the NEQ predicate on the "block_id" field.
+func BlockIDNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldBlockID, v))
+}
+
+// BlockIDIn applies the In predicate on the "block_id" field.
+func BlockIDIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldBlockID, vs...))
+}
+
+// BlockIDNotIn applies the NotIn predicate on the "block_id" field.
+func BlockIDNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldBlockID, vs...))
+}
+
+// BlockIDGT applies the GT predicate on the "block_id" field.
+func BlockIDGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldBlockID, v))
+}
+
+// BlockIDGTE applies the GTE predicate on the "block_id" field.
+func BlockIDGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldBlockID, v))
+}
+
+// BlockIDLT applies the LT predicate on the "block_id" field.
+func BlockIDLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldBlockID, v))
+}
+
+// BlockIDLTE applies the LTE predicate on the "block_id" field.
+func BlockIDLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldBlockID, v))
+}
+
+// BlockIDContains applies the Contains predicate on the "block_id" field.
+func BlockIDContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldBlockID, v))
+}
+
+// BlockIDHasPrefix applies the HasPrefix predicate on the "block_id" field.
+func BlockIDHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldBlockID, v))
+}
+
+// BlockIDHasSuffix applies the HasSuffix predicate on the "block_id" field.
+func BlockIDHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldBlockID, v))
+}
+
+// BlockIDEqualFold applies the EqualFold predicate on the "block_id" field.
+func BlockIDEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldBlockID, v))
+}
+
+// BlockIDContainsFold applies the ContainsFold predicate on the "block_id" field.
+func BlockIDContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldBlockID, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// BlockTimeIsNil applies the IsNil predicate on the "block_time" field.
+func BlockTimeIsNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIsNull(FieldBlockTime))
+}
+
+// BlockTimeNotNil applies the NotNil predicate on the "block_time" field.
+func BlockTimeNotNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotNull(FieldBlockTime))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastErrorAtEQ applies the EQ predicate on the "last_error_at" field.
+func LastErrorAtEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldLastErrorAt, v))
+}
+
+// LastErrorAtNEQ applies the NEQ predicate on the "last_error_at" field.
+func LastErrorAtNEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldLastErrorAt, v))
+}
+
+// LastErrorAtIn applies the In predicate on the "last_error_at" field.
+func LastErrorAtIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldLastErrorAt, vs...))
+}
+
+// LastErrorAtNotIn applies the NotIn predicate on the "last_error_at" field.
+func LastErrorAtNotIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldLastErrorAt, vs...))
+}
+
+// LastErrorAtGT applies the GT predicate on the "last_error_at" field.
+func LastErrorAtGT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldLastErrorAt, v))
+}
+
+// LastErrorAtGTE applies the GTE predicate on the "last_error_at" field.
+func LastErrorAtGTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldLastErrorAt, v))
+}
+
+// LastErrorAtLT applies the LT predicate on the "last_error_at" field.
+func LastErrorAtLT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldLastErrorAt, v))
+}
+
+// LastErrorAtLTE applies the LTE predicate on the "last_error_at" field.
+func LastErrorAtLTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldLastErrorAt, v))
+}
+
+// LastErrorAtIsNil applies the IsNil predicate on the "last_error_at" field.
+func LastErrorAtIsNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIsNull(FieldLastErrorAt))
+}
+
+// LastErrorAtNotNil applies the NotNil predicate on the "last_error_at" field.
+func LastErrorAtNotNil() predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotNull(FieldLastErrorAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Checkpoint) predicate.Checkpoint {
+	return predicate.Checkpoint(sql.NotPredicates(p))
+}
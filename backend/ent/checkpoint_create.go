@@ -0,0 +1,886 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkpoint"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckpointCreate is the builder for creating a Checkpoint entity.
+type CheckpointCreate struct {
+	config
+	mutation *CheckpointMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (_c *CheckpointCreate) SetName(v string) *CheckpointCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *CheckpointCreate) SetBlockHeight(v uint64) *CheckpointCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetBlockID sets the "block_id" field.
+func (_c *CheckpointCreate) SetBlockID(v string) *CheckpointCreate {
+	_c.mutation.SetBlockID(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *CheckpointCreate) SetBlockTime(v time.Time) *CheckpointCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_c *CheckpointCreate) SetNillableBlockTime(v *time.Time) *CheckpointCreate {
+	if v != nil {
+		_c.SetBlockTime(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CheckpointCreate) SetLastError(v string) *CheckpointCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CheckpointCreate) SetNillableLastError(v *string) *CheckpointCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (_c *CheckpointCreate) SetLastErrorAt(v time.Time) *CheckpointCreate {
+	_c.mutation.SetLastErrorAt(v)
+	return _c
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (_c *CheckpointCreate) SetNillableLastErrorAt(v *time.Time) *CheckpointCreate {
+	if v != nil {
+		_c.SetLastErrorAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CheckpointCreate) SetUpdatedAt(v time.Time) *CheckpointCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CheckpointCreate) SetNillableUpdatedAt(v *time.Time) *CheckpointCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (_c *CheckpointCreate) Mutation() *CheckpointMutation {
+	return _c.mutation
+}
+
+// Save creates the Checkpoint in the database.
+func (_c *CheckpointCreate) Save(ctx context.Context) (*Checkpoint, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CheckpointCreate) SaveX(ctx context.Context) *Checkpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckpointCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckpointCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CheckpointCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := checkpoint.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CheckpointCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Checkpoint.name"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "Checkpoint.block_height"`)}
+	}
+	if _, ok := _c.mutation.BlockID(); !ok {
+		return &ValidationError{Name: "block_id", err: errors.New(`ent: missing required field "Checkpoint.block_id"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Checkpoint.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CheckpointCreate) sqlSave(ctx context.Context) (*Checkpoint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CheckpointCreate) createSpec() (*Checkpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Checkpoint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(checkpoint.Table, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(checkpoint.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.BlockID(); ok {
+		_spec.SetField(checkpoint.FieldBlockID, field.TypeString, value)
+		_node.BlockID = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(checkpoint.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(checkpoint.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.LastErrorAt(); ok {
+		_spec.SetField(checkpoint.FieldLastErrorAt, field.TypeTime, value)
+		_node.LastErrorAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkpoint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkpoint.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckpointUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CheckpointCreate) OnConflict(opts ...sql.ConflictOption) *CheckpointUpsertOne {
+	_c.conflict = opts
+	return &CheckpointUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CheckpointCreate) OnConflictColumns(columns ...string) *CheckpointUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CheckpointUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CheckpointUpsertOne is the builder for "upsert"-ing
+	//  one Checkpoint node.
+	CheckpointUpsertOne struct {
+		create *CheckpointCreate
+	}
+
+	// CheckpointUpsert is the "OnConflict" setter.
+	CheckpointUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsert) SetName(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateName() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldName)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *CheckpointUpsert) SetBlockHeight(v uint64) *CheckpointUpsert {
+	u.Set(checkpoint.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateBlockHeight() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *CheckpointUpsert) AddBlockHeight(v uint64) *CheckpointUpsert {
+	u.Add(checkpoint.FieldBlockHeight, v)
+	return u
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *CheckpointUpsert) SetBlockID(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldBlockID, v)
+	return u
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateBlockID() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldBlockID)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *CheckpointUpsert) SetBlockTime(v time.Time) *CheckpointUpsert {
+	u.Set(checkpoint.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateBlockTime() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldBlockTime)
+	return u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *CheckpointUpsert) ClearBlockTime() *CheckpointUpsert {
+	u.SetNull(checkpoint.FieldBlockTime)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CheckpointUpsert) SetLastError(v string) *CheckpointUpsert {
+	u.Set(checkpoint.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateLastError() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CheckpointUpsert) ClearLastError() *CheckpointUpsert {
+	u.SetNull(checkpoint.FieldLastError)
+	return u
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *CheckpointUpsert) SetLastErrorAt(v time.Time) *CheckpointUpsert {
+	u.Set(checkpoint.FieldLastErrorAt, v)
+	return u
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateLastErrorAt() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldLastErrorAt)
+	return u
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *CheckpointUpsert) ClearLastErrorAt() *CheckpointUpsert {
+	u.SetNull(checkpoint.FieldLastErrorAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckpointUpsert) SetUpdatedAt(v time.Time) *CheckpointUpsert {
+	u.Set(checkpoint.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckpointUpsert) UpdateUpdatedAt() *CheckpointUpsert {
+	u.SetExcluded(checkpoint.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheckpointUpsertOne) UpdateNewValues() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CheckpointUpsertOne) Ignore() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckpointUpsertOne) DoNothing() *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckpointCreate.OnConflict
+// documentation for more info.
+func (u *CheckpointUpsertOne) Update(set func(*CheckpointUpsert)) *CheckpointUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsertOne) SetName(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateName() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *CheckpointUpsertOne) SetBlockHeight(v uint64) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *CheckpointUpsertOne) AddBlockHeight(v uint64) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateBlockHeight() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *CheckpointUpsertOne) SetBlockID(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateBlockID() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *CheckpointUpsertOne) SetBlockTime(v time.Time) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateBlockTime() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *CheckpointUpsertOne) ClearBlockTime() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CheckpointUpsertOne) SetLastError(v string) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateLastError() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CheckpointUpsertOne) ClearLastError() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *CheckpointUpsertOne) SetLastErrorAt(v time.Time) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetLastErrorAt(v)
+	})
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateLastErrorAt() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateLastErrorAt()
+	})
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *CheckpointUpsertOne) ClearLastErrorAt() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearLastErrorAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckpointUpsertOne) SetUpdatedAt(v time.Time) *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckpointUpsertOne) UpdateUpdatedAt() *CheckpointUpsertOne {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckpointUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheckpointCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckpointUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CheckpointUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CheckpointUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CheckpointCreateBulk is the builder for creating many Checkpoint entities in bulk.
+type CheckpointCreateBulk struct {
+	config
+	err      error
+	builders []*CheckpointCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Checkpoint entities in the database.
+func (_c *CheckpointCreateBulk) Save(ctx context.Context) ([]*Checkpoint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Checkpoint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CheckpointCreateBulk) SaveX(ctx context.Context) []*Checkpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CheckpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CheckpointCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkpoint.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckpointUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CheckpointCreateBulk) OnConflict(opts ...sql.ConflictOption) *CheckpointUpsertBulk {
+	_c.conflict = opts
+	return &CheckpointUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CheckpointCreateBulk) OnConflictColumns(columns ...string) *CheckpointUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CheckpointUpsertBulk{
+		create: _c,
+	}
+}
+
+// CheckpointUpsertBulk is the builder for "upsert"-ing
+// a bulk of Checkpoint nodes.
+type CheckpointUpsertBulk struct {
+	create *CheckpointCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheckpointUpsertBulk) UpdateNewValues() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkpoint.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CheckpointUpsertBulk) Ignore() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckpointUpsertBulk) DoNothing() *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckpointCreateBulk.OnConflict
+// documentation for more info.
+func (u *CheckpointUpsertBulk) Update(set func(*CheckpointUpsert)) *CheckpointUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckpointUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CheckpointUpsertBulk) SetName(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateName() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *CheckpointUpsertBulk) SetBlockHeight(v uint64) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *CheckpointUpsertBulk) AddBlockHeight(v uint64) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateBlockHeight() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *CheckpointUpsertBulk) SetBlockID(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateBlockID() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *CheckpointUpsertBulk) SetBlockTime(v time.Time) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateBlockTime() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *CheckpointUpsertBulk) ClearBlockTime() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *CheckpointUpsertBulk) SetLastError(v string) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateLastError() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *CheckpointUpsertBulk) ClearLastError() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (u *CheckpointUpsertBulk) SetLastErrorAt(v time.Time) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetLastErrorAt(v)
+	})
+}
+
+// UpdateLastErrorAt sets the "last_error_at" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateLastErrorAt() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateLastErrorAt()
+	})
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (u *CheckpointUpsertBulk) ClearLastErrorAt() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.ClearLastErrorAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckpointUpsertBulk) SetUpdatedAt(v time.Time) *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckpointUpsertBulk) UpdateUpdatedAt() *CheckpointUpsertBulk {
+	return u.Update(func(s *CheckpointUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckpointUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CheckpointCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheckpointCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckpointUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
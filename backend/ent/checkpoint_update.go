@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/checkpoint"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CheckpointUpdate is the builder for updating Checkpoint entities.
+type CheckpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheckpointMutation
+}
+
+// Where appends a list predicates to the CheckpointUpdate builder.
+func (_u *CheckpointUpdate) Where(ps ...predicate.Checkpoint) *CheckpointUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CheckpointUpdate) SetName(v string) *CheckpointUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableName(v *string) *CheckpointUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *CheckpointUpdate) SetBlockHeight(v uint64) *CheckpointUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableBlockHeight(v *uint64) *CheckpointUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *CheckpointUpdate) AddBlockHeight(v int64) *CheckpointUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *CheckpointUpdate) SetBlockID(v string) *CheckpointUpdate {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableBlockID(v *string) *CheckpointUpdate {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *CheckpointUpdate) SetBlockTime(v time.Time) *CheckpointUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableBlockTime(v *time.Time) *CheckpointUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *CheckpointUpdate) ClearBlockTime() *CheckpointUpdate {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CheckpointUpdate) SetLastError(v string) *CheckpointUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableLastError(v *string) *CheckpointUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CheckpointUpdate) ClearLastError() *CheckpointUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (_u *CheckpointUpdate) SetLastErrorAt(v time.Time) *CheckpointUpdate {
+	_u.mutation.SetLastErrorAt(v)
+	return _u
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (_u *CheckpointUpdate) SetNillableLastErrorAt(v *time.Time) *CheckpointUpdate {
+	if v != nil {
+		_u.SetLastErrorAt(*v)
+	}
+	return _u
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (_u *CheckpointUpdate) ClearLastErrorAt() *CheckpointUpdate {
+	_u.mutation.ClearLastErrorAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckpointUpdate) SetUpdatedAt(v time.Time) *CheckpointUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (_u *CheckpointUpdate) Mutation() *CheckpointMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CheckpointUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CheckpointUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckpointUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckpointUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CheckpointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(checkpoint.Table, checkpoint.Columns, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(checkpoint.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(checkpoint.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(checkpoint.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(checkpoint.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(checkpoint.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(checkpoint.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(checkpoint.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastErrorAt(); ok {
+		_spec.SetField(checkpoint.FieldLastErrorAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastErrorAtCleared() {
+		_spec.ClearField(checkpoint.FieldLastErrorAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CheckpointUpdateOne is the builder for updating a single Checkpoint entity.
+type CheckpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheckpointMutation
+}
+
+// SetName sets the "name" field.
+func (_u *CheckpointUpdateOne) SetName(v string) *CheckpointUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableName(v *string) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *CheckpointUpdateOne) SetBlockHeight(v uint64) *CheckpointUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableBlockHeight(v *uint64) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *CheckpointUpdateOne) AddBlockHeight(v int64) *CheckpointUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *CheckpointUpdateOne) SetBlockID(v string) *CheckpointUpdateOne {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableBlockID(v *string) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *CheckpointUpdateOne) SetBlockTime(v time.Time) *CheckpointUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableBlockTime(v *time.Time) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *CheckpointUpdateOne) ClearBlockTime() *CheckpointUpdateOne {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CheckpointUpdateOne) SetLastError(v string) *CheckpointUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableLastError(v *string) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CheckpointUpdateOne) ClearLastError() *CheckpointUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetLastErrorAt sets the "last_error_at" field.
+func (_u *CheckpointUpdateOne) SetLastErrorAt(v time.Time) *CheckpointUpdateOne {
+	_u.mutation.SetLastErrorAt(v)
+	return _u
+}
+
+// SetNillableLastErrorAt sets the "last_error_at" field if the given value is not nil.
+func (_u *CheckpointUpdateOne) SetNillableLastErrorAt(v *time.Time) *CheckpointUpdateOne {
+	if v != nil {
+		_u.SetLastErrorAt(*v)
+	}
+	return _u
+}
+
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (_u *CheckpointUpdateOne) ClearLastErrorAt() *CheckpointUpdateOne {
+	_u.mutation.ClearLastErrorAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CheckpointUpdateOne) SetUpdatedAt(v time.Time) *CheckpointUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CheckpointMutation object of the builder.
+func (_u *CheckpointUpdateOne) Mutation() *CheckpointMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CheckpointUpdate builder.
+func (_u *CheckpointUpdateOne) Where(ps ...predicate.Checkpoint) *CheckpointUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CheckpointUpdateOne) Select(field string, fields ...string) *CheckpointUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Checkpoint entity.
+func (_u *CheckpointUpdateOne) Save(ctx context.Context) (*Checkpoint, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CheckpointUpdateOne) SaveX(ctx context.Context) *Checkpoint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CheckpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CheckpointUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CheckpointUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := checkpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *CheckpointUpdateOne) sqlSave(ctx context.Context) (_node *Checkpoint, err error) {
+	_spec := sqlgraph.NewUpdateSpec(checkpoint.Table, checkpoint.Columns, sqlgraph.NewFieldSpec(checkpoint.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Checkpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkpoint.FieldID)
+		for _, f := range fields {
+			if !checkpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checkpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(checkpoint.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(checkpoint.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(checkpoint.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(checkpoint.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(checkpoint.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(checkpoint.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(checkpoint.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(checkpoint.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastErrorAt(); ok {
+		_spec.SetField(checkpoint.FieldLastErrorAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastErrorAtCleared() {
+		_spec.ClearField(checkpoint.FieldLastErrorAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Checkpoint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/deadletter"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DeadLetter is the model entity for the DeadLetter schema.
+type DeadLetter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BlockHeight holds the value of the "block_height" field.
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// BlockID holds the value of the "block_id" field.
+	BlockID string `json:"block_id,omitempty"`
+	// BlockTime holds the value of the "block_time" field.
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// TxID holds the value of the "tx_id" field.
+	TxID string `json:"tx_id,omitempty"`
+	// TxIndex holds the value of the "tx_index" field.
+	TxIndex int `json:"tx_index,omitempty"`
+	// EventIndex holds the value of the "event_index" field.
+	EventIndex int `json:"event_index,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextRetryAt holds the value of the "next_retry_at" field.
+	NextRetryAt time.Time `json:"next_retry_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeadLetter) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldPayload:
+			values[i] = new([]byte)
+		case deadletter.FieldID, deadletter.FieldBlockHeight, deadletter.FieldTxIndex, deadletter.FieldEventIndex, deadletter.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case deadletter.FieldBlockID, deadletter.FieldTxID, deadletter.FieldType, deadletter.FieldError:
+			values[i] = new(sql.NullString)
+		case deadletter.FieldBlockTime, deadletter.FieldNextRetryAt, deadletter.FieldCreatedAt, deadletter.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeadLetter fields.
+func (_m *DeadLetter) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deadletter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case deadletter.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = uint64(value.Int64)
+			}
+		case deadletter.FieldBlockID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_id", values[i])
+			} else if value.Valid {
+				_m.BlockID = value.String
+			}
+		case deadletter.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case deadletter.FieldTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_id", values[i])
+			} else if value.Valid {
+				_m.TxID = value.String
+			}
+		case deadletter.FieldTxIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_index", values[i])
+			} else if value.Valid {
+				_m.TxIndex = int(value.Int64)
+			}
+		case deadletter.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case deadletter.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case deadletter.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case deadletter.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case deadletter.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case deadletter.FieldNextRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_retry_at", values[i])
+			} else if value.Valid {
+				_m.NextRetryAt = value.Time
+			}
+		case deadletter.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case deadletter.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeadLetter.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeadLetter) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeadLetter.
+// Note that you need to call DeadLetter.Unwrap() before calling this method if this DeadLetter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeadLetter) Update() *DeadLetterUpdateOne {
+	return NewDeadLetterClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeadLetter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeadLetter) Unwrap() *DeadLetter {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeadLetter is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeadLetter) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeadLetter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("block_id=")
+	builder.WriteString(_m.BlockID)
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tx_id=")
+	builder.WriteString(_m.TxID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxIndex))
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_retry_at=")
+	builder.WriteString(_m.NextRetryAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeadLetters is a parsable slice of DeadLetter.
+type DeadLetters []*DeadLetter
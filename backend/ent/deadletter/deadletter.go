@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the deadletter type in the database.
+	Label = "dead_letter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldBlockID holds the string denoting the block_id field in the database.
+	FieldBlockID = "block_id"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
+	// FieldTxID holds the string denoting the tx_id field in the database.
+	FieldTxID = "tx_id"
+	// FieldTxIndex holds the string denoting the tx_index field in the database.
+	FieldTxIndex = "tx_index"
+	// FieldEventIndex holds the string denoting the event_index field in the database.
+	FieldEventIndex = "event_index"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextRetryAt holds the string denoting the next_retry_at field in the database.
+	FieldNextRetryAt = "next_retry_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the deadletter in the database.
+	Table = "dead_letters"
+)
+
+// Columns holds all SQL columns for deadletter fields.
+var Columns = []string{
+	FieldID,
+	FieldBlockHeight,
+	FieldBlockID,
+	FieldBlockTime,
+	FieldTxID,
+	FieldTxIndex,
+	FieldEventIndex,
+	FieldType,
+	FieldPayload,
+	FieldError,
+	FieldAttempts,
+	FieldNextRetryAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DeadLetter queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByBlockID orders the results by the block_id field.
+func ByBlockID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockID, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
+// ByTxID orders the results by the tx_id field.
+func ByTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxID, opts...).ToFunc()
+}
+
+// ByTxIndex orders the results by the tx_index field.
+func ByTxIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxIndex, opts...).ToFunc()
+}
+
+// ByEventIndex orders the results by the event_index field.
+func ByEventIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventIndex, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNextRetryAt orders the results by the next_retry_at field.
+func ByNextRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRetryAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
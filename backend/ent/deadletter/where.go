@@ -0,0 +1,720 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deadletter
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldID, id))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockID applies equality check predicate on the "block_id" field. It's identical to BlockIDEQ.
+func BlockID(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// TxID applies equality check predicate on the "tx_id" field. It's identical to TxIDEQ.
+func TxID(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldType, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldError, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextRetryAt applies equality check predicate on the "next_retry_at" field. It's identical to NextRetryAtEQ.
+func NextRetryAt(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldNextRetryAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// BlockIDEQ applies the EQ predicate on the "block_id" field.
+func BlockIDEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockIDNEQ applies the NEQ predicate on the "block_id" field.
+func BlockIDNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldBlockID, v))
+}
+
+// BlockIDIn applies the In predicate on the "block_id" field.
+func BlockIDIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldBlockID, vs...))
+}
+
+// BlockIDNotIn applies the NotIn predicate on the "block_id" field.
+func BlockIDNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldBlockID, vs...))
+}
+
+// BlockIDGT applies the GT predicate on the "block_id" field.
+func BlockIDGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldBlockID, v))
+}
+
+// BlockIDGTE applies the GTE predicate on the "block_id" field.
+func BlockIDGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldBlockID, v))
+}
+
+// BlockIDLT applies the LT predicate on the "block_id" field.
+func BlockIDLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldBlockID, v))
+}
+
+// BlockIDLTE applies the LTE predicate on the "block_id" field.
+func BlockIDLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldBlockID, v))
+}
+
+// BlockIDContains applies the Contains predicate on the "block_id" field.
+func BlockIDContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldBlockID, v))
+}
+
+// BlockIDHasPrefix applies the HasPrefix predicate on the "block_id" field.
+func BlockIDHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldBlockID, v))
+}
+
+// BlockIDHasSuffix applies the HasSuffix predicate on the "block_id" field.
+func BlockIDHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldBlockID, v))
+}
+
+// BlockIDEqualFold applies the EqualFold predicate on the "block_id" field.
+func BlockIDEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldBlockID, v))
+}
+
+// BlockIDContainsFold applies the ContainsFold predicate on the "block_id" field.
+func BlockIDContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldBlockID, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// BlockTimeIsNil applies the IsNil predicate on the "block_time" field.
+func BlockTimeIsNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIsNull(FieldBlockTime))
+}
+
+// BlockTimeNotNil applies the NotNil predicate on the "block_time" field.
+func BlockTimeNotNil() predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotNull(FieldBlockTime))
+}
+
+// TxIDEQ applies the EQ predicate on the "tx_id" field.
+func TxIDEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIDNEQ applies the NEQ predicate on the "tx_id" field.
+func TxIDNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldTxID, v))
+}
+
+// TxIDIn applies the In predicate on the "tx_id" field.
+func TxIDIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldTxID, vs...))
+}
+
+// TxIDNotIn applies the NotIn predicate on the "tx_id" field.
+func TxIDNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldTxID, vs...))
+}
+
+// TxIDGT applies the GT predicate on the "tx_id" field.
+func TxIDGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldTxID, v))
+}
+
+// TxIDGTE applies the GTE predicate on the "tx_id" field.
+func TxIDGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldTxID, v))
+}
+
+// TxIDLT applies the LT predicate on the "tx_id" field.
+func TxIDLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldTxID, v))
+}
+
+// TxIDLTE applies the LTE predicate on the "tx_id" field.
+func TxIDLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldTxID, v))
+}
+
+// TxIDContains applies the Contains predicate on the "tx_id" field.
+func TxIDContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldTxID, v))
+}
+
+// TxIDHasPrefix applies the HasPrefix predicate on the "tx_id" field.
+func TxIDHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldTxID, v))
+}
+
+// TxIDHasSuffix applies the HasSuffix predicate on the "tx_id" field.
+func TxIDHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldTxID, v))
+}
+
+// TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
+func TxIDEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldTxID, v))
+}
+
+// TxIDContainsFold applies the ContainsFold predicate on the "tx_id" field.
+func TxIDContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldTxID, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldType, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldContainsFold(FieldError, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextRetryAtEQ applies the EQ predicate on the "next_retry_at" field.
+func NextRetryAtEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldNextRetryAt, v))
+}
+
+// NextRetryAtNEQ applies the NEQ predicate on the "next_retry_at" field.
+func NextRetryAtNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldNextRetryAt, v))
+}
+
+// NextRetryAtIn applies the In predicate on the "next_retry_at" field.
+func NextRetryAtIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldNextRetryAt, vs...))
+}
+
+// NextRetryAtNotIn applies the NotIn predicate on the "next_retry_at" field.
+func NextRetryAtNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldNextRetryAt, vs...))
+}
+
+// NextRetryAtGT applies the GT predicate on the "next_retry_at" field.
+func NextRetryAtGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldNextRetryAt, v))
+}
+
+// NextRetryAtGTE applies the GTE predicate on the "next_retry_at" field.
+func NextRetryAtGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldNextRetryAt, v))
+}
+
+// NextRetryAtLT applies the LT predicate on the "next_retry_at" field.
+func NextRetryAtLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldNextRetryAt, v))
+}
+
+// NextRetryAtLTE applies the LTE predicate on the "next_retry_at" field.
+func NextRetryAtLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldNextRetryAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeadLetter) predicate.DeadLetter {
+	return predicate.DeadLetter(sql.NotPredicates(p))
+}
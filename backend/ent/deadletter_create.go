@@ -0,0 +1,1239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/deadletter"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeadLetterCreate is the builder for creating a DeadLetter entity.
+type DeadLetterCreate struct {
+	config
+	mutation *DeadLetterMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *DeadLetterCreate) SetBlockHeight(v uint64) *DeadLetterCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetBlockID sets the "block_id" field.
+func (_c *DeadLetterCreate) SetBlockID(v string) *DeadLetterCreate {
+	_c.mutation.SetBlockID(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *DeadLetterCreate) SetBlockTime(v time.Time) *DeadLetterCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableBlockTime(v *time.Time) *DeadLetterCreate {
+	if v != nil {
+		_c.SetBlockTime(*v)
+	}
+	return _c
+}
+
+// SetTxID sets the "tx_id" field.
+func (_c *DeadLetterCreate) SetTxID(v string) *DeadLetterCreate {
+	_c.mutation.SetTxID(v)
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *DeadLetterCreate) SetTxIndex(v int) *DeadLetterCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *DeadLetterCreate) SetEventIndex(v int) *DeadLetterCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *DeadLetterCreate) SetType(v string) *DeadLetterCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *DeadLetterCreate) SetPayload(v json.RawMessage) *DeadLetterCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *DeadLetterCreate) SetError(v string) *DeadLetterCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *DeadLetterCreate) SetAttempts(v int) *DeadLetterCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableAttempts(v *int) *DeadLetterCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_c *DeadLetterCreate) SetNextRetryAt(v time.Time) *DeadLetterCreate {
+	_c.mutation.SetNextRetryAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeadLetterCreate) SetCreatedAt(v time.Time) *DeadLetterCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableCreatedAt(v *time.Time) *DeadLetterCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeadLetterCreate) SetUpdatedAt(v time.Time) *DeadLetterCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeadLetterCreate) SetNillableUpdatedAt(v *time.Time) *DeadLetterCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_c *DeadLetterCreate) Mutation() *DeadLetterMutation {
+	return _c.mutation
+}
+
+// Save creates the DeadLetter in the database.
+func (_c *DeadLetterCreate) Save(ctx context.Context) (*DeadLetter, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeadLetterCreate) SaveX(ctx context.Context) *DeadLetter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeadLetterCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeadLetterCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeadLetterCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := deadletter.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deadletter.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := deadletter.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeadLetterCreate) check() error {
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "DeadLetter.block_height"`)}
+	}
+	if _, ok := _c.mutation.BlockID(); !ok {
+		return &ValidationError{Name: "block_id", err: errors.New(`ent: missing required field "DeadLetter.block_id"`)}
+	}
+	if _, ok := _c.mutation.TxID(); !ok {
+		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "DeadLetter.tx_id"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "DeadLetter.tx_index"`)}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "DeadLetter.event_index"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "DeadLetter.type"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "DeadLetter.payload"`)}
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		return &ValidationError{Name: "error", err: errors.New(`ent: missing required field "DeadLetter.error"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "DeadLetter.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextRetryAt(); !ok {
+		return &ValidationError{Name: "next_retry_at", err: errors.New(`ent: missing required field "DeadLetter.next_retry_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeadLetter.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeadLetter.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DeadLetterCreate) sqlSave(ctx context.Context) (*DeadLetter, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeadLetterCreate) createSpec() (*DeadLetter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeadLetter{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deadletter.Table, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(deadletter.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.BlockID(); ok {
+		_spec.SetField(deadletter.FieldBlockID, field.TypeString, value)
+		_node.BlockID = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(deadletter.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.TxID(); ok {
+		_spec.SetField(deadletter.FieldTxID, field.TypeString, value)
+		_node.TxID = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(deadletter.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(deadletter.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(deadletter.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+		_node.NextRetryAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.Create().
+//		SetBlockHeight(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetBlockHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeadLetterCreate) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertOne {
+	_c.conflict = opts
+	return &DeadLetterUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeadLetterCreate) OnConflictColumns(columns ...string) *DeadLetterUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeadLetterUpsertOne is the builder for "upsert"-ing
+	//  one DeadLetter node.
+	DeadLetterUpsertOne struct {
+		create *DeadLetterCreate
+	}
+
+	// DeadLetterUpsert is the "OnConflict" setter.
+	DeadLetterUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBlockHeight sets the "block_height" field.
+func (u *DeadLetterUpsert) SetBlockHeight(v uint64) *DeadLetterUpsert {
+	u.Set(deadletter.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateBlockHeight() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *DeadLetterUpsert) AddBlockHeight(v uint64) *DeadLetterUpsert {
+	u.Add(deadletter.FieldBlockHeight, v)
+	return u
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *DeadLetterUpsert) SetBlockID(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldBlockID, v)
+	return u
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateBlockID() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldBlockID)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *DeadLetterUpsert) SetBlockTime(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateBlockTime() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldBlockTime)
+	return u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *DeadLetterUpsert) ClearBlockTime() *DeadLetterUpsert {
+	u.SetNull(deadletter.FieldBlockTime)
+	return u
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *DeadLetterUpsert) SetTxID(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldTxID, v)
+	return u
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateTxID() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldTxID)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *DeadLetterUpsert) SetTxIndex(v int) *DeadLetterUpsert {
+	u.Set(deadletter.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateTxIndex() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *DeadLetterUpsert) AddTxIndex(v int) *DeadLetterUpsert {
+	u.Add(deadletter.FieldTxIndex, v)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *DeadLetterUpsert) SetEventIndex(v int) *DeadLetterUpsert {
+	u.Set(deadletter.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateEventIndex() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *DeadLetterUpsert) AddEventIndex(v int) *DeadLetterUpsert {
+	u.Add(deadletter.FieldEventIndex, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *DeadLetterUpsert) SetType(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateType() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldType)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsert) SetPayload(v json.RawMessage) *DeadLetterUpsert {
+	u.Set(deadletter.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdatePayload() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldPayload)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsert) SetError(v string) *DeadLetterUpsert {
+	u.Set(deadletter.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateError() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldError)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsert) SetAttempts(v int) *DeadLetterUpsert {
+	u.Set(deadletter.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateAttempts() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsert) AddAttempts(v int) *DeadLetterUpsert {
+	u.Add(deadletter.FieldAttempts, v)
+	return u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsert) SetNextRetryAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldNextRetryAt, v)
+	return u
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateNextRetryAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldNextRetryAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeadLetterUpsert) SetCreatedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateCreatedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsert) SetUpdatedAt(v time.Time) *DeadLetterUpsert {
+	u.Set(deadletter.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsert) UpdateUpdatedAt() *DeadLetterUpsert {
+	u.SetExcluded(deadletter.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertOne) UpdateNewValues() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeadLetterUpsertOne) Ignore() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertOne) DoNothing() *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreate.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertOne) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *DeadLetterUpsertOne) SetBlockHeight(v uint64) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *DeadLetterUpsertOne) AddBlockHeight(v uint64) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateBlockHeight() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *DeadLetterUpsertOne) SetBlockID(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateBlockID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *DeadLetterUpsertOne) SetBlockTime(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateBlockTime() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *DeadLetterUpsertOne) ClearBlockTime() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *DeadLetterUpsertOne) SetTxID(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateTxID() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *DeadLetterUpsertOne) SetTxIndex(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *DeadLetterUpsertOne) AddTxIndex(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateTxIndex() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *DeadLetterUpsertOne) SetEventIndex(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *DeadLetterUpsertOne) AddEventIndex(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateEventIndex() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DeadLetterUpsertOne) SetType(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateType() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsertOne) SetPayload(v json.RawMessage) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdatePayload() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsertOne) SetError(v string) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateError() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsertOne) SetAttempts(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsertOne) AddAttempts(v int) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateAttempts() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsertOne) SetNextRetryAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateNextRetryAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeadLetterUpsertOne) SetCreatedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateCreatedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertOne) SetUpdatedAt(v time.Time) *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertOne) UpdateUpdatedAt() *DeadLetterUpsertOne {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeadLetterUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeadLetterUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeadLetterCreateBulk is the builder for creating many DeadLetter entities in bulk.
+type DeadLetterCreateBulk struct {
+	config
+	err      error
+	builders []*DeadLetterCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeadLetter entities in the database.
+func (_c *DeadLetterCreateBulk) Save(ctx context.Context) ([]*DeadLetter, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeadLetter, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeadLetterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeadLetterCreateBulk) SaveX(ctx context.Context) []*DeadLetter {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeadLetterCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeadLetterCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeadLetter.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeadLetterUpsert) {
+//			SetBlockHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeadLetterCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeadLetterUpsertBulk {
+	_c.conflict = opts
+	return &DeadLetterUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeadLetterCreateBulk) OnConflictColumns(columns ...string) *DeadLetterUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeadLetterUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeadLetterUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeadLetter nodes.
+type DeadLetterUpsertBulk struct {
+	create *DeadLetterCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) UpdateNewValues() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeadLetter.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeadLetterUpsertBulk) Ignore() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeadLetterUpsertBulk) DoNothing() *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeadLetterCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeadLetterUpsertBulk) Update(set func(*DeadLetterUpsert)) *DeadLetterUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeadLetterUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *DeadLetterUpsertBulk) SetBlockHeight(v uint64) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *DeadLetterUpsertBulk) AddBlockHeight(v uint64) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateBlockHeight() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *DeadLetterUpsertBulk) SetBlockID(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateBlockID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *DeadLetterUpsertBulk) SetBlockTime(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateBlockTime() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *DeadLetterUpsertBulk) ClearBlockTime() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *DeadLetterUpsertBulk) SetTxID(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateTxID() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *DeadLetterUpsertBulk) SetTxIndex(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *DeadLetterUpsertBulk) AddTxIndex(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateTxIndex() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *DeadLetterUpsertBulk) SetEventIndex(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *DeadLetterUpsertBulk) AddEventIndex(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateEventIndex() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *DeadLetterUpsertBulk) SetType(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateType() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *DeadLetterUpsertBulk) SetPayload(v json.RawMessage) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdatePayload() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *DeadLetterUpsertBulk) SetError(v string) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateError() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateError()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *DeadLetterUpsertBulk) SetAttempts(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *DeadLetterUpsertBulk) AddAttempts(v int) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateAttempts() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (u *DeadLetterUpsertBulk) SetNextRetryAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetNextRetryAt(v)
+	})
+}
+
+// UpdateNextRetryAt sets the "next_retry_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateNextRetryAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateNextRetryAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DeadLetterUpsertBulk) SetCreatedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateCreatedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeadLetterUpsertBulk) SetUpdatedAt(v time.Time) *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeadLetterUpsertBulk) UpdateUpdatedAt() *DeadLetterUpsertBulk {
+	return u.Update(func(s *DeadLetterUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeadLetterUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeadLetterCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeadLetterCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeadLetterUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/deadletter"
+	"backend/ent/predicate"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DeadLetterUpdate is the builder for updating DeadLetter entities.
+type DeadLetterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (_u *DeadLetterUpdate) Where(ps ...predicate.DeadLetter) *DeadLetterUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *DeadLetterUpdate) SetBlockHeight(v uint64) *DeadLetterUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableBlockHeight(v *uint64) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *DeadLetterUpdate) AddBlockHeight(v int64) *DeadLetterUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *DeadLetterUpdate) SetBlockID(v string) *DeadLetterUpdate {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableBlockID(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *DeadLetterUpdate) SetBlockTime(v time.Time) *DeadLetterUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableBlockTime(v *time.Time) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *DeadLetterUpdate) ClearBlockTime() *DeadLetterUpdate {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *DeadLetterUpdate) SetTxID(v string) *DeadLetterUpdate {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableTxID(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *DeadLetterUpdate) SetTxIndex(v int) *DeadLetterUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableTxIndex(v *int) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *DeadLetterUpdate) AddTxIndex(v int) *DeadLetterUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *DeadLetterUpdate) SetEventIndex(v int) *DeadLetterUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableEventIndex(v *int) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *DeadLetterUpdate) AddEventIndex(v int) *DeadLetterUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DeadLetterUpdate) SetType(v string) *DeadLetterUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableType(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DeadLetterUpdate) SetPayload(v json.RawMessage) *DeadLetterUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *DeadLetterUpdate) AppendPayload(v json.RawMessage) *DeadLetterUpdate {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeadLetterUpdate) SetError(v string) *DeadLetterUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableError(v *string) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeadLetterUpdate) SetAttempts(v int) *DeadLetterUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableAttempts(v *int) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeadLetterUpdate) AddAttempts(v int) *DeadLetterUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *DeadLetterUpdate) SetNextRetryAt(v time.Time) *DeadLetterUpdate {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableNextRetryAt(v *time.Time) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeadLetterUpdate) SetCreatedAt(v time.Time) *DeadLetterUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeadLetterUpdate) SetNillableCreatedAt(v *time.Time) *DeadLetterUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeadLetterUpdate) SetUpdatedAt(v time.Time) *DeadLetterUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_u *DeadLetterUpdate) Mutation() *DeadLetterMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeadLetterUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeadLetterUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeadLetterUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeadLetterUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeadLetterUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *DeadLetterUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(deadletter.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(deadletter.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(deadletter.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(deadletter.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(deadletter.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(deadletter.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(deadletter.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(deadletter.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(deadletter.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(deadletter.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(deadletter.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deadletter.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeadLetterUpdateOne is the builder for updating a single DeadLetter entity.
+type DeadLetterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeadLetterMutation
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *DeadLetterUpdateOne) SetBlockHeight(v uint64) *DeadLetterUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableBlockHeight(v *uint64) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *DeadLetterUpdateOne) AddBlockHeight(v int64) *DeadLetterUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *DeadLetterUpdateOne) SetBlockID(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableBlockID(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *DeadLetterUpdateOne) SetBlockTime(v time.Time) *DeadLetterUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableBlockTime(v *time.Time) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *DeadLetterUpdateOne) ClearBlockTime() *DeadLetterUpdateOne {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *DeadLetterUpdateOne) SetTxID(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableTxID(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *DeadLetterUpdateOne) SetTxIndex(v int) *DeadLetterUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableTxIndex(v *int) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *DeadLetterUpdateOne) AddTxIndex(v int) *DeadLetterUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *DeadLetterUpdateOne) SetEventIndex(v int) *DeadLetterUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableEventIndex(v *int) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *DeadLetterUpdateOne) AddEventIndex(v int) *DeadLetterUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DeadLetterUpdateOne) SetType(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableType(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *DeadLetterUpdateOne) SetPayload(v json.RawMessage) *DeadLetterUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *DeadLetterUpdateOne) AppendPayload(v json.RawMessage) *DeadLetterUpdateOne {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeadLetterUpdateOne) SetError(v string) *DeadLetterUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableError(v *string) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *DeadLetterUpdateOne) SetAttempts(v int) *DeadLetterUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableAttempts(v *int) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *DeadLetterUpdateOne) AddAttempts(v int) *DeadLetterUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (_u *DeadLetterUpdateOne) SetNextRetryAt(v time.Time) *DeadLetterUpdateOne {
+	_u.mutation.SetNextRetryAt(v)
+	return _u
+}
+
+// SetNillableNextRetryAt sets the "next_retry_at" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableNextRetryAt(v *time.Time) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetNextRetryAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DeadLetterUpdateOne) SetCreatedAt(v time.Time) *DeadLetterUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DeadLetterUpdateOne) SetNillableCreatedAt(v *time.Time) *DeadLetterUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeadLetterUpdateOne) SetUpdatedAt(v time.Time) *DeadLetterUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the DeadLetterMutation object of the builder.
+func (_u *DeadLetterUpdateOne) Mutation() *DeadLetterMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeadLetterUpdate builder.
+func (_u *DeadLetterUpdateOne) Where(ps ...predicate.DeadLetter) *DeadLetterUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeadLetterUpdateOne) Select(field string, fields ...string) *DeadLetterUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeadLetter entity.
+func (_u *DeadLetterUpdateOne) Save(ctx context.Context) (*DeadLetter, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeadLetterUpdateOne) SaveX(ctx context.Context) *DeadLetter {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeadLetterUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeadLetterUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeadLetterUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deadletter.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *DeadLetterUpdateOne) sqlSave(ctx context.Context) (_node *DeadLetter, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deadletter.Table, deadletter.Columns, sqlgraph.NewFieldSpec(deadletter.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeadLetter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deadletter.FieldID)
+		for _, f := range fields {
+			if !deadletter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deadletter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(deadletter.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(deadletter.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(deadletter.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(deadletter.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(deadletter.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(deadletter.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(deadletter.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(deadletter.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(deadletter.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(deadletter.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(deadletter.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(deadletter.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, deadletter.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deadletter.FieldError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(deadletter.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRetryAt(); ok {
+		_spec.SetField(deadletter.FieldNextRetryAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(deadletter.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deadletter.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &DeadLetter{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deadletter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
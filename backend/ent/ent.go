@@ -4,11 +4,19 @@ package ent
 
 import (
 	"backend/ent/attendance"
+	"backend/ent/checkpoint"
+	"backend/ent/deadletter"
 	"backend/ent/event"
+	"backend/ent/eventcount"
 	"backend/ent/eventpass"
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/pendingevent"
+	"backend/ent/rawevent"
+	"backend/ent/sale"
+	"backend/ent/transfer"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -80,11 +88,19 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			attendance.Table:   attendance.ValidColumn,
+			checkpoint.Table:   checkpoint.ValidColumn,
+			deadletter.Table:   deadletter.ValidColumn,
 			event.Table:        event.ValidColumn,
+			eventcount.Table:   eventcount.ValidColumn,
 			eventpass.Table:    eventpass.ValidColumn,
+			gachareceipt.Table: gachareceipt.ValidColumn,
 			listing.Table:      listing.ValidColumn,
 			nftaccessory.Table: nftaccessory.ValidColumn,
 			nftmoment.Table:    nftmoment.ValidColumn,
+			pendingevent.Table: pendingevent.ValidColumn,
+			rawevent.Table:     rawevent.ValidColumn,
+			sale.Table:         sale.ValidColumn,
+			transfer.Table:     transfer.ValidColumn,
 			user.Table:         user.ValidColumn,
 		})
 	})
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -21,6 +22,7 @@ type EventCreate struct {
 	config
 	mutation *EventMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetEventID sets the "event_id" field.
@@ -226,6 +228,7 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node = &Event{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(event.Table, sqlgraph.NewFieldSpec(event.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.EventID(); ok {
 		_spec.SetField(event.FieldEventID, field.TypeUint64, value)
 		_node.EventID = value
@@ -322,11 +325,485 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Event.Create().
+//		SetEventID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventUpsert) {
+//			SetEventID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCreate) OnConflict(opts ...sql.ConflictOption) *EventUpsertOne {
+	_c.conflict = opts
+	return &EventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCreate) OnConflictColumns(columns ...string) *EventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventUpsertOne is the builder for "upsert"-ing
+	//  one Event node.
+	EventUpsertOne struct {
+		create *EventCreate
+	}
+
+	// EventUpsert is the "OnConflict" setter.
+	EventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEventID sets the "event_id" field.
+func (u *EventUpsert) SetEventID(v uint64) *EventUpsert {
+	u.Set(event.FieldEventID, v)
+	return u
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventUpsert) UpdateEventID() *EventUpsert {
+	u.SetExcluded(event.FieldEventID)
+	return u
+}
+
+// AddEventID adds v to the "event_id" field.
+func (u *EventUpsert) AddEventID(v uint64) *EventUpsert {
+	u.Add(event.FieldEventID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EventUpsert) SetName(v string) *EventUpsert {
+	u.Set(event.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventUpsert) UpdateName() *EventUpsert {
+	u.SetExcluded(event.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsert) SetDescription(v string) *EventUpsert {
+	u.Set(event.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsert) UpdateDescription() *EventUpsert {
+	u.SetExcluded(event.FieldDescription)
+	return u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventUpsert) SetThumbnail(v string) *EventUpsert {
+	u.Set(event.FieldThumbnail, v)
+	return u
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventUpsert) UpdateThumbnail() *EventUpsert {
+	u.SetExcluded(event.FieldThumbnail)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventUpsert) SetEventType(v uint8) *EventUpsert {
+	u.Set(event.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventUpsert) UpdateEventType() *EventUpsert {
+	u.SetExcluded(event.FieldEventType)
+	return u
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventUpsert) AddEventType(v uint8) *EventUpsert {
+	u.Add(event.FieldEventType, v)
+	return u
+}
+
+// SetLocation sets the "location" field.
+func (u *EventUpsert) SetLocation(v string) *EventUpsert {
+	u.Set(event.FieldLocation, v)
+	return u
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLocation() *EventUpsert {
+	u.SetExcluded(event.FieldLocation)
+	return u
+}
+
+// SetLat sets the "lat" field.
+func (u *EventUpsert) SetLat(v float64) *EventUpsert {
+	u.Set(event.FieldLat, v)
+	return u
+}
+
+// UpdateLat sets the "lat" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLat() *EventUpsert {
+	u.SetExcluded(event.FieldLat)
+	return u
+}
+
+// AddLat adds v to the "lat" field.
+func (u *EventUpsert) AddLat(v float64) *EventUpsert {
+	u.Add(event.FieldLat, v)
+	return u
+}
+
+// SetLong sets the "long" field.
+func (u *EventUpsert) SetLong(v float64) *EventUpsert {
+	u.Set(event.FieldLong, v)
+	return u
+}
+
+// UpdateLong sets the "long" field to the value that was provided on create.
+func (u *EventUpsert) UpdateLong() *EventUpsert {
+	u.SetExcluded(event.FieldLong)
+	return u
+}
+
+// AddLong adds v to the "long" field.
+func (u *EventUpsert) AddLong(v float64) *EventUpsert {
+	u.Add(event.FieldLong, v)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsert) SetStartDate(v time.Time) *EventUpsert {
+	u.Set(event.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsert) UpdateStartDate() *EventUpsert {
+	u.SetExcluded(event.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsert) SetEndDate(v time.Time) *EventUpsert {
+	u.Set(event.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsert) UpdateEndDate() *EventUpsert {
+	u.SetExcluded(event.FieldEndDate)
+	return u
+}
+
+// SetQuota sets the "quota" field.
+func (u *EventUpsert) SetQuota(v uint64) *EventUpsert {
+	u.Set(event.FieldQuota, v)
+	return u
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *EventUpsert) UpdateQuota() *EventUpsert {
+	u.SetExcluded(event.FieldQuota)
+	return u
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *EventUpsert) AddQuota(v uint64) *EventUpsert {
+	u.Add(event.FieldQuota, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventUpsertOne) UpdateNewValues() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventUpsertOne) Ignore() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventUpsertOne) DoNothing() *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCreate.OnConflict
+// documentation for more info.
+func (u *EventUpsertOne) Update(set func(*EventUpsert)) *EventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *EventUpsertOne) SetEventID(v uint64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// AddEventID adds v to the "event_id" field.
+func (u *EventUpsertOne) AddEventID(v uint64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateEventID() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventUpsertOne) SetName(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateName() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsertOne) SetDescription(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateDescription() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventUpsertOne) SetThumbnail(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateThumbnail() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventUpsertOne) SetEventType(v uint8) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventUpsertOne) AddEventType(v uint8) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateEventType() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *EventUpsertOne) SetLocation(v string) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLocation() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// SetLat sets the "lat" field.
+func (u *EventUpsertOne) SetLat(v float64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLat(v)
+	})
+}
+
+// AddLat adds v to the "lat" field.
+func (u *EventUpsertOne) AddLat(v float64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddLat(v)
+	})
+}
+
+// UpdateLat sets the "lat" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLat() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLat()
+	})
+}
+
+// SetLong sets the "long" field.
+func (u *EventUpsertOne) SetLong(v float64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLong(v)
+	})
+}
+
+// AddLong adds v to the "long" field.
+func (u *EventUpsertOne) AddLong(v float64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddLong(v)
+	})
+}
+
+// UpdateLong sets the "long" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateLong() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLong()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsertOne) SetStartDate(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateStartDate() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsertOne) SetEndDate(v time.Time) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateEndDate() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetQuota sets the "quota" field.
+func (u *EventUpsertOne) SetQuota(v uint64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.SetQuota(v)
+	})
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *EventUpsertOne) AddQuota(v uint64) *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.AddQuota(v)
+	})
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *EventUpsertOne) UpdateQuota() *EventUpsertOne {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateQuota()
+	})
+}
+
+// Exec executes the query.
+func (u *EventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EventCreateBulk is the builder for creating many Event entities in bulk.
 type EventCreateBulk struct {
 	config
 	err      error
 	builders []*EventCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Event entities in the database.
@@ -355,6 +832,7 @@ func (_c *EventCreateBulk) Save(ctx context.Context) ([]*Event, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -408,3 +886,296 @@ func (_c *EventCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Event.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventUpsert) {
+//			SetEventID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventUpsertBulk {
+	_c.conflict = opts
+	return &EventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCreateBulk) OnConflictColumns(columns ...string) *EventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventUpsertBulk is the builder for "upsert"-ing
+// a bulk of Event nodes.
+type EventUpsertBulk struct {
+	create *EventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventUpsertBulk) UpdateNewValues() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Event.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventUpsertBulk) Ignore() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventUpsertBulk) DoNothing() *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventUpsertBulk) Update(set func(*EventUpsert)) *EventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEventID sets the "event_id" field.
+func (u *EventUpsertBulk) SetEventID(v uint64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEventID(v)
+	})
+}
+
+// AddEventID adds v to the "event_id" field.
+func (u *EventUpsertBulk) AddEventID(v uint64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddEventID(v)
+	})
+}
+
+// UpdateEventID sets the "event_id" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateEventID() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEventID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventUpsertBulk) SetName(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateName() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventUpsertBulk) SetDescription(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateDescription() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventUpsertBulk) SetThumbnail(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateThumbnail() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventUpsertBulk) SetEventType(v uint8) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventUpsertBulk) AddEventType(v uint8) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateEventType() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetLocation sets the "location" field.
+func (u *EventUpsertBulk) SetLocation(v string) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLocation(v)
+	})
+}
+
+// UpdateLocation sets the "location" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLocation() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLocation()
+	})
+}
+
+// SetLat sets the "lat" field.
+func (u *EventUpsertBulk) SetLat(v float64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLat(v)
+	})
+}
+
+// AddLat adds v to the "lat" field.
+func (u *EventUpsertBulk) AddLat(v float64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddLat(v)
+	})
+}
+
+// UpdateLat sets the "lat" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLat() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLat()
+	})
+}
+
+// SetLong sets the "long" field.
+func (u *EventUpsertBulk) SetLong(v float64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetLong(v)
+	})
+}
+
+// AddLong adds v to the "long" field.
+func (u *EventUpsertBulk) AddLong(v float64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddLong(v)
+	})
+}
+
+// UpdateLong sets the "long" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateLong() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateLong()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EventUpsertBulk) SetStartDate(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateStartDate() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EventUpsertBulk) SetEndDate(v time.Time) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateEndDate() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetQuota sets the "quota" field.
+func (u *EventUpsertBulk) SetQuota(v uint64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.SetQuota(v)
+	})
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *EventUpsertBulk) AddQuota(v uint64) *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.AddQuota(v)
+	})
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *EventUpsertBulk) UpdateQuota() *EventUpsertBulk {
+	return u.Update(func(s *EventUpsert) {
+		s.UpdateQuota()
+	})
+}
+
+// Exec executes the query.
+func (u *EventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
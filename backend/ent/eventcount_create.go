@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/eventcount"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCountCreate is the builder for creating a EventCount entity.
+type EventCountCreate struct {
+	config
+	mutation *EventCountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetType sets the "type" field.
+func (_c *EventCountCreate) SetType(v string) *EventCountCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *EventCountCreate) SetCount(v int) *EventCountCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_c *EventCountCreate) SetNillableCount(v *int) *EventCountCreate {
+	if v != nil {
+		_c.SetCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventCountCreate) SetUpdatedAt(v time.Time) *EventCountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventCountCreate) SetNillableUpdatedAt(v *time.Time) *EventCountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventCountMutation object of the builder.
+func (_c *EventCountCreate) Mutation() *EventCountMutation {
+	return _c.mutation
+}
+
+// Save creates the EventCount in the database.
+func (_c *EventCountCreate) Save(ctx context.Context) (*EventCount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventCountCreate) SaveX(ctx context.Context) *EventCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventCountCreate) defaults() {
+	if _, ok := _c.mutation.Count(); !ok {
+		v := eventcount.DefaultCount
+		_c.mutation.SetCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventcount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventCountCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "EventCount.type"`)}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "EventCount.count"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventCount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventCountCreate) sqlSave(ctx context.Context) (*EventCount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventCountCreate) createSpec() (*EventCount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventCount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventcount.Table, sqlgraph.NewFieldSpec(eventcount.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(eventcount.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(eventcount.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventcount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventCount.Create().
+//		SetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventCountUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCountCreate) OnConflict(opts ...sql.ConflictOption) *EventCountUpsertOne {
+	_c.conflict = opts
+	return &EventCountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCountCreate) OnConflictColumns(columns ...string) *EventCountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventCountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventCountUpsertOne is the builder for "upsert"-ing
+	//  one EventCount node.
+	EventCountUpsertOne struct {
+		create *EventCountCreate
+	}
+
+	// EventCountUpsert is the "OnConflict" setter.
+	EventCountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetType sets the "type" field.
+func (u *EventCountUpsert) SetType(v string) *EventCountUpsert {
+	u.Set(eventcount.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventCountUpsert) UpdateType() *EventCountUpsert {
+	u.SetExcluded(eventcount.FieldType)
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *EventCountUpsert) SetCount(v int) *EventCountUpsert {
+	u.Set(eventcount.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *EventCountUpsert) UpdateCount() *EventCountUpsert {
+	u.SetExcluded(eventcount.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *EventCountUpsert) AddCount(v int) *EventCountUpsert {
+	u.Add(eventcount.FieldCount, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventCountUpsert) SetUpdatedAt(v time.Time) *EventCountUpsert {
+	u.Set(eventcount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventCountUpsert) UpdateUpdatedAt() *EventCountUpsert {
+	u.SetExcluded(eventcount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventCountUpsertOne) UpdateNewValues() *EventCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventCountUpsertOne) Ignore() *EventCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventCountUpsertOne) DoNothing() *EventCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCountCreate.OnConflict
+// documentation for more info.
+func (u *EventCountUpsertOne) Update(set func(*EventCountUpsert)) *EventCountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *EventCountUpsertOne) SetType(v string) *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventCountUpsertOne) UpdateType() *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *EventCountUpsertOne) SetCount(v int) *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *EventCountUpsertOne) AddCount(v int) *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *EventCountUpsertOne) UpdateCount() *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventCountUpsertOne) SetUpdatedAt(v time.Time) *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventCountUpsertOne) UpdateUpdatedAt() *EventCountUpsertOne {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EventCountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventCountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventCountUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventCountUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EventCountCreateBulk is the builder for creating many EventCount entities in bulk.
+type EventCountCreateBulk struct {
+	config
+	err      error
+	builders []*EventCountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EventCount entities in the database.
+func (_c *EventCountCreateBulk) Save(ctx context.Context) ([]*EventCount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventCount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventCountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventCountCreateBulk) SaveX(ctx context.Context) []*EventCount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventCount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventCountUpsert) {
+//			SetType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventCountCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventCountUpsertBulk {
+	_c.conflict = opts
+	return &EventCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventCountCreateBulk) OnConflictColumns(columns ...string) *EventCountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventCountUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventCountUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventCount nodes.
+type EventCountUpsertBulk struct {
+	create *EventCountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventCountUpsertBulk) UpdateNewValues() *EventCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventCount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventCountUpsertBulk) Ignore() *EventCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventCountUpsertBulk) DoNothing() *EventCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventCountCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventCountUpsertBulk) Update(set func(*EventCountUpsert)) *EventCountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventCountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *EventCountUpsertBulk) SetType(v string) *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *EventCountUpsertBulk) UpdateType() *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *EventCountUpsertBulk) SetCount(v int) *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *EventCountUpsertBulk) AddCount(v int) *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *EventCountUpsertBulk) UpdateCount() *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EventCountUpsertBulk) SetUpdatedAt(v time.Time) *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EventCountUpsertBulk) UpdateUpdatedAt() *EventCountUpsertBulk {
+	return u.Update(func(s *EventCountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EventCountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventCountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventCountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventCountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
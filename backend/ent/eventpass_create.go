@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +21,7 @@ type EventPassCreate struct {
 	config
 	mutation *EventPassMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetPassID sets the "pass_id" field.
@@ -200,6 +202,7 @@ func (_c *EventPassCreate) createSpec() (*EventPass, *sqlgraph.CreateSpec) {
 		_node = &EventPass{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(eventpass.Table, sqlgraph.NewFieldSpec(eventpass.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.PassID(); ok {
 		_spec.SetField(eventpass.FieldPassID, field.TypeUint64, value)
 		_node.PassID = value
@@ -277,11 +280,316 @@ func (_c *EventPassCreate) createSpec() (*EventPass, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventPass.Create().
+//		SetPassID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventPassUpsert) {
+//			SetPassID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventPassCreate) OnConflict(opts ...sql.ConflictOption) *EventPassUpsertOne {
+	_c.conflict = opts
+	return &EventPassUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventPassCreate) OnConflictColumns(columns ...string) *EventPassUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventPassUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EventPassUpsertOne is the builder for "upsert"-ing
+	//  one EventPass node.
+	EventPassUpsertOne struct {
+		create *EventPassCreate
+	}
+
+	// EventPassUpsert is the "OnConflict" setter.
+	EventPassUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPassID sets the "pass_id" field.
+func (u *EventPassUpsert) SetPassID(v uint64) *EventPassUpsert {
+	u.Set(eventpass.FieldPassID, v)
+	return u
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdatePassID() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldPassID)
+	return u
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *EventPassUpsert) AddPassID(v uint64) *EventPassUpsert {
+	u.Add(eventpass.FieldPassID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EventPassUpsert) SetName(v string) *EventPassUpsert {
+	u.Set(eventpass.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdateName() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *EventPassUpsert) SetDescription(v string) *EventPassUpsert {
+	u.Set(eventpass.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdateDescription() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldDescription)
+	return u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventPassUpsert) SetThumbnail(v string) *EventPassUpsert {
+	u.Set(eventpass.FieldThumbnail, v)
+	return u
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdateThumbnail() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldThumbnail)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventPassUpsert) SetEventType(v uint8) *EventPassUpsert {
+	u.Set(eventpass.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdateEventType() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldEventType)
+	return u
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventPassUpsert) AddEventType(v uint8) *EventPassUpsert {
+	u.Add(eventpass.FieldEventType, v)
+	return u
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EventPassUpsert) SetIsUsed(v bool) *EventPassUpsert {
+	u.Set(eventpass.FieldIsUsed, v)
+	return u
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EventPassUpsert) UpdateIsUsed() *EventPassUpsert {
+	u.SetExcluded(eventpass.FieldIsUsed)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventPassUpsertOne) UpdateNewValues() *EventPassUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventPassUpsertOne) Ignore() *EventPassUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventPassUpsertOne) DoNothing() *EventPassUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventPassCreate.OnConflict
+// documentation for more info.
+func (u *EventPassUpsertOne) Update(set func(*EventPassUpsert)) *EventPassUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventPassUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPassID sets the "pass_id" field.
+func (u *EventPassUpsertOne) SetPassID(v uint64) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetPassID(v)
+	})
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *EventPassUpsertOne) AddPassID(v uint64) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.AddPassID(v)
+	})
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdatePassID() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdatePassID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventPassUpsertOne) SetName(v string) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdateName() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventPassUpsertOne) SetDescription(v string) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdateDescription() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventPassUpsertOne) SetThumbnail(v string) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdateThumbnail() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventPassUpsertOne) SetEventType(v uint8) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventPassUpsertOne) AddEventType(v uint8) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.AddEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdateEventType() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EventPassUpsertOne) SetIsUsed(v bool) *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EventPassUpsertOne) UpdateIsUsed() *EventPassUpsertOne {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// Exec executes the query.
+func (u *EventPassUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventPassCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventPassUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventPassUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventPassUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EventPassCreateBulk is the builder for creating many EventPass entities in bulk.
 type EventPassCreateBulk struct {
 	config
 	err      error
 	builders []*EventPassCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EventPass entities in the database.
@@ -311,6 +619,7 @@ func (_c *EventPassCreateBulk) Save(ctx context.Context) ([]*EventPass, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -364,3 +673,205 @@ func (_c *EventPassCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventPass.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventPassUpsert) {
+//			SetPassID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EventPassCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventPassUpsertBulk {
+	_c.conflict = opts
+	return &EventPassUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EventPassCreateBulk) OnConflictColumns(columns ...string) *EventPassUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EventPassUpsertBulk{
+		create: _c,
+	}
+}
+
+// EventPassUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventPass nodes.
+type EventPassUpsertBulk struct {
+	create *EventPassCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventPassUpsertBulk) UpdateNewValues() *EventPassUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventPass.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventPassUpsertBulk) Ignore() *EventPassUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventPassUpsertBulk) DoNothing() *EventPassUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventPassCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventPassUpsertBulk) Update(set func(*EventPassUpsert)) *EventPassUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventPassUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPassID sets the "pass_id" field.
+func (u *EventPassUpsertBulk) SetPassID(v uint64) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetPassID(v)
+	})
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *EventPassUpsertBulk) AddPassID(v uint64) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.AddPassID(v)
+	})
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdatePassID() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdatePassID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EventPassUpsertBulk) SetName(v string) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdateName() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *EventPassUpsertBulk) SetDescription(v string) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdateDescription() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *EventPassUpsertBulk) SetThumbnail(v string) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdateThumbnail() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *EventPassUpsertBulk) SetEventType(v uint8) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// AddEventType adds v to the "event_type" field.
+func (u *EventPassUpsertBulk) AddEventType(v uint8) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.AddEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdateEventType() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetIsUsed sets the "is_used" field.
+func (u *EventPassUpsertBulk) SetIsUsed(v bool) *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.SetIsUsed(v)
+	})
+}
+
+// UpdateIsUsed sets the "is_used" field to the value that was provided on create.
+func (u *EventPassUpsertBulk) UpdateIsUsed() *EventPassUpsertBulk {
+	return u.Update(func(s *EventPassUpsert) {
+		s.UpdateIsUsed()
+	})
+}
+
+// Exec executes the query.
+func (u *EventPassUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventPassCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventPassCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventPassUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
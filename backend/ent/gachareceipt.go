@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/gachareceipt"
+	"backend/ent/nftaccessory"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// GachaReceipt is the model entity for the GachaReceipt schema.
+type GachaReceipt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ReceiptID holds the value of the "receipt_id" field.
+	ReceiptID uint64 `json:"receipt_id,omitempty"`
+	// Opener holds the value of the "opener" field.
+	Opener string `json:"opener,omitempty"`
+	// CommitBlock holds the value of the "commit_block" field.
+	CommitBlock uint64 `json:"commit_block,omitempty"`
+	// OpenTxID holds the value of the "open_tx_id" field.
+	OpenTxID string `json:"open_tx_id,omitempty"`
+	// OpenBlock holds the value of the "open_block" field.
+	OpenBlock uint64 `json:"open_block,omitempty"`
+	// RevealBlock holds the value of the "reveal_block" field.
+	RevealBlock *uint64 `json:"reveal_block,omitempty"`
+	// RevealTxID holds the value of the "reveal_tx_id" field.
+	RevealTxID string `json:"reveal_tx_id,omitempty"`
+	// Rarity holds the value of the "rarity" field.
+	Rarity *uint8 `json:"rarity,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// RevealedAt holds the value of the "revealed_at" field.
+	RevealedAt *time.Time `json:"revealed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GachaReceiptQuery when eager-loading is set.
+	Edges        GachaReceiptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GachaReceiptEdges holds the relations/edges for other nodes in the graph.
+type GachaReceiptEdges struct {
+	// Accessory holds the value of the accessory edge.
+	Accessory *NFTAccessory `json:"accessory,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccessoryOrErr returns the Accessory value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GachaReceiptEdges) AccessoryOrErr() (*NFTAccessory, error) {
+	if e.Accessory != nil {
+		return e.Accessory, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: nftaccessory.Label}
+	}
+	return nil, &NotLoadedError{edge: "accessory"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GachaReceipt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gachareceipt.FieldID, gachareceipt.FieldReceiptID, gachareceipt.FieldCommitBlock, gachareceipt.FieldOpenBlock, gachareceipt.FieldRevealBlock, gachareceipt.FieldRarity:
+			values[i] = new(sql.NullInt64)
+		case gachareceipt.FieldOpener, gachareceipt.FieldOpenTxID, gachareceipt.FieldRevealTxID:
+			values[i] = new(sql.NullString)
+		case gachareceipt.FieldCreatedAt, gachareceipt.FieldRevealedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GachaReceipt fields.
+func (_m *GachaReceipt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gachareceipt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case gachareceipt.FieldReceiptID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_id", values[i])
+			} else if value.Valid {
+				_m.ReceiptID = uint64(value.Int64)
+			}
+		case gachareceipt.FieldOpener:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field opener", values[i])
+			} else if value.Valid {
+				_m.Opener = value.String
+			}
+		case gachareceipt.FieldCommitBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field commit_block", values[i])
+			} else if value.Valid {
+				_m.CommitBlock = uint64(value.Int64)
+			}
+		case gachareceipt.FieldOpenTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open_tx_id", values[i])
+			} else if value.Valid {
+				_m.OpenTxID = value.String
+			}
+		case gachareceipt.FieldOpenBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field open_block", values[i])
+			} else if value.Valid {
+				_m.OpenBlock = uint64(value.Int64)
+			}
+		case gachareceipt.FieldRevealBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reveal_block", values[i])
+			} else if value.Valid {
+				_m.RevealBlock = new(uint64)
+				*_m.RevealBlock = uint64(value.Int64)
+			}
+		case gachareceipt.FieldRevealTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reveal_tx_id", values[i])
+			} else if value.Valid {
+				_m.RevealTxID = value.String
+			}
+		case gachareceipt.FieldRarity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rarity", values[i])
+			} else if value.Valid {
+				_m.Rarity = new(uint8)
+				*_m.Rarity = uint8(value.Int64)
+			}
+		case gachareceipt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case gachareceipt.FieldRevealedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revealed_at", values[i])
+			} else if value.Valid {
+				_m.RevealedAt = new(time.Time)
+				*_m.RevealedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GachaReceipt.
+// This includes values selected through modifiers, order, etc.
+func (_m *GachaReceipt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccessory queries the "accessory" edge of the GachaReceipt entity.
+func (_m *GachaReceipt) QueryAccessory() *NFTAccessoryQuery {
+	return NewGachaReceiptClient(_m.config).QueryAccessory(_m)
+}
+
+// Update returns a builder for updating this GachaReceipt.
+// Note that you need to call GachaReceipt.Unwrap() before calling this method if this GachaReceipt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GachaReceipt) Update() *GachaReceiptUpdateOne {
+	return NewGachaReceiptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GachaReceipt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GachaReceipt) Unwrap() *GachaReceipt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GachaReceipt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GachaReceipt) String() string {
+	var builder strings.Builder
+	builder.WriteString("GachaReceipt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("receipt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiptID))
+	builder.WriteString(", ")
+	builder.WriteString("opener=")
+	builder.WriteString(_m.Opener)
+	builder.WriteString(", ")
+	builder.WriteString("commit_block=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommitBlock))
+	builder.WriteString(", ")
+	builder.WriteString("open_tx_id=")
+	builder.WriteString(_m.OpenTxID)
+	builder.WriteString(", ")
+	builder.WriteString("open_block=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpenBlock))
+	builder.WriteString(", ")
+	if v := _m.RevealBlock; v != nil {
+		builder.WriteString("reveal_block=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reveal_tx_id=")
+	builder.WriteString(_m.RevealTxID)
+	builder.WriteString(", ")
+	if v := _m.Rarity; v != nil {
+		builder.WriteString("rarity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevealedAt; v != nil {
+		builder.WriteString("revealed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GachaReceipts is a parsable slice of GachaReceipt.
+type GachaReceipts []*GachaReceipt
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gachareceipt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the gachareceipt type in the database.
+	Label = "gacha_receipt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReceiptID holds the string denoting the receipt_id field in the database.
+	FieldReceiptID = "receipt_id"
+	// FieldOpener holds the string denoting the opener field in the database.
+	FieldOpener = "opener"
+	// FieldCommitBlock holds the string denoting the commit_block field in the database.
+	FieldCommitBlock = "commit_block"
+	// FieldOpenTxID holds the string denoting the open_tx_id field in the database.
+	FieldOpenTxID = "open_tx_id"
+	// FieldOpenBlock holds the string denoting the open_block field in the database.
+	FieldOpenBlock = "open_block"
+	// FieldRevealBlock holds the string denoting the reveal_block field in the database.
+	FieldRevealBlock = "reveal_block"
+	// FieldRevealTxID holds the string denoting the reveal_tx_id field in the database.
+	FieldRevealTxID = "reveal_tx_id"
+	// FieldRarity holds the string denoting the rarity field in the database.
+	FieldRarity = "rarity"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRevealedAt holds the string denoting the revealed_at field in the database.
+	FieldRevealedAt = "revealed_at"
+	// EdgeAccessory holds the string denoting the accessory edge name in mutations.
+	EdgeAccessory = "accessory"
+	// Table holds the table name of the gachareceipt in the database.
+	Table = "gacha_receipts"
+	// AccessoryTable is the table that holds the accessory relation/edge.
+	AccessoryTable = "nft_accessories"
+	// AccessoryInverseTable is the table name for the NFTAccessory entity.
+	// It exists in this package in order to avoid circular dependency with the "nftaccessory" package.
+	AccessoryInverseTable = "nft_accessories"
+	// AccessoryColumn is the table column denoting the accessory relation/edge.
+	AccessoryColumn = "gacha_receipt_accessory"
+)
+
+// Columns holds all SQL columns for gachareceipt fields.
+var Columns = []string{
+	FieldID,
+	FieldReceiptID,
+	FieldOpener,
+	FieldCommitBlock,
+	FieldOpenTxID,
+	FieldOpenBlock,
+	FieldRevealBlock,
+	FieldRevealTxID,
+	FieldRarity,
+	FieldCreatedAt,
+	FieldRevealedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the GachaReceipt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReceiptID orders the results by the receipt_id field.
+func ByReceiptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptID, opts...).ToFunc()
+}
+
+// ByOpener orders the results by the opener field.
+func ByOpener(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpener, opts...).ToFunc()
+}
+
+// ByCommitBlock orders the results by the commit_block field.
+func ByCommitBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommitBlock, opts...).ToFunc()
+}
+
+// ByOpenTxID orders the results by the open_tx_id field.
+func ByOpenTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenTxID, opts...).ToFunc()
+}
+
+// ByOpenBlock orders the results by the open_block field.
+func ByOpenBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenBlock, opts...).ToFunc()
+}
+
+// ByRevealBlock orders the results by the reveal_block field.
+func ByRevealBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevealBlock, opts...).ToFunc()
+}
+
+// ByRevealTxID orders the results by the reveal_tx_id field.
+func ByRevealTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevealTxID, opts...).ToFunc()
+}
+
+// ByRarity orders the results by the rarity field.
+func ByRarity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRarity, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRevealedAt orders the results by the revealed_at field.
+func ByRevealedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevealedAt, opts...).ToFunc()
+}
+
+// ByAccessoryField orders the results by accessory field.
+func ByAccessoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccessoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AccessoryTable, AccessoryColumn),
+	)
+}
@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gachareceipt
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldID, id))
+}
+
+// ReceiptID applies equality check predicate on the "receipt_id" field. It's identical to ReceiptIDEQ.
+func ReceiptID(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// Opener applies equality check predicate on the "opener" field. It's identical to OpenerEQ.
+func Opener(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpener, v))
+}
+
+// CommitBlock applies equality check predicate on the "commit_block" field. It's identical to CommitBlockEQ.
+func CommitBlock(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldCommitBlock, v))
+}
+
+// OpenTxID applies equality check predicate on the "open_tx_id" field. It's identical to OpenTxIDEQ.
+func OpenTxID(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpenTxID, v))
+}
+
+// OpenBlock applies equality check predicate on the "open_block" field. It's identical to OpenBlockEQ.
+func OpenBlock(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpenBlock, v))
+}
+
+// RevealBlock applies equality check predicate on the "reveal_block" field. It's identical to RevealBlockEQ.
+func RevealBlock(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealBlock, v))
+}
+
+// RevealTxID applies equality check predicate on the "reveal_tx_id" field. It's identical to RevealTxIDEQ.
+func RevealTxID(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealTxID, v))
+}
+
+// Rarity applies equality check predicate on the "rarity" field. It's identical to RarityEQ.
+func Rarity(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRarity, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RevealedAt applies equality check predicate on the "revealed_at" field. It's identical to RevealedAtEQ.
+func RevealedAt(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealedAt, v))
+}
+
+// ReceiptIDEQ applies the EQ predicate on the "receipt_id" field.
+func ReceiptIDEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDNEQ applies the NEQ predicate on the "receipt_id" field.
+func ReceiptIDNEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldReceiptID, v))
+}
+
+// ReceiptIDIn applies the In predicate on the "receipt_id" field.
+func ReceiptIDIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDNotIn applies the NotIn predicate on the "receipt_id" field.
+func ReceiptIDNotIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldReceiptID, vs...))
+}
+
+// ReceiptIDGT applies the GT predicate on the "receipt_id" field.
+func ReceiptIDGT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldReceiptID, v))
+}
+
+// ReceiptIDGTE applies the GTE predicate on the "receipt_id" field.
+func ReceiptIDGTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldReceiptID, v))
+}
+
+// ReceiptIDLT applies the LT predicate on the "receipt_id" field.
+func ReceiptIDLT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldReceiptID, v))
+}
+
+// ReceiptIDLTE applies the LTE predicate on the "receipt_id" field.
+func ReceiptIDLTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldReceiptID, v))
+}
+
+// OpenerEQ applies the EQ predicate on the "opener" field.
+func OpenerEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpener, v))
+}
+
+// OpenerNEQ applies the NEQ predicate on the "opener" field.
+func OpenerNEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldOpener, v))
+}
+
+// OpenerIn applies the In predicate on the "opener" field.
+func OpenerIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldOpener, vs...))
+}
+
+// OpenerNotIn applies the NotIn predicate on the "opener" field.
+func OpenerNotIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldOpener, vs...))
+}
+
+// OpenerGT applies the GT predicate on the "opener" field.
+func OpenerGT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldOpener, v))
+}
+
+// OpenerGTE applies the GTE predicate on the "opener" field.
+func OpenerGTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldOpener, v))
+}
+
+// OpenerLT applies the LT predicate on the "opener" field.
+func OpenerLT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldOpener, v))
+}
+
+// OpenerLTE applies the LTE predicate on the "opener" field.
+func OpenerLTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldOpener, v))
+}
+
+// OpenerContains applies the Contains predicate on the "opener" field.
+func OpenerContains(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContains(FieldOpener, v))
+}
+
+// OpenerHasPrefix applies the HasPrefix predicate on the "opener" field.
+func OpenerHasPrefix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasPrefix(FieldOpener, v))
+}
+
+// OpenerHasSuffix applies the HasSuffix predicate on the "opener" field.
+func OpenerHasSuffix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasSuffix(FieldOpener, v))
+}
+
+// OpenerIsNil applies the IsNil predicate on the "opener" field.
+func OpenerIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldOpener))
+}
+
+// OpenerNotNil applies the NotNil predicate on the "opener" field.
+func OpenerNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldOpener))
+}
+
+// OpenerEqualFold applies the EqualFold predicate on the "opener" field.
+func OpenerEqualFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEqualFold(FieldOpener, v))
+}
+
+// OpenerContainsFold applies the ContainsFold predicate on the "opener" field.
+func OpenerContainsFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContainsFold(FieldOpener, v))
+}
+
+// CommitBlockEQ applies the EQ predicate on the "commit_block" field.
+func CommitBlockEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldCommitBlock, v))
+}
+
+// CommitBlockNEQ applies the NEQ predicate on the "commit_block" field.
+func CommitBlockNEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldCommitBlock, v))
+}
+
+// CommitBlockIn applies the In predicate on the "commit_block" field.
+func CommitBlockIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldCommitBlock, vs...))
+}
+
+// CommitBlockNotIn applies the NotIn predicate on the "commit_block" field.
+func CommitBlockNotIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldCommitBlock, vs...))
+}
+
+// CommitBlockGT applies the GT predicate on the "commit_block" field.
+func CommitBlockGT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldCommitBlock, v))
+}
+
+// CommitBlockGTE applies the GTE predicate on the "commit_block" field.
+func CommitBlockGTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldCommitBlock, v))
+}
+
+// CommitBlockLT applies the LT predicate on the "commit_block" field.
+func CommitBlockLT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldCommitBlock, v))
+}
+
+// CommitBlockLTE applies the LTE predicate on the "commit_block" field.
+func CommitBlockLTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldCommitBlock, v))
+}
+
+// OpenTxIDEQ applies the EQ predicate on the "open_tx_id" field.
+func OpenTxIDEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpenTxID, v))
+}
+
+// OpenTxIDNEQ applies the NEQ predicate on the "open_tx_id" field.
+func OpenTxIDNEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldOpenTxID, v))
+}
+
+// OpenTxIDIn applies the In predicate on the "open_tx_id" field.
+func OpenTxIDIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldOpenTxID, vs...))
+}
+
+// OpenTxIDNotIn applies the NotIn predicate on the "open_tx_id" field.
+func OpenTxIDNotIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldOpenTxID, vs...))
+}
+
+// OpenTxIDGT applies the GT predicate on the "open_tx_id" field.
+func OpenTxIDGT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldOpenTxID, v))
+}
+
+// OpenTxIDGTE applies the GTE predicate on the "open_tx_id" field.
+func OpenTxIDGTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldOpenTxID, v))
+}
+
+// OpenTxIDLT applies the LT predicate on the "open_tx_id" field.
+func OpenTxIDLT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldOpenTxID, v))
+}
+
+// OpenTxIDLTE applies the LTE predicate on the "open_tx_id" field.
+func OpenTxIDLTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldOpenTxID, v))
+}
+
+// OpenTxIDContains applies the Contains predicate on the "open_tx_id" field.
+func OpenTxIDContains(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContains(FieldOpenTxID, v))
+}
+
+// OpenTxIDHasPrefix applies the HasPrefix predicate on the "open_tx_id" field.
+func OpenTxIDHasPrefix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasPrefix(FieldOpenTxID, v))
+}
+
+// OpenTxIDHasSuffix applies the HasSuffix predicate on the "open_tx_id" field.
+func OpenTxIDHasSuffix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasSuffix(FieldOpenTxID, v))
+}
+
+// OpenTxIDEqualFold applies the EqualFold predicate on the "open_tx_id" field.
+func OpenTxIDEqualFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEqualFold(FieldOpenTxID, v))
+}
+
+// OpenTxIDContainsFold applies the ContainsFold predicate on the "open_tx_id" field.
+func OpenTxIDContainsFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContainsFold(FieldOpenTxID, v))
+}
+
+// OpenBlockEQ applies the EQ predicate on the "open_block" field.
+func OpenBlockEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldOpenBlock, v))
+}
+
+// OpenBlockNEQ applies the NEQ predicate on the "open_block" field.
+func OpenBlockNEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldOpenBlock, v))
+}
+
+// OpenBlockIn applies the In predicate on the "open_block" field.
+func OpenBlockIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldOpenBlock, vs...))
+}
+
+// OpenBlockNotIn applies the NotIn predicate on the "open_block" field.
+func OpenBlockNotIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldOpenBlock, vs...))
+}
+
+// OpenBlockGT applies the GT predicate on the "open_block" field.
+func OpenBlockGT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldOpenBlock, v))
+}
+
+// OpenBlockGTE applies the GTE predicate on the "open_block" field.
+func OpenBlockGTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldOpenBlock, v))
+}
+
+// OpenBlockLT applies the LT predicate on the "open_block" field.
+func OpenBlockLT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldOpenBlock, v))
+}
+
+// OpenBlockLTE applies the LTE predicate on the "open_block" field.
+func OpenBlockLTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldOpenBlock, v))
+}
+
+// OpenBlockIsNil applies the IsNil predicate on the "open_block" field.
+func OpenBlockIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldOpenBlock))
+}
+
+// OpenBlockNotNil applies the NotNil predicate on the "open_block" field.
+func OpenBlockNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldOpenBlock))
+}
+
+// RevealBlockEQ applies the EQ predicate on the "reveal_block" field.
+func RevealBlockEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealBlock, v))
+}
+
+// RevealBlockNEQ applies the NEQ predicate on the "reveal_block" field.
+func RevealBlockNEQ(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldRevealBlock, v))
+}
+
+// RevealBlockIn applies the In predicate on the "reveal_block" field.
+func RevealBlockIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldRevealBlock, vs...))
+}
+
+// RevealBlockNotIn applies the NotIn predicate on the "reveal_block" field.
+func RevealBlockNotIn(vs ...uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldRevealBlock, vs...))
+}
+
+// RevealBlockGT applies the GT predicate on the "reveal_block" field.
+func RevealBlockGT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldRevealBlock, v))
+}
+
+// RevealBlockGTE applies the GTE predicate on the "reveal_block" field.
+func RevealBlockGTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldRevealBlock, v))
+}
+
+// RevealBlockLT applies the LT predicate on the "reveal_block" field.
+func RevealBlockLT(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldRevealBlock, v))
+}
+
+// RevealBlockLTE applies the LTE predicate on the "reveal_block" field.
+func RevealBlockLTE(v uint64) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldRevealBlock, v))
+}
+
+// RevealBlockIsNil applies the IsNil predicate on the "reveal_block" field.
+func RevealBlockIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldRevealBlock))
+}
+
+// RevealBlockNotNil applies the NotNil predicate on the "reveal_block" field.
+func RevealBlockNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldRevealBlock))
+}
+
+// RevealTxIDEQ applies the EQ predicate on the "reveal_tx_id" field.
+func RevealTxIDEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealTxID, v))
+}
+
+// RevealTxIDNEQ applies the NEQ predicate on the "reveal_tx_id" field.
+func RevealTxIDNEQ(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldRevealTxID, v))
+}
+
+// RevealTxIDIn applies the In predicate on the "reveal_tx_id" field.
+func RevealTxIDIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldRevealTxID, vs...))
+}
+
+// RevealTxIDNotIn applies the NotIn predicate on the "reveal_tx_id" field.
+func RevealTxIDNotIn(vs ...string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldRevealTxID, vs...))
+}
+
+// RevealTxIDGT applies the GT predicate on the "reveal_tx_id" field.
+func RevealTxIDGT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldRevealTxID, v))
+}
+
+// RevealTxIDGTE applies the GTE predicate on the "reveal_tx_id" field.
+func RevealTxIDGTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldRevealTxID, v))
+}
+
+// RevealTxIDLT applies the LT predicate on the "reveal_tx_id" field.
+func RevealTxIDLT(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldRevealTxID, v))
+}
+
+// RevealTxIDLTE applies the LTE predicate on the "reveal_tx_id" field.
+func RevealTxIDLTE(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldRevealTxID, v))
+}
+
+// RevealTxIDContains applies the Contains predicate on the "reveal_tx_id" field.
+func RevealTxIDContains(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContains(FieldRevealTxID, v))
+}
+
+// RevealTxIDHasPrefix applies the HasPrefix predicate on the "reveal_tx_id" field.
+func RevealTxIDHasPrefix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasPrefix(FieldRevealTxID, v))
+}
+
+// RevealTxIDHasSuffix applies the HasSuffix predicate on the "reveal_tx_id" field.
+func RevealTxIDHasSuffix(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldHasSuffix(FieldRevealTxID, v))
+}
+
+// RevealTxIDIsNil applies the IsNil predicate on the "reveal_tx_id" field.
+func RevealTxIDIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldRevealTxID))
+}
+
+// RevealTxIDNotNil applies the NotNil predicate on the "reveal_tx_id" field.
+func RevealTxIDNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldRevealTxID))
+}
+
+// RevealTxIDEqualFold applies the EqualFold predicate on the "reveal_tx_id" field.
+func RevealTxIDEqualFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEqualFold(FieldRevealTxID, v))
+}
+
+// RevealTxIDContainsFold applies the ContainsFold predicate on the "reveal_tx_id" field.
+func RevealTxIDContainsFold(v string) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldContainsFold(FieldRevealTxID, v))
+}
+
+// RarityEQ applies the EQ predicate on the "rarity" field.
+func RarityEQ(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRarity, v))
+}
+
+// RarityNEQ applies the NEQ predicate on the "rarity" field.
+func RarityNEQ(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldRarity, v))
+}
+
+// RarityIn applies the In predicate on the "rarity" field.
+func RarityIn(vs ...uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldRarity, vs...))
+}
+
+// RarityNotIn applies the NotIn predicate on the "rarity" field.
+func RarityNotIn(vs ...uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldRarity, vs...))
+}
+
+// RarityGT applies the GT predicate on the "rarity" field.
+func RarityGT(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldRarity, v))
+}
+
+// RarityGTE applies the GTE predicate on the "rarity" field.
+func RarityGTE(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldRarity, v))
+}
+
+// RarityLT applies the LT predicate on the "rarity" field.
+func RarityLT(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldRarity, v))
+}
+
+// RarityLTE applies the LTE predicate on the "rarity" field.
+func RarityLTE(v uint8) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldRarity, v))
+}
+
+// RarityIsNil applies the IsNil predicate on the "rarity" field.
+func RarityIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldRarity))
+}
+
+// RarityNotNil applies the NotNil predicate on the "rarity" field.
+func RarityNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldRarity))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RevealedAtEQ applies the EQ predicate on the "revealed_at" field.
+func RevealedAtEQ(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldEQ(FieldRevealedAt, v))
+}
+
+// RevealedAtNEQ applies the NEQ predicate on the "revealed_at" field.
+func RevealedAtNEQ(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNEQ(FieldRevealedAt, v))
+}
+
+// RevealedAtIn applies the In predicate on the "revealed_at" field.
+func RevealedAtIn(vs ...time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIn(FieldRevealedAt, vs...))
+}
+
+// RevealedAtNotIn applies the NotIn predicate on the "revealed_at" field.
+func RevealedAtNotIn(vs ...time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotIn(FieldRevealedAt, vs...))
+}
+
+// RevealedAtGT applies the GT predicate on the "revealed_at" field.
+func RevealedAtGT(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGT(FieldRevealedAt, v))
+}
+
+// RevealedAtGTE applies the GTE predicate on the "revealed_at" field.
+func RevealedAtGTE(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldGTE(FieldRevealedAt, v))
+}
+
+// RevealedAtLT applies the LT predicate on the "revealed_at" field.
+func RevealedAtLT(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLT(FieldRevealedAt, v))
+}
+
+// RevealedAtLTE applies the LTE predicate on the "revealed_at" field.
+func RevealedAtLTE(v time.Time) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldLTE(FieldRevealedAt, v))
+}
+
+// RevealedAtIsNil applies the IsNil predicate on the "revealed_at" field.
+func RevealedAtIsNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldIsNull(FieldRevealedAt))
+}
+
+// RevealedAtNotNil applies the NotNil predicate on the "revealed_at" field.
+func RevealedAtNotNil() predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.FieldNotNull(FieldRevealedAt))
+}
+
+// HasAccessory applies the HasEdge predicate on the "accessory" edge.
+func HasAccessory() predicate.GachaReceipt {
+	return predicate.GachaReceipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AccessoryTable, AccessoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessoryWith applies the HasEdge predicate on the "accessory" edge with a given conditions (other predicates).
+func HasAccessoryWith(preds ...predicate.NFTAccessory) predicate.GachaReceipt {
+	return predicate.GachaReceipt(func(s *sql.Selector) {
+		step := newAccessoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GachaReceipt) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GachaReceipt) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GachaReceipt) predicate.GachaReceipt {
+	return predicate.GachaReceipt(sql.NotPredicates(p))
+}
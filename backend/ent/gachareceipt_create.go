@@ -0,0 +1,1236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/gachareceipt"
+	"backend/ent/nftaccessory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GachaReceiptCreate is the builder for creating a GachaReceipt entity.
+type GachaReceiptCreate struct {
+	config
+	mutation *GachaReceiptMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_c *GachaReceiptCreate) SetReceiptID(v uint64) *GachaReceiptCreate {
+	_c.mutation.SetReceiptID(v)
+	return _c
+}
+
+// SetOpener sets the "opener" field.
+func (_c *GachaReceiptCreate) SetOpener(v string) *GachaReceiptCreate {
+	_c.mutation.SetOpener(v)
+	return _c
+}
+
+// SetNillableOpener sets the "opener" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableOpener(v *string) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetOpener(*v)
+	}
+	return _c
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (_c *GachaReceiptCreate) SetCommitBlock(v uint64) *GachaReceiptCreate {
+	_c.mutation.SetCommitBlock(v)
+	return _c
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (_c *GachaReceiptCreate) SetOpenTxID(v string) *GachaReceiptCreate {
+	_c.mutation.SetOpenTxID(v)
+	return _c
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (_c *GachaReceiptCreate) SetOpenBlock(v uint64) *GachaReceiptCreate {
+	_c.mutation.SetOpenBlock(v)
+	return _c
+}
+
+// SetNillableOpenBlock sets the "open_block" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableOpenBlock(v *uint64) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetOpenBlock(*v)
+	}
+	return _c
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (_c *GachaReceiptCreate) SetRevealBlock(v uint64) *GachaReceiptCreate {
+	_c.mutation.SetRevealBlock(v)
+	return _c
+}
+
+// SetNillableRevealBlock sets the "reveal_block" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableRevealBlock(v *uint64) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetRevealBlock(*v)
+	}
+	return _c
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (_c *GachaReceiptCreate) SetRevealTxID(v string) *GachaReceiptCreate {
+	_c.mutation.SetRevealTxID(v)
+	return _c
+}
+
+// SetNillableRevealTxID sets the "reveal_tx_id" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableRevealTxID(v *string) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetRevealTxID(*v)
+	}
+	return _c
+}
+
+// SetRarity sets the "rarity" field.
+func (_c *GachaReceiptCreate) SetRarity(v uint8) *GachaReceiptCreate {
+	_c.mutation.SetRarity(v)
+	return _c
+}
+
+// SetNillableRarity sets the "rarity" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableRarity(v *uint8) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetRarity(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GachaReceiptCreate) SetCreatedAt(v time.Time) *GachaReceiptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableCreatedAt(v *time.Time) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (_c *GachaReceiptCreate) SetRevealedAt(v time.Time) *GachaReceiptCreate {
+	_c.mutation.SetRevealedAt(v)
+	return _c
+}
+
+// SetNillableRevealedAt sets the "revealed_at" field if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableRevealedAt(v *time.Time) *GachaReceiptCreate {
+	if v != nil {
+		_c.SetRevealedAt(*v)
+	}
+	return _c
+}
+
+// SetAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID.
+func (_c *GachaReceiptCreate) SetAccessoryID(id int) *GachaReceiptCreate {
+	_c.mutation.SetAccessoryID(id)
+	return _c
+}
+
+// SetNillableAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_c *GachaReceiptCreate) SetNillableAccessoryID(id *int) *GachaReceiptCreate {
+	if id != nil {
+		_c = _c.SetAccessoryID(*id)
+	}
+	return _c
+}
+
+// SetAccessory sets the "accessory" edge to the NFTAccessory entity.
+func (_c *GachaReceiptCreate) SetAccessory(v *NFTAccessory) *GachaReceiptCreate {
+	return _c.SetAccessoryID(v.ID)
+}
+
+// Mutation returns the GachaReceiptMutation object of the builder.
+func (_c *GachaReceiptCreate) Mutation() *GachaReceiptMutation {
+	return _c.mutation
+}
+
+// Save creates the GachaReceipt in the database.
+func (_c *GachaReceiptCreate) Save(ctx context.Context) (*GachaReceipt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GachaReceiptCreate) SaveX(ctx context.Context) *GachaReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GachaReceiptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GachaReceiptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GachaReceiptCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := gachareceipt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GachaReceiptCreate) check() error {
+	if _, ok := _c.mutation.ReceiptID(); !ok {
+		return &ValidationError{Name: "receipt_id", err: errors.New(`ent: missing required field "GachaReceipt.receipt_id"`)}
+	}
+	if _, ok := _c.mutation.CommitBlock(); !ok {
+		return &ValidationError{Name: "commit_block", err: errors.New(`ent: missing required field "GachaReceipt.commit_block"`)}
+	}
+	if _, ok := _c.mutation.OpenTxID(); !ok {
+		return &ValidationError{Name: "open_tx_id", err: errors.New(`ent: missing required field "GachaReceipt.open_tx_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GachaReceipt.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *GachaReceiptCreate) sqlSave(ctx context.Context) (*GachaReceipt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GachaReceiptCreate) createSpec() (*GachaReceipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GachaReceipt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gachareceipt.Table, sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.ReceiptID(); ok {
+		_spec.SetField(gachareceipt.FieldReceiptID, field.TypeUint64, value)
+		_node.ReceiptID = value
+	}
+	if value, ok := _c.mutation.Opener(); ok {
+		_spec.SetField(gachareceipt.FieldOpener, field.TypeString, value)
+		_node.Opener = value
+	}
+	if value, ok := _c.mutation.CommitBlock(); ok {
+		_spec.SetField(gachareceipt.FieldCommitBlock, field.TypeUint64, value)
+		_node.CommitBlock = value
+	}
+	if value, ok := _c.mutation.OpenTxID(); ok {
+		_spec.SetField(gachareceipt.FieldOpenTxID, field.TypeString, value)
+		_node.OpenTxID = value
+	}
+	if value, ok := _c.mutation.OpenBlock(); ok {
+		_spec.SetField(gachareceipt.FieldOpenBlock, field.TypeUint64, value)
+		_node.OpenBlock = value
+	}
+	if value, ok := _c.mutation.RevealBlock(); ok {
+		_spec.SetField(gachareceipt.FieldRevealBlock, field.TypeUint64, value)
+		_node.RevealBlock = &value
+	}
+	if value, ok := _c.mutation.RevealTxID(); ok {
+		_spec.SetField(gachareceipt.FieldRevealTxID, field.TypeString, value)
+		_node.RevealTxID = value
+	}
+	if value, ok := _c.mutation.Rarity(); ok {
+		_spec.SetField(gachareceipt.FieldRarity, field.TypeUint8, value)
+		_node.Rarity = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(gachareceipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RevealedAt(); ok {
+		_spec.SetField(gachareceipt.FieldRevealedAt, field.TypeTime, value)
+		_node.RevealedAt = &value
+	}
+	if nodes := _c.mutation.AccessoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   gachareceipt.AccessoryTable,
+			Columns: []string{gachareceipt.AccessoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GachaReceipt.Create().
+//		SetReceiptID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GachaReceiptUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GachaReceiptCreate) OnConflict(opts ...sql.ConflictOption) *GachaReceiptUpsertOne {
+	_c.conflict = opts
+	return &GachaReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GachaReceiptCreate) OnConflictColumns(columns ...string) *GachaReceiptUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GachaReceiptUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GachaReceiptUpsertOne is the builder for "upsert"-ing
+	//  one GachaReceipt node.
+	GachaReceiptUpsertOne struct {
+		create *GachaReceiptCreate
+	}
+
+	// GachaReceiptUpsert is the "OnConflict" setter.
+	GachaReceiptUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GachaReceiptUpsert) SetReceiptID(v uint64) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldReceiptID, v)
+	return u
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateReceiptID() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldReceiptID)
+	return u
+}
+
+// AddReceiptID adds v to the "receipt_id" field.
+func (u *GachaReceiptUpsert) AddReceiptID(v uint64) *GachaReceiptUpsert {
+	u.Add(gachareceipt.FieldReceiptID, v)
+	return u
+}
+
+// SetOpener sets the "opener" field.
+func (u *GachaReceiptUpsert) SetOpener(v string) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldOpener, v)
+	return u
+}
+
+// UpdateOpener sets the "opener" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateOpener() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldOpener)
+	return u
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (u *GachaReceiptUpsert) ClearOpener() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldOpener)
+	return u
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (u *GachaReceiptUpsert) SetCommitBlock(v uint64) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldCommitBlock, v)
+	return u
+}
+
+// UpdateCommitBlock sets the "commit_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateCommitBlock() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldCommitBlock)
+	return u
+}
+
+// AddCommitBlock adds v to the "commit_block" field.
+func (u *GachaReceiptUpsert) AddCommitBlock(v uint64) *GachaReceiptUpsert {
+	u.Add(gachareceipt.FieldCommitBlock, v)
+	return u
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (u *GachaReceiptUpsert) SetOpenTxID(v string) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldOpenTxID, v)
+	return u
+}
+
+// UpdateOpenTxID sets the "open_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateOpenTxID() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldOpenTxID)
+	return u
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (u *GachaReceiptUpsert) SetOpenBlock(v uint64) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldOpenBlock, v)
+	return u
+}
+
+// UpdateOpenBlock sets the "open_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateOpenBlock() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldOpenBlock)
+	return u
+}
+
+// AddOpenBlock adds v to the "open_block" field.
+func (u *GachaReceiptUpsert) AddOpenBlock(v uint64) *GachaReceiptUpsert {
+	u.Add(gachareceipt.FieldOpenBlock, v)
+	return u
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (u *GachaReceiptUpsert) ClearOpenBlock() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldOpenBlock)
+	return u
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (u *GachaReceiptUpsert) SetRevealBlock(v uint64) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldRevealBlock, v)
+	return u
+}
+
+// UpdateRevealBlock sets the "reveal_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateRevealBlock() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldRevealBlock)
+	return u
+}
+
+// AddRevealBlock adds v to the "reveal_block" field.
+func (u *GachaReceiptUpsert) AddRevealBlock(v uint64) *GachaReceiptUpsert {
+	u.Add(gachareceipt.FieldRevealBlock, v)
+	return u
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (u *GachaReceiptUpsert) ClearRevealBlock() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldRevealBlock)
+	return u
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (u *GachaReceiptUpsert) SetRevealTxID(v string) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldRevealTxID, v)
+	return u
+}
+
+// UpdateRevealTxID sets the "reveal_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateRevealTxID() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldRevealTxID)
+	return u
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (u *GachaReceiptUpsert) ClearRevealTxID() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldRevealTxID)
+	return u
+}
+
+// SetRarity sets the "rarity" field.
+func (u *GachaReceiptUpsert) SetRarity(v uint8) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldRarity, v)
+	return u
+}
+
+// UpdateRarity sets the "rarity" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateRarity() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldRarity)
+	return u
+}
+
+// AddRarity adds v to the "rarity" field.
+func (u *GachaReceiptUpsert) AddRarity(v uint8) *GachaReceiptUpsert {
+	u.Add(gachareceipt.FieldRarity, v)
+	return u
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (u *GachaReceiptUpsert) ClearRarity() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldRarity)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GachaReceiptUpsert) SetCreatedAt(v time.Time) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateCreatedAt() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldCreatedAt)
+	return u
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (u *GachaReceiptUpsert) SetRevealedAt(v time.Time) *GachaReceiptUpsert {
+	u.Set(gachareceipt.FieldRevealedAt, v)
+	return u
+}
+
+// UpdateRevealedAt sets the "revealed_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsert) UpdateRevealedAt() *GachaReceiptUpsert {
+	u.SetExcluded(gachareceipt.FieldRevealedAt)
+	return u
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (u *GachaReceiptUpsert) ClearRevealedAt() *GachaReceiptUpsert {
+	u.SetNull(gachareceipt.FieldRevealedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GachaReceiptUpsertOne) UpdateNewValues() *GachaReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GachaReceiptUpsertOne) Ignore() *GachaReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GachaReceiptUpsertOne) DoNothing() *GachaReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GachaReceiptCreate.OnConflict
+// documentation for more info.
+func (u *GachaReceiptUpsertOne) Update(set func(*GachaReceiptUpsert)) *GachaReceiptUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GachaReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GachaReceiptUpsertOne) SetReceiptID(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// AddReceiptID adds v to the "receipt_id" field.
+func (u *GachaReceiptUpsertOne) AddReceiptID(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateReceiptID() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetOpener sets the "opener" field.
+func (u *GachaReceiptUpsertOne) SetOpener(v string) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpener(v)
+	})
+}
+
+// UpdateOpener sets the "opener" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateOpener() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpener()
+	})
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (u *GachaReceiptUpsertOne) ClearOpener() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearOpener()
+	})
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (u *GachaReceiptUpsertOne) SetCommitBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetCommitBlock(v)
+	})
+}
+
+// AddCommitBlock adds v to the "commit_block" field.
+func (u *GachaReceiptUpsertOne) AddCommitBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddCommitBlock(v)
+	})
+}
+
+// UpdateCommitBlock sets the "commit_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateCommitBlock() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateCommitBlock()
+	})
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (u *GachaReceiptUpsertOne) SetOpenTxID(v string) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpenTxID(v)
+	})
+}
+
+// UpdateOpenTxID sets the "open_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateOpenTxID() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpenTxID()
+	})
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (u *GachaReceiptUpsertOne) SetOpenBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpenBlock(v)
+	})
+}
+
+// AddOpenBlock adds v to the "open_block" field.
+func (u *GachaReceiptUpsertOne) AddOpenBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddOpenBlock(v)
+	})
+}
+
+// UpdateOpenBlock sets the "open_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateOpenBlock() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpenBlock()
+	})
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (u *GachaReceiptUpsertOne) ClearOpenBlock() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearOpenBlock()
+	})
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (u *GachaReceiptUpsertOne) SetRevealBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealBlock(v)
+	})
+}
+
+// AddRevealBlock adds v to the "reveal_block" field.
+func (u *GachaReceiptUpsertOne) AddRevealBlock(v uint64) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddRevealBlock(v)
+	})
+}
+
+// UpdateRevealBlock sets the "reveal_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateRevealBlock() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealBlock()
+	})
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (u *GachaReceiptUpsertOne) ClearRevealBlock() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealBlock()
+	})
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (u *GachaReceiptUpsertOne) SetRevealTxID(v string) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealTxID(v)
+	})
+}
+
+// UpdateRevealTxID sets the "reveal_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateRevealTxID() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealTxID()
+	})
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (u *GachaReceiptUpsertOne) ClearRevealTxID() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealTxID()
+	})
+}
+
+// SetRarity sets the "rarity" field.
+func (u *GachaReceiptUpsertOne) SetRarity(v uint8) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRarity(v)
+	})
+}
+
+// AddRarity adds v to the "rarity" field.
+func (u *GachaReceiptUpsertOne) AddRarity(v uint8) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddRarity(v)
+	})
+}
+
+// UpdateRarity sets the "rarity" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateRarity() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRarity()
+	})
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (u *GachaReceiptUpsertOne) ClearRarity() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRarity()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GachaReceiptUpsertOne) SetCreatedAt(v time.Time) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateCreatedAt() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (u *GachaReceiptUpsertOne) SetRevealedAt(v time.Time) *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealedAt(v)
+	})
+}
+
+// UpdateRevealedAt sets the "revealed_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsertOne) UpdateRevealedAt() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealedAt()
+	})
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (u *GachaReceiptUpsertOne) ClearRevealedAt() *GachaReceiptUpsertOne {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GachaReceiptUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GachaReceiptCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GachaReceiptUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GachaReceiptUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GachaReceiptUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GachaReceiptCreateBulk is the builder for creating many GachaReceipt entities in bulk.
+type GachaReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*GachaReceiptCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GachaReceipt entities in the database.
+func (_c *GachaReceiptCreateBulk) Save(ctx context.Context) ([]*GachaReceipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GachaReceipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GachaReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GachaReceiptCreateBulk) SaveX(ctx context.Context) []*GachaReceipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GachaReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GachaReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GachaReceipt.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GachaReceiptUpsert) {
+//			SetReceiptID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GachaReceiptCreateBulk) OnConflict(opts ...sql.ConflictOption) *GachaReceiptUpsertBulk {
+	_c.conflict = opts
+	return &GachaReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GachaReceiptCreateBulk) OnConflictColumns(columns ...string) *GachaReceiptUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GachaReceiptUpsertBulk{
+		create: _c,
+	}
+}
+
+// GachaReceiptUpsertBulk is the builder for "upsert"-ing
+// a bulk of GachaReceipt nodes.
+type GachaReceiptUpsertBulk struct {
+	create *GachaReceiptCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GachaReceiptUpsertBulk) UpdateNewValues() *GachaReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GachaReceipt.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GachaReceiptUpsertBulk) Ignore() *GachaReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GachaReceiptUpsertBulk) DoNothing() *GachaReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GachaReceiptCreateBulk.OnConflict
+// documentation for more info.
+func (u *GachaReceiptUpsertBulk) Update(set func(*GachaReceiptUpsert)) *GachaReceiptUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GachaReceiptUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (u *GachaReceiptUpsertBulk) SetReceiptID(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetReceiptID(v)
+	})
+}
+
+// AddReceiptID adds v to the "receipt_id" field.
+func (u *GachaReceiptUpsertBulk) AddReceiptID(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddReceiptID(v)
+	})
+}
+
+// UpdateReceiptID sets the "receipt_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateReceiptID() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateReceiptID()
+	})
+}
+
+// SetOpener sets the "opener" field.
+func (u *GachaReceiptUpsertBulk) SetOpener(v string) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpener(v)
+	})
+}
+
+// UpdateOpener sets the "opener" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateOpener() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpener()
+	})
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (u *GachaReceiptUpsertBulk) ClearOpener() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearOpener()
+	})
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (u *GachaReceiptUpsertBulk) SetCommitBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetCommitBlock(v)
+	})
+}
+
+// AddCommitBlock adds v to the "commit_block" field.
+func (u *GachaReceiptUpsertBulk) AddCommitBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddCommitBlock(v)
+	})
+}
+
+// UpdateCommitBlock sets the "commit_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateCommitBlock() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateCommitBlock()
+	})
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (u *GachaReceiptUpsertBulk) SetOpenTxID(v string) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpenTxID(v)
+	})
+}
+
+// UpdateOpenTxID sets the "open_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateOpenTxID() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpenTxID()
+	})
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (u *GachaReceiptUpsertBulk) SetOpenBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetOpenBlock(v)
+	})
+}
+
+// AddOpenBlock adds v to the "open_block" field.
+func (u *GachaReceiptUpsertBulk) AddOpenBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddOpenBlock(v)
+	})
+}
+
+// UpdateOpenBlock sets the "open_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateOpenBlock() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateOpenBlock()
+	})
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (u *GachaReceiptUpsertBulk) ClearOpenBlock() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearOpenBlock()
+	})
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (u *GachaReceiptUpsertBulk) SetRevealBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealBlock(v)
+	})
+}
+
+// AddRevealBlock adds v to the "reveal_block" field.
+func (u *GachaReceiptUpsertBulk) AddRevealBlock(v uint64) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddRevealBlock(v)
+	})
+}
+
+// UpdateRevealBlock sets the "reveal_block" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateRevealBlock() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealBlock()
+	})
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (u *GachaReceiptUpsertBulk) ClearRevealBlock() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealBlock()
+	})
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (u *GachaReceiptUpsertBulk) SetRevealTxID(v string) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealTxID(v)
+	})
+}
+
+// UpdateRevealTxID sets the "reveal_tx_id" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateRevealTxID() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealTxID()
+	})
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (u *GachaReceiptUpsertBulk) ClearRevealTxID() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealTxID()
+	})
+}
+
+// SetRarity sets the "rarity" field.
+func (u *GachaReceiptUpsertBulk) SetRarity(v uint8) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRarity(v)
+	})
+}
+
+// AddRarity adds v to the "rarity" field.
+func (u *GachaReceiptUpsertBulk) AddRarity(v uint8) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.AddRarity(v)
+	})
+}
+
+// UpdateRarity sets the "rarity" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateRarity() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRarity()
+	})
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (u *GachaReceiptUpsertBulk) ClearRarity() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRarity()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *GachaReceiptUpsertBulk) SetCreatedAt(v time.Time) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateCreatedAt() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (u *GachaReceiptUpsertBulk) SetRevealedAt(v time.Time) *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.SetRevealedAt(v)
+	})
+}
+
+// UpdateRevealedAt sets the "revealed_at" field to the value that was provided on create.
+func (u *GachaReceiptUpsertBulk) UpdateRevealedAt() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.UpdateRevealedAt()
+	})
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (u *GachaReceiptUpsertBulk) ClearRevealedAt() *GachaReceiptUpsertBulk {
+	return u.Update(func(s *GachaReceiptUpsert) {
+		s.ClearRevealedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GachaReceiptUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GachaReceiptCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GachaReceiptCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GachaReceiptUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
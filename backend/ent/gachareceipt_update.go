@@ -0,0 +1,833 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/gachareceipt"
+	"backend/ent/nftaccessory"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GachaReceiptUpdate is the builder for updating GachaReceipt entities.
+type GachaReceiptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GachaReceiptMutation
+}
+
+// Where appends a list predicates to the GachaReceiptUpdate builder.
+func (_u *GachaReceiptUpdate) Where(ps ...predicate.GachaReceipt) *GachaReceiptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *GachaReceiptUpdate) SetReceiptID(v uint64) *GachaReceiptUpdate {
+	_u.mutation.ResetReceiptID()
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableReceiptID(v *uint64) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// AddReceiptID adds value to the "receipt_id" field.
+func (_u *GachaReceiptUpdate) AddReceiptID(v int64) *GachaReceiptUpdate {
+	_u.mutation.AddReceiptID(v)
+	return _u
+}
+
+// SetOpener sets the "opener" field.
+func (_u *GachaReceiptUpdate) SetOpener(v string) *GachaReceiptUpdate {
+	_u.mutation.SetOpener(v)
+	return _u
+}
+
+// SetNillableOpener sets the "opener" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableOpener(v *string) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetOpener(*v)
+	}
+	return _u
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (_u *GachaReceiptUpdate) ClearOpener() *GachaReceiptUpdate {
+	_u.mutation.ClearOpener()
+	return _u
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (_u *GachaReceiptUpdate) SetCommitBlock(v uint64) *GachaReceiptUpdate {
+	_u.mutation.ResetCommitBlock()
+	_u.mutation.SetCommitBlock(v)
+	return _u
+}
+
+// SetNillableCommitBlock sets the "commit_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableCommitBlock(v *uint64) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetCommitBlock(*v)
+	}
+	return _u
+}
+
+// AddCommitBlock adds value to the "commit_block" field.
+func (_u *GachaReceiptUpdate) AddCommitBlock(v int64) *GachaReceiptUpdate {
+	_u.mutation.AddCommitBlock(v)
+	return _u
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (_u *GachaReceiptUpdate) SetOpenTxID(v string) *GachaReceiptUpdate {
+	_u.mutation.SetOpenTxID(v)
+	return _u
+}
+
+// SetNillableOpenTxID sets the "open_tx_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableOpenTxID(v *string) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetOpenTxID(*v)
+	}
+	return _u
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (_u *GachaReceiptUpdate) SetOpenBlock(v uint64) *GachaReceiptUpdate {
+	_u.mutation.ResetOpenBlock()
+	_u.mutation.SetOpenBlock(v)
+	return _u
+}
+
+// SetNillableOpenBlock sets the "open_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableOpenBlock(v *uint64) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetOpenBlock(*v)
+	}
+	return _u
+}
+
+// AddOpenBlock adds value to the "open_block" field.
+func (_u *GachaReceiptUpdate) AddOpenBlock(v int64) *GachaReceiptUpdate {
+	_u.mutation.AddOpenBlock(v)
+	return _u
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (_u *GachaReceiptUpdate) ClearOpenBlock() *GachaReceiptUpdate {
+	_u.mutation.ClearOpenBlock()
+	return _u
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (_u *GachaReceiptUpdate) SetRevealBlock(v uint64) *GachaReceiptUpdate {
+	_u.mutation.ResetRevealBlock()
+	_u.mutation.SetRevealBlock(v)
+	return _u
+}
+
+// SetNillableRevealBlock sets the "reveal_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableRevealBlock(v *uint64) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetRevealBlock(*v)
+	}
+	return _u
+}
+
+// AddRevealBlock adds value to the "reveal_block" field.
+func (_u *GachaReceiptUpdate) AddRevealBlock(v int64) *GachaReceiptUpdate {
+	_u.mutation.AddRevealBlock(v)
+	return _u
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (_u *GachaReceiptUpdate) ClearRevealBlock() *GachaReceiptUpdate {
+	_u.mutation.ClearRevealBlock()
+	return _u
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (_u *GachaReceiptUpdate) SetRevealTxID(v string) *GachaReceiptUpdate {
+	_u.mutation.SetRevealTxID(v)
+	return _u
+}
+
+// SetNillableRevealTxID sets the "reveal_tx_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableRevealTxID(v *string) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetRevealTxID(*v)
+	}
+	return _u
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (_u *GachaReceiptUpdate) ClearRevealTxID() *GachaReceiptUpdate {
+	_u.mutation.ClearRevealTxID()
+	return _u
+}
+
+// SetRarity sets the "rarity" field.
+func (_u *GachaReceiptUpdate) SetRarity(v uint8) *GachaReceiptUpdate {
+	_u.mutation.ResetRarity()
+	_u.mutation.SetRarity(v)
+	return _u
+}
+
+// SetNillableRarity sets the "rarity" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableRarity(v *uint8) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetRarity(*v)
+	}
+	return _u
+}
+
+// AddRarity adds value to the "rarity" field.
+func (_u *GachaReceiptUpdate) AddRarity(v int8) *GachaReceiptUpdate {
+	_u.mutation.AddRarity(v)
+	return _u
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (_u *GachaReceiptUpdate) ClearRarity() *GachaReceiptUpdate {
+	_u.mutation.ClearRarity()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GachaReceiptUpdate) SetCreatedAt(v time.Time) *GachaReceiptUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableCreatedAt(v *time.Time) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (_u *GachaReceiptUpdate) SetRevealedAt(v time.Time) *GachaReceiptUpdate {
+	_u.mutation.SetRevealedAt(v)
+	return _u
+}
+
+// SetNillableRevealedAt sets the "revealed_at" field if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableRevealedAt(v *time.Time) *GachaReceiptUpdate {
+	if v != nil {
+		_u.SetRevealedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (_u *GachaReceiptUpdate) ClearRevealedAt() *GachaReceiptUpdate {
+	_u.mutation.ClearRevealedAt()
+	return _u
+}
+
+// SetAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID.
+func (_u *GachaReceiptUpdate) SetAccessoryID(id int) *GachaReceiptUpdate {
+	_u.mutation.SetAccessoryID(id)
+	return _u
+}
+
+// SetNillableAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_u *GachaReceiptUpdate) SetNillableAccessoryID(id *int) *GachaReceiptUpdate {
+	if id != nil {
+		_u = _u.SetAccessoryID(*id)
+	}
+	return _u
+}
+
+// SetAccessory sets the "accessory" edge to the NFTAccessory entity.
+func (_u *GachaReceiptUpdate) SetAccessory(v *NFTAccessory) *GachaReceiptUpdate {
+	return _u.SetAccessoryID(v.ID)
+}
+
+// Mutation returns the GachaReceiptMutation object of the builder.
+func (_u *GachaReceiptUpdate) Mutation() *GachaReceiptMutation {
+	return _u.mutation
+}
+
+// ClearAccessory clears the "accessory" edge to the NFTAccessory entity.
+func (_u *GachaReceiptUpdate) ClearAccessory() *GachaReceiptUpdate {
+	_u.mutation.ClearAccessory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GachaReceiptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GachaReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GachaReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GachaReceiptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GachaReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gachareceipt.Table, gachareceipt.Columns, sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(gachareceipt.FieldReceiptID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedReceiptID(); ok {
+		_spec.AddField(gachareceipt.FieldReceiptID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Opener(); ok {
+		_spec.SetField(gachareceipt.FieldOpener, field.TypeString, value)
+	}
+	if _u.mutation.OpenerCleared() {
+		_spec.ClearField(gachareceipt.FieldOpener, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitBlock(); ok {
+		_spec.SetField(gachareceipt.FieldCommitBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedCommitBlock(); ok {
+		_spec.AddField(gachareceipt.FieldCommitBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.OpenTxID(); ok {
+		_spec.SetField(gachareceipt.FieldOpenTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpenBlock(); ok {
+		_spec.SetField(gachareceipt.FieldOpenBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedOpenBlock(); ok {
+		_spec.AddField(gachareceipt.FieldOpenBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.OpenBlockCleared() {
+		_spec.ClearField(gachareceipt.FieldOpenBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RevealBlock(); ok {
+		_spec.SetField(gachareceipt.FieldRevealBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRevealBlock(); ok {
+		_spec.AddField(gachareceipt.FieldRevealBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.RevealBlockCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RevealTxID(); ok {
+		_spec.SetField(gachareceipt.FieldRevealTxID, field.TypeString, value)
+	}
+	if _u.mutation.RevealTxIDCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealTxID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rarity(); ok {
+		_spec.SetField(gachareceipt.FieldRarity, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedRarity(); ok {
+		_spec.AddField(gachareceipt.FieldRarity, field.TypeUint8, value)
+	}
+	if _u.mutation.RarityCleared() {
+		_spec.ClearField(gachareceipt.FieldRarity, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(gachareceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevealedAt(); ok {
+		_spec.SetField(gachareceipt.FieldRevealedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevealedAtCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealedAt, field.TypeTime)
+	}
+	if _u.mutation.AccessoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   gachareceipt.AccessoryTable,
+			Columns: []string{gachareceipt.AccessoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   gachareceipt.AccessoryTable,
+			Columns: []string{gachareceipt.AccessoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gachareceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GachaReceiptUpdateOne is the builder for updating a single GachaReceipt entity.
+type GachaReceiptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GachaReceiptMutation
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (_u *GachaReceiptUpdateOne) SetReceiptID(v uint64) *GachaReceiptUpdateOne {
+	_u.mutation.ResetReceiptID()
+	_u.mutation.SetReceiptID(v)
+	return _u
+}
+
+// SetNillableReceiptID sets the "receipt_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableReceiptID(v *uint64) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetReceiptID(*v)
+	}
+	return _u
+}
+
+// AddReceiptID adds value to the "receipt_id" field.
+func (_u *GachaReceiptUpdateOne) AddReceiptID(v int64) *GachaReceiptUpdateOne {
+	_u.mutation.AddReceiptID(v)
+	return _u
+}
+
+// SetOpener sets the "opener" field.
+func (_u *GachaReceiptUpdateOne) SetOpener(v string) *GachaReceiptUpdateOne {
+	_u.mutation.SetOpener(v)
+	return _u
+}
+
+// SetNillableOpener sets the "opener" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableOpener(v *string) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetOpener(*v)
+	}
+	return _u
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (_u *GachaReceiptUpdateOne) ClearOpener() *GachaReceiptUpdateOne {
+	_u.mutation.ClearOpener()
+	return _u
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (_u *GachaReceiptUpdateOne) SetCommitBlock(v uint64) *GachaReceiptUpdateOne {
+	_u.mutation.ResetCommitBlock()
+	_u.mutation.SetCommitBlock(v)
+	return _u
+}
+
+// SetNillableCommitBlock sets the "commit_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableCommitBlock(v *uint64) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetCommitBlock(*v)
+	}
+	return _u
+}
+
+// AddCommitBlock adds value to the "commit_block" field.
+func (_u *GachaReceiptUpdateOne) AddCommitBlock(v int64) *GachaReceiptUpdateOne {
+	_u.mutation.AddCommitBlock(v)
+	return _u
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (_u *GachaReceiptUpdateOne) SetOpenTxID(v string) *GachaReceiptUpdateOne {
+	_u.mutation.SetOpenTxID(v)
+	return _u
+}
+
+// SetNillableOpenTxID sets the "open_tx_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableOpenTxID(v *string) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetOpenTxID(*v)
+	}
+	return _u
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (_u *GachaReceiptUpdateOne) SetOpenBlock(v uint64) *GachaReceiptUpdateOne {
+	_u.mutation.ResetOpenBlock()
+	_u.mutation.SetOpenBlock(v)
+	return _u
+}
+
+// SetNillableOpenBlock sets the "open_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableOpenBlock(v *uint64) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetOpenBlock(*v)
+	}
+	return _u
+}
+
+// AddOpenBlock adds value to the "open_block" field.
+func (_u *GachaReceiptUpdateOne) AddOpenBlock(v int64) *GachaReceiptUpdateOne {
+	_u.mutation.AddOpenBlock(v)
+	return _u
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (_u *GachaReceiptUpdateOne) ClearOpenBlock() *GachaReceiptUpdateOne {
+	_u.mutation.ClearOpenBlock()
+	return _u
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (_u *GachaReceiptUpdateOne) SetRevealBlock(v uint64) *GachaReceiptUpdateOne {
+	_u.mutation.ResetRevealBlock()
+	_u.mutation.SetRevealBlock(v)
+	return _u
+}
+
+// SetNillableRevealBlock sets the "reveal_block" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableRevealBlock(v *uint64) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetRevealBlock(*v)
+	}
+	return _u
+}
+
+// AddRevealBlock adds value to the "reveal_block" field.
+func (_u *GachaReceiptUpdateOne) AddRevealBlock(v int64) *GachaReceiptUpdateOne {
+	_u.mutation.AddRevealBlock(v)
+	return _u
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (_u *GachaReceiptUpdateOne) ClearRevealBlock() *GachaReceiptUpdateOne {
+	_u.mutation.ClearRevealBlock()
+	return _u
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (_u *GachaReceiptUpdateOne) SetRevealTxID(v string) *GachaReceiptUpdateOne {
+	_u.mutation.SetRevealTxID(v)
+	return _u
+}
+
+// SetNillableRevealTxID sets the "reveal_tx_id" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableRevealTxID(v *string) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetRevealTxID(*v)
+	}
+	return _u
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (_u *GachaReceiptUpdateOne) ClearRevealTxID() *GachaReceiptUpdateOne {
+	_u.mutation.ClearRevealTxID()
+	return _u
+}
+
+// SetRarity sets the "rarity" field.
+func (_u *GachaReceiptUpdateOne) SetRarity(v uint8) *GachaReceiptUpdateOne {
+	_u.mutation.ResetRarity()
+	_u.mutation.SetRarity(v)
+	return _u
+}
+
+// SetNillableRarity sets the "rarity" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableRarity(v *uint8) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetRarity(*v)
+	}
+	return _u
+}
+
+// AddRarity adds value to the "rarity" field.
+func (_u *GachaReceiptUpdateOne) AddRarity(v int8) *GachaReceiptUpdateOne {
+	_u.mutation.AddRarity(v)
+	return _u
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (_u *GachaReceiptUpdateOne) ClearRarity() *GachaReceiptUpdateOne {
+	_u.mutation.ClearRarity()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GachaReceiptUpdateOne) SetCreatedAt(v time.Time) *GachaReceiptUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableCreatedAt(v *time.Time) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (_u *GachaReceiptUpdateOne) SetRevealedAt(v time.Time) *GachaReceiptUpdateOne {
+	_u.mutation.SetRevealedAt(v)
+	return _u
+}
+
+// SetNillableRevealedAt sets the "revealed_at" field if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableRevealedAt(v *time.Time) *GachaReceiptUpdateOne {
+	if v != nil {
+		_u.SetRevealedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (_u *GachaReceiptUpdateOne) ClearRevealedAt() *GachaReceiptUpdateOne {
+	_u.mutation.ClearRevealedAt()
+	return _u
+}
+
+// SetAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID.
+func (_u *GachaReceiptUpdateOne) SetAccessoryID(id int) *GachaReceiptUpdateOne {
+	_u.mutation.SetAccessoryID(id)
+	return _u
+}
+
+// SetNillableAccessoryID sets the "accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_u *GachaReceiptUpdateOne) SetNillableAccessoryID(id *int) *GachaReceiptUpdateOne {
+	if id != nil {
+		_u = _u.SetAccessoryID(*id)
+	}
+	return _u
+}
+
+// SetAccessory sets the "accessory" edge to the NFTAccessory entity.
+func (_u *GachaReceiptUpdateOne) SetAccessory(v *NFTAccessory) *GachaReceiptUpdateOne {
+	return _u.SetAccessoryID(v.ID)
+}
+
+// Mutation returns the GachaReceiptMutation object of the builder.
+func (_u *GachaReceiptUpdateOne) Mutation() *GachaReceiptMutation {
+	return _u.mutation
+}
+
+// ClearAccessory clears the "accessory" edge to the NFTAccessory entity.
+func (_u *GachaReceiptUpdateOne) ClearAccessory() *GachaReceiptUpdateOne {
+	_u.mutation.ClearAccessory()
+	return _u
+}
+
+// Where appends a list predicates to the GachaReceiptUpdate builder.
+func (_u *GachaReceiptUpdateOne) Where(ps ...predicate.GachaReceipt) *GachaReceiptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GachaReceiptUpdateOne) Select(field string, fields ...string) *GachaReceiptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GachaReceipt entity.
+func (_u *GachaReceiptUpdateOne) Save(ctx context.Context) (*GachaReceipt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GachaReceiptUpdateOne) SaveX(ctx context.Context) *GachaReceipt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GachaReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GachaReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GachaReceiptUpdateOne) sqlSave(ctx context.Context) (_node *GachaReceipt, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gachareceipt.Table, gachareceipt.Columns, sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GachaReceipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gachareceipt.FieldID)
+		for _, f := range fields {
+			if !gachareceipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gachareceipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReceiptID(); ok {
+		_spec.SetField(gachareceipt.FieldReceiptID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedReceiptID(); ok {
+		_spec.AddField(gachareceipt.FieldReceiptID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Opener(); ok {
+		_spec.SetField(gachareceipt.FieldOpener, field.TypeString, value)
+	}
+	if _u.mutation.OpenerCleared() {
+		_spec.ClearField(gachareceipt.FieldOpener, field.TypeString)
+	}
+	if value, ok := _u.mutation.CommitBlock(); ok {
+		_spec.SetField(gachareceipt.FieldCommitBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedCommitBlock(); ok {
+		_spec.AddField(gachareceipt.FieldCommitBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.OpenTxID(); ok {
+		_spec.SetField(gachareceipt.FieldOpenTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OpenBlock(); ok {
+		_spec.SetField(gachareceipt.FieldOpenBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedOpenBlock(); ok {
+		_spec.AddField(gachareceipt.FieldOpenBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.OpenBlockCleared() {
+		_spec.ClearField(gachareceipt.FieldOpenBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RevealBlock(); ok {
+		_spec.SetField(gachareceipt.FieldRevealBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedRevealBlock(); ok {
+		_spec.AddField(gachareceipt.FieldRevealBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.RevealBlockCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.RevealTxID(); ok {
+		_spec.SetField(gachareceipt.FieldRevealTxID, field.TypeString, value)
+	}
+	if _u.mutation.RevealTxIDCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealTxID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rarity(); ok {
+		_spec.SetField(gachareceipt.FieldRarity, field.TypeUint8, value)
+	}
+	if value, ok := _u.mutation.AddedRarity(); ok {
+		_spec.AddField(gachareceipt.FieldRarity, field.TypeUint8, value)
+	}
+	if _u.mutation.RarityCleared() {
+		_spec.ClearField(gachareceipt.FieldRarity, field.TypeUint8)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(gachareceipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevealedAt(); ok {
+		_spec.SetField(gachareceipt.FieldRevealedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevealedAtCleared() {
+		_spec.ClearField(gachareceipt.FieldRevealedAt, field.TypeTime)
+	}
+	if _u.mutation.AccessoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   gachareceipt.AccessoryTable,
+			Columns: []string{gachareceipt.AccessoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccessoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   gachareceipt.AccessoryTable,
+			Columns: []string{gachareceipt.AccessoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &GachaReceipt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gachareceipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,6 +5,8 @@ package ent
 import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"fmt"
 	"strings"
@@ -29,11 +31,17 @@ type Listing struct {
 	CustomID *string `json:"custom_id,omitempty"`
 	// Expiry holds the value of the "expiry" field.
 	Expiry time.Time `json:"expiry,omitempty"`
+	// Status holds the value of the "status" field.
+	Status listing.Status `json:"status,omitempty"`
+	// NftType holds the value of the "nft_type" field.
+	NftType listing.NftType `json:"nft_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ListingQuery when eager-loading is set.
-	Edges         ListingEdges `json:"edges"`
-	user_listings *int
-	selectValues  sql.SelectValues
+	Edges                 ListingEdges `json:"edges"`
+	listing_nft_accessory *int
+	listing_nft_moment    *int
+	user_listings         *int
+	selectValues          sql.SelectValues
 }
 
 // ListingEdges holds the relations/edges for other nodes in the graph.
@@ -42,9 +50,13 @@ type ListingEdges struct {
 	Seller *User `json:"seller,omitempty"`
 	// NftAccessory holds the value of the nft_accessory edge.
 	NftAccessory *NFTAccessory `json:"nft_accessory,omitempty"`
+	// NftMoment holds the value of the nft_moment edge.
+	NftMoment *NFTMoment `json:"nft_moment,omitempty"`
+	// Sale holds the value of the sale edge.
+	Sale *Sale `json:"sale,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // SellerOrErr returns the Seller value or an error if the edge
@@ -69,6 +81,28 @@ func (e ListingEdges) NftAccessoryOrErr() (*NFTAccessory, error) {
 	return nil, &NotLoadedError{edge: "nft_accessory"}
 }
 
+// NftMomentOrErr returns the NftMoment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) NftMomentOrErr() (*NFTMoment, error) {
+	if e.NftMoment != nil {
+		return e.NftMoment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: nftmoment.Label}
+	}
+	return nil, &NotLoadedError{edge: "nft_moment"}
+}
+
+// SaleOrErr returns the Sale value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) SaleOrErr() (*Sale, error) {
+	if e.Sale != nil {
+		return e.Sale, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: sale.Label}
+	}
+	return nil, &NotLoadedError{edge: "sale"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Listing) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -78,11 +112,15 @@ func (*Listing) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case listing.FieldID, listing.FieldListingID:
 			values[i] = new(sql.NullInt64)
-		case listing.FieldPaymentVaultType, listing.FieldCustomID:
+		case listing.FieldPaymentVaultType, listing.FieldCustomID, listing.FieldStatus, listing.FieldNftType:
 			values[i] = new(sql.NullString)
 		case listing.FieldExpiry:
 			values[i] = new(sql.NullTime)
-		case listing.ForeignKeys[0]: // user_listings
+		case listing.ForeignKeys[0]: // listing_nft_accessory
+			values[i] = new(sql.NullInt64)
+		case listing.ForeignKeys[1]: // listing_nft_moment
+			values[i] = new(sql.NullInt64)
+		case listing.ForeignKeys[2]: // user_listings
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -136,7 +174,33 @@ func (_m *Listing) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Expiry = value.Time
 			}
+		case listing.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = listing.Status(value.String)
+			}
+		case listing.FieldNftType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nft_type", values[i])
+			} else if value.Valid {
+				_m.NftType = listing.NftType(value.String)
+			}
 		case listing.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field listing_nft_accessory", value)
+			} else if value.Valid {
+				_m.listing_nft_accessory = new(int)
+				*_m.listing_nft_accessory = int(value.Int64)
+			}
+		case listing.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field listing_nft_moment", value)
+			} else if value.Valid {
+				_m.listing_nft_moment = new(int)
+				*_m.listing_nft_moment = int(value.Int64)
+			}
+		case listing.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_listings", value)
 			} else if value.Valid {
@@ -166,6 +230,16 @@ func (_m *Listing) QueryNftAccessory() *NFTAccessoryQuery {
 	return NewListingClient(_m.config).QueryNftAccessory(_m)
 }
 
+// QueryNftMoment queries the "nft_moment" edge of the Listing entity.
+func (_m *Listing) QueryNftMoment() *NFTMomentQuery {
+	return NewListingClient(_m.config).QueryNftMoment(_m)
+}
+
+// QuerySale queries the "sale" edge of the Listing entity.
+func (_m *Listing) QuerySale() *SaleQuery {
+	return NewListingClient(_m.config).QuerySale(_m)
+}
+
 // Update returns a builder for updating this Listing.
 // Note that you need to call Listing.Unwrap() before calling this method if this Listing
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -205,6 +279,12 @@ func (_m *Listing) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expiry=")
 	builder.WriteString(_m.Expiry.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("nft_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NftType))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package listing
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -22,10 +24,18 @@ const (
 	FieldCustomID = "custom_id"
 	// FieldExpiry holds the string denoting the expiry field in the database.
 	FieldExpiry = "expiry"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldNftType holds the string denoting the nft_type field in the database.
+	FieldNftType = "nft_type"
 	// EdgeSeller holds the string denoting the seller edge name in mutations.
 	EdgeSeller = "seller"
 	// EdgeNftAccessory holds the string denoting the nft_accessory edge name in mutations.
 	EdgeNftAccessory = "nft_accessory"
+	// EdgeNftMoment holds the string denoting the nft_moment edge name in mutations.
+	EdgeNftMoment = "nft_moment"
+	// EdgeSale holds the string denoting the sale edge name in mutations.
+	EdgeSale = "sale"
 	// Table holds the table name of the listing in the database.
 	Table = "listings"
 	// SellerTable is the table that holds the seller relation/edge.
@@ -36,12 +46,26 @@ const (
 	// SellerColumn is the table column denoting the seller relation/edge.
 	SellerColumn = "user_listings"
 	// NftAccessoryTable is the table that holds the nft_accessory relation/edge.
-	NftAccessoryTable = "nft_accessories"
+	NftAccessoryTable = "listings"
 	// NftAccessoryInverseTable is the table name for the NFTAccessory entity.
 	// It exists in this package in order to avoid circular dependency with the "nftaccessory" package.
 	NftAccessoryInverseTable = "nft_accessories"
 	// NftAccessoryColumn is the table column denoting the nft_accessory relation/edge.
 	NftAccessoryColumn = "listing_nft_accessory"
+	// NftMomentTable is the table that holds the nft_moment relation/edge.
+	NftMomentTable = "listings"
+	// NftMomentInverseTable is the table name for the NFTMoment entity.
+	// It exists in this package in order to avoid circular dependency with the "nftmoment" package.
+	NftMomentInverseTable = "nft_moments"
+	// NftMomentColumn is the table column denoting the nft_moment relation/edge.
+	NftMomentColumn = "listing_nft_moment"
+	// SaleTable is the table that holds the sale relation/edge.
+	SaleTable = "sales"
+	// SaleInverseTable is the table name for the Sale entity.
+	// It exists in this package in order to avoid circular dependency with the "sale" package.
+	SaleInverseTable = "sales"
+	// SaleColumn is the table column denoting the sale relation/edge.
+	SaleColumn = "listing_sale"
 )
 
 // Columns holds all SQL columns for listing fields.
@@ -52,11 +76,15 @@ var Columns = []string{
 	FieldPaymentVaultType,
 	FieldCustomID,
 	FieldExpiry,
+	FieldStatus,
+	FieldNftType,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "listings"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"listing_nft_accessory",
+	"listing_nft_moment",
 	"user_listings",
 }
 
@@ -75,6 +103,61 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "active"
+	StatusSold      Status = "sold"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
+	StatusGhost     Status = "ghost"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusSold, StatusCancelled, StatusExpired, StatusGhost:
+		return nil
+	default:
+		return fmt.Errorf("listing: invalid enum value for status field: %q", s)
+	}
+}
+
+// NftType defines the type for the "nft_type" enum field.
+type NftType string
+
+// NftTypeAccessory is the default value of the NftType enum.
+const DefaultNftType = NftTypeAccessory
+
+// NftType values.
+const (
+	NftTypeMoment    NftType = "moment"
+	NftTypeAccessory NftType = "accessory"
+)
+
+func (nt NftType) String() string {
+	return string(nt)
+}
+
+// NftTypeValidator is a validator for the "nft_type" field enum values. It is called by the builders before save.
+func NftTypeValidator(nt NftType) error {
+	switch nt {
+	case NftTypeMoment, NftTypeAccessory:
+		return nil
+	default:
+		return fmt.Errorf("listing: invalid enum value for nft_type field: %q", nt)
+	}
+}
+
 // OrderOption defines the ordering options for the Listing queries.
 type OrderOption func(*sql.Selector)
 
@@ -108,6 +191,16 @@ func ByExpiry(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiry, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByNftType orders the results by the nft_type field.
+func ByNftType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNftType, opts...).ToFunc()
+}
+
 // BySellerField orders the results by seller field.
 func BySellerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -121,6 +214,20 @@ func ByNftAccessoryField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newNftAccessoryStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByNftMomentField orders the results by nft_moment field.
+func ByNftMomentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNftMomentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySaleField orders the results by sale field.
+func BySaleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSaleStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newSellerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -132,6 +239,20 @@ func newNftAccessoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(NftAccessoryInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, NftAccessoryTable, NftAccessoryColumn),
+		sqlgraph.Edge(sqlgraph.M2O, false, NftAccessoryTable, NftAccessoryColumn),
+	)
+}
+func newNftMomentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NftMomentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, NftMomentTable, NftMomentColumn),
+	)
+}
+func newSaleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SaleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SaleTable, SaleColumn),
 	)
 }
@@ -340,6 +340,46 @@ func ExpiryLTE(v time.Time) predicate.Listing {
 	return predicate.Listing(sql.FieldLTE(FieldExpiry, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// NftTypeEQ applies the EQ predicate on the "nft_type" field.
+func NftTypeEQ(v NftType) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldNftType, v))
+}
+
+// NftTypeNEQ applies the NEQ predicate on the "nft_type" field.
+func NftTypeNEQ(v NftType) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldNftType, v))
+}
+
+// NftTypeIn applies the In predicate on the "nft_type" field.
+func NftTypeIn(vs ...NftType) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldNftType, vs...))
+}
+
+// NftTypeNotIn applies the NotIn predicate on the "nft_type" field.
+func NftTypeNotIn(vs ...NftType) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldNftType, vs...))
+}
+
 // HasSeller applies the HasEdge predicate on the "seller" edge.
 func HasSeller() predicate.Listing {
 	return predicate.Listing(func(s *sql.Selector) {
@@ -368,7 +408,7 @@ func HasNftAccessory() predicate.Listing {
 	return predicate.Listing(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, NftAccessoryTable, NftAccessoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, NftAccessoryTable, NftAccessoryColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -386,6 +426,52 @@ func HasNftAccessoryWith(preds ...predicate.NFTAccessory) predicate.Listing {
 	})
 }
 
+// HasNftMoment applies the HasEdge predicate on the "nft_moment" edge.
+func HasNftMoment() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, NftMomentTable, NftMomentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNftMomentWith applies the HasEdge predicate on the "nft_moment" edge with a given conditions (other predicates).
+func HasNftMomentWith(preds ...predicate.NFTMoment) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newNftMomentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSale applies the HasEdge predicate on the "sale" edge.
+func HasSale() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SaleTable, SaleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSaleWith applies the HasEdge predicate on the "sale" edge with a given conditions (other predicates).
+func HasSaleWith(preds ...predicate.Sale) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newSaleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Listing) predicate.Listing {
 	return predicate.Listing(sql.AndPredicates(predicates...))
@@ -5,12 +5,15 @@ package ent
 import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +23,7 @@ type ListingCreate struct {
 	config
 	mutation *ListingMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetListingID sets the "listing_id" field.
@@ -60,6 +64,34 @@ func (_c *ListingCreate) SetExpiry(v time.Time) *ListingCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *ListingCreate) SetStatus(v listing.Status) *ListingCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableStatus(v *listing.Status) *ListingCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetNftType sets the "nft_type" field.
+func (_c *ListingCreate) SetNftType(v listing.NftType) *ListingCreate {
+	_c.mutation.SetNftType(v)
+	return _c
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableNftType(v *listing.NftType) *ListingCreate {
+	if v != nil {
+		_c.SetNftType(*v)
+	}
+	return _c
+}
+
 // SetSellerID sets the "seller" edge to the User entity by ID.
 func (_c *ListingCreate) SetSellerID(id int) *ListingCreate {
 	_c.mutation.SetSellerID(id)
@@ -77,11 +109,57 @@ func (_c *ListingCreate) SetNftAccessoryID(id int) *ListingCreate {
 	return _c
 }
 
+// SetNillableNftAccessoryID sets the "nft_accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_c *ListingCreate) SetNillableNftAccessoryID(id *int) *ListingCreate {
+	if id != nil {
+		_c = _c.SetNftAccessoryID(*id)
+	}
+	return _c
+}
+
 // SetNftAccessory sets the "nft_accessory" edge to the NFTAccessory entity.
 func (_c *ListingCreate) SetNftAccessory(v *NFTAccessory) *ListingCreate {
 	return _c.SetNftAccessoryID(v.ID)
 }
 
+// SetNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID.
+func (_c *ListingCreate) SetNftMomentID(id int) *ListingCreate {
+	_c.mutation.SetNftMomentID(id)
+	return _c
+}
+
+// SetNillableNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID if the given value is not nil.
+func (_c *ListingCreate) SetNillableNftMomentID(id *int) *ListingCreate {
+	if id != nil {
+		_c = _c.SetNftMomentID(*id)
+	}
+	return _c
+}
+
+// SetNftMoment sets the "nft_moment" edge to the NFTMoment entity.
+func (_c *ListingCreate) SetNftMoment(v *NFTMoment) *ListingCreate {
+	return _c.SetNftMomentID(v.ID)
+}
+
+// SetSaleID sets the "sale" edge to the Sale entity by ID.
+func (_c *ListingCreate) SetSaleID(id int) *ListingCreate {
+	_c.mutation.SetSaleID(id)
+	return _c
+}
+
+// SetNillableSaleID sets the "sale" edge to the Sale entity by ID if the given value is not nil.
+func (_c *ListingCreate) SetNillableSaleID(id *int) *ListingCreate {
+	if id != nil {
+		_c = _c.SetSaleID(*id)
+	}
+	return _c
+}
+
+// SetSale sets the "sale" edge to the Sale entity.
+func (_c *ListingCreate) SetSale(v *Sale) *ListingCreate {
+	return _c.SetSaleID(v.ID)
+}
+
 // Mutation returns the ListingMutation object of the builder.
 func (_c *ListingCreate) Mutation() *ListingMutation {
 	return _c.mutation
@@ -89,6 +167,7 @@ func (_c *ListingCreate) Mutation() *ListingMutation {
 
 // Save creates the Listing in the database.
 func (_c *ListingCreate) Save(ctx context.Context) (*Listing, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -114,6 +193,18 @@ func (_c *ListingCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *ListingCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := listing.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.NftType(); !ok {
+		v := listing.DefaultNftType
+		_c.mutation.SetNftType(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *ListingCreate) check() error {
 	if _, ok := _c.mutation.ListingID(); !ok {
@@ -128,12 +219,25 @@ func (_c *ListingCreate) check() error {
 	if _, ok := _c.mutation.Expiry(); !ok {
 		return &ValidationError{Name: "expiry", err: errors.New(`ent: missing required field "Listing.expiry"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Listing.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := listing.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NftType(); !ok {
+		return &ValidationError{Name: "nft_type", err: errors.New(`ent: missing required field "Listing.nft_type"`)}
+	}
+	if v, ok := _c.mutation.NftType(); ok {
+		if err := listing.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Listing.nft_type": %w`, err)}
+		}
+	}
 	if len(_c.mutation.SellerIDs()) == 0 {
 		return &ValidationError{Name: "seller", err: errors.New(`ent: missing required edge "Listing.seller"`)}
 	}
-	if len(_c.mutation.NftAccessoryIDs()) == 0 {
-		return &ValidationError{Name: "nft_accessory", err: errors.New(`ent: missing required edge "Listing.nft_accessory"`)}
-	}
 	return nil
 }
 
@@ -160,6 +264,7 @@ func (_c *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 		_node = &Listing{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(listing.Table, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.ListingID(); ok {
 		_spec.SetField(listing.FieldListingID, field.TypeUint64, value)
 		_node.ListingID = value
@@ -180,6 +285,14 @@ func (_c *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 		_spec.SetField(listing.FieldExpiry, field.TypeTime, value)
 		_node.Expiry = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.NftType(); ok {
+		_spec.SetField(listing.FieldNftType, field.TypeEnum, value)
+		_node.NftType = value
+	}
 	if nodes := _c.mutation.SellerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -199,7 +312,7 @@ func (_c *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 	}
 	if nodes := _c.mutation.NftAccessoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   listing.NftAccessoryTable,
 			Columns: []string{listing.NftAccessoryColumn},
@@ -211,16 +324,394 @@ func (_c *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.listing_nft_accessory = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NftMomentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listing.NftMomentTable,
+			Columns: []string{listing.NftMomentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.listing_nft_moment = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   listing.SaleTable,
+			Columns: []string{listing.SaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.Create().
+//		SetListingID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingCreate) OnConflict(opts ...sql.ConflictOption) *ListingUpsertOne {
+	_c.conflict = opts
+	return &ListingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingCreate) OnConflictColumns(columns ...string) *ListingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingUpsertOne is the builder for "upsert"-ing
+	//  one Listing node.
+	ListingUpsertOne struct {
+		create *ListingCreate
+	}
+
+	// ListingUpsert is the "OnConflict" setter.
+	ListingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingUpsert) SetListingID(v uint64) *ListingUpsert {
+	u.Set(listing.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateListingID() *ListingUpsert {
+	u.SetExcluded(listing.FieldListingID)
+	return u
+}
+
+// AddListingID adds v to the "listing_id" field.
+func (u *ListingUpsert) AddListingID(v uint64) *ListingUpsert {
+	u.Add(listing.FieldListingID, v)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsert) SetPrice(v float64) *ListingUpsert {
+	u.Set(listing.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePrice() *ListingUpsert {
+	u.SetExcluded(listing.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsert) AddPrice(v float64) *ListingUpsert {
+	u.Add(listing.FieldPrice, v)
+	return u
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *ListingUpsert) SetPaymentVaultType(v string) *ListingUpsert {
+	u.Set(listing.FieldPaymentVaultType, v)
+	return u
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePaymentVaultType() *ListingUpsert {
+	u.SetExcluded(listing.FieldPaymentVaultType)
+	return u
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *ListingUpsert) SetCustomID(v string) *ListingUpsert {
+	u.Set(listing.FieldCustomID, v)
+	return u
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCustomID() *ListingUpsert {
+	u.SetExcluded(listing.FieldCustomID)
+	return u
+}
+
+// ClearCustomID clears the value of the "custom_id" field.
+func (u *ListingUpsert) ClearCustomID() *ListingUpsert {
+	u.SetNull(listing.FieldCustomID)
+	return u
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *ListingUpsert) SetExpiry(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldExpiry, v)
+	return u
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateExpiry() *ListingUpsert {
+	u.SetExcluded(listing.FieldExpiry)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsert) SetStatus(v listing.Status) *ListingUpsert {
+	u.Set(listing.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateStatus() *ListingUpsert {
+	u.SetExcluded(listing.FieldStatus)
+	return u
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *ListingUpsert) SetNftType(v listing.NftType) *ListingUpsert {
+	u.Set(listing.FieldNftType, v)
+	return u
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateNftType() *ListingUpsert {
+	u.SetExcluded(listing.FieldNftType)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertOne) UpdateNewValues() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingUpsertOne) Ignore() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertOne) DoNothing() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreate.OnConflict
+// documentation for more info.
+func (u *ListingUpsertOne) Update(set func(*ListingUpsert)) *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingUpsertOne) SetListingID(v uint64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// AddListingID adds v to the "listing_id" field.
+func (u *ListingUpsertOne) AddListingID(v uint64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateListingID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertOne) SetPrice(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertOne) AddPrice(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePrice() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *ListingUpsertOne) SetPaymentVaultType(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPaymentVaultType(v)
+	})
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePaymentVaultType() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePaymentVaultType()
+	})
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *ListingUpsertOne) SetCustomID(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCustomID(v)
+	})
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCustomID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCustomID()
+	})
+}
+
+// ClearCustomID clears the value of the "custom_id" field.
+func (u *ListingUpsertOne) ClearCustomID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearCustomID()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *ListingUpsertOne) SetExpiry(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateExpiry() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsertOne) SetStatus(v listing.Status) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateStatus() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *ListingUpsertOne) SetNftType(v listing.NftType) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateNftType() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ListingCreateBulk is the builder for creating many Listing entities in bulk.
 type ListingCreateBulk struct {
 	config
 	err      error
 	builders []*ListingCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Listing entities in the database.
@@ -234,6 +725,7 @@ func (_c *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ListingMutation)
 				if !ok {
@@ -249,6 +741,7 @@ func (_c *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -302,3 +795,226 @@ func (_c *ListingCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingUpsertBulk {
+	_c.conflict = opts
+	return &ListingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingCreateBulk) OnConflictColumns(columns ...string) *ListingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Listing nodes.
+type ListingUpsertBulk struct {
+	create *ListingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) UpdateNewValues() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) Ignore() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertBulk) DoNothing() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingUpsertBulk) Update(set func(*ListingUpsert)) *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingUpsertBulk) SetListingID(v uint64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// AddListingID adds v to the "listing_id" field.
+func (u *ListingUpsertBulk) AddListingID(v uint64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateListingID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertBulk) SetPrice(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertBulk) AddPrice(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePrice() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *ListingUpsertBulk) SetPaymentVaultType(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPaymentVaultType(v)
+	})
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePaymentVaultType() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePaymentVaultType()
+	})
+}
+
+// SetCustomID sets the "custom_id" field.
+func (u *ListingUpsertBulk) SetCustomID(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCustomID(v)
+	})
+}
+
+// UpdateCustomID sets the "custom_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCustomID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCustomID()
+	})
+}
+
+// ClearCustomID clears the value of the "custom_id" field.
+func (u *ListingUpsertBulk) ClearCustomID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearCustomID()
+	})
+}
+
+// SetExpiry sets the "expiry" field.
+func (u *ListingUpsertBulk) SetExpiry(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiry(v)
+	})
+}
+
+// UpdateExpiry sets the "expiry" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateExpiry() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiry()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsertBulk) SetStatus(v listing.Status) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateStatus() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *ListingUpsertBulk) SetNftType(v listing.NftType) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateNftType() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -5,7 +5,9 @@ package ent
 import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
 	"backend/ent/predicate"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"database/sql/driver"
@@ -27,6 +29,8 @@ type ListingQuery struct {
 	predicates       []predicate.Listing
 	withSeller       *UserQuery
 	withNftAccessory *NFTAccessoryQuery
+	withNftMoment    *NFTMomentQuery
+	withSale         *SaleQuery
 	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -100,7 +104,51 @@ func (_q *ListingQuery) QueryNftAccessory() *NFTAccessoryQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(listing.Table, listing.FieldID, selector),
 			sqlgraph.To(nftaccessory.Table, nftaccessory.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, listing.NftAccessoryTable, listing.NftAccessoryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, listing.NftAccessoryTable, listing.NftAccessoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNftMoment chains the current query on the "nft_moment" edge.
+func (_q *ListingQuery) QueryNftMoment() *NFTMomentQuery {
+	query := (&NFTMomentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(nftmoment.Table, nftmoment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, listing.NftMomentTable, listing.NftMomentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySale chains the current query on the "sale" edge.
+func (_q *ListingQuery) QuerySale() *SaleQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(sale.Table, sale.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, listing.SaleTable, listing.SaleColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -302,6 +350,8 @@ func (_q *ListingQuery) Clone() *ListingQuery {
 		predicates:       append([]predicate.Listing{}, _q.predicates...),
 		withSeller:       _q.withSeller.Clone(),
 		withNftAccessory: _q.withNftAccessory.Clone(),
+		withNftMoment:    _q.withNftMoment.Clone(),
+		withSale:         _q.withSale.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +380,28 @@ func (_q *ListingQuery) WithNftAccessory(opts ...func(*NFTAccessoryQuery)) *List
 	return _q
 }
 
+// WithNftMoment tells the query-builder to eager-load the nodes that are connected to
+// the "nft_moment" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithNftMoment(opts ...func(*NFTMomentQuery)) *ListingQuery {
+	query := (&NFTMomentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNftMoment = query
+	return _q
+}
+
+// WithSale tells the query-builder to eager-load the nodes that are connected to
+// the "sale" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithSale(opts ...func(*SaleQuery)) *ListingQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSale = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +481,14 @@ func (_q *ListingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*List
 		nodes       = []*Listing{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withSeller != nil,
 			_q.withNftAccessory != nil,
+			_q.withNftMoment != nil,
+			_q.withSale != nil,
 		}
 	)
-	if _q.withSeller != nil {
+	if _q.withSeller != nil || _q.withNftAccessory != nil || _q.withNftMoment != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -450,6 +524,18 @@ func (_q *ListingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*List
 			return nil, err
 		}
 	}
+	if query := _q.withNftMoment; query != nil {
+		if err := _q.loadNftMoment(ctx, query, nodes, nil,
+			func(n *Listing, e *NFTMoment) { n.Edges.NftMoment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSale; query != nil {
+		if err := _q.loadSale(ctx, query, nodes, nil,
+			func(n *Listing, e *Sale) { n.Edges.Sale = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -486,6 +572,70 @@ func (_q *ListingQuery) loadSeller(ctx context.Context, query *UserQuery, nodes
 	return nil
 }
 func (_q *ListingQuery) loadNftAccessory(ctx context.Context, query *NFTAccessoryQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *NFTAccessory)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Listing)
+	for i := range nodes {
+		if nodes[i].listing_nft_accessory == nil {
+			continue
+		}
+		fk := *nodes[i].listing_nft_accessory
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(nftaccessory.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "listing_nft_accessory" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingQuery) loadNftMoment(ctx context.Context, query *NFTMomentQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *NFTMoment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Listing)
+	for i := range nodes {
+		if nodes[i].listing_nft_moment == nil {
+			continue
+		}
+		fk := *nodes[i].listing_nft_moment
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(nftmoment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "listing_nft_moment" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingQuery) loadSale(ctx context.Context, query *SaleQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Sale)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Listing)
 	for i := range nodes {
@@ -493,21 +643,21 @@ func (_q *ListingQuery) loadNftAccessory(ctx context.Context, query *NFTAccessor
 		nodeids[nodes[i].ID] = nodes[i]
 	}
 	query.withFKs = true
-	query.Where(predicate.NFTAccessory(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(listing.NftAccessoryColumn), fks...))
+	query.Where(predicate.Sale(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.SaleColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.listing_nft_accessory
+		fk := n.listing_sale
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "listing_nft_accessory" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "listing_sale" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "listing_nft_accessory" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_sale" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -5,7 +5,9 @@ package ent
 import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
 	"backend/ent/predicate"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -120,6 +122,34 @@ func (_u *ListingUpdate) SetNillableExpiry(v *time.Time) *ListingUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ListingUpdate) SetStatus(v listing.Status) *ListingUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableStatus(v *listing.Status) *ListingUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *ListingUpdate) SetNftType(v listing.NftType) *ListingUpdate {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableNftType(v *listing.NftType) *ListingUpdate {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
 // SetSellerID sets the "seller" edge to the User entity by ID.
 func (_u *ListingUpdate) SetSellerID(id int) *ListingUpdate {
 	_u.mutation.SetSellerID(id)
@@ -137,11 +167,57 @@ func (_u *ListingUpdate) SetNftAccessoryID(id int) *ListingUpdate {
 	return _u
 }
 
+// SetNillableNftAccessoryID sets the "nft_accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_u *ListingUpdate) SetNillableNftAccessoryID(id *int) *ListingUpdate {
+	if id != nil {
+		_u = _u.SetNftAccessoryID(*id)
+	}
+	return _u
+}
+
 // SetNftAccessory sets the "nft_accessory" edge to the NFTAccessory entity.
 func (_u *ListingUpdate) SetNftAccessory(v *NFTAccessory) *ListingUpdate {
 	return _u.SetNftAccessoryID(v.ID)
 }
 
+// SetNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID.
+func (_u *ListingUpdate) SetNftMomentID(id int) *ListingUpdate {
+	_u.mutation.SetNftMomentID(id)
+	return _u
+}
+
+// SetNillableNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID if the given value is not nil.
+func (_u *ListingUpdate) SetNillableNftMomentID(id *int) *ListingUpdate {
+	if id != nil {
+		_u = _u.SetNftMomentID(*id)
+	}
+	return _u
+}
+
+// SetNftMoment sets the "nft_moment" edge to the NFTMoment entity.
+func (_u *ListingUpdate) SetNftMoment(v *NFTMoment) *ListingUpdate {
+	return _u.SetNftMomentID(v.ID)
+}
+
+// SetSaleID sets the "sale" edge to the Sale entity by ID.
+func (_u *ListingUpdate) SetSaleID(id int) *ListingUpdate {
+	_u.mutation.SetSaleID(id)
+	return _u
+}
+
+// SetNillableSaleID sets the "sale" edge to the Sale entity by ID if the given value is not nil.
+func (_u *ListingUpdate) SetNillableSaleID(id *int) *ListingUpdate {
+	if id != nil {
+		_u = _u.SetSaleID(*id)
+	}
+	return _u
+}
+
+// SetSale sets the "sale" edge to the Sale entity.
+func (_u *ListingUpdate) SetSale(v *Sale) *ListingUpdate {
+	return _u.SetSaleID(v.ID)
+}
+
 // Mutation returns the ListingMutation object of the builder.
 func (_u *ListingUpdate) Mutation() *ListingMutation {
 	return _u.mutation
@@ -159,6 +235,18 @@ func (_u *ListingUpdate) ClearNftAccessory() *ListingUpdate {
 	return _u
 }
 
+// ClearNftMoment clears the "nft_moment" edge to the NFTMoment entity.
+func (_u *ListingUpdate) ClearNftMoment() *ListingUpdate {
+	_u.mutation.ClearNftMoment()
+	return _u
+}
+
+// ClearSale clears the "sale" edge to the Sale entity.
+func (_u *ListingUpdate) ClearSale() *ListingUpdate {
+	_u.mutation.ClearSale()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ListingUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -188,12 +276,19 @@ func (_u *ListingUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ListingUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listing.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := listing.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Listing.nft_type": %w`, err)}
+		}
+	}
 	if _u.mutation.SellerCleared() && len(_u.mutation.SellerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Listing.seller"`)
 	}
-	if _u.mutation.NftAccessoryCleared() && len(_u.mutation.NftAccessoryIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Listing.nft_accessory"`)
-	}
 	return nil
 }
 
@@ -233,6 +328,12 @@ func (_u *ListingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Expiry(); ok {
 		_spec.SetField(listing.FieldExpiry, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(listing.FieldNftType, field.TypeEnum, value)
+	}
 	if _u.mutation.SellerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -264,7 +365,7 @@ func (_u *ListingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if _u.mutation.NftAccessoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   listing.NftAccessoryTable,
 			Columns: []string{listing.NftAccessoryColumn},
@@ -277,7 +378,7 @@ func (_u *ListingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if nodes := _u.mutation.NftAccessoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   listing.NftAccessoryTable,
 			Columns: []string{listing.NftAccessoryColumn},
@@ -291,6 +392,64 @@ func (_u *ListingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NftMomentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listing.NftMomentTable,
+			Columns: []string{listing.NftMomentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NftMomentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listing.NftMomentTable,
+			Columns: []string{listing.NftMomentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SaleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   listing.SaleTable,
+			Columns: []string{listing.SaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   listing.SaleTable,
+			Columns: []string{listing.SaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{listing.Label}
@@ -401,6 +560,34 @@ func (_u *ListingUpdateOne) SetNillableExpiry(v *time.Time) *ListingUpdateOne {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ListingUpdateOne) SetStatus(v listing.Status) *ListingUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableStatus(v *listing.Status) *ListingUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *ListingUpdateOne) SetNftType(v listing.NftType) *ListingUpdateOne {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableNftType(v *listing.NftType) *ListingUpdateOne {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
 // SetSellerID sets the "seller" edge to the User entity by ID.
 func (_u *ListingUpdateOne) SetSellerID(id int) *ListingUpdateOne {
 	_u.mutation.SetSellerID(id)
@@ -418,11 +605,57 @@ func (_u *ListingUpdateOne) SetNftAccessoryID(id int) *ListingUpdateOne {
 	return _u
 }
 
+// SetNillableNftAccessoryID sets the "nft_accessory" edge to the NFTAccessory entity by ID if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableNftAccessoryID(id *int) *ListingUpdateOne {
+	if id != nil {
+		_u = _u.SetNftAccessoryID(*id)
+	}
+	return _u
+}
+
 // SetNftAccessory sets the "nft_accessory" edge to the NFTAccessory entity.
 func (_u *ListingUpdateOne) SetNftAccessory(v *NFTAccessory) *ListingUpdateOne {
 	return _u.SetNftAccessoryID(v.ID)
 }
 
+// SetNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID.
+func (_u *ListingUpdateOne) SetNftMomentID(id int) *ListingUpdateOne {
+	_u.mutation.SetNftMomentID(id)
+	return _u
+}
+
+// SetNillableNftMomentID sets the "nft_moment" edge to the NFTMoment entity by ID if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableNftMomentID(id *int) *ListingUpdateOne {
+	if id != nil {
+		_u = _u.SetNftMomentID(*id)
+	}
+	return _u
+}
+
+// SetNftMoment sets the "nft_moment" edge to the NFTMoment entity.
+func (_u *ListingUpdateOne) SetNftMoment(v *NFTMoment) *ListingUpdateOne {
+	return _u.SetNftMomentID(v.ID)
+}
+
+// SetSaleID sets the "sale" edge to the Sale entity by ID.
+func (_u *ListingUpdateOne) SetSaleID(id int) *ListingUpdateOne {
+	_u.mutation.SetSaleID(id)
+	return _u
+}
+
+// SetNillableSaleID sets the "sale" edge to the Sale entity by ID if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSaleID(id *int) *ListingUpdateOne {
+	if id != nil {
+		_u = _u.SetSaleID(*id)
+	}
+	return _u
+}
+
+// SetSale sets the "sale" edge to the Sale entity.
+func (_u *ListingUpdateOne) SetSale(v *Sale) *ListingUpdateOne {
+	return _u.SetSaleID(v.ID)
+}
+
 // Mutation returns the ListingMutation object of the builder.
 func (_u *ListingUpdateOne) Mutation() *ListingMutation {
 	return _u.mutation
@@ -440,6 +673,18 @@ func (_u *ListingUpdateOne) ClearNftAccessory() *ListingUpdateOne {
 	return _u
 }
 
+// ClearNftMoment clears the "nft_moment" edge to the NFTMoment entity.
+func (_u *ListingUpdateOne) ClearNftMoment() *ListingUpdateOne {
+	_u.mutation.ClearNftMoment()
+	return _u
+}
+
+// ClearSale clears the "sale" edge to the Sale entity.
+func (_u *ListingUpdateOne) ClearSale() *ListingUpdateOne {
+	_u.mutation.ClearSale()
+	return _u
+}
+
 // Where appends a list predicates to the ListingUpdate builder.
 func (_u *ListingUpdateOne) Where(ps ...predicate.Listing) *ListingUpdateOne {
 	_u.mutation.Where(ps...)
@@ -482,12 +727,19 @@ func (_u *ListingUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ListingUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listing.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := listing.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Listing.nft_type": %w`, err)}
+		}
+	}
 	if _u.mutation.SellerCleared() && len(_u.mutation.SellerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Listing.seller"`)
 	}
-	if _u.mutation.NftAccessoryCleared() && len(_u.mutation.NftAccessoryIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Listing.nft_accessory"`)
-	}
 	return nil
 }
 
@@ -544,6 +796,12 @@ func (_u *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err er
 	if value, ok := _u.mutation.Expiry(); ok {
 		_spec.SetField(listing.FieldExpiry, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(listing.FieldNftType, field.TypeEnum, value)
+	}
 	if _u.mutation.SellerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -575,7 +833,7 @@ func (_u *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err er
 	}
 	if _u.mutation.NftAccessoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   listing.NftAccessoryTable,
 			Columns: []string{listing.NftAccessoryColumn},
@@ -588,7 +846,7 @@ func (_u *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err er
 	}
 	if nodes := _u.mutation.NftAccessoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   listing.NftAccessoryTable,
 			Columns: []string{listing.NftAccessoryColumn},
@@ -602,6 +860,64 @@ func (_u *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.NftMomentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listing.NftMomentTable,
+			Columns: []string{listing.NftMomentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NftMomentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   listing.NftMomentTable,
+			Columns: []string{listing.NftMomentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SaleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   listing.SaleTable,
+			Columns: []string{listing.SaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SaleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   listing.SaleTable,
+			Columns: []string{listing.SaleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Listing{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -36,6 +36,58 @@ var (
 			},
 		},
 	}
+	// CheckpointsColumns holds the columns for the "checkpoints" table.
+	CheckpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "block_id", Type: field.TypeString},
+		{Name: "block_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "last_error_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CheckpointsTable holds the schema information for the "checkpoints" table.
+	CheckpointsTable = &schema.Table{
+		Name:       "checkpoints",
+		Columns:    CheckpointsColumns,
+		PrimaryKey: []*schema.Column{CheckpointsColumns[0]},
+	}
+	// DeadLettersColumns holds the columns for the "dead_letters" table.
+	DeadLettersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "block_id", Type: field.TypeString},
+		{Name: "block_time", Type: field.TypeTime, Nullable: true},
+		{Name: "tx_id", Type: field.TypeString},
+		{Name: "tx_index", Type: field.TypeInt},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "error", Type: field.TypeString, Size: 2147483647},
+		{Name: "attempts", Type: field.TypeInt, Default: 1},
+		{Name: "next_retry_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DeadLettersTable holds the schema information for the "dead_letters" table.
+	DeadLettersTable = &schema.Table{
+		Name:       "dead_letters",
+		Columns:    DeadLettersColumns,
+		PrimaryKey: []*schema.Column{DeadLettersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deadletter_tx_id_event_index",
+				Unique:  true,
+				Columns: []*schema.Column{DeadLettersColumns[4], DeadLettersColumns[6]},
+			},
+			{
+				Name:    "deadletter_next_retry_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeadLettersColumns[11]},
+			},
+		},
+	}
 	// EventsColumns holds the columns for the "events" table.
 	EventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -66,6 +118,19 @@ var (
 			},
 		},
 	}
+	// EventCountsColumns holds the columns for the "event_counts" table.
+	EventCountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString, Unique: true},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EventCountsTable holds the schema information for the "event_counts" table.
+	EventCountsTable = &schema.Table{
+		Name:       "event_counts",
+		Columns:    EventCountsColumns,
+		PrimaryKey: []*schema.Column{EventCountsColumns[0]},
+	}
 	// EventPassesColumns holds the columns for the "event_passes" table.
 	EventPassesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -98,6 +163,38 @@ var (
 			},
 		},
 	}
+	// GachaReceiptsColumns holds the columns for the "gacha_receipts" table.
+	GachaReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "receipt_id", Type: field.TypeUint64, Unique: true},
+		{Name: "opener", Type: field.TypeString, Nullable: true},
+		{Name: "commit_block", Type: field.TypeUint64},
+		{Name: "open_tx_id", Type: field.TypeString},
+		{Name: "open_block", Type: field.TypeUint64, Nullable: true},
+		{Name: "reveal_block", Type: field.TypeUint64, Nullable: true},
+		{Name: "reveal_tx_id", Type: field.TypeString, Nullable: true},
+		{Name: "rarity", Type: field.TypeUint8, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "revealed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// GachaReceiptsTable holds the schema information for the "gacha_receipts" table.
+	GachaReceiptsTable = &schema.Table{
+		Name:       "gacha_receipts",
+		Columns:    GachaReceiptsColumns,
+		PrimaryKey: []*schema.Column{GachaReceiptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gachareceipt_opener",
+				Unique:  false,
+				Columns: []*schema.Column{GachaReceiptsColumns[2]},
+			},
+			{
+				Name:    "gachareceipt_reveal_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{GachaReceiptsColumns[7]},
+			},
+		},
+	}
 	// ListingsColumns holds the columns for the "listings" table.
 	ListingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -106,6 +203,10 @@ var (
 		{Name: "payment_vault_type", Type: field.TypeString},
 		{Name: "custom_id", Type: field.TypeString, Nullable: true},
 		{Name: "expiry", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "sold", "cancelled", "expired", "ghost"}, Default: "active"},
+		{Name: "nft_type", Type: field.TypeEnum, Enums: []string{"moment", "accessory"}, Default: "accessory"},
+		{Name: "listing_nft_accessory", Type: field.TypeInt, Nullable: true},
+		{Name: "listing_nft_moment", Type: field.TypeInt, Nullable: true},
 		{Name: "user_listings", Type: field.TypeInt},
 	}
 	// ListingsTable holds the schema information for the "listings" table.
@@ -114,9 +215,21 @@ var (
 		Columns:    ListingsColumns,
 		PrimaryKey: []*schema.Column{ListingsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listings_nft_accessories_nft_accessory",
+				Columns:    []*schema.Column{ListingsColumns[8]},
+				RefColumns: []*schema.Column{NftAccessoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "listings_nft_moments_nft_moment",
+				Columns:    []*schema.Column{ListingsColumns[9]},
+				RefColumns: []*schema.Column{NftMomentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "listings_users_listings",
-				Columns:    []*schema.Column{ListingsColumns[6]},
+				Columns:    []*schema.Column{ListingsColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -130,7 +243,10 @@ var (
 		{Name: "description", Type: field.TypeString},
 		{Name: "thumbnail", Type: field.TypeString},
 		{Name: "equipment_type", Type: field.TypeString},
-		{Name: "listing_nft_accessory", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "rarity_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "rarity_max", Type: field.TypeFloat64, Nullable: true},
+		{Name: "rarity_description", Type: field.TypeString, Nullable: true},
+		{Name: "gacha_receipt_accessory", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "nft_moment_equipped_accessories", Type: field.TypeInt, Nullable: true},
 		{Name: "user_accessories", Type: field.TypeInt},
 	}
@@ -141,20 +257,20 @@ var (
 		PrimaryKey: []*schema.Column{NftAccessoriesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "nft_accessories_listings_nft_accessory",
-				Columns:    []*schema.Column{NftAccessoriesColumns[6]},
-				RefColumns: []*schema.Column{ListingsColumns[0]},
+				Symbol:     "nft_accessories_gacha_receipts_accessory",
+				Columns:    []*schema.Column{NftAccessoriesColumns[9]},
+				RefColumns: []*schema.Column{GachaReceiptsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "nft_accessories_nft_moments_equipped_accessories",
-				Columns:    []*schema.Column{NftAccessoriesColumns[7]},
+				Columns:    []*schema.Column{NftAccessoriesColumns[10]},
 				RefColumns: []*schema.Column{NftMomentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "nft_accessories_users_accessories",
-				Columns:    []*schema.Column{NftAccessoriesColumns[8]},
+				Columns:    []*schema.Column{NftAccessoriesColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -167,6 +283,10 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "thumbnail", Type: field.TypeString},
+		{Name: "tier", Type: field.TypeString, Nullable: true},
+		{Name: "minted_block", Type: field.TypeUint64, Nullable: true},
+		{Name: "minted_time", Type: field.TypeTime, Nullable: true},
+		{Name: "pass_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "event_pass_moment", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "user_moments", Type: field.TypeInt},
 	}
@@ -178,16 +298,172 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "nft_moments_event_passes_moment",
-				Columns:    []*schema.Column{NftMomentsColumns[5]},
+				Columns:    []*schema.Column{NftMomentsColumns[9]},
 				RefColumns: []*schema.Column{EventPassesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "nft_moments_users_moments",
-				Columns:    []*schema.Column{NftMomentsColumns[6]},
+				Columns:    []*schema.Column{NftMomentsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// PendingEventsColumns holds the columns for the "pending_events" table.
+	PendingEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entity", Type: field.TypeString},
+		{Name: "entity_key", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "block_id", Type: field.TypeString},
+		{Name: "block_time", Type: field.TypeTime, Nullable: true},
+		{Name: "tx_id", Type: field.TypeString},
+		{Name: "tx_index", Type: field.TypeInt},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PendingEventsTable holds the schema information for the "pending_events" table.
+	PendingEventsTable = &schema.Table{
+		Name:       "pending_events",
+		Columns:    PendingEventsColumns,
+		PrimaryKey: []*schema.Column{PendingEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pendingevent_tx_id_event_index",
+				Unique:  true,
+				Columns: []*schema.Column{PendingEventsColumns[6], PendingEventsColumns[8]},
+			},
+			{
+				Name:    "pendingevent_entity_entity_key",
+				Unique:  false,
+				Columns: []*schema.Column{PendingEventsColumns[1], PendingEventsColumns[2]},
+			},
+		},
+	}
+	// RawEventsColumns holds the columns for the "raw_events" table.
+	RawEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "block_id", Type: field.TypeString},
+		{Name: "block_time", Type: field.TypeTime, Nullable: true},
+		{Name: "tx_id", Type: field.TypeString},
+		{Name: "tx_index", Type: field.TypeInt},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RawEventsTable holds the schema information for the "raw_events" table.
+	RawEventsTable = &schema.Table{
+		Name:       "raw_events",
+		Columns:    RawEventsColumns,
+		PrimaryKey: []*schema.Column{RawEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rawevent_tx_id_event_index",
+				Unique:  true,
+				Columns: []*schema.Column{RawEventsColumns[4], RawEventsColumns[6]},
+			},
+			{
+				Name:    "rawevent_block_height",
+				Unique:  false,
+				Columns: []*schema.Column{RawEventsColumns[1]},
+			},
+			{
+				Name:    "rawevent_type",
+				Unique:  false,
+				Columns: []*schema.Column{RawEventsColumns[7]},
+			},
+		},
+	}
+	// SalesColumns holds the columns for the "sales" table.
+	SalesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "price", Type: field.TypeFloat64},
+		{Name: "payment_vault_type", Type: field.TypeString},
+		{Name: "nft_type", Type: field.TypeEnum, Enums: []string{"moment", "accessory"}},
+		{Name: "nft_id", Type: field.TypeUint64},
+		{Name: "tx_id", Type: field.TypeString},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "listing_sale", Type: field.TypeInt, Unique: true, Nullable: true},
+		{Name: "user_sales", Type: field.TypeInt},
+		{Name: "user_purchases", Type: field.TypeInt, Nullable: true},
+	}
+	// SalesTable holds the schema information for the "sales" table.
+	SalesTable = &schema.Table{
+		Name:       "sales",
+		Columns:    SalesColumns,
+		PrimaryKey: []*schema.Column{SalesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sales_listings_sale",
+				Columns:    []*schema.Column{SalesColumns[8]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "sales_users_sales",
+				Columns:    []*schema.Column{SalesColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "sales_users_purchases",
+				Columns:    []*schema.Column{SalesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sale_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{SalesColumns[5]},
+			},
+			{
+				Name:    "sale_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{SalesColumns[7]},
+			},
+		},
+	}
+	// TransfersColumns holds the columns for the "transfers" table.
+	TransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "nft_type", Type: field.TypeEnum, Enums: []string{"moment", "accessory"}},
+		{Name: "nft_id", Type: field.TypeUint64},
+		{Name: "from", Type: field.TypeString, Nullable: true},
+		{Name: "to", Type: field.TypeString, Nullable: true},
+		{Name: "tx_id", Type: field.TypeString},
+		{Name: "event_index", Type: field.TypeInt},
+		{Name: "block_height", Type: field.TypeUint64},
+		{Name: "timestamp", Type: field.TypeTime},
+	}
+	// TransfersTable holds the schema information for the "transfers" table.
+	TransfersTable = &schema.Table{
+		Name:       "transfers",
+		Columns:    TransfersColumns,
+		PrimaryKey: []*schema.Column{TransfersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "transfer_nft_type_nft_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[1], TransfersColumns[2]},
+			},
+			{
+				Name:    "transfer_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[5]},
+			},
+			{
+				Name:    "transfer_tx_id_event_index",
+				Unique:  true,
+				Columns: []*schema.Column{TransfersColumns[5], TransfersColumns[6]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -202,6 +478,7 @@ var (
 		{Name: "highlighted_event_pass_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "highlighted_moment_id", Type: field.TypeUint64, Nullable: true},
 		{Name: "socials", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_verified", Type: field.TypeBool, Default: false},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -212,11 +489,19 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AttendancesTable,
+		CheckpointsTable,
+		DeadLettersTable,
 		EventsTable,
+		EventCountsTable,
 		EventPassesTable,
+		GachaReceiptsTable,
 		ListingsTable,
 		NftAccessoriesTable,
 		NftMomentsTable,
+		PendingEventsTable,
+		RawEventsTable,
+		SalesTable,
+		TransfersTable,
 		UsersTable,
 	}
 )
@@ -227,10 +512,15 @@ func init() {
 	EventsTable.ForeignKeys[0].RefTable = UsersTable
 	EventPassesTable.ForeignKeys[0].RefTable = EventsTable
 	EventPassesTable.ForeignKeys[1].RefTable = UsersTable
-	ListingsTable.ForeignKeys[0].RefTable = UsersTable
-	NftAccessoriesTable.ForeignKeys[0].RefTable = ListingsTable
+	ListingsTable.ForeignKeys[0].RefTable = NftAccessoriesTable
+	ListingsTable.ForeignKeys[1].RefTable = NftMomentsTable
+	ListingsTable.ForeignKeys[2].RefTable = UsersTable
+	NftAccessoriesTable.ForeignKeys[0].RefTable = GachaReceiptsTable
 	NftAccessoriesTable.ForeignKeys[1].RefTable = NftMomentsTable
 	NftAccessoriesTable.ForeignKeys[2].RefTable = UsersTable
 	NftMomentsTable.ForeignKeys[0].RefTable = EventPassesTable
 	NftMomentsTable.ForeignKeys[1].RefTable = UsersTable
+	SalesTable.ForeignKeys[0].RefTable = ListingsTable
+	SalesTable.ForeignKeys[1].RefTable = UsersTable
+	SalesTable.ForeignKeys[2].RefTable = UsersTable
 }
@@ -4,14 +4,23 @@ package ent
 
 import (
 	"backend/ent/attendance"
+	"backend/ent/checkpoint"
+	"backend/ent/deadletter"
 	"backend/ent/event"
+	"backend/ent/eventcount"
 	"backend/ent/eventpass"
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/pendingevent"
 	"backend/ent/predicate"
+	"backend/ent/rawevent"
+	"backend/ent/sale"
+	"backend/ent/transfer"
 	"backend/ent/user"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -31,11 +40,19 @@ const (
 
 	// Node types.
 	TypeAttendance   = "Attendance"
+	TypeCheckpoint   = "Checkpoint"
+	TypeDeadLetter   = "DeadLetter"
 	TypeEvent        = "Event"
+	TypeEventCount   = "EventCount"
 	TypeEventPass    = "EventPass"
+	TypeGachaReceipt = "GachaReceipt"
 	TypeListing      = "Listing"
 	TypeNFTAccessory = "NFTAccessory"
 	TypeNFTMoment    = "NFTMoment"
+	TypePendingEvent = "PendingEvent"
+	TypeRawEvent     = "RawEvent"
+	TypeSale         = "Sale"
+	TypeTransfer     = "Transfer"
 	TypeUser         = "User"
 )
 
@@ -545,53 +562,37 @@ func (m *AttendanceMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Attendance edge %s", name)
 }
 
-// EventMutation represents an operation that mutates the Event nodes in the graph.
-type EventMutation struct {
+// CheckpointMutation represents an operation that mutates the Checkpoint nodes in the graph.
+type CheckpointMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	event_id             *uint64
-	addevent_id          *int64
-	name                 *string
-	description          *string
-	thumbnail            *string
-	event_type           *uint8
-	addevent_type        *int8
-	location             *string
-	lat                  *float64
-	addlat               *float64
-	long                 *float64
-	addlong              *float64
-	start_date           *time.Time
-	end_date             *time.Time
-	quota                *uint64
-	addquota             *int64
-	clearedFields        map[string]struct{}
-	host                 *int
-	clearedhost          bool
-	passes_issued        map[int]struct{}
-	removedpasses_issued map[int]struct{}
-	clearedpasses_issued bool
-	attendances          map[int]struct{}
-	removedattendances   map[int]struct{}
-	clearedattendances   bool
-	done                 bool
-	oldValue             func(context.Context) (*Event, error)
-	predicates           []predicate.Event
-}
-
-var _ ent.Mutation = (*EventMutation)(nil)
-
-// eventOption allows management of the mutation configuration using functional options.
-type eventOption func(*EventMutation)
-
-// newEventMutation creates new mutation for the Event entity.
-func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
-	m := &EventMutation{
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	block_height    *uint64
+	addblock_height *int64
+	block_id        *string
+	block_time      *time.Time
+	last_error      *string
+	last_error_at   *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Checkpoint, error)
+	predicates      []predicate.Checkpoint
+}
+
+var _ ent.Mutation = (*CheckpointMutation)(nil)
+
+// checkpointOption allows management of the mutation configuration using functional options.
+type checkpointOption func(*CheckpointMutation)
+
+// newCheckpointMutation creates new mutation for the Checkpoint entity.
+func newCheckpointMutation(c config, op Op, opts ...checkpointOption) *CheckpointMutation {
+	m := &CheckpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEvent,
+		typ:           TypeCheckpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -600,20 +601,20 @@ func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
 	return m
 }
 
-// withEventID sets the ID field of the mutation.
-func withEventID(id int) eventOption {
-	return func(m *EventMutation) {
+// withCheckpointID sets the ID field of the mutation.
+func withCheckpointID(id int) checkpointOption {
+	return func(m *CheckpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Event
+			value *Checkpoint
 		)
-		m.oldValue = func(ctx context.Context) (*Event, error) {
+		m.oldValue = func(ctx context.Context) (*Checkpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Event.Get(ctx, id)
+					value, err = m.Client().Checkpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -622,10 +623,10 @@ func withEventID(id int) eventOption {
 	}
 }
 
-// withEvent sets the old Event of the mutation.
-func withEvent(node *Event) eventOption {
-	return func(m *EventMutation) {
-		m.oldValue = func(context.Context) (*Event, error) {
+// withCheckpoint sets the old Checkpoint of the mutation.
+func withCheckpoint(node *Checkpoint) checkpointOption {
+	return func(m *CheckpointMutation) {
+		m.oldValue = func(context.Context) (*Checkpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -634,7 +635,7 @@ func withEvent(node *Event) eventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventMutation) Client() *Client {
+func (m CheckpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -642,7 +643,7 @@ func (m EventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventMutation) Tx() (*Tx, error) {
+func (m CheckpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -653,7 +654,7 @@ func (m EventMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventMutation) ID() (id int, exists bool) {
+func (m *CheckpointMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -664,7 +665,7 @@ func (m *EventMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CheckpointMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -673,316 +674,2225 @@ func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Checkpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEventID sets the "event_id" field.
-func (m *EventMutation) SetEventID(u uint64) {
-	m.event_id = &u
-	m.addevent_id = nil
+// SetName sets the "name" field.
+func (m *CheckpointMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EventID returns the value of the "event_id" field in the mutation.
-func (m *EventMutation) EventID() (r uint64, exists bool) {
-	v := m.event_id
+// Name returns the value of the "name" field in the mutation.
+func (m *CheckpointMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventID returns the old "event_id" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEventID(ctx context.Context) (v uint64, err error) {
+func (m *CheckpointMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
-	}
-	return oldValue.EventID, nil
-}
-
-// AddEventID adds u to the "event_id" field.
-func (m *EventMutation) AddEventID(u int64) {
-	if m.addevent_id != nil {
-		*m.addevent_id += u
-	} else {
-		m.addevent_id = &u
-	}
-}
-
-// AddedEventID returns the value that was added to the "event_id" field in this mutation.
-func (m *EventMutation) AddedEventID() (r int64, exists bool) {
-	v := m.addevent_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ResetEventID resets all changes to the "event_id" field.
-func (m *EventMutation) ResetEventID() {
-	m.event_id = nil
-	m.addevent_id = nil
+// ResetName resets all changes to the "name" field.
+func (m *CheckpointMutation) ResetName() {
+	m.name = nil
 }
 
-// SetName sets the "name" field.
-func (m *EventMutation) SetName(s string) {
-	m.name = &s
+// SetBlockHeight sets the "block_height" field.
+func (m *CheckpointMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EventMutation) Name() (r string, exists bool) {
-	v := m.name
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *CheckpointMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.BlockHeight, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EventMutation) ResetName() {
-	m.name = nil
+// AddBlockHeight adds u to the "block_height" field.
+func (m *CheckpointMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
+	} else {
+		m.addblock_height = &u
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *EventMutation) SetDescription(s string) {
-	m.description = &s
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *CheckpointMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EventMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *CheckpointMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetBlockID sets the "block_id" field.
+func (m *CheckpointMutation) SetBlockID(s string) {
+	m.block_id = &s
+}
+
+// BlockID returns the value of the "block_id" field in the mutation.
+func (m *CheckpointMutation) BlockID() (r string, exists bool) {
+	v := m.block_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockID returns the old "block_id" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldBlockID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldBlockID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockID: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.BlockID, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EventMutation) ResetDescription() {
-	m.description = nil
+// ResetBlockID resets all changes to the "block_id" field.
+func (m *CheckpointMutation) ResetBlockID() {
+	m.block_id = nil
 }
 
-// SetThumbnail sets the "thumbnail" field.
-func (m *EventMutation) SetThumbnail(s string) {
-	m.thumbnail = &s
+// SetBlockTime sets the "block_time" field.
+func (m *CheckpointMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
 }
 
-// Thumbnail returns the value of the "thumbnail" field in the mutation.
-func (m *EventMutation) Thumbnail() (r string, exists bool) {
-	v := m.thumbnail
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *CheckpointMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnail returns the old "thumbnail" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockTime returns the old "block_time" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
 	}
-	return oldValue.Thumbnail, nil
+	return oldValue.BlockTime, nil
 }
 
-// ResetThumbnail resets all changes to the "thumbnail" field.
-func (m *EventMutation) ResetThumbnail() {
-	m.thumbnail = nil
+// ClearBlockTime clears the value of the "block_time" field.
+func (m *CheckpointMutation) ClearBlockTime() {
+	m.block_time = nil
+	m.clearedFields[checkpoint.FieldBlockTime] = struct{}{}
 }
 
-// SetEventType sets the "event_type" field.
-func (m *EventMutation) SetEventType(u uint8) {
-	m.event_type = &u
-	m.addevent_type = nil
+// BlockTimeCleared returns if the "block_time" field was cleared in this mutation.
+func (m *CheckpointMutation) BlockTimeCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldBlockTime]
+	return ok
 }
 
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *EventMutation) EventType() (r uint8, exists bool) {
-	v := m.event_type
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *CheckpointMutation) ResetBlockTime() {
+	m.block_time = nil
+	delete(m.clearedFields, checkpoint.FieldBlockTime)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *CheckpointMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *CheckpointMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventType returns the old "event_type" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEventType(ctx context.Context) (v uint8, err error) {
+func (m *CheckpointMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.EventType, nil
+	return oldValue.LastError, nil
 }
 
-// AddEventType adds u to the "event_type" field.
-func (m *EventMutation) AddEventType(u int8) {
-	if m.addevent_type != nil {
-		*m.addevent_type += u
-	} else {
-		m.addevent_type = &u
-	}
+// ClearLastError clears the value of the "last_error" field.
+func (m *CheckpointMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[checkpoint.FieldLastError] = struct{}{}
 }
 
-// AddedEventType returns the value that was added to the "event_type" field in this mutation.
-func (m *EventMutation) AddedEventType() (r int8, exists bool) {
-	v := m.addevent_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *CheckpointMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldLastError]
+	return ok
 }
 
-// ResetEventType resets all changes to the "event_type" field.
-func (m *EventMutation) ResetEventType() {
-	m.event_type = nil
-	m.addevent_type = nil
+// ResetLastError resets all changes to the "last_error" field.
+func (m *CheckpointMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, checkpoint.FieldLastError)
 }
 
-// SetLocation sets the "location" field.
-func (m *EventMutation) SetLocation(s string) {
-	m.location = &s
+// SetLastErrorAt sets the "last_error_at" field.
+func (m *CheckpointMutation) SetLastErrorAt(t time.Time) {
+	m.last_error_at = &t
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *EventMutation) Location() (r string, exists bool) {
-	v := m.location
+// LastErrorAt returns the value of the "last_error_at" field in the mutation.
+func (m *CheckpointMutation) LastErrorAt() (r time.Time, exists bool) {
+	v := m.last_error_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldLastErrorAt returns the old "last_error_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLocation(ctx context.Context) (v string, err error) {
+func (m *CheckpointMutation) OldLastErrorAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastErrorAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldLastErrorAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastErrorAt: %w", err)
 	}
-	return oldValue.Location, nil
+	return oldValue.LastErrorAt, nil
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *EventMutation) ResetLocation() {
-	m.location = nil
+// ClearLastErrorAt clears the value of the "last_error_at" field.
+func (m *CheckpointMutation) ClearLastErrorAt() {
+	m.last_error_at = nil
+	m.clearedFields[checkpoint.FieldLastErrorAt] = struct{}{}
 }
 
-// SetLat sets the "lat" field.
-func (m *EventMutation) SetLat(f float64) {
-	m.lat = &f
-	m.addlat = nil
+// LastErrorAtCleared returns if the "last_error_at" field was cleared in this mutation.
+func (m *CheckpointMutation) LastErrorAtCleared() bool {
+	_, ok := m.clearedFields[checkpoint.FieldLastErrorAt]
+	return ok
 }
 
-// Lat returns the value of the "lat" field in the mutation.
-func (m *EventMutation) Lat() (r float64, exists bool) {
-	v := m.lat
+// ResetLastErrorAt resets all changes to the "last_error_at" field.
+func (m *CheckpointMutation) ResetLastErrorAt() {
+	m.last_error_at = nil
+	delete(m.clearedFields, checkpoint.FieldLastErrorAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CheckpointMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CheckpointMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLat returns the old "lat" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Checkpoint entity.
+// If the Checkpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLat(ctx context.Context) (v float64, err error) {
+func (m *CheckpointMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLat is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLat requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLat: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Lat, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddLat adds f to the "lat" field.
-func (m *EventMutation) AddLat(f float64) {
-	if m.addlat != nil {
-		*m.addlat += f
-	} else {
-		m.addlat = &f
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CheckpointMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedLat returns the value that was added to the "lat" field in this mutation.
-func (m *EventMutation) AddedLat() (r float64, exists bool) {
-	v := m.addlat
-	if v == nil {
-		return
+// Where appends a list predicates to the CheckpointMutation builder.
+func (m *CheckpointMutation) Where(ps ...predicate.Checkpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CheckpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CheckpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Checkpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CheckpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CheckpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Checkpoint).
+func (m *CheckpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CheckpointMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, checkpoint.FieldName)
+	}
+	if m.block_height != nil {
+		fields = append(fields, checkpoint.FieldBlockHeight)
+	}
+	if m.block_id != nil {
+		fields = append(fields, checkpoint.FieldBlockID)
+	}
+	if m.block_time != nil {
+		fields = append(fields, checkpoint.FieldBlockTime)
+	}
+	if m.last_error != nil {
+		fields = append(fields, checkpoint.FieldLastError)
+	}
+	if m.last_error_at != nil {
+		fields = append(fields, checkpoint.FieldLastErrorAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, checkpoint.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CheckpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case checkpoint.FieldName:
+		return m.Name()
+	case checkpoint.FieldBlockHeight:
+		return m.BlockHeight()
+	case checkpoint.FieldBlockID:
+		return m.BlockID()
+	case checkpoint.FieldBlockTime:
+		return m.BlockTime()
+	case checkpoint.FieldLastError:
+		return m.LastError()
+	case checkpoint.FieldLastErrorAt:
+		return m.LastErrorAt()
+	case checkpoint.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CheckpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case checkpoint.FieldName:
+		return m.OldName(ctx)
+	case checkpoint.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case checkpoint.FieldBlockID:
+		return m.OldBlockID(ctx)
+	case checkpoint.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case checkpoint.FieldLastError:
+		return m.OldLastError(ctx)
+	case checkpoint.FieldLastErrorAt:
+		return m.OldLastErrorAt(ctx)
+	case checkpoint.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case checkpoint.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case checkpoint.FieldBlockHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case checkpoint.FieldBlockID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockID(v)
+		return nil
+	case checkpoint.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case checkpoint.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case checkpoint.FieldLastErrorAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastErrorAt(v)
+		return nil
+	case checkpoint.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CheckpointMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_height != nil {
+		fields = append(fields, checkpoint.FieldBlockHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CheckpointMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case checkpoint.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CheckpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case checkpoint.FieldBlockHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CheckpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(checkpoint.FieldBlockTime) {
+		fields = append(fields, checkpoint.FieldBlockTime)
+	}
+	if m.FieldCleared(checkpoint.FieldLastError) {
+		fields = append(fields, checkpoint.FieldLastError)
+	}
+	if m.FieldCleared(checkpoint.FieldLastErrorAt) {
+		fields = append(fields, checkpoint.FieldLastErrorAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CheckpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CheckpointMutation) ClearField(name string) error {
+	switch name {
+	case checkpoint.FieldBlockTime:
+		m.ClearBlockTime()
+		return nil
+	case checkpoint.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case checkpoint.FieldLastErrorAt:
+		m.ClearLastErrorAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CheckpointMutation) ResetField(name string) error {
+	switch name {
+	case checkpoint.FieldName:
+		m.ResetName()
+		return nil
+	case checkpoint.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case checkpoint.FieldBlockID:
+		m.ResetBlockID()
+		return nil
+	case checkpoint.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case checkpoint.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case checkpoint.FieldLastErrorAt:
+		m.ResetLastErrorAt()
+		return nil
+	case checkpoint.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Checkpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CheckpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CheckpointMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CheckpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CheckpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CheckpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CheckpointMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CheckpointMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Checkpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CheckpointMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Checkpoint edge %s", name)
+}
+
+// DeadLetterMutation represents an operation that mutates the DeadLetter nodes in the graph.
+type DeadLetterMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	block_height    *uint64
+	addblock_height *int64
+	block_id        *string
+	block_time      *time.Time
+	tx_id           *string
+	tx_index        *int
+	addtx_index     *int
+	event_index     *int
+	addevent_index  *int
+	_type           *string
+	payload         *json.RawMessage
+	appendpayload   json.RawMessage
+	error           *string
+	attempts        *int
+	addattempts     *int
+	next_retry_at   *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*DeadLetter, error)
+	predicates      []predicate.DeadLetter
+}
+
+var _ ent.Mutation = (*DeadLetterMutation)(nil)
+
+// deadletterOption allows management of the mutation configuration using functional options.
+type deadletterOption func(*DeadLetterMutation)
+
+// newDeadLetterMutation creates new mutation for the DeadLetter entity.
+func newDeadLetterMutation(c config, op Op, opts ...deadletterOption) *DeadLetterMutation {
+	m := &DeadLetterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeadLetter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeadLetterID sets the ID field of the mutation.
+func withDeadLetterID(id int) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeadLetter
+		)
+		m.oldValue = func(ctx context.Context) (*DeadLetter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeadLetter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeadLetter sets the old DeadLetter of the mutation.
+func withDeadLetter(node *DeadLetter) deadletterOption {
+	return func(m *DeadLetterMutation) {
+		m.oldValue = func(context.Context) (*DeadLetter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeadLetterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeadLetterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeadLetterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeadLetterMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeadLetter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *DeadLetterMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *DeadLetterMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds u to the "block_height" field.
+func (m *DeadLetterMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
+	} else {
+		m.addblock_height = &u
+	}
+}
+
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *DeadLetterMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *DeadLetterMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
+}
+
+// SetBlockID sets the "block_id" field.
+func (m *DeadLetterMutation) SetBlockID(s string) {
+	m.block_id = &s
+}
+
+// BlockID returns the value of the "block_id" field in the mutation.
+func (m *DeadLetterMutation) BlockID() (r string, exists bool) {
+	v := m.block_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockID returns the old "block_id" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldBlockID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockID: %w", err)
+	}
+	return oldValue.BlockID, nil
+}
+
+// ResetBlockID resets all changes to the "block_id" field.
+func (m *DeadLetterMutation) ResetBlockID() {
+	m.block_id = nil
+}
+
+// SetBlockTime sets the "block_time" field.
+func (m *DeadLetterMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
+}
+
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *DeadLetterMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockTime returns the old "block_time" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
+	}
+	return oldValue.BlockTime, nil
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (m *DeadLetterMutation) ClearBlockTime() {
+	m.block_time = nil
+	m.clearedFields[deadletter.FieldBlockTime] = struct{}{}
+}
+
+// BlockTimeCleared returns if the "block_time" field was cleared in this mutation.
+func (m *DeadLetterMutation) BlockTimeCleared() bool {
+	_, ok := m.clearedFields[deadletter.FieldBlockTime]
+	return ok
+}
+
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *DeadLetterMutation) ResetBlockTime() {
+	m.block_time = nil
+	delete(m.clearedFields, deadletter.FieldBlockTime)
+}
+
+// SetTxID sets the "tx_id" field.
+func (m *DeadLetterMutation) SetTxID(s string) {
+	m.tx_id = &s
+}
+
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *DeadLetterMutation) TxID() (r string, exists bool) {
+	v := m.tx_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxID returns the old "tx_id" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldTxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
+	}
+	return oldValue.TxID, nil
+}
+
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *DeadLetterMutation) ResetTxID() {
+	m.tx_id = nil
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (m *DeadLetterMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
+}
+
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *DeadLetterMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTxIndex returns the old "tx_index" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldTxIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
+	}
+	return oldValue.TxIndex, nil
+}
+
+// AddTxIndex adds i to the "tx_index" field.
+func (m *DeadLetterMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
+	} else {
+		m.addtx_index = &i
+	}
+}
+
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *DeadLetterMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *DeadLetterMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
+}
+
+// SetEventIndex sets the "event_index" field.
+func (m *DeadLetterMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
+}
+
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *DeadLetterMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventIndex returns the old "event_index" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
+}
+
+// AddEventIndex adds i to the "event_index" field.
+func (m *DeadLetterMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
+}
+
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *DeadLetterMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *DeadLetterMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
+}
+
+// SetType sets the "type" field.
+func (m *DeadLetterMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *DeadLetterMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *DeadLetterMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *DeadLetterMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *DeadLetterMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *DeadLetterMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *DeadLetterMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *DeadLetterMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetError sets the "error" field.
+func (m *DeadLetterMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DeadLetterMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DeadLetterMutation) ResetError() {
+	m.error = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *DeadLetterMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *DeadLetterMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *DeadLetterMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *DeadLetterMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *DeadLetterMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextRetryAt sets the "next_retry_at" field.
+func (m *DeadLetterMutation) SetNextRetryAt(t time.Time) {
+	m.next_retry_at = &t
+}
+
+// NextRetryAt returns the value of the "next_retry_at" field in the mutation.
+func (m *DeadLetterMutation) NextRetryAt() (r time.Time, exists bool) {
+	v := m.next_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRetryAt returns the old "next_retry_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldNextRetryAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRetryAt: %w", err)
+	}
+	return oldValue.NextRetryAt, nil
+}
+
+// ResetNextRetryAt resets all changes to the "next_retry_at" field.
+func (m *DeadLetterMutation) ResetNextRetryAt() {
+	m.next_retry_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeadLetterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeadLetterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeadLetterMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeadLetterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeadLetterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DeadLetter entity.
+// If the DeadLetter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeadLetterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeadLetterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the DeadLetterMutation builder.
+func (m *DeadLetterMutation) Where(ps ...predicate.DeadLetter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeadLetterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeadLetterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeadLetter, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeadLetterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeadLetterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeadLetter).
+func (m *DeadLetterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeadLetterMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.block_height != nil {
+		fields = append(fields, deadletter.FieldBlockHeight)
+	}
+	if m.block_id != nil {
+		fields = append(fields, deadletter.FieldBlockID)
+	}
+	if m.block_time != nil {
+		fields = append(fields, deadletter.FieldBlockTime)
+	}
+	if m.tx_id != nil {
+		fields = append(fields, deadletter.FieldTxID)
+	}
+	if m.tx_index != nil {
+		fields = append(fields, deadletter.FieldTxIndex)
+	}
+	if m.event_index != nil {
+		fields = append(fields, deadletter.FieldEventIndex)
+	}
+	if m._type != nil {
+		fields = append(fields, deadletter.FieldType)
+	}
+	if m.payload != nil {
+		fields = append(fields, deadletter.FieldPayload)
+	}
+	if m.error != nil {
+		fields = append(fields, deadletter.FieldError)
+	}
+	if m.attempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
+	}
+	if m.next_retry_at != nil {
+		fields = append(fields, deadletter.FieldNextRetryAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deadletter.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deadletter.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeadLetterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		return m.BlockHeight()
+	case deadletter.FieldBlockID:
+		return m.BlockID()
+	case deadletter.FieldBlockTime:
+		return m.BlockTime()
+	case deadletter.FieldTxID:
+		return m.TxID()
+	case deadletter.FieldTxIndex:
+		return m.TxIndex()
+	case deadletter.FieldEventIndex:
+		return m.EventIndex()
+	case deadletter.FieldType:
+		return m.GetType()
+	case deadletter.FieldPayload:
+		return m.Payload()
+	case deadletter.FieldError:
+		return m.Error()
+	case deadletter.FieldAttempts:
+		return m.Attempts()
+	case deadletter.FieldNextRetryAt:
+		return m.NextRetryAt()
+	case deadletter.FieldCreatedAt:
+		return m.CreatedAt()
+	case deadletter.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeadLetterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case deadletter.FieldBlockID:
+		return m.OldBlockID(ctx)
+	case deadletter.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case deadletter.FieldTxID:
+		return m.OldTxID(ctx)
+	case deadletter.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case deadletter.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case deadletter.FieldType:
+		return m.OldType(ctx)
+	case deadletter.FieldPayload:
+		return m.OldPayload(ctx)
+	case deadletter.FieldError:
+		return m.OldError(ctx)
+	case deadletter.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case deadletter.FieldNextRetryAt:
+		return m.OldNextRetryAt(ctx)
+	case deadletter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deadletter.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeadLetterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case deadletter.FieldBlockID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockID(v)
+		return nil
+	case deadletter.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case deadletter.FieldTxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxID(v)
+		return nil
+	case deadletter.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxIndex(v)
+		return nil
+	case deadletter.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case deadletter.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case deadletter.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case deadletter.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case deadletter.FieldNextRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRetryAt(v)
+		return nil
+	case deadletter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deadletter.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeadLetterMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_height != nil {
+		fields = append(fields, deadletter.FieldBlockHeight)
+	}
+	if m.addtx_index != nil {
+		fields = append(fields, deadletter.FieldTxIndex)
+	}
+	if m.addevent_index != nil {
+		fields = append(fields, deadletter.FieldEventIndex)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, deadletter.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeadLetterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case deadletter.FieldTxIndex:
+		return m.AddedTxIndex()
+	case deadletter.FieldEventIndex:
+		return m.AddedEventIndex()
+	case deadletter.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeadLetterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
+	case deadletter.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTxIndex(v)
+		return nil
+	case deadletter.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
+		return nil
+	case deadletter.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeadLetterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deadletter.FieldBlockTime) {
+		fields = append(fields, deadletter.FieldBlockTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeadLetterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeadLetterMutation) ClearField(name string) error {
+	switch name {
+	case deadletter.FieldBlockTime:
+		m.ClearBlockTime()
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeadLetterMutation) ResetField(name string) error {
+	switch name {
+	case deadletter.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case deadletter.FieldBlockID:
+		m.ResetBlockID()
+		return nil
+	case deadletter.FieldBlockTime:
+		m.ResetBlockTime()
+		return nil
+	case deadletter.FieldTxID:
+		m.ResetTxID()
+		return nil
+	case deadletter.FieldTxIndex:
+		m.ResetTxIndex()
+		return nil
+	case deadletter.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case deadletter.FieldType:
+		m.ResetType()
+		return nil
+	case deadletter.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case deadletter.FieldError:
+		m.ResetError()
+		return nil
+	case deadletter.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case deadletter.FieldNextRetryAt:
+		m.ResetNextRetryAt()
+		return nil
+	case deadletter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deadletter.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeadLetter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeadLetterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeadLetterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeadLetterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeadLetterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeadLetterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeadLetterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeadLetterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeadLetterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeadLetter edge %s", name)
+}
+
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	event_id             *uint64
+	addevent_id          *int64
+	name                 *string
+	description          *string
+	thumbnail            *string
+	event_type           *uint8
+	addevent_type        *int8
+	location             *string
+	lat                  *float64
+	addlat               *float64
+	long                 *float64
+	addlong              *float64
+	start_date           *time.Time
+	end_date             *time.Time
+	quota                *uint64
+	addquota             *int64
+	clearedFields        map[string]struct{}
+	host                 *int
+	clearedhost          bool
+	passes_issued        map[int]struct{}
+	removedpasses_issued map[int]struct{}
+	clearedpasses_issued bool
+	attendances          map[int]struct{}
+	removedattendances   map[int]struct{}
+	clearedattendances   bool
+	done                 bool
+	oldValue             func(context.Context) (*Event, error)
+	predicates           []predicate.Event
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the ID field of the mutation.
+func withEventID(id int) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventMutation) SetEventID(u uint64) {
+	m.event_id = &u
+	m.addevent_id = nil
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventMutation) EventID() (r uint64, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEventID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// AddEventID adds u to the "event_id" field.
+func (m *EventMutation) AddEventID(u int64) {
+	if m.addevent_id != nil {
+		*m.addevent_id += u
+	} else {
+		m.addevent_id = &u
+	}
+}
+
+// AddedEventID returns the value that was added to the "event_id" field in this mutation.
+func (m *EventMutation) AddedEventID() (r int64, exists bool) {
+	v := m.addevent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventMutation) ResetEventID() {
+	m.event_id = nil
+	m.addevent_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *EventMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EventMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EventMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (m *EventMutation) SetThumbnail(s string) {
+	m.thumbnail = &s
+}
+
+// Thumbnail returns the value of the "thumbnail" field in the mutation.
+func (m *EventMutation) Thumbnail() (r string, exists bool) {
+	v := m.thumbnail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnail returns the old "thumbnail" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+	}
+	return oldValue.Thumbnail, nil
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" field.
+func (m *EventMutation) ResetThumbnail() {
+	m.thumbnail = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *EventMutation) SetEventType(u uint8) {
+	m.event_type = &u
+	m.addevent_type = nil
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *EventMutation) EventType() (r uint8, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEventType(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// AddEventType adds u to the "event_type" field.
+func (m *EventMutation) AddEventType(u int8) {
+	if m.addevent_type != nil {
+		*m.addevent_type += u
+	} else {
+		m.addevent_type = &u
+	}
+}
+
+// AddedEventType returns the value that was added to the "event_type" field in this mutation.
+func (m *EventMutation) AddedEventType() (r int8, exists bool) {
+	v := m.addevent_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *EventMutation) ResetEventType() {
+	m.event_type = nil
+	m.addevent_type = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *EventMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *EventMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *EventMutation) ResetLocation() {
+	m.location = nil
+}
+
+// SetLat sets the "lat" field.
+func (m *EventMutation) SetLat(f float64) {
+	m.lat = &f
+	m.addlat = nil
+}
+
+// Lat returns the value of the "lat" field in the mutation.
+func (m *EventMutation) Lat() (r float64, exists bool) {
+	v := m.lat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLat returns the old "lat" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLat(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLat: %w", err)
+	}
+	return oldValue.Lat, nil
+}
+
+// AddLat adds f to the "lat" field.
+func (m *EventMutation) AddLat(f float64) {
+	if m.addlat != nil {
+		*m.addlat += f
+	} else {
+		m.addlat = &f
+	}
+}
+
+// AddedLat returns the value that was added to the "lat" field in this mutation.
+func (m *EventMutation) AddedLat() (r float64, exists bool) {
+	v := m.addlat
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
 // ResetLat resets all changes to the "lat" field.
@@ -991,346 +2901,6167 @@ func (m *EventMutation) ResetLat() {
 	m.addlat = nil
 }
 
-// SetLong sets the "long" field.
-func (m *EventMutation) SetLong(f float64) {
-	m.long = &f
-	m.addlong = nil
+// SetLong sets the "long" field.
+func (m *EventMutation) SetLong(f float64) {
+	m.long = &f
+	m.addlong = nil
+}
+
+// Long returns the value of the "long" field in the mutation.
+func (m *EventMutation) Long() (r float64, exists bool) {
+	v := m.long
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLong returns the old "long" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLong(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLong is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLong requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLong: %w", err)
+	}
+	return oldValue.Long, nil
+}
+
+// AddLong adds f to the "long" field.
+func (m *EventMutation) AddLong(f float64) {
+	if m.addlong != nil {
+		*m.addlong += f
+	} else {
+		m.addlong = &f
+	}
+}
+
+// AddedLong returns the value that was added to the "long" field in this mutation.
+func (m *EventMutation) AddedLong() (r float64, exists bool) {
+	v := m.addlong
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLong resets all changes to the "long" field.
+func (m *EventMutation) ResetLong() {
+	m.long = nil
+	m.addlong = nil
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *EventMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *EventMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *EventMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *EventMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *EventMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *EventMutation) ResetEndDate() {
+	m.end_date = nil
+}
+
+// SetQuota sets the "quota" field.
+func (m *EventMutation) SetQuota(u uint64) {
+	m.quota = &u
+	m.addquota = nil
+}
+
+// Quota returns the value of the "quota" field in the mutation.
+func (m *EventMutation) Quota() (r uint64, exists bool) {
+	v := m.quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuota returns the old "quota" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldQuota(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuota: %w", err)
+	}
+	return oldValue.Quota, nil
+}
+
+// AddQuota adds u to the "quota" field.
+func (m *EventMutation) AddQuota(u int64) {
+	if m.addquota != nil {
+		*m.addquota += u
+	} else {
+		m.addquota = &u
+	}
+}
+
+// AddedQuota returns the value that was added to the "quota" field in this mutation.
+func (m *EventMutation) AddedQuota() (r int64, exists bool) {
+	v := m.addquota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuota resets all changes to the "quota" field.
+func (m *EventMutation) ResetQuota() {
+	m.quota = nil
+	m.addquota = nil
+}
+
+// SetHostID sets the "host" edge to the User entity by id.
+func (m *EventMutation) SetHostID(id int) {
+	m.host = &id
+}
+
+// ClearHost clears the "host" edge to the User entity.
+func (m *EventMutation) ClearHost() {
+	m.clearedhost = true
+}
+
+// HostCleared reports if the "host" edge to the User entity was cleared.
+func (m *EventMutation) HostCleared() bool {
+	return m.clearedhost
+}
+
+// HostID returns the "host" edge ID in the mutation.
+func (m *EventMutation) HostID() (id int, exists bool) {
+	if m.host != nil {
+		return *m.host, true
+	}
+	return
+}
+
+// HostIDs returns the "host" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HostID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) HostIDs() (ids []int) {
+	if id := m.host; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHost resets all changes to the "host" edge.
+func (m *EventMutation) ResetHost() {
+	m.host = nil
+	m.clearedhost = false
+}
+
+// AddPassesIssuedIDs adds the "passes_issued" edge to the EventPass entity by ids.
+func (m *EventMutation) AddPassesIssuedIDs(ids ...int) {
+	if m.passes_issued == nil {
+		m.passes_issued = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.passes_issued[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPassesIssued clears the "passes_issued" edge to the EventPass entity.
+func (m *EventMutation) ClearPassesIssued() {
+	m.clearedpasses_issued = true
+}
+
+// PassesIssuedCleared reports if the "passes_issued" edge to the EventPass entity was cleared.
+func (m *EventMutation) PassesIssuedCleared() bool {
+	return m.clearedpasses_issued
+}
+
+// RemovePassesIssuedIDs removes the "passes_issued" edge to the EventPass entity by IDs.
+func (m *EventMutation) RemovePassesIssuedIDs(ids ...int) {
+	if m.removedpasses_issued == nil {
+		m.removedpasses_issued = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.passes_issued, ids[i])
+		m.removedpasses_issued[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPassesIssued returns the removed IDs of the "passes_issued" edge to the EventPass entity.
+func (m *EventMutation) RemovedPassesIssuedIDs() (ids []int) {
+	for id := range m.removedpasses_issued {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PassesIssuedIDs returns the "passes_issued" edge IDs in the mutation.
+func (m *EventMutation) PassesIssuedIDs() (ids []int) {
+	for id := range m.passes_issued {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPassesIssued resets all changes to the "passes_issued" edge.
+func (m *EventMutation) ResetPassesIssued() {
+	m.passes_issued = nil
+	m.clearedpasses_issued = false
+	m.removedpasses_issued = nil
+}
+
+// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by ids.
+func (m *EventMutation) AddAttendanceIDs(ids ...int) {
+	if m.attendances == nil {
+		m.attendances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attendances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttendances clears the "attendances" edge to the Attendance entity.
+func (m *EventMutation) ClearAttendances() {
+	m.clearedattendances = true
+}
+
+// AttendancesCleared reports if the "attendances" edge to the Attendance entity was cleared.
+func (m *EventMutation) AttendancesCleared() bool {
+	return m.clearedattendances
+}
+
+// RemoveAttendanceIDs removes the "attendances" edge to the Attendance entity by IDs.
+func (m *EventMutation) RemoveAttendanceIDs(ids ...int) {
+	if m.removedattendances == nil {
+		m.removedattendances = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attendances, ids[i])
+		m.removedattendances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttendances returns the removed IDs of the "attendances" edge to the Attendance entity.
+func (m *EventMutation) RemovedAttendancesIDs() (ids []int) {
+	for id := range m.removedattendances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttendancesIDs returns the "attendances" edge IDs in the mutation.
+func (m *EventMutation) AttendancesIDs() (ids []int) {
+	for id := range m.attendances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttendances resets all changes to the "attendances" edge.
+func (m *EventMutation) ResetAttendances() {
+	m.attendances = nil
+	m.clearedattendances = false
+	m.removedattendances = nil
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.event_id != nil {
+		fields = append(fields, event.FieldEventID)
+	}
+	if m.name != nil {
+		fields = append(fields, event.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.thumbnail != nil {
+		fields = append(fields, event.FieldThumbnail)
+	}
+	if m.event_type != nil {
+		fields = append(fields, event.FieldEventType)
+	}
+	if m.location != nil {
+		fields = append(fields, event.FieldLocation)
+	}
+	if m.lat != nil {
+		fields = append(fields, event.FieldLat)
+	}
+	if m.long != nil {
+		fields = append(fields, event.FieldLong)
+	}
+	if m.start_date != nil {
+		fields = append(fields, event.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, event.FieldEndDate)
+	}
+	if m.quota != nil {
+		fields = append(fields, event.FieldQuota)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldEventID:
+		return m.EventID()
+	case event.FieldName:
+		return m.Name()
+	case event.FieldDescription:
+		return m.Description()
+	case event.FieldThumbnail:
+		return m.Thumbnail()
+	case event.FieldEventType:
+		return m.EventType()
+	case event.FieldLocation:
+		return m.Location()
+	case event.FieldLat:
+		return m.Lat()
+	case event.FieldLong:
+		return m.Long()
+	case event.FieldStartDate:
+		return m.StartDate()
+	case event.FieldEndDate:
+		return m.EndDate()
+	case event.FieldQuota:
+		return m.Quota()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldEventID:
+		return m.OldEventID(ctx)
+	case event.FieldName:
+		return m.OldName(ctx)
+	case event.FieldDescription:
+		return m.OldDescription(ctx)
+	case event.FieldThumbnail:
+		return m.OldThumbnail(ctx)
+	case event.FieldEventType:
+		return m.OldEventType(ctx)
+	case event.FieldLocation:
+		return m.OldLocation(ctx)
+	case event.FieldLat:
+		return m.OldLat(ctx)
+	case event.FieldLong:
+		return m.OldLong(ctx)
+	case event.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case event.FieldEndDate:
+		return m.OldEndDate(ctx)
+	case event.FieldQuota:
+		return m.OldQuota(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldEventID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case event.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case event.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case event.FieldThumbnail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnail(v)
+		return nil
+	case event.FieldEventType:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case event.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case event.FieldLat:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLat(v)
+		return nil
+	case event.FieldLong:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLong(v)
+		return nil
+	case event.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case event.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	case event.FieldQuota:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuota(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_id != nil {
+		fields = append(fields, event.FieldEventID)
+	}
+	if m.addevent_type != nil {
+		fields = append(fields, event.FieldEventType)
+	}
+	if m.addlat != nil {
+		fields = append(fields, event.FieldLat)
+	}
+	if m.addlong != nil {
+		fields = append(fields, event.FieldLong)
+	}
+	if m.addquota != nil {
+		fields = append(fields, event.FieldQuota)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldEventID:
+		return m.AddedEventID()
+	case event.FieldEventType:
+		return m.AddedEventType()
+	case event.FieldLat:
+		return m.AddedLat()
+	case event.FieldLong:
+		return m.AddedLong()
+	case event.FieldQuota:
+		return m.AddedQuota()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldEventID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventID(v)
+		return nil
+	case event.FieldEventType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventType(v)
+		return nil
+	case event.FieldLat:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLat(v)
+		return nil
+	case event.FieldLong:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLong(v)
+		return nil
+	case event.FieldQuota:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuota(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case event.FieldName:
+		m.ResetName()
+		return nil
+	case event.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case event.FieldThumbnail:
+		m.ResetThumbnail()
+		return nil
+	case event.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case event.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case event.FieldLat:
+		m.ResetLat()
+		return nil
+	case event.FieldLong:
+		m.ResetLong()
+		return nil
+	case event.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case event.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	case event.FieldQuota:
+		m.ResetQuota()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.host != nil {
+		edges = append(edges, event.EdgeHost)
+	}
+	if m.passes_issued != nil {
+		edges = append(edges, event.EdgePassesIssued)
+	}
+	if m.attendances != nil {
+		edges = append(edges, event.EdgeAttendances)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeHost:
+		if id := m.host; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgePassesIssued:
+		ids := make([]ent.Value, 0, len(m.passes_issued))
+		for id := range m.passes_issued {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeAttendances:
+		ids := make([]ent.Value, 0, len(m.attendances))
+		for id := range m.attendances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedpasses_issued != nil {
+		edges = append(edges, event.EdgePassesIssued)
+	}
+	if m.removedattendances != nil {
+		edges = append(edges, event.EdgeAttendances)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgePassesIssued:
+		ids := make([]ent.Value, 0, len(m.removedpasses_issued))
+		for id := range m.removedpasses_issued {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeAttendances:
+		ids := make([]ent.Value, 0, len(m.removedattendances))
+		for id := range m.removedattendances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedhost {
+		edges = append(edges, event.EdgeHost)
+	}
+	if m.clearedpasses_issued {
+		edges = append(edges, event.EdgePassesIssued)
+	}
+	if m.clearedattendances {
+		edges = append(edges, event.EdgeAttendances)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeHost:
+		return m.clearedhost
+	case event.EdgePassesIssued:
+		return m.clearedpasses_issued
+	case event.EdgeAttendances:
+		return m.clearedattendances
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
+	case event.EdgeHost:
+		m.ClearHost()
+		return nil
+	}
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	switch name {
+	case event.EdgeHost:
+		m.ResetHost()
+		return nil
+	case event.EdgePassesIssued:
+		m.ResetPassesIssued()
+		return nil
+	case event.EdgeAttendances:
+		m.ResetAttendances()
+		return nil
+	}
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// EventCountMutation represents an operation that mutates the EventCount nodes in the graph.
+type EventCountMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_type         *string
+	count         *int
+	addcount      *int
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventCount, error)
+	predicates    []predicate.EventCount
+}
+
+var _ ent.Mutation = (*EventCountMutation)(nil)
+
+// eventcountOption allows management of the mutation configuration using functional options.
+type eventcountOption func(*EventCountMutation)
+
+// newEventCountMutation creates new mutation for the EventCount entity.
+func newEventCountMutation(c config, op Op, opts ...eventcountOption) *EventCountMutation {
+	m := &EventCountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventCount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventCountID sets the ID field of the mutation.
+func withEventCountID(id int) eventcountOption {
+	return func(m *EventCountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventCount
+		)
+		m.oldValue = func(ctx context.Context) (*EventCount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventCount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventCount sets the old EventCount of the mutation.
+func withEventCount(node *EventCount) eventcountOption {
+	return func(m *EventCountMutation) {
+		m.oldValue = func(context.Context) (*EventCount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventCountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventCountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventCountMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventCountMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventCount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *EventCountMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventCountMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the EventCount entity.
+// If the EventCount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCountMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *EventCountMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCount sets the "count" field.
+func (m *EventCountMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *EventCountMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the EventCount entity.
+// If the EventCount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCountMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *EventCountMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *EventCountMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *EventCountMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventCountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventCountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventCount entity.
+// If the EventCount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCountMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventCountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the EventCountMutation builder.
+func (m *EventCountMutation) Where(ps ...predicate.EventCount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventCountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventCountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventCount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventCountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventCountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventCount).
+func (m *EventCountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventCountMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._type != nil {
+		fields = append(fields, eventcount.FieldType)
+	}
+	if m.count != nil {
+		fields = append(fields, eventcount.FieldCount)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, eventcount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventCountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventcount.FieldType:
+		return m.GetType()
+	case eventcount.FieldCount:
+		return m.Count()
+	case eventcount.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventCountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventcount.FieldType:
+		return m.OldType(ctx)
+	case eventcount.FieldCount:
+		return m.OldCount(ctx)
+	case eventcount.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventCount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventcount.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case eventcount.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case eventcount.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventCount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventCountMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, eventcount.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventCountMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventcount.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventcount.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventCount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventCountMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventCountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventCountMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventCount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventCountMutation) ResetField(name string) error {
+	switch name {
+	case eventcount.FieldType:
+		m.ResetType()
+		return nil
+	case eventcount.FieldCount:
+		m.ResetCount()
+		return nil
+	case eventcount.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventCount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventCountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventCountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventCountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventCountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventCountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventCountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventCountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventCount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventCountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventCount edge %s", name)
+}
+
+// EventPassMutation represents an operation that mutates the EventPass nodes in the graph.
+type EventPassMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	pass_id       *uint64
+	addpass_id    *int64
+	name          *string
+	description   *string
+	thumbnail     *string
+	event_type    *uint8
+	addevent_type *int8
+	is_used       *bool
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	event         *int
+	clearedevent  bool
+	moment        *int
+	clearedmoment bool
+	done          bool
+	oldValue      func(context.Context) (*EventPass, error)
+	predicates    []predicate.EventPass
+}
+
+var _ ent.Mutation = (*EventPassMutation)(nil)
+
+// eventpassOption allows management of the mutation configuration using functional options.
+type eventpassOption func(*EventPassMutation)
+
+// newEventPassMutation creates new mutation for the EventPass entity.
+func newEventPassMutation(c config, op Op, opts ...eventpassOption) *EventPassMutation {
+	m := &EventPassMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventPass,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventPassID sets the ID field of the mutation.
+func withEventPassID(id int) eventpassOption {
+	return func(m *EventPassMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventPass
+		)
+		m.oldValue = func(ctx context.Context) (*EventPass, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventPass.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventPass sets the old EventPass of the mutation.
+func withEventPass(node *EventPass) eventpassOption {
+	return func(m *EventPassMutation) {
+		m.oldValue = func(context.Context) (*EventPass, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventPassMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventPassMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventPassMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventPassMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventPass.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPassID sets the "pass_id" field.
+func (m *EventPassMutation) SetPassID(u uint64) {
+	m.pass_id = &u
+	m.addpass_id = nil
+}
+
+// PassID returns the value of the "pass_id" field in the mutation.
+func (m *EventPassMutation) PassID() (r uint64, exists bool) {
+	v := m.pass_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassID returns the old "pass_id" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldPassID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassID: %w", err)
+	}
+	return oldValue.PassID, nil
+}
+
+// AddPassID adds u to the "pass_id" field.
+func (m *EventPassMutation) AddPassID(u int64) {
+	if m.addpass_id != nil {
+		*m.addpass_id += u
+	} else {
+		m.addpass_id = &u
+	}
+}
+
+// AddedPassID returns the value that was added to the "pass_id" field in this mutation.
+func (m *EventPassMutation) AddedPassID() (r int64, exists bool) {
+	v := m.addpass_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPassID resets all changes to the "pass_id" field.
+func (m *EventPassMutation) ResetPassID() {
+	m.pass_id = nil
+	m.addpass_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *EventPassMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EventPassMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EventPassMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventPassMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventPassMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventPassMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (m *EventPassMutation) SetThumbnail(s string) {
+	m.thumbnail = &s
+}
+
+// Thumbnail returns the value of the "thumbnail" field in the mutation.
+func (m *EventPassMutation) Thumbnail() (r string, exists bool) {
+	v := m.thumbnail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnail returns the old "thumbnail" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+	}
+	return oldValue.Thumbnail, nil
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" field.
+func (m *EventPassMutation) ResetThumbnail() {
+	m.thumbnail = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *EventPassMutation) SetEventType(u uint8) {
+	m.event_type = &u
+	m.addevent_type = nil
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *EventPassMutation) EventType() (r uint8, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldEventType(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// AddEventType adds u to the "event_type" field.
+func (m *EventPassMutation) AddEventType(u int8) {
+	if m.addevent_type != nil {
+		*m.addevent_type += u
+	} else {
+		m.addevent_type = &u
+	}
+}
+
+// AddedEventType returns the value that was added to the "event_type" field in this mutation.
+func (m *EventPassMutation) AddedEventType() (r int8, exists bool) {
+	v := m.addevent_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *EventPassMutation) ResetEventType() {
+	m.event_type = nil
+	m.addevent_type = nil
+}
+
+// SetIsUsed sets the "is_used" field.
+func (m *EventPassMutation) SetIsUsed(b bool) {
+	m.is_used = &b
+}
+
+// IsUsed returns the value of the "is_used" field in the mutation.
+func (m *EventPassMutation) IsUsed() (r bool, exists bool) {
+	v := m.is_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUsed returns the old "is_used" field's value of the EventPass entity.
+// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventPassMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+	}
+	return oldValue.IsUsed, nil
+}
+
+// ResetIsUsed resets all changes to the "is_used" field.
+func (m *EventPassMutation) ResetIsUsed() {
+	m.is_used = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *EventPassMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *EventPassMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *EventPassMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *EventPassMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *EventPassMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *EventPassMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetEventID sets the "event" edge to the Event entity by id.
+func (m *EventPassMutation) SetEventID(id int) {
+	m.event = &id
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *EventPassMutation) ClearEvent() {
+	m.clearedevent = true
+}
+
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *EventPassMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventID returns the "event" edge ID in the mutation.
+func (m *EventPassMutation) EventID() (id int, exists bool) {
+	if m.event != nil {
+		return *m.event, true
+	}
+	return
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *EventPassMutation) EventIDs() (ids []int) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEvent resets all changes to the "event" edge.
+func (m *EventPassMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
+}
+
+// SetMomentID sets the "moment" edge to the NFTMoment entity by id.
+func (m *EventPassMutation) SetMomentID(id int) {
+	m.moment = &id
+}
+
+// ClearMoment clears the "moment" edge to the NFTMoment entity.
+func (m *EventPassMutation) ClearMoment() {
+	m.clearedmoment = true
+}
+
+// MomentCleared reports if the "moment" edge to the NFTMoment entity was cleared.
+func (m *EventPassMutation) MomentCleared() bool {
+	return m.clearedmoment
+}
+
+// MomentID returns the "moment" edge ID in the mutation.
+func (m *EventPassMutation) MomentID() (id int, exists bool) {
+	if m.moment != nil {
+		return *m.moment, true
+	}
+	return
+}
+
+// MomentIDs returns the "moment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MomentID instead. It exists only for internal usage by the builders.
+func (m *EventPassMutation) MomentIDs() (ids []int) {
+	if id := m.moment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMoment resets all changes to the "moment" edge.
+func (m *EventPassMutation) ResetMoment() {
+	m.moment = nil
+	m.clearedmoment = false
+}
+
+// Where appends a list predicates to the EventPassMutation builder.
+func (m *EventPassMutation) Where(ps ...predicate.EventPass) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventPassMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventPassMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventPass, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventPassMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventPassMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventPass).
+func (m *EventPassMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventPassMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.pass_id != nil {
+		fields = append(fields, eventpass.FieldPassID)
+	}
+	if m.name != nil {
+		fields = append(fields, eventpass.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, eventpass.FieldDescription)
+	}
+	if m.thumbnail != nil {
+		fields = append(fields, eventpass.FieldThumbnail)
+	}
+	if m.event_type != nil {
+		fields = append(fields, eventpass.FieldEventType)
+	}
+	if m.is_used != nil {
+		fields = append(fields, eventpass.FieldIsUsed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventPassMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventpass.FieldPassID:
+		return m.PassID()
+	case eventpass.FieldName:
+		return m.Name()
+	case eventpass.FieldDescription:
+		return m.Description()
+	case eventpass.FieldThumbnail:
+		return m.Thumbnail()
+	case eventpass.FieldEventType:
+		return m.EventType()
+	case eventpass.FieldIsUsed:
+		return m.IsUsed()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventPassMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventpass.FieldPassID:
+		return m.OldPassID(ctx)
+	case eventpass.FieldName:
+		return m.OldName(ctx)
+	case eventpass.FieldDescription:
+		return m.OldDescription(ctx)
+	case eventpass.FieldThumbnail:
+		return m.OldThumbnail(ctx)
+	case eventpass.FieldEventType:
+		return m.OldEventType(ctx)
+	case eventpass.FieldIsUsed:
+		return m.OldIsUsed(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventPass field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventPassMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventpass.FieldPassID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassID(v)
+		return nil
+	case eventpass.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case eventpass.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case eventpass.FieldThumbnail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnail(v)
+		return nil
+	case eventpass.FieldEventType:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case eventpass.FieldIsUsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventPass field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventPassMutation) AddedFields() []string {
+	var fields []string
+	if m.addpass_id != nil {
+		fields = append(fields, eventpass.FieldPassID)
+	}
+	if m.addevent_type != nil {
+		fields = append(fields, eventpass.FieldEventType)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventPassMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventpass.FieldPassID:
+		return m.AddedPassID()
+	case eventpass.FieldEventType:
+		return m.AddedEventType()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventPassMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventpass.FieldPassID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPassID(v)
+		return nil
+	case eventpass.FieldEventType:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventPass numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventPassMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventPassMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventPassMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventPass nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventPassMutation) ResetField(name string) error {
+	switch name {
+	case eventpass.FieldPassID:
+		m.ResetPassID()
+		return nil
+	case eventpass.FieldName:
+		m.ResetName()
+		return nil
+	case eventpass.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case eventpass.FieldThumbnail:
+		m.ResetThumbnail()
+		return nil
+	case eventpass.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case eventpass.FieldIsUsed:
+		m.ResetIsUsed()
+		return nil
+	}
+	return fmt.Errorf("unknown EventPass field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventPassMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.owner != nil {
+		edges = append(edges, eventpass.EdgeOwner)
+	}
+	if m.event != nil {
+		edges = append(edges, eventpass.EdgeEvent)
+	}
+	if m.moment != nil {
+		edges = append(edges, eventpass.EdgeMoment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventPassMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventpass.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case eventpass.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	case eventpass.EdgeMoment:
+		if id := m.moment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventPassMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventPassMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventPassMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedowner {
+		edges = append(edges, eventpass.EdgeOwner)
+	}
+	if m.clearedevent {
+		edges = append(edges, eventpass.EdgeEvent)
+	}
+	if m.clearedmoment {
+		edges = append(edges, eventpass.EdgeMoment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventPassMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventpass.EdgeOwner:
+		return m.clearedowner
+	case eventpass.EdgeEvent:
+		return m.clearedevent
+	case eventpass.EdgeMoment:
+		return m.clearedmoment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventPassMutation) ClearEdge(name string) error {
+	switch name {
+	case eventpass.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case eventpass.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	case eventpass.EdgeMoment:
+		m.ClearMoment()
+		return nil
+	}
+	return fmt.Errorf("unknown EventPass unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventPassMutation) ResetEdge(name string) error {
+	switch name {
+	case eventpass.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case eventpass.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	case eventpass.EdgeMoment:
+		m.ResetMoment()
+		return nil
+	}
+	return fmt.Errorf("unknown EventPass edge %s", name)
+}
+
+// GachaReceiptMutation represents an operation that mutates the GachaReceipt nodes in the graph.
+type GachaReceiptMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	receipt_id       *uint64
+	addreceipt_id    *int64
+	opener           *string
+	commit_block     *uint64
+	addcommit_block  *int64
+	open_tx_id       *string
+	open_block       *uint64
+	addopen_block    *int64
+	reveal_block     *uint64
+	addreveal_block  *int64
+	reveal_tx_id     *string
+	rarity           *uint8
+	addrarity        *int8
+	created_at       *time.Time
+	revealed_at      *time.Time
+	clearedFields    map[string]struct{}
+	accessory        *int
+	clearedaccessory bool
+	done             bool
+	oldValue         func(context.Context) (*GachaReceipt, error)
+	predicates       []predicate.GachaReceipt
+}
+
+var _ ent.Mutation = (*GachaReceiptMutation)(nil)
+
+// gachareceiptOption allows management of the mutation configuration using functional options.
+type gachareceiptOption func(*GachaReceiptMutation)
+
+// newGachaReceiptMutation creates new mutation for the GachaReceipt entity.
+func newGachaReceiptMutation(c config, op Op, opts ...gachareceiptOption) *GachaReceiptMutation {
+	m := &GachaReceiptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGachaReceipt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGachaReceiptID sets the ID field of the mutation.
+func withGachaReceiptID(id int) gachareceiptOption {
+	return func(m *GachaReceiptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GachaReceipt
+		)
+		m.oldValue = func(ctx context.Context) (*GachaReceipt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GachaReceipt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGachaReceipt sets the old GachaReceipt of the mutation.
+func withGachaReceipt(node *GachaReceipt) gachareceiptOption {
+	return func(m *GachaReceiptMutation) {
+		m.oldValue = func(context.Context) (*GachaReceipt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GachaReceiptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GachaReceiptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GachaReceiptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GachaReceiptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GachaReceipt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReceiptID sets the "receipt_id" field.
+func (m *GachaReceiptMutation) SetReceiptID(u uint64) {
+	m.receipt_id = &u
+	m.addreceipt_id = nil
+}
+
+// ReceiptID returns the value of the "receipt_id" field in the mutation.
+func (m *GachaReceiptMutation) ReceiptID() (r uint64, exists bool) {
+	v := m.receipt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiptID returns the old "receipt_id" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldReceiptID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiptID: %w", err)
+	}
+	return oldValue.ReceiptID, nil
+}
+
+// AddReceiptID adds u to the "receipt_id" field.
+func (m *GachaReceiptMutation) AddReceiptID(u int64) {
+	if m.addreceipt_id != nil {
+		*m.addreceipt_id += u
+	} else {
+		m.addreceipt_id = &u
+	}
+}
+
+// AddedReceiptID returns the value that was added to the "receipt_id" field in this mutation.
+func (m *GachaReceiptMutation) AddedReceiptID() (r int64, exists bool) {
+	v := m.addreceipt_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReceiptID resets all changes to the "receipt_id" field.
+func (m *GachaReceiptMutation) ResetReceiptID() {
+	m.receipt_id = nil
+	m.addreceipt_id = nil
+}
+
+// SetOpener sets the "opener" field.
+func (m *GachaReceiptMutation) SetOpener(s string) {
+	m.opener = &s
+}
+
+// Opener returns the value of the "opener" field in the mutation.
+func (m *GachaReceiptMutation) Opener() (r string, exists bool) {
+	v := m.opener
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpener returns the old "opener" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldOpener(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpener is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpener requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpener: %w", err)
+	}
+	return oldValue.Opener, nil
+}
+
+// ClearOpener clears the value of the "opener" field.
+func (m *GachaReceiptMutation) ClearOpener() {
+	m.opener = nil
+	m.clearedFields[gachareceipt.FieldOpener] = struct{}{}
+}
+
+// OpenerCleared returns if the "opener" field was cleared in this mutation.
+func (m *GachaReceiptMutation) OpenerCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldOpener]
+	return ok
+}
+
+// ResetOpener resets all changes to the "opener" field.
+func (m *GachaReceiptMutation) ResetOpener() {
+	m.opener = nil
+	delete(m.clearedFields, gachareceipt.FieldOpener)
+}
+
+// SetCommitBlock sets the "commit_block" field.
+func (m *GachaReceiptMutation) SetCommitBlock(u uint64) {
+	m.commit_block = &u
+	m.addcommit_block = nil
+}
+
+// CommitBlock returns the value of the "commit_block" field in the mutation.
+func (m *GachaReceiptMutation) CommitBlock() (r uint64, exists bool) {
+	v := m.commit_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommitBlock returns the old "commit_block" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldCommitBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommitBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommitBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommitBlock: %w", err)
+	}
+	return oldValue.CommitBlock, nil
+}
+
+// AddCommitBlock adds u to the "commit_block" field.
+func (m *GachaReceiptMutation) AddCommitBlock(u int64) {
+	if m.addcommit_block != nil {
+		*m.addcommit_block += u
+	} else {
+		m.addcommit_block = &u
+	}
+}
+
+// AddedCommitBlock returns the value that was added to the "commit_block" field in this mutation.
+func (m *GachaReceiptMutation) AddedCommitBlock() (r int64, exists bool) {
+	v := m.addcommit_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCommitBlock resets all changes to the "commit_block" field.
+func (m *GachaReceiptMutation) ResetCommitBlock() {
+	m.commit_block = nil
+	m.addcommit_block = nil
+}
+
+// SetOpenTxID sets the "open_tx_id" field.
+func (m *GachaReceiptMutation) SetOpenTxID(s string) {
+	m.open_tx_id = &s
+}
+
+// OpenTxID returns the value of the "open_tx_id" field in the mutation.
+func (m *GachaReceiptMutation) OpenTxID() (r string, exists bool) {
+	v := m.open_tx_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenTxID returns the old "open_tx_id" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldOpenTxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenTxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenTxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenTxID: %w", err)
+	}
+	return oldValue.OpenTxID, nil
+}
+
+// ResetOpenTxID resets all changes to the "open_tx_id" field.
+func (m *GachaReceiptMutation) ResetOpenTxID() {
+	m.open_tx_id = nil
+}
+
+// SetOpenBlock sets the "open_block" field.
+func (m *GachaReceiptMutation) SetOpenBlock(u uint64) {
+	m.open_block = &u
+	m.addopen_block = nil
+}
+
+// OpenBlock returns the value of the "open_block" field in the mutation.
+func (m *GachaReceiptMutation) OpenBlock() (r uint64, exists bool) {
+	v := m.open_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenBlock returns the old "open_block" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldOpenBlock(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenBlock: %w", err)
+	}
+	return oldValue.OpenBlock, nil
+}
+
+// AddOpenBlock adds u to the "open_block" field.
+func (m *GachaReceiptMutation) AddOpenBlock(u int64) {
+	if m.addopen_block != nil {
+		*m.addopen_block += u
+	} else {
+		m.addopen_block = &u
+	}
+}
+
+// AddedOpenBlock returns the value that was added to the "open_block" field in this mutation.
+func (m *GachaReceiptMutation) AddedOpenBlock() (r int64, exists bool) {
+	v := m.addopen_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOpenBlock clears the value of the "open_block" field.
+func (m *GachaReceiptMutation) ClearOpenBlock() {
+	m.open_block = nil
+	m.addopen_block = nil
+	m.clearedFields[gachareceipt.FieldOpenBlock] = struct{}{}
+}
+
+// OpenBlockCleared returns if the "open_block" field was cleared in this mutation.
+func (m *GachaReceiptMutation) OpenBlockCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldOpenBlock]
+	return ok
+}
+
+// ResetOpenBlock resets all changes to the "open_block" field.
+func (m *GachaReceiptMutation) ResetOpenBlock() {
+	m.open_block = nil
+	m.addopen_block = nil
+	delete(m.clearedFields, gachareceipt.FieldOpenBlock)
+}
+
+// SetRevealBlock sets the "reveal_block" field.
+func (m *GachaReceiptMutation) SetRevealBlock(u uint64) {
+	m.reveal_block = &u
+	m.addreveal_block = nil
+}
+
+// RevealBlock returns the value of the "reveal_block" field in the mutation.
+func (m *GachaReceiptMutation) RevealBlock() (r uint64, exists bool) {
+	v := m.reveal_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevealBlock returns the old "reveal_block" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldRevealBlock(ctx context.Context) (v *uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevealBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevealBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevealBlock: %w", err)
+	}
+	return oldValue.RevealBlock, nil
+}
+
+// AddRevealBlock adds u to the "reveal_block" field.
+func (m *GachaReceiptMutation) AddRevealBlock(u int64) {
+	if m.addreveal_block != nil {
+		*m.addreveal_block += u
+	} else {
+		m.addreveal_block = &u
+	}
+}
+
+// AddedRevealBlock returns the value that was added to the "reveal_block" field in this mutation.
+func (m *GachaReceiptMutation) AddedRevealBlock() (r int64, exists bool) {
+	v := m.addreveal_block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRevealBlock clears the value of the "reveal_block" field.
+func (m *GachaReceiptMutation) ClearRevealBlock() {
+	m.reveal_block = nil
+	m.addreveal_block = nil
+	m.clearedFields[gachareceipt.FieldRevealBlock] = struct{}{}
+}
+
+// RevealBlockCleared returns if the "reveal_block" field was cleared in this mutation.
+func (m *GachaReceiptMutation) RevealBlockCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldRevealBlock]
+	return ok
+}
+
+// ResetRevealBlock resets all changes to the "reveal_block" field.
+func (m *GachaReceiptMutation) ResetRevealBlock() {
+	m.reveal_block = nil
+	m.addreveal_block = nil
+	delete(m.clearedFields, gachareceipt.FieldRevealBlock)
+}
+
+// SetRevealTxID sets the "reveal_tx_id" field.
+func (m *GachaReceiptMutation) SetRevealTxID(s string) {
+	m.reveal_tx_id = &s
+}
+
+// RevealTxID returns the value of the "reveal_tx_id" field in the mutation.
+func (m *GachaReceiptMutation) RevealTxID() (r string, exists bool) {
+	v := m.reveal_tx_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevealTxID returns the old "reveal_tx_id" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldRevealTxID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevealTxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevealTxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevealTxID: %w", err)
+	}
+	return oldValue.RevealTxID, nil
+}
+
+// ClearRevealTxID clears the value of the "reveal_tx_id" field.
+func (m *GachaReceiptMutation) ClearRevealTxID() {
+	m.reveal_tx_id = nil
+	m.clearedFields[gachareceipt.FieldRevealTxID] = struct{}{}
+}
+
+// RevealTxIDCleared returns if the "reveal_tx_id" field was cleared in this mutation.
+func (m *GachaReceiptMutation) RevealTxIDCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldRevealTxID]
+	return ok
+}
+
+// ResetRevealTxID resets all changes to the "reveal_tx_id" field.
+func (m *GachaReceiptMutation) ResetRevealTxID() {
+	m.reveal_tx_id = nil
+	delete(m.clearedFields, gachareceipt.FieldRevealTxID)
+}
+
+// SetRarity sets the "rarity" field.
+func (m *GachaReceiptMutation) SetRarity(u uint8) {
+	m.rarity = &u
+	m.addrarity = nil
+}
+
+// Rarity returns the value of the "rarity" field in the mutation.
+func (m *GachaReceiptMutation) Rarity() (r uint8, exists bool) {
+	v := m.rarity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRarity returns the old "rarity" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldRarity(ctx context.Context) (v *uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRarity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRarity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRarity: %w", err)
+	}
+	return oldValue.Rarity, nil
+}
+
+// AddRarity adds u to the "rarity" field.
+func (m *GachaReceiptMutation) AddRarity(u int8) {
+	if m.addrarity != nil {
+		*m.addrarity += u
+	} else {
+		m.addrarity = &u
+	}
+}
+
+// AddedRarity returns the value that was added to the "rarity" field in this mutation.
+func (m *GachaReceiptMutation) AddedRarity() (r int8, exists bool) {
+	v := m.addrarity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRarity clears the value of the "rarity" field.
+func (m *GachaReceiptMutation) ClearRarity() {
+	m.rarity = nil
+	m.addrarity = nil
+	m.clearedFields[gachareceipt.FieldRarity] = struct{}{}
+}
+
+// RarityCleared returns if the "rarity" field was cleared in this mutation.
+func (m *GachaReceiptMutation) RarityCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldRarity]
+	return ok
+}
+
+// ResetRarity resets all changes to the "rarity" field.
+func (m *GachaReceiptMutation) ResetRarity() {
+	m.rarity = nil
+	m.addrarity = nil
+	delete(m.clearedFields, gachareceipt.FieldRarity)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GachaReceiptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GachaReceiptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GachaReceiptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRevealedAt sets the "revealed_at" field.
+func (m *GachaReceiptMutation) SetRevealedAt(t time.Time) {
+	m.revealed_at = &t
+}
+
+// RevealedAt returns the value of the "revealed_at" field in the mutation.
+func (m *GachaReceiptMutation) RevealedAt() (r time.Time, exists bool) {
+	v := m.revealed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevealedAt returns the old "revealed_at" field's value of the GachaReceipt entity.
+// If the GachaReceipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GachaReceiptMutation) OldRevealedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevealedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevealedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevealedAt: %w", err)
+	}
+	return oldValue.RevealedAt, nil
+}
+
+// ClearRevealedAt clears the value of the "revealed_at" field.
+func (m *GachaReceiptMutation) ClearRevealedAt() {
+	m.revealed_at = nil
+	m.clearedFields[gachareceipt.FieldRevealedAt] = struct{}{}
+}
+
+// RevealedAtCleared returns if the "revealed_at" field was cleared in this mutation.
+func (m *GachaReceiptMutation) RevealedAtCleared() bool {
+	_, ok := m.clearedFields[gachareceipt.FieldRevealedAt]
+	return ok
+}
+
+// ResetRevealedAt resets all changes to the "revealed_at" field.
+func (m *GachaReceiptMutation) ResetRevealedAt() {
+	m.revealed_at = nil
+	delete(m.clearedFields, gachareceipt.FieldRevealedAt)
+}
+
+// SetAccessoryID sets the "accessory" edge to the NFTAccessory entity by id.
+func (m *GachaReceiptMutation) SetAccessoryID(id int) {
+	m.accessory = &id
+}
+
+// ClearAccessory clears the "accessory" edge to the NFTAccessory entity.
+func (m *GachaReceiptMutation) ClearAccessory() {
+	m.clearedaccessory = true
+}
+
+// AccessoryCleared reports if the "accessory" edge to the NFTAccessory entity was cleared.
+func (m *GachaReceiptMutation) AccessoryCleared() bool {
+	return m.clearedaccessory
+}
+
+// AccessoryID returns the "accessory" edge ID in the mutation.
+func (m *GachaReceiptMutation) AccessoryID() (id int, exists bool) {
+	if m.accessory != nil {
+		return *m.accessory, true
+	}
+	return
+}
+
+// AccessoryIDs returns the "accessory" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccessoryID instead. It exists only for internal usage by the builders.
+func (m *GachaReceiptMutation) AccessoryIDs() (ids []int) {
+	if id := m.accessory; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccessory resets all changes to the "accessory" edge.
+func (m *GachaReceiptMutation) ResetAccessory() {
+	m.accessory = nil
+	m.clearedaccessory = false
+}
+
+// Where appends a list predicates to the GachaReceiptMutation builder.
+func (m *GachaReceiptMutation) Where(ps ...predicate.GachaReceipt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GachaReceiptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GachaReceiptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GachaReceipt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GachaReceiptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GachaReceiptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GachaReceipt).
+func (m *GachaReceiptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GachaReceiptMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.receipt_id != nil {
+		fields = append(fields, gachareceipt.FieldReceiptID)
+	}
+	if m.opener != nil {
+		fields = append(fields, gachareceipt.FieldOpener)
+	}
+	if m.commit_block != nil {
+		fields = append(fields, gachareceipt.FieldCommitBlock)
+	}
+	if m.open_tx_id != nil {
+		fields = append(fields, gachareceipt.FieldOpenTxID)
+	}
+	if m.open_block != nil {
+		fields = append(fields, gachareceipt.FieldOpenBlock)
+	}
+	if m.reveal_block != nil {
+		fields = append(fields, gachareceipt.FieldRevealBlock)
+	}
+	if m.reveal_tx_id != nil {
+		fields = append(fields, gachareceipt.FieldRevealTxID)
+	}
+	if m.rarity != nil {
+		fields = append(fields, gachareceipt.FieldRarity)
+	}
+	if m.created_at != nil {
+		fields = append(fields, gachareceipt.FieldCreatedAt)
+	}
+	if m.revealed_at != nil {
+		fields = append(fields, gachareceipt.FieldRevealedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GachaReceiptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		return m.ReceiptID()
+	case gachareceipt.FieldOpener:
+		return m.Opener()
+	case gachareceipt.FieldCommitBlock:
+		return m.CommitBlock()
+	case gachareceipt.FieldOpenTxID:
+		return m.OpenTxID()
+	case gachareceipt.FieldOpenBlock:
+		return m.OpenBlock()
+	case gachareceipt.FieldRevealBlock:
+		return m.RevealBlock()
+	case gachareceipt.FieldRevealTxID:
+		return m.RevealTxID()
+	case gachareceipt.FieldRarity:
+		return m.Rarity()
+	case gachareceipt.FieldCreatedAt:
+		return m.CreatedAt()
+	case gachareceipt.FieldRevealedAt:
+		return m.RevealedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GachaReceiptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		return m.OldReceiptID(ctx)
+	case gachareceipt.FieldOpener:
+		return m.OldOpener(ctx)
+	case gachareceipt.FieldCommitBlock:
+		return m.OldCommitBlock(ctx)
+	case gachareceipt.FieldOpenTxID:
+		return m.OldOpenTxID(ctx)
+	case gachareceipt.FieldOpenBlock:
+		return m.OldOpenBlock(ctx)
+	case gachareceipt.FieldRevealBlock:
+		return m.OldRevealBlock(ctx)
+	case gachareceipt.FieldRevealTxID:
+		return m.OldRevealTxID(ctx)
+	case gachareceipt.FieldRarity:
+		return m.OldRarity(ctx)
+	case gachareceipt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case gachareceipt.FieldRevealedAt:
+		return m.OldRevealedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown GachaReceipt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GachaReceiptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiptID(v)
+		return nil
+	case gachareceipt.FieldOpener:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpener(v)
+		return nil
+	case gachareceipt.FieldCommitBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommitBlock(v)
+		return nil
+	case gachareceipt.FieldOpenTxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenTxID(v)
+		return nil
+	case gachareceipt.FieldOpenBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenBlock(v)
+		return nil
+	case gachareceipt.FieldRevealBlock:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevealBlock(v)
+		return nil
+	case gachareceipt.FieldRevealTxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevealTxID(v)
+		return nil
+	case gachareceipt.FieldRarity:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRarity(v)
+		return nil
+	case gachareceipt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case gachareceipt.FieldRevealedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevealedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GachaReceiptMutation) AddedFields() []string {
+	var fields []string
+	if m.addreceipt_id != nil {
+		fields = append(fields, gachareceipt.FieldReceiptID)
+	}
+	if m.addcommit_block != nil {
+		fields = append(fields, gachareceipt.FieldCommitBlock)
+	}
+	if m.addopen_block != nil {
+		fields = append(fields, gachareceipt.FieldOpenBlock)
+	}
+	if m.addreveal_block != nil {
+		fields = append(fields, gachareceipt.FieldRevealBlock)
+	}
+	if m.addrarity != nil {
+		fields = append(fields, gachareceipt.FieldRarity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GachaReceiptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		return m.AddedReceiptID()
+	case gachareceipt.FieldCommitBlock:
+		return m.AddedCommitBlock()
+	case gachareceipt.FieldOpenBlock:
+		return m.AddedOpenBlock()
+	case gachareceipt.FieldRevealBlock:
+		return m.AddedRevealBlock()
+	case gachareceipt.FieldRarity:
+		return m.AddedRarity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GachaReceiptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReceiptID(v)
+		return nil
+	case gachareceipt.FieldCommitBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCommitBlock(v)
+		return nil
+	case gachareceipt.FieldOpenBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOpenBlock(v)
+		return nil
+	case gachareceipt.FieldRevealBlock:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevealBlock(v)
+		return nil
+	case gachareceipt.FieldRarity:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRarity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GachaReceiptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(gachareceipt.FieldOpener) {
+		fields = append(fields, gachareceipt.FieldOpener)
+	}
+	if m.FieldCleared(gachareceipt.FieldOpenBlock) {
+		fields = append(fields, gachareceipt.FieldOpenBlock)
+	}
+	if m.FieldCleared(gachareceipt.FieldRevealBlock) {
+		fields = append(fields, gachareceipt.FieldRevealBlock)
+	}
+	if m.FieldCleared(gachareceipt.FieldRevealTxID) {
+		fields = append(fields, gachareceipt.FieldRevealTxID)
+	}
+	if m.FieldCleared(gachareceipt.FieldRarity) {
+		fields = append(fields, gachareceipt.FieldRarity)
+	}
+	if m.FieldCleared(gachareceipt.FieldRevealedAt) {
+		fields = append(fields, gachareceipt.FieldRevealedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GachaReceiptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GachaReceiptMutation) ClearField(name string) error {
+	switch name {
+	case gachareceipt.FieldOpener:
+		m.ClearOpener()
+		return nil
+	case gachareceipt.FieldOpenBlock:
+		m.ClearOpenBlock()
+		return nil
+	case gachareceipt.FieldRevealBlock:
+		m.ClearRevealBlock()
+		return nil
+	case gachareceipt.FieldRevealTxID:
+		m.ClearRevealTxID()
+		return nil
+	case gachareceipt.FieldRarity:
+		m.ClearRarity()
+		return nil
+	case gachareceipt.FieldRevealedAt:
+		m.ClearRevealedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GachaReceiptMutation) ResetField(name string) error {
+	switch name {
+	case gachareceipt.FieldReceiptID:
+		m.ResetReceiptID()
+		return nil
+	case gachareceipt.FieldOpener:
+		m.ResetOpener()
+		return nil
+	case gachareceipt.FieldCommitBlock:
+		m.ResetCommitBlock()
+		return nil
+	case gachareceipt.FieldOpenTxID:
+		m.ResetOpenTxID()
+		return nil
+	case gachareceipt.FieldOpenBlock:
+		m.ResetOpenBlock()
+		return nil
+	case gachareceipt.FieldRevealBlock:
+		m.ResetRevealBlock()
+		return nil
+	case gachareceipt.FieldRevealTxID:
+		m.ResetRevealTxID()
+		return nil
+	case gachareceipt.FieldRarity:
+		m.ResetRarity()
+		return nil
+	case gachareceipt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case gachareceipt.FieldRevealedAt:
+		m.ResetRevealedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GachaReceiptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.accessory != nil {
+		edges = append(edges, gachareceipt.EdgeAccessory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GachaReceiptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case gachareceipt.EdgeAccessory:
+		if id := m.accessory; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GachaReceiptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GachaReceiptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GachaReceiptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccessory {
+		edges = append(edges, gachareceipt.EdgeAccessory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GachaReceiptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case gachareceipt.EdgeAccessory:
+		return m.clearedaccessory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GachaReceiptMutation) ClearEdge(name string) error {
+	switch name {
+	case gachareceipt.EdgeAccessory:
+		m.ClearAccessory()
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GachaReceiptMutation) ResetEdge(name string) error {
+	switch name {
+	case gachareceipt.EdgeAccessory:
+		m.ResetAccessory()
+		return nil
+	}
+	return fmt.Errorf("unknown GachaReceipt edge %s", name)
+}
+
+// ListingMutation represents an operation that mutates the Listing nodes in the graph.
+type ListingMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	listing_id           *uint64
+	addlisting_id        *int64
+	price                *float64
+	addprice             *float64
+	payment_vault_type   *string
+	custom_id            *string
+	expiry               *time.Time
+	status               *listing.Status
+	nft_type             *listing.NftType
+	clearedFields        map[string]struct{}
+	seller               *int
+	clearedseller        bool
+	nft_accessory        *int
+	clearednft_accessory bool
+	nft_moment           *int
+	clearednft_moment    bool
+	sale                 *int
+	clearedsale          bool
+	done                 bool
+	oldValue             func(context.Context) (*Listing, error)
+	predicates           []predicate.Listing
+}
+
+var _ ent.Mutation = (*ListingMutation)(nil)
+
+// listingOption allows management of the mutation configuration using functional options.
+type listingOption func(*ListingMutation)
+
+// newListingMutation creates new mutation for the Listing entity.
+func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
+	m := &ListingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListing,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingID sets the ID field of the mutation.
+func withListingID(id int) listingOption {
+	return func(m *ListingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Listing
+		)
+		m.oldValue = func(ctx context.Context) (*Listing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Listing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListing sets the old Listing of the mutation.
+func withListing(node *Listing) listingOption {
+	return func(m *ListingMutation) {
+		m.oldValue = func(context.Context) (*Listing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingMutation) SetListingID(u uint64) {
+	m.listing_id = &u
+	m.addlisting_id = nil
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingMutation) ListingID() (r uint64, exists bool) {
+	v := m.listing_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldListingID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// AddListingID adds u to the "listing_id" field.
+func (m *ListingMutation) AddListingID(u int64) {
+	if m.addlisting_id != nil {
+		*m.addlisting_id += u
+	} else {
+		m.addlisting_id = &u
+	}
+}
+
+// AddedListingID returns the value that was added to the "listing_id" field in this mutation.
+func (m *ListingMutation) AddedListingID() (r int64, exists bool) {
+	v := m.addlisting_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingMutation) ResetListingID() {
+	m.listing_id = nil
+	m.addlisting_id = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *ListingMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ListingMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *ListingMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ListingMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ListingMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (m *ListingMutation) SetPaymentVaultType(s string) {
+	m.payment_vault_type = &s
+}
+
+// PaymentVaultType returns the value of the "payment_vault_type" field in the mutation.
+func (m *ListingMutation) PaymentVaultType() (r string, exists bool) {
+	v := m.payment_vault_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentVaultType returns the old "payment_vault_type" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPaymentVaultType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentVaultType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentVaultType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentVaultType: %w", err)
+	}
+	return oldValue.PaymentVaultType, nil
+}
+
+// ResetPaymentVaultType resets all changes to the "payment_vault_type" field.
+func (m *ListingMutation) ResetPaymentVaultType() {
+	m.payment_vault_type = nil
+}
+
+// SetCustomID sets the "custom_id" field.
+func (m *ListingMutation) SetCustomID(s string) {
+	m.custom_id = &s
+}
+
+// CustomID returns the value of the "custom_id" field in the mutation.
+func (m *ListingMutation) CustomID() (r string, exists bool) {
+	v := m.custom_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCustomID returns the old "custom_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCustomID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCustomID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCustomID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCustomID: %w", err)
+	}
+	return oldValue.CustomID, nil
+}
+
+// ClearCustomID clears the value of the "custom_id" field.
+func (m *ListingMutation) ClearCustomID() {
+	m.custom_id = nil
+	m.clearedFields[listing.FieldCustomID] = struct{}{}
+}
+
+// CustomIDCleared returns if the "custom_id" field was cleared in this mutation.
+func (m *ListingMutation) CustomIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldCustomID]
+	return ok
+}
+
+// ResetCustomID resets all changes to the "custom_id" field.
+func (m *ListingMutation) ResetCustomID() {
+	m.custom_id = nil
+	delete(m.clearedFields, listing.FieldCustomID)
+}
+
+// SetExpiry sets the "expiry" field.
+func (m *ListingMutation) SetExpiry(t time.Time) {
+	m.expiry = &t
+}
+
+// Expiry returns the value of the "expiry" field in the mutation.
+func (m *ListingMutation) Expiry() (r time.Time, exists bool) {
+	v := m.expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiry returns the old "expiry" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+	}
+	return oldValue.Expiry, nil
+}
+
+// ResetExpiry resets all changes to the "expiry" field.
+func (m *ListingMutation) ResetExpiry() {
+	m.expiry = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ListingMutation) SetStatus(l listing.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ListingMutation) Status() (r listing.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldStatus(ctx context.Context) (v listing.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ListingMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetNftType sets the "nft_type" field.
+func (m *ListingMutation) SetNftType(lt listing.NftType) {
+	m.nft_type = &lt
+}
+
+// NftType returns the value of the "nft_type" field in the mutation.
+func (m *ListingMutation) NftType() (r listing.NftType, exists bool) {
+	v := m.nft_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNftType returns the old "nft_type" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldNftType(ctx context.Context) (v listing.NftType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNftType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNftType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNftType: %w", err)
+	}
+	return oldValue.NftType, nil
+}
+
+// ResetNftType resets all changes to the "nft_type" field.
+func (m *ListingMutation) ResetNftType() {
+	m.nft_type = nil
+}
+
+// SetSellerID sets the "seller" edge to the User entity by id.
+func (m *ListingMutation) SetSellerID(id int) {
+	m.seller = &id
+}
+
+// ClearSeller clears the "seller" edge to the User entity.
+func (m *ListingMutation) ClearSeller() {
+	m.clearedseller = true
+}
+
+// SellerCleared reports if the "seller" edge to the User entity was cleared.
+func (m *ListingMutation) SellerCleared() bool {
+	return m.clearedseller
+}
+
+// SellerID returns the "seller" edge ID in the mutation.
+func (m *ListingMutation) SellerID() (id int, exists bool) {
+	if m.seller != nil {
+		return *m.seller, true
+	}
+	return
+}
+
+// SellerIDs returns the "seller" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SellerID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) SellerIDs() (ids []int) {
+	if id := m.seller; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeller resets all changes to the "seller" edge.
+func (m *ListingMutation) ResetSeller() {
+	m.seller = nil
+	m.clearedseller = false
+}
+
+// SetNftAccessoryID sets the "nft_accessory" edge to the NFTAccessory entity by id.
+func (m *ListingMutation) SetNftAccessoryID(id int) {
+	m.nft_accessory = &id
+}
+
+// ClearNftAccessory clears the "nft_accessory" edge to the NFTAccessory entity.
+func (m *ListingMutation) ClearNftAccessory() {
+	m.clearednft_accessory = true
+}
+
+// NftAccessoryCleared reports if the "nft_accessory" edge to the NFTAccessory entity was cleared.
+func (m *ListingMutation) NftAccessoryCleared() bool {
+	return m.clearednft_accessory
+}
+
+// NftAccessoryID returns the "nft_accessory" edge ID in the mutation.
+func (m *ListingMutation) NftAccessoryID() (id int, exists bool) {
+	if m.nft_accessory != nil {
+		return *m.nft_accessory, true
+	}
+	return
+}
+
+// NftAccessoryIDs returns the "nft_accessory" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NftAccessoryID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) NftAccessoryIDs() (ids []int) {
+	if id := m.nft_accessory; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNftAccessory resets all changes to the "nft_accessory" edge.
+func (m *ListingMutation) ResetNftAccessory() {
+	m.nft_accessory = nil
+	m.clearednft_accessory = false
+}
+
+// SetNftMomentID sets the "nft_moment" edge to the NFTMoment entity by id.
+func (m *ListingMutation) SetNftMomentID(id int) {
+	m.nft_moment = &id
+}
+
+// ClearNftMoment clears the "nft_moment" edge to the NFTMoment entity.
+func (m *ListingMutation) ClearNftMoment() {
+	m.clearednft_moment = true
+}
+
+// NftMomentCleared reports if the "nft_moment" edge to the NFTMoment entity was cleared.
+func (m *ListingMutation) NftMomentCleared() bool {
+	return m.clearednft_moment
+}
+
+// NftMomentID returns the "nft_moment" edge ID in the mutation.
+func (m *ListingMutation) NftMomentID() (id int, exists bool) {
+	if m.nft_moment != nil {
+		return *m.nft_moment, true
+	}
+	return
+}
+
+// NftMomentIDs returns the "nft_moment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NftMomentID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) NftMomentIDs() (ids []int) {
+	if id := m.nft_moment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNftMoment resets all changes to the "nft_moment" edge.
+func (m *ListingMutation) ResetNftMoment() {
+	m.nft_moment = nil
+	m.clearednft_moment = false
+}
+
+// SetSaleID sets the "sale" edge to the Sale entity by id.
+func (m *ListingMutation) SetSaleID(id int) {
+	m.sale = &id
+}
+
+// ClearSale clears the "sale" edge to the Sale entity.
+func (m *ListingMutation) ClearSale() {
+	m.clearedsale = true
+}
+
+// SaleCleared reports if the "sale" edge to the Sale entity was cleared.
+func (m *ListingMutation) SaleCleared() bool {
+	return m.clearedsale
+}
+
+// SaleID returns the "sale" edge ID in the mutation.
+func (m *ListingMutation) SaleID() (id int, exists bool) {
+	if m.sale != nil {
+		return *m.sale, true
+	}
+	return
+}
+
+// SaleIDs returns the "sale" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SaleID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) SaleIDs() (ids []int) {
+	if id := m.sale; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSale resets all changes to the "sale" edge.
+func (m *ListingMutation) ResetSale() {
+	m.sale = nil
+	m.clearedsale = false
+}
+
+// Where appends a list predicates to the ListingMutation builder.
+func (m *ListingMutation) Where(ps ...predicate.Listing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Listing, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Listing).
+func (m *ListingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.listing_id != nil {
+		fields = append(fields, listing.FieldListingID)
+	}
+	if m.price != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.payment_vault_type != nil {
+		fields = append(fields, listing.FieldPaymentVaultType)
+	}
+	if m.custom_id != nil {
+		fields = append(fields, listing.FieldCustomID)
+	}
+	if m.expiry != nil {
+		fields = append(fields, listing.FieldExpiry)
+	}
+	if m.status != nil {
+		fields = append(fields, listing.FieldStatus)
+	}
+	if m.nft_type != nil {
+		fields = append(fields, listing.FieldNftType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldListingID:
+		return m.ListingID()
+	case listing.FieldPrice:
+		return m.Price()
+	case listing.FieldPaymentVaultType:
+		return m.PaymentVaultType()
+	case listing.FieldCustomID:
+		return m.CustomID()
+	case listing.FieldExpiry:
+		return m.Expiry()
+	case listing.FieldStatus:
+		return m.Status()
+	case listing.FieldNftType:
+		return m.NftType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listing.FieldListingID:
+		return m.OldListingID(ctx)
+	case listing.FieldPrice:
+		return m.OldPrice(ctx)
+	case listing.FieldPaymentVaultType:
+		return m.OldPaymentVaultType(ctx)
+	case listing.FieldCustomID:
+		return m.OldCustomID(ctx)
+	case listing.FieldExpiry:
+		return m.OldExpiry(ctx)
+	case listing.FieldStatus:
+		return m.OldStatus(ctx)
+	case listing.FieldNftType:
+		return m.OldNftType(ctx)
+	}
+	return nil, fmt.Errorf("unknown Listing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldListingID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case listing.FieldPaymentVaultType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentVaultType(v)
+		return nil
+	case listing.FieldCustomID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCustomID(v)
+		return nil
+	case listing.FieldExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiry(v)
+		return nil
+	case listing.FieldStatus:
+		v, ok := value.(listing.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case listing.FieldNftType:
+		v, ok := value.(listing.NftType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNftType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingMutation) AddedFields() []string {
+	var fields []string
+	if m.addlisting_id != nil {
+		fields = append(fields, listing.FieldListingID)
+	}
+	if m.addprice != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldListingID:
+		return m.AddedListingID()
+	case listing.FieldPrice:
+		return m.AddedPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldListingID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddListingID(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listing.FieldCustomID) {
+		fields = append(fields, listing.FieldCustomID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingMutation) ClearField(name string) error {
+	switch name {
+	case listing.FieldCustomID:
+		m.ClearCustomID()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingMutation) ResetField(name string) error {
+	switch name {
+	case listing.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listing.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case listing.FieldPaymentVaultType:
+		m.ResetPaymentVaultType()
+		return nil
+	case listing.FieldCustomID:
+		m.ResetCustomID()
+		return nil
+	case listing.FieldExpiry:
+		m.ResetExpiry()
+		return nil
+	case listing.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case listing.FieldNftType:
+		m.ResetNftType()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.seller != nil {
+		edges = append(edges, listing.EdgeSeller)
+	}
+	if m.nft_accessory != nil {
+		edges = append(edges, listing.EdgeNftAccessory)
+	}
+	if m.nft_moment != nil {
+		edges = append(edges, listing.EdgeNftMoment)
+	}
+	if m.sale != nil {
+		edges = append(edges, listing.EdgeSale)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listing.EdgeSeller:
+		if id := m.seller; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeNftAccessory:
+		if id := m.nft_accessory; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeNftMoment:
+		if id := m.nft_moment; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeSale:
+		if id := m.sale; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedseller {
+		edges = append(edges, listing.EdgeSeller)
+	}
+	if m.clearednft_accessory {
+		edges = append(edges, listing.EdgeNftAccessory)
+	}
+	if m.clearednft_moment {
+		edges = append(edges, listing.EdgeNftMoment)
+	}
+	if m.clearedsale {
+		edges = append(edges, listing.EdgeSale)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listing.EdgeSeller:
+		return m.clearedseller
+	case listing.EdgeNftAccessory:
+		return m.clearednft_accessory
+	case listing.EdgeNftMoment:
+		return m.clearednft_moment
+	case listing.EdgeSale:
+		return m.clearedsale
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingMutation) ClearEdge(name string) error {
+	switch name {
+	case listing.EdgeSeller:
+		m.ClearSeller()
+		return nil
+	case listing.EdgeNftAccessory:
+		m.ClearNftAccessory()
+		return nil
+	case listing.EdgeNftMoment:
+		m.ClearNftMoment()
+		return nil
+	case listing.EdgeSale:
+		m.ClearSale()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingMutation) ResetEdge(name string) error {
+	switch name {
+	case listing.EdgeSeller:
+		m.ResetSeller()
+		return nil
+	case listing.EdgeNftAccessory:
+		m.ResetNftAccessory()
+		return nil
+	case listing.EdgeNftMoment:
+		m.ResetNftMoment()
+		return nil
+	case listing.EdgeSale:
+		m.ResetSale()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing edge %s", name)
+}
+
+// NFTAccessoryMutation represents an operation that mutates the NFTAccessory nodes in the graph.
+type NFTAccessoryMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	nft_id                    *uint64
+	addnft_id                 *int64
+	name                      *string
+	description               *string
+	thumbnail                 *string
+	equipment_type            *string
+	rarity_score              *float64
+	addrarity_score           *float64
+	rarity_max                *float64
+	addrarity_max             *float64
+	rarity_description        *string
+	clearedFields             map[string]struct{}
+	owner                     *int
+	clearedowner              bool
+	equipped_on_moment        *int
+	clearedequipped_on_moment bool
+	listings                  map[int]struct{}
+	removedlistings           map[int]struct{}
+	clearedlistings           bool
+	gacha_receipt             *int
+	clearedgacha_receipt      bool
+	done                      bool
+	oldValue                  func(context.Context) (*NFTAccessory, error)
+	predicates                []predicate.NFTAccessory
+}
+
+var _ ent.Mutation = (*NFTAccessoryMutation)(nil)
+
+// nftaccessoryOption allows management of the mutation configuration using functional options.
+type nftaccessoryOption func(*NFTAccessoryMutation)
+
+// newNFTAccessoryMutation creates new mutation for the NFTAccessory entity.
+func newNFTAccessoryMutation(c config, op Op, opts ...nftaccessoryOption) *NFTAccessoryMutation {
+	m := &NFTAccessoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNFTAccessory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNFTAccessoryID sets the ID field of the mutation.
+func withNFTAccessoryID(id int) nftaccessoryOption {
+	return func(m *NFTAccessoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NFTAccessory
+		)
+		m.oldValue = func(ctx context.Context) (*NFTAccessory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NFTAccessory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNFTAccessory sets the old NFTAccessory of the mutation.
+func withNFTAccessory(node *NFTAccessory) nftaccessoryOption {
+	return func(m *NFTAccessoryMutation) {
+		m.oldValue = func(context.Context) (*NFTAccessory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NFTAccessoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NFTAccessoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NFTAccessoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NFTAccessoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NFTAccessory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNftID sets the "nft_id" field.
+func (m *NFTAccessoryMutation) SetNftID(u uint64) {
+	m.nft_id = &u
+	m.addnft_id = nil
+}
+
+// NftID returns the value of the "nft_id" field in the mutation.
+func (m *NFTAccessoryMutation) NftID() (r uint64, exists bool) {
+	v := m.nft_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNftID returns the old "nft_id" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldNftID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNftID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNftID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNftID: %w", err)
+	}
+	return oldValue.NftID, nil
+}
+
+// AddNftID adds u to the "nft_id" field.
+func (m *NFTAccessoryMutation) AddNftID(u int64) {
+	if m.addnft_id != nil {
+		*m.addnft_id += u
+	} else {
+		m.addnft_id = &u
+	}
+}
+
+// AddedNftID returns the value that was added to the "nft_id" field in this mutation.
+func (m *NFTAccessoryMutation) AddedNftID() (r int64, exists bool) {
+	v := m.addnft_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNftID resets all changes to the "nft_id" field.
+func (m *NFTAccessoryMutation) ResetNftID() {
+	m.nft_id = nil
+	m.addnft_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *NFTAccessoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NFTAccessoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NFTAccessoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NFTAccessoryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NFTAccessoryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NFTAccessoryMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (m *NFTAccessoryMutation) SetThumbnail(s string) {
+	m.thumbnail = &s
+}
+
+// Thumbnail returns the value of the "thumbnail" field in the mutation.
+func (m *NFTAccessoryMutation) Thumbnail() (r string, exists bool) {
+	v := m.thumbnail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnail returns the old "thumbnail" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+	}
+	return oldValue.Thumbnail, nil
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" field.
+func (m *NFTAccessoryMutation) ResetThumbnail() {
+	m.thumbnail = nil
+}
+
+// SetEquipmentType sets the "equipment_type" field.
+func (m *NFTAccessoryMutation) SetEquipmentType(s string) {
+	m.equipment_type = &s
+}
+
+// EquipmentType returns the value of the "equipment_type" field in the mutation.
+func (m *NFTAccessoryMutation) EquipmentType() (r string, exists bool) {
+	v := m.equipment_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEquipmentType returns the old "equipment_type" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldEquipmentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEquipmentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEquipmentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEquipmentType: %w", err)
+	}
+	return oldValue.EquipmentType, nil
+}
+
+// ResetEquipmentType resets all changes to the "equipment_type" field.
+func (m *NFTAccessoryMutation) ResetEquipmentType() {
+	m.equipment_type = nil
+}
+
+// SetRarityScore sets the "rarity_score" field.
+func (m *NFTAccessoryMutation) SetRarityScore(f float64) {
+	m.rarity_score = &f
+	m.addrarity_score = nil
+}
+
+// RarityScore returns the value of the "rarity_score" field in the mutation.
+func (m *NFTAccessoryMutation) RarityScore() (r float64, exists bool) {
+	v := m.rarity_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRarityScore returns the old "rarity_score" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldRarityScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRarityScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRarityScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRarityScore: %w", err)
+	}
+	return oldValue.RarityScore, nil
+}
+
+// AddRarityScore adds f to the "rarity_score" field.
+func (m *NFTAccessoryMutation) AddRarityScore(f float64) {
+	if m.addrarity_score != nil {
+		*m.addrarity_score += f
+	} else {
+		m.addrarity_score = &f
+	}
+}
+
+// AddedRarityScore returns the value that was added to the "rarity_score" field in this mutation.
+func (m *NFTAccessoryMutation) AddedRarityScore() (r float64, exists bool) {
+	v := m.addrarity_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (m *NFTAccessoryMutation) ClearRarityScore() {
+	m.rarity_score = nil
+	m.addrarity_score = nil
+	m.clearedFields[nftaccessory.FieldRarityScore] = struct{}{}
+}
+
+// RarityScoreCleared returns if the "rarity_score" field was cleared in this mutation.
+func (m *NFTAccessoryMutation) RarityScoreCleared() bool {
+	_, ok := m.clearedFields[nftaccessory.FieldRarityScore]
+	return ok
+}
+
+// ResetRarityScore resets all changes to the "rarity_score" field.
+func (m *NFTAccessoryMutation) ResetRarityScore() {
+	m.rarity_score = nil
+	m.addrarity_score = nil
+	delete(m.clearedFields, nftaccessory.FieldRarityScore)
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (m *NFTAccessoryMutation) SetRarityMax(f float64) {
+	m.rarity_max = &f
+	m.addrarity_max = nil
+}
+
+// RarityMax returns the value of the "rarity_max" field in the mutation.
+func (m *NFTAccessoryMutation) RarityMax() (r float64, exists bool) {
+	v := m.rarity_max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRarityMax returns the old "rarity_max" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldRarityMax(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRarityMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRarityMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRarityMax: %w", err)
+	}
+	return oldValue.RarityMax, nil
+}
+
+// AddRarityMax adds f to the "rarity_max" field.
+func (m *NFTAccessoryMutation) AddRarityMax(f float64) {
+	if m.addrarity_max != nil {
+		*m.addrarity_max += f
+	} else {
+		m.addrarity_max = &f
+	}
+}
+
+// AddedRarityMax returns the value that was added to the "rarity_max" field in this mutation.
+func (m *NFTAccessoryMutation) AddedRarityMax() (r float64, exists bool) {
+	v := m.addrarity_max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (m *NFTAccessoryMutation) ClearRarityMax() {
+	m.rarity_max = nil
+	m.addrarity_max = nil
+	m.clearedFields[nftaccessory.FieldRarityMax] = struct{}{}
+}
+
+// RarityMaxCleared returns if the "rarity_max" field was cleared in this mutation.
+func (m *NFTAccessoryMutation) RarityMaxCleared() bool {
+	_, ok := m.clearedFields[nftaccessory.FieldRarityMax]
+	return ok
+}
+
+// ResetRarityMax resets all changes to the "rarity_max" field.
+func (m *NFTAccessoryMutation) ResetRarityMax() {
+	m.rarity_max = nil
+	m.addrarity_max = nil
+	delete(m.clearedFields, nftaccessory.FieldRarityMax)
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (m *NFTAccessoryMutation) SetRarityDescription(s string) {
+	m.rarity_description = &s
+}
+
+// RarityDescription returns the value of the "rarity_description" field in the mutation.
+func (m *NFTAccessoryMutation) RarityDescription() (r string, exists bool) {
+	v := m.rarity_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRarityDescription returns the old "rarity_description" field's value of the NFTAccessory entity.
+// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTAccessoryMutation) OldRarityDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRarityDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRarityDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRarityDescription: %w", err)
+	}
+	return oldValue.RarityDescription, nil
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (m *NFTAccessoryMutation) ClearRarityDescription() {
+	m.rarity_description = nil
+	m.clearedFields[nftaccessory.FieldRarityDescription] = struct{}{}
+}
+
+// RarityDescriptionCleared returns if the "rarity_description" field was cleared in this mutation.
+func (m *NFTAccessoryMutation) RarityDescriptionCleared() bool {
+	_, ok := m.clearedFields[nftaccessory.FieldRarityDescription]
+	return ok
+}
+
+// ResetRarityDescription resets all changes to the "rarity_description" field.
+func (m *NFTAccessoryMutation) ResetRarityDescription() {
+	m.rarity_description = nil
+	delete(m.clearedFields, nftaccessory.FieldRarityDescription)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *NFTAccessoryMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *NFTAccessoryMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *NFTAccessoryMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *NFTAccessoryMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *NFTAccessoryMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *NFTAccessoryMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetEquippedOnMomentID sets the "equipped_on_moment" edge to the NFTMoment entity by id.
+func (m *NFTAccessoryMutation) SetEquippedOnMomentID(id int) {
+	m.equipped_on_moment = &id
+}
+
+// ClearEquippedOnMoment clears the "equipped_on_moment" edge to the NFTMoment entity.
+func (m *NFTAccessoryMutation) ClearEquippedOnMoment() {
+	m.clearedequipped_on_moment = true
+}
+
+// EquippedOnMomentCleared reports if the "equipped_on_moment" edge to the NFTMoment entity was cleared.
+func (m *NFTAccessoryMutation) EquippedOnMomentCleared() bool {
+	return m.clearedequipped_on_moment
+}
+
+// EquippedOnMomentID returns the "equipped_on_moment" edge ID in the mutation.
+func (m *NFTAccessoryMutation) EquippedOnMomentID() (id int, exists bool) {
+	if m.equipped_on_moment != nil {
+		return *m.equipped_on_moment, true
+	}
+	return
+}
+
+// EquippedOnMomentIDs returns the "equipped_on_moment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EquippedOnMomentID instead. It exists only for internal usage by the builders.
+func (m *NFTAccessoryMutation) EquippedOnMomentIDs() (ids []int) {
+	if id := m.equipped_on_moment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEquippedOnMoment resets all changes to the "equipped_on_moment" edge.
+func (m *NFTAccessoryMutation) ResetEquippedOnMoment() {
+	m.equipped_on_moment = nil
+	m.clearedequipped_on_moment = false
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *NFTAccessoryMutation) AddListingIDs(ids ...int) {
+	if m.listings == nil {
+		m.listings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.listings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *NFTAccessoryMutation) ClearListings() {
+	m.clearedlistings = true
+}
+
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *NFTAccessoryMutation) ListingsCleared() bool {
+	return m.clearedlistings
+}
+
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *NFTAccessoryMutation) RemoveListingIDs(ids ...int) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *NFTAccessoryMutation) RemovedListingsIDs() (ids []int) {
+	for id := range m.removedlistings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *NFTAccessoryMutation) ListingsIDs() (ids []int) {
+	for id := range m.listings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetListings resets all changes to the "listings" edge.
+func (m *NFTAccessoryMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
+}
+
+// SetGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by id.
+func (m *NFTAccessoryMutation) SetGachaReceiptID(id int) {
+	m.gacha_receipt = &id
+}
+
+// ClearGachaReceipt clears the "gacha_receipt" edge to the GachaReceipt entity.
+func (m *NFTAccessoryMutation) ClearGachaReceipt() {
+	m.clearedgacha_receipt = true
+}
+
+// GachaReceiptCleared reports if the "gacha_receipt" edge to the GachaReceipt entity was cleared.
+func (m *NFTAccessoryMutation) GachaReceiptCleared() bool {
+	return m.clearedgacha_receipt
+}
+
+// GachaReceiptID returns the "gacha_receipt" edge ID in the mutation.
+func (m *NFTAccessoryMutation) GachaReceiptID() (id int, exists bool) {
+	if m.gacha_receipt != nil {
+		return *m.gacha_receipt, true
+	}
+	return
+}
+
+// GachaReceiptIDs returns the "gacha_receipt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GachaReceiptID instead. It exists only for internal usage by the builders.
+func (m *NFTAccessoryMutation) GachaReceiptIDs() (ids []int) {
+	if id := m.gacha_receipt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGachaReceipt resets all changes to the "gacha_receipt" edge.
+func (m *NFTAccessoryMutation) ResetGachaReceipt() {
+	m.gacha_receipt = nil
+	m.clearedgacha_receipt = false
+}
+
+// Where appends a list predicates to the NFTAccessoryMutation builder.
+func (m *NFTAccessoryMutation) Where(ps ...predicate.NFTAccessory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NFTAccessoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NFTAccessoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NFTAccessory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NFTAccessoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NFTAccessoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NFTAccessory).
+func (m *NFTAccessoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NFTAccessoryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.nft_id != nil {
+		fields = append(fields, nftaccessory.FieldNftID)
+	}
+	if m.name != nil {
+		fields = append(fields, nftaccessory.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, nftaccessory.FieldDescription)
+	}
+	if m.thumbnail != nil {
+		fields = append(fields, nftaccessory.FieldThumbnail)
+	}
+	if m.equipment_type != nil {
+		fields = append(fields, nftaccessory.FieldEquipmentType)
+	}
+	if m.rarity_score != nil {
+		fields = append(fields, nftaccessory.FieldRarityScore)
+	}
+	if m.rarity_max != nil {
+		fields = append(fields, nftaccessory.FieldRarityMax)
+	}
+	if m.rarity_description != nil {
+		fields = append(fields, nftaccessory.FieldRarityDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NFTAccessoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nftaccessory.FieldNftID:
+		return m.NftID()
+	case nftaccessory.FieldName:
+		return m.Name()
+	case nftaccessory.FieldDescription:
+		return m.Description()
+	case nftaccessory.FieldThumbnail:
+		return m.Thumbnail()
+	case nftaccessory.FieldEquipmentType:
+		return m.EquipmentType()
+	case nftaccessory.FieldRarityScore:
+		return m.RarityScore()
+	case nftaccessory.FieldRarityMax:
+		return m.RarityMax()
+	case nftaccessory.FieldRarityDescription:
+		return m.RarityDescription()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NFTAccessoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nftaccessory.FieldNftID:
+		return m.OldNftID(ctx)
+	case nftaccessory.FieldName:
+		return m.OldName(ctx)
+	case nftaccessory.FieldDescription:
+		return m.OldDescription(ctx)
+	case nftaccessory.FieldThumbnail:
+		return m.OldThumbnail(ctx)
+	case nftaccessory.FieldEquipmentType:
+		return m.OldEquipmentType(ctx)
+	case nftaccessory.FieldRarityScore:
+		return m.OldRarityScore(ctx)
+	case nftaccessory.FieldRarityMax:
+		return m.OldRarityMax(ctx)
+	case nftaccessory.FieldRarityDescription:
+		return m.OldRarityDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown NFTAccessory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NFTAccessoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nftaccessory.FieldNftID:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNftID(v)
+		return nil
+	case nftaccessory.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case nftaccessory.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case nftaccessory.FieldThumbnail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnail(v)
+		return nil
+	case nftaccessory.FieldEquipmentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEquipmentType(v)
+		return nil
+	case nftaccessory.FieldRarityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRarityScore(v)
+		return nil
+	case nftaccessory.FieldRarityMax:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRarityMax(v)
+		return nil
+	case nftaccessory.FieldRarityDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRarityDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NFTAccessoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addnft_id != nil {
+		fields = append(fields, nftaccessory.FieldNftID)
+	}
+	if m.addrarity_score != nil {
+		fields = append(fields, nftaccessory.FieldRarityScore)
+	}
+	if m.addrarity_max != nil {
+		fields = append(fields, nftaccessory.FieldRarityMax)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NFTAccessoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nftaccessory.FieldNftID:
+		return m.AddedNftID()
+	case nftaccessory.FieldRarityScore:
+		return m.AddedRarityScore()
+	case nftaccessory.FieldRarityMax:
+		return m.AddedRarityMax()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NFTAccessoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nftaccessory.FieldNftID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNftID(v)
+		return nil
+	case nftaccessory.FieldRarityScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRarityScore(v)
+		return nil
+	case nftaccessory.FieldRarityMax:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRarityMax(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NFTAccessoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nftaccessory.FieldRarityScore) {
+		fields = append(fields, nftaccessory.FieldRarityScore)
+	}
+	if m.FieldCleared(nftaccessory.FieldRarityMax) {
+		fields = append(fields, nftaccessory.FieldRarityMax)
+	}
+	if m.FieldCleared(nftaccessory.FieldRarityDescription) {
+		fields = append(fields, nftaccessory.FieldRarityDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NFTAccessoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NFTAccessoryMutation) ClearField(name string) error {
+	switch name {
+	case nftaccessory.FieldRarityScore:
+		m.ClearRarityScore()
+		return nil
+	case nftaccessory.FieldRarityMax:
+		m.ClearRarityMax()
+		return nil
+	case nftaccessory.FieldRarityDescription:
+		m.ClearRarityDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NFTAccessoryMutation) ResetField(name string) error {
+	switch name {
+	case nftaccessory.FieldNftID:
+		m.ResetNftID()
+		return nil
+	case nftaccessory.FieldName:
+		m.ResetName()
+		return nil
+	case nftaccessory.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case nftaccessory.FieldThumbnail:
+		m.ResetThumbnail()
+		return nil
+	case nftaccessory.FieldEquipmentType:
+		m.ResetEquipmentType()
+		return nil
+	case nftaccessory.FieldRarityScore:
+		m.ResetRarityScore()
+		return nil
+	case nftaccessory.FieldRarityMax:
+		m.ResetRarityMax()
+		return nil
+	case nftaccessory.FieldRarityDescription:
+		m.ResetRarityDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NFTAccessoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.owner != nil {
+		edges = append(edges, nftaccessory.EdgeOwner)
+	}
+	if m.equipped_on_moment != nil {
+		edges = append(edges, nftaccessory.EdgeEquippedOnMoment)
+	}
+	if m.listings != nil {
+		edges = append(edges, nftaccessory.EdgeListings)
+	}
+	if m.gacha_receipt != nil {
+		edges = append(edges, nftaccessory.EdgeGachaReceipt)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NFTAccessoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case nftaccessory.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case nftaccessory.EdgeEquippedOnMoment:
+		if id := m.equipped_on_moment; id != nil {
+			return []ent.Value{*id}
+		}
+	case nftaccessory.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
+			ids = append(ids, id)
+		}
+		return ids
+	case nftaccessory.EdgeGachaReceipt:
+		if id := m.gacha_receipt; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NFTAccessoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedlistings != nil {
+		edges = append(edges, nftaccessory.EdgeListings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NFTAccessoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case nftaccessory.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NFTAccessoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedowner {
+		edges = append(edges, nftaccessory.EdgeOwner)
+	}
+	if m.clearedequipped_on_moment {
+		edges = append(edges, nftaccessory.EdgeEquippedOnMoment)
+	}
+	if m.clearedlistings {
+		edges = append(edges, nftaccessory.EdgeListings)
+	}
+	if m.clearedgacha_receipt {
+		edges = append(edges, nftaccessory.EdgeGachaReceipt)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NFTAccessoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case nftaccessory.EdgeOwner:
+		return m.clearedowner
+	case nftaccessory.EdgeEquippedOnMoment:
+		return m.clearedequipped_on_moment
+	case nftaccessory.EdgeListings:
+		return m.clearedlistings
+	case nftaccessory.EdgeGachaReceipt:
+		return m.clearedgacha_receipt
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NFTAccessoryMutation) ClearEdge(name string) error {
+	switch name {
+	case nftaccessory.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case nftaccessory.EdgeEquippedOnMoment:
+		m.ClearEquippedOnMoment()
+		return nil
+	case nftaccessory.EdgeGachaReceipt:
+		m.ClearGachaReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NFTAccessoryMutation) ResetEdge(name string) error {
+	switch name {
+	case nftaccessory.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case nftaccessory.EdgeEquippedOnMoment:
+		m.ResetEquippedOnMoment()
+		return nil
+	case nftaccessory.EdgeListings:
+		m.ResetListings()
+		return nil
+	case nftaccessory.EdgeGachaReceipt:
+		m.ResetGachaReceipt()
+		return nil
+	}
+	return fmt.Errorf("unknown NFTAccessory edge %s", name)
+}
+
+// NFTMomentMutation represents an operation that mutates the NFTMoment nodes in the graph.
+type NFTMomentMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	nft_id                      *uint64
+	addnft_id                   *int64
+	name                        *string
+	description                 *string
+	thumbnail                   *string
+	tier                        *string
+	minted_block                *uint64
+	addminted_block             *int64
+	minted_time                 *time.Time
+	pass_id                     *uint64
+	addpass_id                  *int64
+	clearedFields               map[string]struct{}
+	owner                       *int
+	clearedowner                bool
+	equipped_accessories        map[int]struct{}
+	removedequipped_accessories map[int]struct{}
+	clearedequipped_accessories bool
+	minted_with_pass            *int
+	clearedminted_with_pass     bool
+	listings                    map[int]struct{}
+	removedlistings             map[int]struct{}
+	clearedlistings             bool
+	done                        bool
+	oldValue                    func(context.Context) (*NFTMoment, error)
+	predicates                  []predicate.NFTMoment
+}
+
+var _ ent.Mutation = (*NFTMomentMutation)(nil)
+
+// nftmomentOption allows management of the mutation configuration using functional options.
+type nftmomentOption func(*NFTMomentMutation)
+
+// newNFTMomentMutation creates new mutation for the NFTMoment entity.
+func newNFTMomentMutation(c config, op Op, opts ...nftmomentOption) *NFTMomentMutation {
+	m := &NFTMomentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNFTMoment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNFTMomentID sets the ID field of the mutation.
+func withNFTMomentID(id int) nftmomentOption {
+	return func(m *NFTMomentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NFTMoment
+		)
+		m.oldValue = func(ctx context.Context) (*NFTMoment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NFTMoment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNFTMoment sets the old NFTMoment of the mutation.
+func withNFTMoment(node *NFTMoment) nftmomentOption {
+	return func(m *NFTMomentMutation) {
+		m.oldValue = func(context.Context) (*NFTMoment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NFTMomentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NFTMomentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NFTMomentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NFTMomentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NFTMoment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNftID sets the "nft_id" field.
+func (m *NFTMomentMutation) SetNftID(u uint64) {
+	m.nft_id = &u
+	m.addnft_id = nil
+}
+
+// NftID returns the value of the "nft_id" field in the mutation.
+func (m *NFTMomentMutation) NftID() (r uint64, exists bool) {
+	v := m.nft_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNftID returns the old "nft_id" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTMomentMutation) OldNftID(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNftID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNftID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNftID: %w", err)
+	}
+	return oldValue.NftID, nil
+}
+
+// AddNftID adds u to the "nft_id" field.
+func (m *NFTMomentMutation) AddNftID(u int64) {
+	if m.addnft_id != nil {
+		*m.addnft_id += u
+	} else {
+		m.addnft_id = &u
+	}
+}
+
+// AddedNftID returns the value that was added to the "nft_id" field in this mutation.
+func (m *NFTMomentMutation) AddedNftID() (r int64, exists bool) {
+	v := m.addnft_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNftID resets all changes to the "nft_id" field.
+func (m *NFTMomentMutation) ResetNftID() {
+	m.nft_id = nil
+	m.addnft_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *NFTMomentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NFTMomentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTMomentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NFTMomentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *NFTMomentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NFTMomentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTMomentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NFTMomentMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (m *NFTMomentMutation) SetThumbnail(s string) {
+	m.thumbnail = &s
+}
+
+// Thumbnail returns the value of the "thumbnail" field in the mutation.
+func (m *NFTMomentMutation) Thumbnail() (r string, exists bool) {
+	v := m.thumbnail
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnail returns the old "thumbnail" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NFTMomentMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+	}
+	return oldValue.Thumbnail, nil
+}
+
+// ResetThumbnail resets all changes to the "thumbnail" field.
+func (m *NFTMomentMutation) ResetThumbnail() {
+	m.thumbnail = nil
+}
+
+// SetTier sets the "tier" field.
+func (m *NFTMomentMutation) SetTier(s string) {
+	m.tier = &s
 }
 
-// Long returns the value of the "long" field in the mutation.
-func (m *EventMutation) Long() (r float64, exists bool) {
-	v := m.long
+// Tier returns the value of the "tier" field in the mutation.
+func (m *NFTMomentMutation) Tier() (r string, exists bool) {
+	v := m.tier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLong returns the old "long" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldTier returns the old "tier" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLong(ctx context.Context) (v float64, err error) {
+func (m *NFTMomentMutation) OldTier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLong is only allowed on UpdateOne operations")
+		return v, errors.New("OldTier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLong requires an ID field in the mutation")
+		return v, errors.New("OldTier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLong: %w", err)
+		return v, fmt.Errorf("querying old value for OldTier: %w", err)
 	}
-	return oldValue.Long, nil
+	return oldValue.Tier, nil
 }
 
-// AddLong adds f to the "long" field.
-func (m *EventMutation) AddLong(f float64) {
-	if m.addlong != nil {
-		*m.addlong += f
-	} else {
-		m.addlong = &f
-	}
+// ClearTier clears the value of the "tier" field.
+func (m *NFTMomentMutation) ClearTier() {
+	m.tier = nil
+	m.clearedFields[nftmoment.FieldTier] = struct{}{}
 }
 
-// AddedLong returns the value that was added to the "long" field in this mutation.
-func (m *EventMutation) AddedLong() (r float64, exists bool) {
-	v := m.addlong
-	if v == nil {
-		return
-	}
-	return *v, true
+// TierCleared returns if the "tier" field was cleared in this mutation.
+func (m *NFTMomentMutation) TierCleared() bool {
+	_, ok := m.clearedFields[nftmoment.FieldTier]
+	return ok
 }
 
-// ResetLong resets all changes to the "long" field.
-func (m *EventMutation) ResetLong() {
-	m.long = nil
-	m.addlong = nil
+// ResetTier resets all changes to the "tier" field.
+func (m *NFTMomentMutation) ResetTier() {
+	m.tier = nil
+	delete(m.clearedFields, nftmoment.FieldTier)
 }
 
-// SetStartDate sets the "start_date" field.
-func (m *EventMutation) SetStartDate(t time.Time) {
-	m.start_date = &t
+// SetMintedBlock sets the "minted_block" field.
+func (m *NFTMomentMutation) SetMintedBlock(u uint64) {
+	m.minted_block = &u
+	m.addminted_block = nil
 }
 
-// StartDate returns the value of the "start_date" field in the mutation.
-func (m *EventMutation) StartDate() (r time.Time, exists bool) {
-	v := m.start_date
+// MintedBlock returns the value of the "minted_block" field in the mutation.
+func (m *NFTMomentMutation) MintedBlock() (r uint64, exists bool) {
+	v := m.minted_block
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartDate returns the old "start_date" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldMintedBlock returns the old "minted_block" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+func (m *NFTMomentMutation) OldMintedBlock(ctx context.Context) (v *uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldMintedBlock is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartDate requires an ID field in the mutation")
+		return v, errors.New("OldMintedBlock requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldMintedBlock: %w", err)
 	}
-	return oldValue.StartDate, nil
+	return oldValue.MintedBlock, nil
 }
 
-// ResetStartDate resets all changes to the "start_date" field.
-func (m *EventMutation) ResetStartDate() {
-	m.start_date = nil
+// AddMintedBlock adds u to the "minted_block" field.
+func (m *NFTMomentMutation) AddMintedBlock(u int64) {
+	if m.addminted_block != nil {
+		*m.addminted_block += u
+	} else {
+		m.addminted_block = &u
+	}
 }
 
-// SetEndDate sets the "end_date" field.
-func (m *EventMutation) SetEndDate(t time.Time) {
-	m.end_date = &t
+// AddedMintedBlock returns the value that was added to the "minted_block" field in this mutation.
+func (m *NFTMomentMutation) AddedMintedBlock() (r int64, exists bool) {
+	v := m.addminted_block
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EndDate returns the value of the "end_date" field in the mutation.
-func (m *EventMutation) EndDate() (r time.Time, exists bool) {
-	v := m.end_date
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (m *NFTMomentMutation) ClearMintedBlock() {
+	m.minted_block = nil
+	m.addminted_block = nil
+	m.clearedFields[nftmoment.FieldMintedBlock] = struct{}{}
+}
+
+// MintedBlockCleared returns if the "minted_block" field was cleared in this mutation.
+func (m *NFTMomentMutation) MintedBlockCleared() bool {
+	_, ok := m.clearedFields[nftmoment.FieldMintedBlock]
+	return ok
+}
+
+// ResetMintedBlock resets all changes to the "minted_block" field.
+func (m *NFTMomentMutation) ResetMintedBlock() {
+	m.minted_block = nil
+	m.addminted_block = nil
+	delete(m.clearedFields, nftmoment.FieldMintedBlock)
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (m *NFTMomentMutation) SetMintedTime(t time.Time) {
+	m.minted_time = &t
+}
+
+// MintedTime returns the value of the "minted_time" field in the mutation.
+func (m *NFTMomentMutation) MintedTime() (r time.Time, exists bool) {
+	v := m.minted_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndDate returns the old "end_date" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldMintedTime returns the old "minted_time" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+func (m *NFTMomentMutation) OldMintedTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldMintedTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndDate requires an ID field in the mutation")
+		return v, errors.New("OldMintedTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldMintedTime: %w", err)
 	}
-	return oldValue.EndDate, nil
+	return oldValue.MintedTime, nil
 }
 
-// ResetEndDate resets all changes to the "end_date" field.
-func (m *EventMutation) ResetEndDate() {
-	m.end_date = nil
+// ClearMintedTime clears the value of the "minted_time" field.
+func (m *NFTMomentMutation) ClearMintedTime() {
+	m.minted_time = nil
+	m.clearedFields[nftmoment.FieldMintedTime] = struct{}{}
 }
 
-// SetQuota sets the "quota" field.
-func (m *EventMutation) SetQuota(u uint64) {
-	m.quota = &u
-	m.addquota = nil
+// MintedTimeCleared returns if the "minted_time" field was cleared in this mutation.
+func (m *NFTMomentMutation) MintedTimeCleared() bool {
+	_, ok := m.clearedFields[nftmoment.FieldMintedTime]
+	return ok
 }
 
-// Quota returns the value of the "quota" field in the mutation.
-func (m *EventMutation) Quota() (r uint64, exists bool) {
-	v := m.quota
+// ResetMintedTime resets all changes to the "minted_time" field.
+func (m *NFTMomentMutation) ResetMintedTime() {
+	m.minted_time = nil
+	delete(m.clearedFields, nftmoment.FieldMintedTime)
+}
+
+// SetPassID sets the "pass_id" field.
+func (m *NFTMomentMutation) SetPassID(u uint64) {
+	m.pass_id = &u
+	m.addpass_id = nil
+}
+
+// PassID returns the value of the "pass_id" field in the mutation.
+func (m *NFTMomentMutation) PassID() (r uint64, exists bool) {
+	v := m.pass_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuota returns the old "quota" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldPassID returns the old "pass_id" field's value of the NFTMoment entity.
+// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldQuota(ctx context.Context) (v uint64, err error) {
+func (m *NFTMomentMutation) OldPassID(ctx context.Context) (v *uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuota is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuota requires an ID field in the mutation")
+		return v, errors.New("OldPassID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuota: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassID: %w", err)
 	}
-	return oldValue.Quota, nil
+	return oldValue.PassID, nil
 }
 
-// AddQuota adds u to the "quota" field.
-func (m *EventMutation) AddQuota(u int64) {
-	if m.addquota != nil {
-		*m.addquota += u
+// AddPassID adds u to the "pass_id" field.
+func (m *NFTMomentMutation) AddPassID(u int64) {
+	if m.addpass_id != nil {
+		*m.addpass_id += u
 	} else {
-		m.addquota = &u
+		m.addpass_id = &u
 	}
 }
 
-// AddedQuota returns the value that was added to the "quota" field in this mutation.
-func (m *EventMutation) AddedQuota() (r int64, exists bool) {
-	v := m.addquota
+// AddedPassID returns the value that was added to the "pass_id" field in this mutation.
+func (m *NFTMomentMutation) AddedPassID() (r int64, exists bool) {
+	v := m.addpass_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuota resets all changes to the "quota" field.
-func (m *EventMutation) ResetQuota() {
-	m.quota = nil
-	m.addquota = nil
+// ClearPassID clears the value of the "pass_id" field.
+func (m *NFTMomentMutation) ClearPassID() {
+	m.pass_id = nil
+	m.addpass_id = nil
+	m.clearedFields[nftmoment.FieldPassID] = struct{}{}
 }
 
-// SetHostID sets the "host" edge to the User entity by id.
-func (m *EventMutation) SetHostID(id int) {
-	m.host = &id
+// PassIDCleared returns if the "pass_id" field was cleared in this mutation.
+func (m *NFTMomentMutation) PassIDCleared() bool {
+	_, ok := m.clearedFields[nftmoment.FieldPassID]
+	return ok
 }
 
-// ClearHost clears the "host" edge to the User entity.
-func (m *EventMutation) ClearHost() {
-	m.clearedhost = true
+// ResetPassID resets all changes to the "pass_id" field.
+func (m *NFTMomentMutation) ResetPassID() {
+	m.pass_id = nil
+	m.addpass_id = nil
+	delete(m.clearedFields, nftmoment.FieldPassID)
 }
 
-// HostCleared reports if the "host" edge to the User entity was cleared.
-func (m *EventMutation) HostCleared() bool {
-	return m.clearedhost
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *NFTMomentMutation) SetOwnerID(id int) {
+	m.owner = &id
 }
 
-// HostID returns the "host" edge ID in the mutation.
-func (m *EventMutation) HostID() (id int, exists bool) {
-	if m.host != nil {
-		return *m.host, true
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *NFTMomentMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *NFTMomentMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *NFTMomentMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
 	return
 }
 
-// HostIDs returns the "host" edge IDs in the mutation.
+// OwnerIDs returns the "owner" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HostID instead. It exists only for internal usage by the builders.
-func (m *EventMutation) HostIDs() (ids []int) {
-	if id := m.host; id != nil {
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *NFTMomentMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetHost resets all changes to the "host" edge.
-func (m *EventMutation) ResetHost() {
-	m.host = nil
-	m.clearedhost = false
+// ResetOwner resets all changes to the "owner" edge.
+func (m *NFTMomentMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// AddPassesIssuedIDs adds the "passes_issued" edge to the EventPass entity by ids.
-func (m *EventMutation) AddPassesIssuedIDs(ids ...int) {
-	if m.passes_issued == nil {
-		m.passes_issued = make(map[int]struct{})
+// AddEquippedAccessoryIDs adds the "equipped_accessories" edge to the NFTAccessory entity by ids.
+func (m *NFTMomentMutation) AddEquippedAccessoryIDs(ids ...int) {
+	if m.equipped_accessories == nil {
+		m.equipped_accessories = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.passes_issued[ids[i]] = struct{}{}
+		m.equipped_accessories[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPassesIssued clears the "passes_issued" edge to the EventPass entity.
-func (m *EventMutation) ClearPassesIssued() {
-	m.clearedpasses_issued = true
+// ClearEquippedAccessories clears the "equipped_accessories" edge to the NFTAccessory entity.
+func (m *NFTMomentMutation) ClearEquippedAccessories() {
+	m.clearedequipped_accessories = true
 }
 
-// PassesIssuedCleared reports if the "passes_issued" edge to the EventPass entity was cleared.
-func (m *EventMutation) PassesIssuedCleared() bool {
-	return m.clearedpasses_issued
+// EquippedAccessoriesCleared reports if the "equipped_accessories" edge to the NFTAccessory entity was cleared.
+func (m *NFTMomentMutation) EquippedAccessoriesCleared() bool {
+	return m.clearedequipped_accessories
 }
 
-// RemovePassesIssuedIDs removes the "passes_issued" edge to the EventPass entity by IDs.
-func (m *EventMutation) RemovePassesIssuedIDs(ids ...int) {
-	if m.removedpasses_issued == nil {
-		m.removedpasses_issued = make(map[int]struct{})
+// RemoveEquippedAccessoryIDs removes the "equipped_accessories" edge to the NFTAccessory entity by IDs.
+func (m *NFTMomentMutation) RemoveEquippedAccessoryIDs(ids ...int) {
+	if m.removedequipped_accessories == nil {
+		m.removedequipped_accessories = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.passes_issued, ids[i])
-		m.removedpasses_issued[ids[i]] = struct{}{}
+		delete(m.equipped_accessories, ids[i])
+		m.removedequipped_accessories[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPassesIssued returns the removed IDs of the "passes_issued" edge to the EventPass entity.
-func (m *EventMutation) RemovedPassesIssuedIDs() (ids []int) {
-	for id := range m.removedpasses_issued {
+// RemovedEquippedAccessories returns the removed IDs of the "equipped_accessories" edge to the NFTAccessory entity.
+func (m *NFTMomentMutation) RemovedEquippedAccessoriesIDs() (ids []int) {
+	for id := range m.removedequipped_accessories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EquippedAccessoriesIDs returns the "equipped_accessories" edge IDs in the mutation.
+func (m *NFTMomentMutation) EquippedAccessoriesIDs() (ids []int) {
+	for id := range m.equipped_accessories {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PassesIssuedIDs returns the "passes_issued" edge IDs in the mutation.
-func (m *EventMutation) PassesIssuedIDs() (ids []int) {
-	for id := range m.passes_issued {
-		ids = append(ids, id)
+// ResetEquippedAccessories resets all changes to the "equipped_accessories" edge.
+func (m *NFTMomentMutation) ResetEquippedAccessories() {
+	m.equipped_accessories = nil
+	m.clearedequipped_accessories = false
+	m.removedequipped_accessories = nil
+}
+
+// SetMintedWithPassID sets the "minted_with_pass" edge to the EventPass entity by id.
+func (m *NFTMomentMutation) SetMintedWithPassID(id int) {
+	m.minted_with_pass = &id
+}
+
+// ClearMintedWithPass clears the "minted_with_pass" edge to the EventPass entity.
+func (m *NFTMomentMutation) ClearMintedWithPass() {
+	m.clearedminted_with_pass = true
+}
+
+// MintedWithPassCleared reports if the "minted_with_pass" edge to the EventPass entity was cleared.
+func (m *NFTMomentMutation) MintedWithPassCleared() bool {
+	return m.clearedminted_with_pass
+}
+
+// MintedWithPassID returns the "minted_with_pass" edge ID in the mutation.
+func (m *NFTMomentMutation) MintedWithPassID() (id int, exists bool) {
+	if m.minted_with_pass != nil {
+		return *m.minted_with_pass, true
+	}
+	return
+}
+
+// MintedWithPassIDs returns the "minted_with_pass" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MintedWithPassID instead. It exists only for internal usage by the builders.
+func (m *NFTMomentMutation) MintedWithPassIDs() (ids []int) {
+	if id := m.minted_with_pass; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPassesIssued resets all changes to the "passes_issued" edge.
-func (m *EventMutation) ResetPassesIssued() {
-	m.passes_issued = nil
-	m.clearedpasses_issued = false
-	m.removedpasses_issued = nil
+// ResetMintedWithPass resets all changes to the "minted_with_pass" edge.
+func (m *NFTMomentMutation) ResetMintedWithPass() {
+	m.minted_with_pass = nil
+	m.clearedminted_with_pass = false
 }
 
-// AddAttendanceIDs adds the "attendances" edge to the Attendance entity by ids.
-func (m *EventMutation) AddAttendanceIDs(ids ...int) {
-	if m.attendances == nil {
-		m.attendances = make(map[int]struct{})
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *NFTMomentMutation) AddListingIDs(ids ...int) {
+	if m.listings == nil {
+		m.listings = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.attendances[ids[i]] = struct{}{}
+		m.listings[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAttendances clears the "attendances" edge to the Attendance entity.
-func (m *EventMutation) ClearAttendances() {
-	m.clearedattendances = true
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *NFTMomentMutation) ClearListings() {
+	m.clearedlistings = true
 }
 
-// AttendancesCleared reports if the "attendances" edge to the Attendance entity was cleared.
-func (m *EventMutation) AttendancesCleared() bool {
-	return m.clearedattendances
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *NFTMomentMutation) ListingsCleared() bool {
+	return m.clearedlistings
 }
 
-// RemoveAttendanceIDs removes the "attendances" edge to the Attendance entity by IDs.
-func (m *EventMutation) RemoveAttendanceIDs(ids ...int) {
-	if m.removedattendances == nil {
-		m.removedattendances = make(map[int]struct{})
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *NFTMomentMutation) RemoveListingIDs(ids ...int) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.attendances, ids[i])
-		m.removedattendances[ids[i]] = struct{}{}
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAttendances returns the removed IDs of the "attendances" edge to the Attendance entity.
-func (m *EventMutation) RemovedAttendancesIDs() (ids []int) {
-	for id := range m.removedattendances {
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *NFTMomentMutation) RemovedListingsIDs() (ids []int) {
+	for id := range m.removedlistings {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AttendancesIDs returns the "attendances" edge IDs in the mutation.
-func (m *EventMutation) AttendancesIDs() (ids []int) {
-	for id := range m.attendances {
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *NFTMomentMutation) ListingsIDs() (ids []int) {
+	for id := range m.listings {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAttendances resets all changes to the "attendances" edge.
-func (m *EventMutation) ResetAttendances() {
-	m.attendances = nil
-	m.clearedattendances = false
-	m.removedattendances = nil
+// ResetListings resets all changes to the "listings" edge.
+func (m *NFTMomentMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
 }
 
-// Where appends a list predicates to the EventMutation builder.
-func (m *EventMutation) Where(ps ...predicate.Event) {
+// Where appends a list predicates to the NFTMomentMutation builder.
+func (m *NFTMomentMutation) Where(ps ...predicate.NFTMoment) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// WhereP appends storage-level predicates to the NFTMomentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Event, len(ps))
+func (m *NFTMomentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NFTMoment, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1338,57 +9069,48 @@ func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EventMutation) Op() Op {
+func (m *NFTMomentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EventMutation) SetOp(op Op) {
+func (m *NFTMomentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Event).
-func (m *EventMutation) Type() string {
+// Type returns the node type of this mutation (NFTMoment).
+func (m *NFTMomentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EventMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.event_id != nil {
-		fields = append(fields, event.FieldEventID)
+func (m *NFTMomentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.nft_id != nil {
+		fields = append(fields, nftmoment.FieldNftID)
 	}
 	if m.name != nil {
-		fields = append(fields, event.FieldName)
+		fields = append(fields, nftmoment.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, event.FieldDescription)
+		fields = append(fields, nftmoment.FieldDescription)
 	}
 	if m.thumbnail != nil {
-		fields = append(fields, event.FieldThumbnail)
-	}
-	if m.event_type != nil {
-		fields = append(fields, event.FieldEventType)
-	}
-	if m.location != nil {
-		fields = append(fields, event.FieldLocation)
-	}
-	if m.lat != nil {
-		fields = append(fields, event.FieldLat)
+		fields = append(fields, nftmoment.FieldThumbnail)
 	}
-	if m.long != nil {
-		fields = append(fields, event.FieldLong)
+	if m.tier != nil {
+		fields = append(fields, nftmoment.FieldTier)
 	}
-	if m.start_date != nil {
-		fields = append(fields, event.FieldStartDate)
+	if m.minted_block != nil {
+		fields = append(fields, nftmoment.FieldMintedBlock)
 	}
-	if m.end_date != nil {
-		fields = append(fields, event.FieldEndDate)
+	if m.minted_time != nil {
+		fields = append(fields, nftmoment.FieldMintedTime)
 	}
-	if m.quota != nil {
-		fields = append(fields, event.FieldQuota)
+	if m.pass_id != nil {
+		fields = append(fields, nftmoment.FieldPassID)
 	}
 	return fields
 }
@@ -1396,30 +9118,24 @@ func (m *EventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EventMutation) Field(name string) (ent.Value, bool) {
+func (m *NFTMomentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case event.FieldEventID:
-		return m.EventID()
-	case event.FieldName:
+	case nftmoment.FieldNftID:
+		return m.NftID()
+	case nftmoment.FieldName:
 		return m.Name()
-	case event.FieldDescription:
+	case nftmoment.FieldDescription:
 		return m.Description()
-	case event.FieldThumbnail:
+	case nftmoment.FieldThumbnail:
 		return m.Thumbnail()
-	case event.FieldEventType:
-		return m.EventType()
-	case event.FieldLocation:
-		return m.Location()
-	case event.FieldLat:
-		return m.Lat()
-	case event.FieldLong:
-		return m.Long()
-	case event.FieldStartDate:
-		return m.StartDate()
-	case event.FieldEndDate:
-		return m.EndDate()
-	case event.FieldQuota:
-		return m.Quota()
+	case nftmoment.FieldTier:
+		return m.Tier()
+	case nftmoment.FieldMintedBlock:
+		return m.MintedBlock()
+	case nftmoment.FieldMintedTime:
+		return m.MintedTime()
+	case nftmoment.FieldPassID:
+		return m.PassID()
 	}
 	return nil, false
 }
@@ -1427,157 +9143,120 @@ func (m *EventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *NFTMomentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case event.FieldEventID:
-		return m.OldEventID(ctx)
-	case event.FieldName:
+	case nftmoment.FieldNftID:
+		return m.OldNftID(ctx)
+	case nftmoment.FieldName:
 		return m.OldName(ctx)
-	case event.FieldDescription:
+	case nftmoment.FieldDescription:
 		return m.OldDescription(ctx)
-	case event.FieldThumbnail:
+	case nftmoment.FieldThumbnail:
 		return m.OldThumbnail(ctx)
-	case event.FieldEventType:
-		return m.OldEventType(ctx)
-	case event.FieldLocation:
-		return m.OldLocation(ctx)
-	case event.FieldLat:
-		return m.OldLat(ctx)
-	case event.FieldLong:
-		return m.OldLong(ctx)
-	case event.FieldStartDate:
-		return m.OldStartDate(ctx)
-	case event.FieldEndDate:
-		return m.OldEndDate(ctx)
-	case event.FieldQuota:
-		return m.OldQuota(ctx)
+	case nftmoment.FieldTier:
+		return m.OldTier(ctx)
+	case nftmoment.FieldMintedBlock:
+		return m.OldMintedBlock(ctx)
+	case nftmoment.FieldMintedTime:
+		return m.OldMintedTime(ctx)
+	case nftmoment.FieldPassID:
+		return m.OldPassID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Event field %s", name)
+	return nil, fmt.Errorf("unknown NFTMoment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) SetField(name string, value ent.Value) error {
+func (m *NFTMomentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldEventID:
+	case nftmoment.FieldNftID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventID(v)
+		m.SetNftID(v)
 		return nil
-	case event.FieldName:
+	case nftmoment.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case event.FieldDescription:
+	case nftmoment.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case event.FieldThumbnail:
+	case nftmoment.FieldThumbnail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetThumbnail(v)
 		return nil
-	case event.FieldEventType:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventType(v)
-		return nil
-	case event.FieldLocation:
+	case nftmoment.FieldTier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
-		return nil
-	case event.FieldLat:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLat(v)
-		return nil
-	case event.FieldLong:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLong(v)
+		m.SetTier(v)
 		return nil
-	case event.FieldStartDate:
-		v, ok := value.(time.Time)
+	case nftmoment.FieldMintedBlock:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartDate(v)
+		m.SetMintedBlock(v)
 		return nil
-	case event.FieldEndDate:
+	case nftmoment.FieldMintedTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndDate(v)
+		m.SetMintedTime(v)
 		return nil
-	case event.FieldQuota:
+	case nftmoment.FieldPassID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuota(v)
+		m.SetPassID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown NFTMoment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EventMutation) AddedFields() []string {
+func (m *NFTMomentMutation) AddedFields() []string {
 	var fields []string
-	if m.addevent_id != nil {
-		fields = append(fields, event.FieldEventID)
-	}
-	if m.addevent_type != nil {
-		fields = append(fields, event.FieldEventType)
-	}
-	if m.addlat != nil {
-		fields = append(fields, event.FieldLat)
+	if m.addnft_id != nil {
+		fields = append(fields, nftmoment.FieldNftID)
 	}
-	if m.addlong != nil {
-		fields = append(fields, event.FieldLong)
+	if m.addminted_block != nil {
+		fields = append(fields, nftmoment.FieldMintedBlock)
 	}
-	if m.addquota != nil {
-		fields = append(fields, event.FieldQuota)
+	if m.addpass_id != nil {
+		fields = append(fields, nftmoment.FieldPassID)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case event.FieldEventID:
-		return m.AddedEventID()
-	case event.FieldEventType:
-		return m.AddedEventType()
-	case event.FieldLat:
-		return m.AddedLat()
-	case event.FieldLong:
-		return m.AddedLong()
-	case event.FieldQuota:
-		return m.AddedQuota()
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NFTMomentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nftmoment.FieldNftID:
+		return m.AddedNftID()
+	case nftmoment.FieldMintedBlock:
+		return m.AddedMintedBlock()
+	case nftmoment.FieldPassID:
+		return m.AddedPassID()
 	}
 	return nil, false
 }
@@ -1585,139 +9264,150 @@ func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) AddField(name string, value ent.Value) error {
+func (m *NFTMomentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldEventID:
+	case nftmoment.FieldNftID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEventID(v)
-		return nil
-	case event.FieldEventType:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddEventType(v)
-		return nil
-	case event.FieldLat:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLat(v)
+		m.AddNftID(v)
 		return nil
-	case event.FieldLong:
-		v, ok := value.(float64)
+	case nftmoment.FieldMintedBlock:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLong(v)
+		m.AddMintedBlock(v)
 		return nil
-	case event.FieldQuota:
+	case nftmoment.FieldPassID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuota(v)
+		m.AddPassID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Event numeric field %s", name)
+	return fmt.Errorf("unknown NFTMoment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EventMutation) ClearedFields() []string {
-	return nil
+func (m *NFTMomentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nftmoment.FieldTier) {
+		fields = append(fields, nftmoment.FieldTier)
+	}
+	if m.FieldCleared(nftmoment.FieldMintedBlock) {
+		fields = append(fields, nftmoment.FieldMintedBlock)
+	}
+	if m.FieldCleared(nftmoment.FieldMintedTime) {
+		fields = append(fields, nftmoment.FieldMintedTime)
+	}
+	if m.FieldCleared(nftmoment.FieldPassID) {
+		fields = append(fields, nftmoment.FieldPassID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EventMutation) FieldCleared(name string) bool {
+func (m *NFTMomentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EventMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Event nullable field %s", name)
+func (m *NFTMomentMutation) ClearField(name string) error {
+	switch name {
+	case nftmoment.FieldTier:
+		m.ClearTier()
+		return nil
+	case nftmoment.FieldMintedBlock:
+		m.ClearMintedBlock()
+		return nil
+	case nftmoment.FieldMintedTime:
+		m.ClearMintedTime()
+		return nil
+	case nftmoment.FieldPassID:
+		m.ClearPassID()
+		return nil
+	}
+	return fmt.Errorf("unknown NFTMoment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EventMutation) ResetField(name string) error {
+func (m *NFTMomentMutation) ResetField(name string) error {
 	switch name {
-	case event.FieldEventID:
-		m.ResetEventID()
+	case nftmoment.FieldNftID:
+		m.ResetNftID()
 		return nil
-	case event.FieldName:
+	case nftmoment.FieldName:
 		m.ResetName()
 		return nil
-	case event.FieldDescription:
+	case nftmoment.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case event.FieldThumbnail:
+	case nftmoment.FieldThumbnail:
 		m.ResetThumbnail()
 		return nil
-	case event.FieldEventType:
-		m.ResetEventType()
-		return nil
-	case event.FieldLocation:
-		m.ResetLocation()
-		return nil
-	case event.FieldLat:
-		m.ResetLat()
-		return nil
-	case event.FieldLong:
-		m.ResetLong()
+	case nftmoment.FieldTier:
+		m.ResetTier()
 		return nil
-	case event.FieldStartDate:
-		m.ResetStartDate()
+	case nftmoment.FieldMintedBlock:
+		m.ResetMintedBlock()
 		return nil
-	case event.FieldEndDate:
-		m.ResetEndDate()
+	case nftmoment.FieldMintedTime:
+		m.ResetMintedTime()
 		return nil
-	case event.FieldQuota:
-		m.ResetQuota()
+	case nftmoment.FieldPassID:
+		m.ResetPassID()
 		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown NFTMoment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.host != nil {
-		edges = append(edges, event.EdgeHost)
+func (m *NFTMomentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.owner != nil {
+		edges = append(edges, nftmoment.EdgeOwner)
 	}
-	if m.passes_issued != nil {
-		edges = append(edges, event.EdgePassesIssued)
+	if m.equipped_accessories != nil {
+		edges = append(edges, nftmoment.EdgeEquippedAccessories)
 	}
-	if m.attendances != nil {
-		edges = append(edges, event.EdgeAttendances)
+	if m.minted_with_pass != nil {
+		edges = append(edges, nftmoment.EdgeMintedWithPass)
+	}
+	if m.listings != nil {
+		edges = append(edges, nftmoment.EdgeListings)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EventMutation) AddedIDs(name string) []ent.Value {
+func (m *NFTMomentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case event.EdgeHost:
-		if id := m.host; id != nil {
+	case nftmoment.EdgeOwner:
+		if id := m.owner; id != nil {
 			return []ent.Value{*id}
 		}
-	case event.EdgePassesIssued:
-		ids := make([]ent.Value, 0, len(m.passes_issued))
-		for id := range m.passes_issued {
+	case nftmoment.EdgeEquippedAccessories:
+		ids := make([]ent.Value, 0, len(m.equipped_accessories))
+		for id := range m.equipped_accessories {
 			ids = append(ids, id)
 		}
 		return ids
-	case event.EdgeAttendances:
-		ids := make([]ent.Value, 0, len(m.attendances))
-		for id := range m.attendances {
+	case nftmoment.EdgeMintedWithPass:
+		if id := m.minted_with_pass; id != nil {
+			return []ent.Value{*id}
+		}
+	case nftmoment.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1726,30 +9416,30 @@ func (m *EventMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedpasses_issued != nil {
-		edges = append(edges, event.EdgePassesIssued)
+func (m *NFTMomentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedequipped_accessories != nil {
+		edges = append(edges, nftmoment.EdgeEquippedAccessories)
 	}
-	if m.removedattendances != nil {
-		edges = append(edges, event.EdgeAttendances)
+	if m.removedlistings != nil {
+		edges = append(edges, nftmoment.EdgeListings)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+func (m *NFTMomentMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case event.EdgePassesIssued:
-		ids := make([]ent.Value, 0, len(m.removedpasses_issued))
-		for id := range m.removedpasses_issued {
+	case nftmoment.EdgeEquippedAccessories:
+		ids := make([]ent.Value, 0, len(m.removedequipped_accessories))
+		for id := range m.removedequipped_accessories {
 			ids = append(ids, id)
 		}
 		return ids
-	case event.EdgeAttendances:
-		ids := make([]ent.Value, 0, len(m.removedattendances))
-		for id := range m.removedattendances {
+	case nftmoment.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1758,99 +9448,111 @@ func (m *EventMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedhost {
-		edges = append(edges, event.EdgeHost)
+func (m *NFTMomentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedowner {
+		edges = append(edges, nftmoment.EdgeOwner)
 	}
-	if m.clearedpasses_issued {
-		edges = append(edges, event.EdgePassesIssued)
+	if m.clearedequipped_accessories {
+		edges = append(edges, nftmoment.EdgeEquippedAccessories)
 	}
-	if m.clearedattendances {
-		edges = append(edges, event.EdgeAttendances)
+	if m.clearedminted_with_pass {
+		edges = append(edges, nftmoment.EdgeMintedWithPass)
+	}
+	if m.clearedlistings {
+		edges = append(edges, nftmoment.EdgeListings)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EventMutation) EdgeCleared(name string) bool {
+func (m *NFTMomentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case event.EdgeHost:
-		return m.clearedhost
-	case event.EdgePassesIssued:
-		return m.clearedpasses_issued
-	case event.EdgeAttendances:
-		return m.clearedattendances
+	case nftmoment.EdgeOwner:
+		return m.clearedowner
+	case nftmoment.EdgeEquippedAccessories:
+		return m.clearedequipped_accessories
+	case nftmoment.EdgeMintedWithPass:
+		return m.clearedminted_with_pass
+	case nftmoment.EdgeListings:
+		return m.clearedlistings
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EventMutation) ClearEdge(name string) error {
+func (m *NFTMomentMutation) ClearEdge(name string) error {
 	switch name {
-	case event.EdgeHost:
-		m.ClearHost()
+	case nftmoment.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case nftmoment.EdgeMintedWithPass:
+		m.ClearMintedWithPass()
 		return nil
 	}
-	return fmt.Errorf("unknown Event unique edge %s", name)
+	return fmt.Errorf("unknown NFTMoment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EventMutation) ResetEdge(name string) error {
+func (m *NFTMomentMutation) ResetEdge(name string) error {
 	switch name {
-	case event.EdgeHost:
-		m.ResetHost()
+	case nftmoment.EdgeOwner:
+		m.ResetOwner()
 		return nil
-	case event.EdgePassesIssued:
-		m.ResetPassesIssued()
+	case nftmoment.EdgeEquippedAccessories:
+		m.ResetEquippedAccessories()
 		return nil
-	case event.EdgeAttendances:
-		m.ResetAttendances()
+	case nftmoment.EdgeMintedWithPass:
+		m.ResetMintedWithPass()
+		return nil
+	case nftmoment.EdgeListings:
+		m.ResetListings()
 		return nil
 	}
-	return fmt.Errorf("unknown Event edge %s", name)
+	return fmt.Errorf("unknown NFTMoment edge %s", name)
 }
 
-// EventPassMutation represents an operation that mutates the EventPass nodes in the graph.
-type EventPassMutation struct {
+// PendingEventMutation represents an operation that mutates the PendingEvent nodes in the graph.
+type PendingEventMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	pass_id       *uint64
-	addpass_id    *int64
-	name          *string
-	description   *string
-	thumbnail     *string
-	event_type    *uint8
-	addevent_type *int8
-	is_used       *bool
-	clearedFields map[string]struct{}
-	owner         *int
-	clearedowner  bool
-	event         *int
-	clearedevent  bool
-	moment        *int
-	clearedmoment bool
-	done          bool
-	oldValue      func(context.Context) (*EventPass, error)
-	predicates    []predicate.EventPass
-}
-
-var _ ent.Mutation = (*EventPassMutation)(nil)
-
-// eventpassOption allows management of the mutation configuration using functional options.
-type eventpassOption func(*EventPassMutation)
-
-// newEventPassMutation creates new mutation for the EventPass entity.
-func newEventPassMutation(c config, op Op, opts ...eventpassOption) *EventPassMutation {
-	m := &EventPassMutation{
+	op              Op
+	typ             string
+	id              *int
+	entity          *string
+	entity_key      *string
+	block_height    *uint64
+	addblock_height *int64
+	block_id        *string
+	block_time      *time.Time
+	tx_id           *string
+	tx_index        *int
+	addtx_index     *int
+	event_index     *int
+	addevent_index  *int
+	_type           *string
+	payload         *json.RawMessage
+	appendpayload   json.RawMessage
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*PendingEvent, error)
+	predicates      []predicate.PendingEvent
+}
+
+var _ ent.Mutation = (*PendingEventMutation)(nil)
+
+// pendingeventOption allows management of the mutation configuration using functional options.
+type pendingeventOption func(*PendingEventMutation)
+
+// newPendingEventMutation creates new mutation for the PendingEvent entity.
+func newPendingEventMutation(c config, op Op, opts ...pendingeventOption) *PendingEventMutation {
+	m := &PendingEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEventPass,
+		typ:           TypePendingEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1859,20 +9561,20 @@ func newEventPassMutation(c config, op Op, opts ...eventpassOption) *EventPassMu
 	return m
 }
 
-// withEventPassID sets the ID field of the mutation.
-func withEventPassID(id int) eventpassOption {
-	return func(m *EventPassMutation) {
+// withPendingEventID sets the ID field of the mutation.
+func withPendingEventID(id int) pendingeventOption {
+	return func(m *PendingEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EventPass
+			value *PendingEvent
 		)
-		m.oldValue = func(ctx context.Context) (*EventPass, error) {
+		m.oldValue = func(ctx context.Context) (*PendingEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EventPass.Get(ctx, id)
+					value, err = m.Client().PendingEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1881,10 +9583,10 @@ func withEventPassID(id int) eventpassOption {
 	}
 }
 
-// withEventPass sets the old EventPass of the mutation.
-func withEventPass(node *EventPass) eventpassOption {
-	return func(m *EventPassMutation) {
-		m.oldValue = func(context.Context) (*EventPass, error) {
+// withPendingEvent sets the old PendingEvent of the mutation.
+func withPendingEvent(node *PendingEvent) pendingeventOption {
+	return func(m *PendingEventMutation) {
+		m.oldValue = func(context.Context) (*PendingEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1893,7 +9595,7 @@ func withEventPass(node *EventPass) eventpassOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventPassMutation) Client() *Client {
+func (m PendingEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1901,7 +9603,7 @@ func (m EventPassMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventPassMutation) Tx() (*Tx, error) {
+func (m PendingEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1912,7 +9614,7 @@ func (m EventPassMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventPassMutation) ID() (id int, exists bool) {
+func (m *PendingEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1923,7 +9625,7 @@ func (m *EventPassMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventPassMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PendingEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1932,394 +9634,505 @@ func (m *EventPassMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EventPass.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PendingEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPassID sets the "pass_id" field.
-func (m *EventPassMutation) SetPassID(u uint64) {
-	m.pass_id = &u
-	m.addpass_id = nil
+// SetEntity sets the "entity" field.
+func (m *PendingEventMutation) SetEntity(s string) {
+	m.entity = &s
 }
 
-// PassID returns the value of the "pass_id" field in the mutation.
-func (m *EventPassMutation) PassID() (r uint64, exists bool) {
-	v := m.pass_id
+// Entity returns the value of the "entity" field in the mutation.
+func (m *PendingEventMutation) Entity() (r string, exists bool) {
+	v := m.entity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassID returns the old "pass_id" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldEntity returns the old "entity" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldPassID(ctx context.Context) (v uint64, err error) {
+func (m *PendingEventMutation) OldEntity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassID requires an ID field in the mutation")
+		return v, errors.New("OldEntity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntity: %w", err)
 	}
-	return oldValue.PassID, nil
+	return oldValue.Entity, nil
 }
 
-// AddPassID adds u to the "pass_id" field.
-func (m *EventPassMutation) AddPassID(u int64) {
-	if m.addpass_id != nil {
-		*m.addpass_id += u
+// ResetEntity resets all changes to the "entity" field.
+func (m *PendingEventMutation) ResetEntity() {
+	m.entity = nil
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (m *PendingEventMutation) SetEntityKey(s string) {
+	m.entity_key = &s
+}
+
+// EntityKey returns the value of the "entity_key" field in the mutation.
+func (m *PendingEventMutation) EntityKey() (r string, exists bool) {
+	v := m.entity_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityKey returns the old "entity_key" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingEventMutation) OldEntityKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityKey: %w", err)
+	}
+	return oldValue.EntityKey, nil
+}
+
+// ResetEntityKey resets all changes to the "entity_key" field.
+func (m *PendingEventMutation) ResetEntityKey() {
+	m.entity_key = nil
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (m *PendingEventMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
+}
+
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *PendingEventMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHeight returns the old "block_height" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingEventMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
+}
+
+// AddBlockHeight adds u to the "block_height" field.
+func (m *PendingEventMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
 	} else {
-		m.addpass_id = &u
+		m.addblock_height = &u
 	}
 }
 
-// AddedPassID returns the value that was added to the "pass_id" field in this mutation.
-func (m *EventPassMutation) AddedPassID() (r int64, exists bool) {
-	v := m.addpass_id
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *PendingEventMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPassID resets all changes to the "pass_id" field.
-func (m *EventPassMutation) ResetPassID() {
-	m.pass_id = nil
-	m.addpass_id = nil
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *PendingEventMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// SetName sets the "name" field.
-func (m *EventPassMutation) SetName(s string) {
-	m.name = &s
+// SetBlockID sets the "block_id" field.
+func (m *PendingEventMutation) SetBlockID(s string) {
+	m.block_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *EventPassMutation) Name() (r string, exists bool) {
-	v := m.name
+// BlockID returns the value of the "block_id" field in the mutation.
+func (m *PendingEventMutation) BlockID() (r string, exists bool) {
+	v := m.block_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockID returns the old "block_id" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *PendingEventMutation) OldBlockID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldBlockID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.BlockID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *EventPassMutation) ResetName() {
-	m.name = nil
+// ResetBlockID resets all changes to the "block_id" field.
+func (m *PendingEventMutation) ResetBlockID() {
+	m.block_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EventPassMutation) SetDescription(s string) {
-	m.description = &s
+// SetBlockTime sets the "block_time" field.
+func (m *PendingEventMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EventPassMutation) Description() (r string, exists bool) {
-	v := m.description
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *PendingEventMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockTime returns the old "block_time" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *PendingEventMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.BlockTime, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *EventPassMutation) ResetDescription() {
-	m.description = nil
+// ClearBlockTime clears the value of the "block_time" field.
+func (m *PendingEventMutation) ClearBlockTime() {
+	m.block_time = nil
+	m.clearedFields[pendingevent.FieldBlockTime] = struct{}{}
 }
 
-// SetThumbnail sets the "thumbnail" field.
-func (m *EventPassMutation) SetThumbnail(s string) {
-	m.thumbnail = &s
+// BlockTimeCleared returns if the "block_time" field was cleared in this mutation.
+func (m *PendingEventMutation) BlockTimeCleared() bool {
+	_, ok := m.clearedFields[pendingevent.FieldBlockTime]
+	return ok
 }
 
-// Thumbnail returns the value of the "thumbnail" field in the mutation.
-func (m *EventPassMutation) Thumbnail() (r string, exists bool) {
-	v := m.thumbnail
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *PendingEventMutation) ResetBlockTime() {
+	m.block_time = nil
+	delete(m.clearedFields, pendingevent.FieldBlockTime)
+}
+
+// SetTxID sets the "tx_id" field.
+func (m *PendingEventMutation) SetTxID(s string) {
+	m.tx_id = &s
+}
+
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *PendingEventMutation) TxID() (r string, exists bool) {
+	v := m.tx_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnail returns the old "thumbnail" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldTxID returns the old "tx_id" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+func (m *PendingEventMutation) OldTxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+		return v, errors.New("OldTxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
 	}
-	return oldValue.Thumbnail, nil
+	return oldValue.TxID, nil
 }
 
-// ResetThumbnail resets all changes to the "thumbnail" field.
-func (m *EventPassMutation) ResetThumbnail() {
-	m.thumbnail = nil
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *PendingEventMutation) ResetTxID() {
+	m.tx_id = nil
 }
 
-// SetEventType sets the "event_type" field.
-func (m *EventPassMutation) SetEventType(u uint8) {
-	m.event_type = &u
-	m.addevent_type = nil
+// SetTxIndex sets the "tx_index" field.
+func (m *PendingEventMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
 }
 
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *EventPassMutation) EventType() (r uint8, exists bool) {
-	v := m.event_type
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *PendingEventMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventType returns the old "event_type" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldTxIndex returns the old "tx_index" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldEventType(ctx context.Context) (v uint8, err error) {
+func (m *PendingEventMutation) OldTxIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
 	}
-	return oldValue.EventType, nil
+	return oldValue.TxIndex, nil
 }
 
-// AddEventType adds u to the "event_type" field.
-func (m *EventPassMutation) AddEventType(u int8) {
-	if m.addevent_type != nil {
-		*m.addevent_type += u
+// AddTxIndex adds i to the "tx_index" field.
+func (m *PendingEventMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
 	} else {
-		m.addevent_type = &u
+		m.addtx_index = &i
 	}
 }
 
-// AddedEventType returns the value that was added to the "event_type" field in this mutation.
-func (m *EventPassMutation) AddedEventType() (r int8, exists bool) {
-	v := m.addevent_type
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *PendingEventMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEventType resets all changes to the "event_type" field.
-func (m *EventPassMutation) ResetEventType() {
-	m.event_type = nil
-	m.addevent_type = nil
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *PendingEventMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
 }
 
-// SetIsUsed sets the "is_used" field.
-func (m *EventPassMutation) SetIsUsed(b bool) {
-	m.is_used = &b
+// SetEventIndex sets the "event_index" field.
+func (m *PendingEventMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
 }
 
-// IsUsed returns the value of the "is_used" field in the mutation.
-func (m *EventPassMutation) IsUsed() (r bool, exists bool) {
-	v := m.is_used
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *PendingEventMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUsed returns the old "is_used" field's value of the EventPass entity.
-// If the EventPass object wasn't provided to the builder, the object is fetched from the database.
+// OldEventIndex returns the old "event_index" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventPassMutation) OldIsUsed(ctx context.Context) (v bool, err error) {
+func (m *PendingEventMutation) OldEventIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUsed requires an ID field in the mutation")
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
 	}
-	return oldValue.IsUsed, nil
+	return oldValue.EventIndex, nil
 }
 
-// ResetIsUsed resets all changes to the "is_used" field.
-func (m *EventPassMutation) ResetIsUsed() {
-	m.is_used = nil
+// AddEventIndex adds i to the "event_index" field.
+func (m *PendingEventMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *EventPassMutation) SetOwnerID(id int) {
-	m.owner = &id
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *PendingEventMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *EventPassMutation) ClearOwner() {
-	m.clearedowner = true
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *PendingEventMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *EventPassMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetType sets the "type" field.
+func (m *PendingEventMutation) SetType(s string) {
+	m._type = &s
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *EventPassMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// GetType returns the value of the "type" field in the mutation.
+func (m *PendingEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *EventPassMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldType returns the old "type" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *EventPassMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// SetEventID sets the "event" edge to the Event entity by id.
-func (m *EventPassMutation) SetEventID(id int) {
-	m.event = &id
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
 }
 
-// ClearEvent clears the "event" edge to the Event entity.
-func (m *EventPassMutation) ClearEvent() {
-	m.clearedevent = true
+// ResetType resets all changes to the "type" field.
+func (m *PendingEventMutation) ResetType() {
+	m._type = nil
 }
 
-// EventCleared reports if the "event" edge to the Event entity was cleared.
-func (m *EventPassMutation) EventCleared() bool {
-	return m.clearedevent
+// SetPayload sets the "payload" field.
+func (m *PendingEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
 }
 
-// EventID returns the "event" edge ID in the mutation.
-func (m *EventPassMutation) EventID() (id int, exists bool) {
-	if m.event != nil {
-		return *m.event, true
+// Payload returns the value of the "payload" field in the mutation.
+func (m *PendingEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// EventIDs returns the "event" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EventID instead. It exists only for internal usage by the builders.
-func (m *EventPassMutation) EventIDs() (ids []int) {
-	if id := m.event; id != nil {
-		ids = append(ids, *id)
+// OldPayload returns the old "payload" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetEvent resets all changes to the "event" edge.
-func (m *EventPassMutation) ResetEvent() {
-	m.event = nil
-	m.clearedevent = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// SetMomentID sets the "moment" edge to the NFTMoment entity by id.
-func (m *EventPassMutation) SetMomentID(id int) {
-	m.moment = &id
+// AppendPayload adds jm to the "payload" field.
+func (m *PendingEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
 }
 
-// ClearMoment clears the "moment" edge to the NFTMoment entity.
-func (m *EventPassMutation) ClearMoment() {
-	m.clearedmoment = true
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *PendingEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
 }
 
-// MomentCleared reports if the "moment" edge to the NFTMoment entity was cleared.
-func (m *EventPassMutation) MomentCleared() bool {
-	return m.clearedmoment
+// ResetPayload resets all changes to the "payload" field.
+func (m *PendingEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
 }
 
-// MomentID returns the "moment" edge ID in the mutation.
-func (m *EventPassMutation) MomentID() (id int, exists bool) {
-	if m.moment != nil {
-		return *m.moment, true
+// SetCreatedAt sets the "created_at" field.
+func (m *PendingEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PendingEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MomentIDs returns the "moment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MomentID instead. It exists only for internal usage by the builders.
-func (m *EventPassMutation) MomentIDs() (ids []int) {
-	if id := m.moment; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the PendingEvent entity.
+// If the PendingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PendingEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMoment resets all changes to the "moment" edge.
-func (m *EventPassMutation) ResetMoment() {
-	m.moment = nil
-	m.clearedmoment = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PendingEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the EventPassMutation builder.
-func (m *EventPassMutation) Where(ps ...predicate.EventPass) {
+// Where appends a list predicates to the PendingEventMutation builder.
+func (m *PendingEventMutation) Where(ps ...predicate.PendingEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EventPassMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PendingEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventPassMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EventPass, len(ps))
+func (m *PendingEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PendingEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2327,42 +10140,57 @@ func (m *EventPassMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EventPassMutation) Op() Op {
+func (m *PendingEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EventPassMutation) SetOp(op Op) {
+func (m *PendingEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EventPass).
-func (m *EventPassMutation) Type() string {
+// Type returns the node type of this mutation (PendingEvent).
+func (m *PendingEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EventPassMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.pass_id != nil {
-		fields = append(fields, eventpass.FieldPassID)
+func (m *PendingEventMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.entity != nil {
+		fields = append(fields, pendingevent.FieldEntity)
 	}
-	if m.name != nil {
-		fields = append(fields, eventpass.FieldName)
+	if m.entity_key != nil {
+		fields = append(fields, pendingevent.FieldEntityKey)
 	}
-	if m.description != nil {
-		fields = append(fields, eventpass.FieldDescription)
+	if m.block_height != nil {
+		fields = append(fields, pendingevent.FieldBlockHeight)
 	}
-	if m.thumbnail != nil {
-		fields = append(fields, eventpass.FieldThumbnail)
+	if m.block_id != nil {
+		fields = append(fields, pendingevent.FieldBlockID)
 	}
-	if m.event_type != nil {
-		fields = append(fields, eventpass.FieldEventType)
+	if m.block_time != nil {
+		fields = append(fields, pendingevent.FieldBlockTime)
 	}
-	if m.is_used != nil {
-		fields = append(fields, eventpass.FieldIsUsed)
+	if m.tx_id != nil {
+		fields = append(fields, pendingevent.FieldTxID)
+	}
+	if m.tx_index != nil {
+		fields = append(fields, pendingevent.FieldTxIndex)
+	}
+	if m.event_index != nil {
+		fields = append(fields, pendingevent.FieldEventIndex)
+	}
+	if m._type != nil {
+		fields = append(fields, pendingevent.FieldType)
+	}
+	if m.payload != nil {
+		fields = append(fields, pendingevent.FieldPayload)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pendingevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2370,20 +10198,30 @@ func (m *EventPassMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EventPassMutation) Field(name string) (ent.Value, bool) {
+func (m *PendingEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case eventpass.FieldPassID:
-		return m.PassID()
-	case eventpass.FieldName:
-		return m.Name()
-	case eventpass.FieldDescription:
-		return m.Description()
-	case eventpass.FieldThumbnail:
-		return m.Thumbnail()
-	case eventpass.FieldEventType:
-		return m.EventType()
-	case eventpass.FieldIsUsed:
-		return m.IsUsed()
+	case pendingevent.FieldEntity:
+		return m.Entity()
+	case pendingevent.FieldEntityKey:
+		return m.EntityKey()
+	case pendingevent.FieldBlockHeight:
+		return m.BlockHeight()
+	case pendingevent.FieldBlockID:
+		return m.BlockID()
+	case pendingevent.FieldBlockTime:
+		return m.BlockTime()
+	case pendingevent.FieldTxID:
+		return m.TxID()
+	case pendingevent.FieldTxIndex:
+		return m.TxIndex()
+	case pendingevent.FieldEventIndex:
+		return m.EventIndex()
+	case pendingevent.FieldType:
+		return m.GetType()
+	case pendingevent.FieldPayload:
+		return m.Payload()
+	case pendingevent.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -2391,84 +10229,132 @@ func (m *EventPassMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EventPassMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PendingEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case eventpass.FieldPassID:
-		return m.OldPassID(ctx)
-	case eventpass.FieldName:
-		return m.OldName(ctx)
-	case eventpass.FieldDescription:
-		return m.OldDescription(ctx)
-	case eventpass.FieldThumbnail:
-		return m.OldThumbnail(ctx)
-	case eventpass.FieldEventType:
-		return m.OldEventType(ctx)
-	case eventpass.FieldIsUsed:
-		return m.OldIsUsed(ctx)
-	}
-	return nil, fmt.Errorf("unknown EventPass field %s", name)
+	case pendingevent.FieldEntity:
+		return m.OldEntity(ctx)
+	case pendingevent.FieldEntityKey:
+		return m.OldEntityKey(ctx)
+	case pendingevent.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case pendingevent.FieldBlockID:
+		return m.OldBlockID(ctx)
+	case pendingevent.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case pendingevent.FieldTxID:
+		return m.OldTxID(ctx)
+	case pendingevent.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case pendingevent.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case pendingevent.FieldType:
+		return m.OldType(ctx)
+	case pendingevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case pendingevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PendingEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventPassMutation) SetField(name string, value ent.Value) error {
+func (m *PendingEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case eventpass.FieldPassID:
+	case pendingevent.FieldEntity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntity(v)
+		return nil
+	case pendingevent.FieldEntityKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityKey(v)
+		return nil
+	case pendingevent.FieldBlockHeight:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassID(v)
+		m.SetBlockHeight(v)
 		return nil
-	case eventpass.FieldName:
+	case pendingevent.FieldBlockID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBlockID(v)
 		return nil
-	case eventpass.FieldDescription:
+	case pendingevent.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case pendingevent.FieldTxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTxID(v)
 		return nil
-	case eventpass.FieldThumbnail:
+	case pendingevent.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxIndex(v)
+		return nil
+	case pendingevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case pendingevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnail(v)
+		m.SetType(v)
 		return nil
-	case eventpass.FieldEventType:
-		v, ok := value.(uint8)
+	case pendingevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventType(v)
+		m.SetPayload(v)
 		return nil
-	case eventpass.FieldIsUsed:
-		v, ok := value.(bool)
+	case pendingevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUsed(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EventPass field %s", name)
+	return fmt.Errorf("unknown PendingEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EventPassMutation) AddedFields() []string {
+func (m *PendingEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addpass_id != nil {
-		fields = append(fields, eventpass.FieldPassID)
+	if m.addblock_height != nil {
+		fields = append(fields, pendingevent.FieldBlockHeight)
 	}
-	if m.addevent_type != nil {
-		fields = append(fields, eventpass.FieldEventType)
+	if m.addtx_index != nil {
+		fields = append(fields, pendingevent.FieldTxIndex)
+	}
+	if m.addevent_index != nil {
+		fields = append(fields, pendingevent.FieldEventIndex)
 	}
 	return fields
 }
@@ -2476,12 +10362,14 @@ func (m *EventPassMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EventPassMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PendingEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case eventpass.FieldPassID:
-		return m.AddedPassID()
-	case eventpass.FieldEventType:
-		return m.AddedEventType()
+	case pendingevent.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case pendingevent.FieldTxIndex:
+		return m.AddedTxIndex()
+	case pendingevent.FieldEventIndex:
+		return m.AddedEventIndex()
 	}
 	return nil, false
 }
@@ -2489,215 +10377,186 @@ func (m *EventPassMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventPassMutation) AddField(name string, value ent.Value) error {
+func (m *PendingEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case eventpass.FieldPassID:
+	case pendingevent.FieldBlockHeight:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPassID(v)
+		m.AddBlockHeight(v)
 		return nil
-	case eventpass.FieldEventType:
-		v, ok := value.(int8)
+	case pendingevent.FieldTxIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEventType(v)
+		m.AddTxIndex(v)
+		return nil
+	case pendingevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EventPass numeric field %s", name)
+	return fmt.Errorf("unknown PendingEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EventPassMutation) ClearedFields() []string {
-	return nil
+func (m *PendingEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pendingevent.FieldBlockTime) {
+		fields = append(fields, pendingevent.FieldBlockTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EventPassMutation) FieldCleared(name string) bool {
+func (m *PendingEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EventPassMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EventPass nullable field %s", name)
+func (m *PendingEventMutation) ClearField(name string) error {
+	switch name {
+	case pendingevent.FieldBlockTime:
+		m.ClearBlockTime()
+		return nil
+	}
+	return fmt.Errorf("unknown PendingEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EventPassMutation) ResetField(name string) error {
+func (m *PendingEventMutation) ResetField(name string) error {
 	switch name {
-	case eventpass.FieldPassID:
-		m.ResetPassID()
+	case pendingevent.FieldEntity:
+		m.ResetEntity()
 		return nil
-	case eventpass.FieldName:
-		m.ResetName()
+	case pendingevent.FieldEntityKey:
+		m.ResetEntityKey()
 		return nil
-	case eventpass.FieldDescription:
-		m.ResetDescription()
+	case pendingevent.FieldBlockHeight:
+		m.ResetBlockHeight()
 		return nil
-	case eventpass.FieldThumbnail:
-		m.ResetThumbnail()
+	case pendingevent.FieldBlockID:
+		m.ResetBlockID()
 		return nil
-	case eventpass.FieldEventType:
-		m.ResetEventType()
+	case pendingevent.FieldBlockTime:
+		m.ResetBlockTime()
 		return nil
-	case eventpass.FieldIsUsed:
-		m.ResetIsUsed()
+	case pendingevent.FieldTxID:
+		m.ResetTxID()
+		return nil
+	case pendingevent.FieldTxIndex:
+		m.ResetTxIndex()
+		return nil
+	case pendingevent.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case pendingevent.FieldType:
+		m.ResetType()
+		return nil
+	case pendingevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case pendingevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown EventPass field %s", name)
+	return fmt.Errorf("unknown PendingEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventPassMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, eventpass.EdgeOwner)
-	}
-	if m.event != nil {
-		edges = append(edges, eventpass.EdgeEvent)
-	}
-	if m.moment != nil {
-		edges = append(edges, eventpass.EdgeMoment)
-	}
+func (m *PendingEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EventPassMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case eventpass.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case eventpass.EdgeEvent:
-		if id := m.event; id != nil {
-			return []ent.Value{*id}
-		}
-	case eventpass.EdgeMoment:
-		if id := m.moment; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PendingEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventPassMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *PendingEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EventPassMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventPassMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, eventpass.EdgeOwner)
-	}
-	if m.clearedevent {
-		edges = append(edges, eventpass.EdgeEvent)
-	}
-	if m.clearedmoment {
-		edges = append(edges, eventpass.EdgeMoment)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EventPassMutation) EdgeCleared(name string) bool {
-	switch name {
-	case eventpass.EdgeOwner:
-		return m.clearedowner
-	case eventpass.EdgeEvent:
-		return m.clearedevent
-	case eventpass.EdgeMoment:
-		return m.clearedmoment
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EventPassMutation) ClearEdge(name string) error {
-	switch name {
-	case eventpass.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case eventpass.EdgeEvent:
-		m.ClearEvent()
-		return nil
-	case eventpass.EdgeMoment:
-		m.ClearMoment()
-		return nil
-	}
-	return fmt.Errorf("unknown EventPass unique edge %s", name)
+func (m *PendingEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EventPassMutation) ResetEdge(name string) error {
-	switch name {
-	case eventpass.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case eventpass.EdgeEvent:
-		m.ResetEvent()
-		return nil
-	case eventpass.EdgeMoment:
-		m.ResetMoment()
-		return nil
-	}
-	return fmt.Errorf("unknown EventPass edge %s", name)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PendingEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ListingMutation represents an operation that mutates the Listing nodes in the graph.
-type ListingMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *int
-	listing_id           *uint64
-	addlisting_id        *int64
-	price                *float64
-	addprice             *float64
-	payment_vault_type   *string
-	custom_id            *string
-	expiry               *time.Time
-	clearedFields        map[string]struct{}
-	seller               *int
-	clearedseller        bool
-	nft_accessory        *int
-	clearednft_accessory bool
-	done                 bool
-	oldValue             func(context.Context) (*Listing, error)
-	predicates           []predicate.Listing
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PendingEventMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-var _ ent.Mutation = (*ListingMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PendingEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PendingEvent unique edge %s", name)
+}
 
-// listingOption allows management of the mutation configuration using functional options.
-type listingOption func(*ListingMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PendingEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PendingEvent edge %s", name)
+}
 
-// newListingMutation creates new mutation for the Listing entity.
-func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
-	m := &ListingMutation{
+// RawEventMutation represents an operation that mutates the RawEvent nodes in the graph.
+type RawEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	block_height    *uint64
+	addblock_height *int64
+	block_id        *string
+	block_time      *time.Time
+	tx_id           *string
+	tx_index        *int
+	addtx_index     *int
+	event_index     *int
+	addevent_index  *int
+	_type           *string
+	payload         *json.RawMessage
+	appendpayload   json.RawMessage
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RawEvent, error)
+	predicates      []predicate.RawEvent
+}
+
+var _ ent.Mutation = (*RawEventMutation)(nil)
+
+// raweventOption allows management of the mutation configuration using functional options.
+type raweventOption func(*RawEventMutation)
+
+// newRawEventMutation creates new mutation for the RawEvent entity.
+func newRawEventMutation(c config, op Op, opts ...raweventOption) *RawEventMutation {
+	m := &RawEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeListing,
+		typ:           TypeRawEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2706,20 +10565,20 @@ func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation
 	return m
 }
 
-// withListingID sets the ID field of the mutation.
-func withListingID(id int) listingOption {
-	return func(m *ListingMutation) {
+// withRawEventID sets the ID field of the mutation.
+func withRawEventID(id int) raweventOption {
+	return func(m *RawEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Listing
+			value *RawEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Listing, error) {
+		m.oldValue = func(ctx context.Context) (*RawEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Listing.Get(ctx, id)
+					value, err = m.Client().RawEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2728,10 +10587,10 @@ func withListingID(id int) listingOption {
 	}
 }
 
-// withListing sets the old Listing of the mutation.
-func withListing(node *Listing) listingOption {
-	return func(m *ListingMutation) {
-		m.oldValue = func(context.Context) (*Listing, error) {
+// withRawEvent sets the old RawEvent of the mutation.
+func withRawEvent(node *RawEvent) raweventOption {
+	return func(m *RawEventMutation) {
+		m.oldValue = func(context.Context) (*RawEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2740,7 +10599,7 @@ func withListing(node *Listing) listingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ListingMutation) Client() *Client {
+func (m RawEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2748,7 +10607,7 @@ func (m ListingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ListingMutation) Tx() (*Tx, error) {
+func (m RawEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2759,7 +10618,7 @@ func (m ListingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ListingMutation) ID() (id int, exists bool) {
+func (m *RawEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2770,7 +10629,7 @@ func (m *ListingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ListingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *RawEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2779,332 +10638,433 @@ func (m *ListingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RawEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetListingID sets the "listing_id" field.
-func (m *ListingMutation) SetListingID(u uint64) {
-	m.listing_id = &u
-	m.addlisting_id = nil
+// SetBlockHeight sets the "block_height" field.
+func (m *RawEventMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
 }
 
-// ListingID returns the value of the "listing_id" field in the mutation.
-func (m *ListingMutation) ListingID() (r uint64, exists bool) {
-	v := m.listing_id
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *RawEventMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldListingID returns the old "listing_id" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldListingID(ctx context.Context) (v uint64, err error) {
+func (m *RawEventMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldListingID requires an ID field in the mutation")
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
 	}
-	return oldValue.ListingID, nil
+	return oldValue.BlockHeight, nil
 }
 
-// AddListingID adds u to the "listing_id" field.
-func (m *ListingMutation) AddListingID(u int64) {
-	if m.addlisting_id != nil {
-		*m.addlisting_id += u
+// AddBlockHeight adds u to the "block_height" field.
+func (m *RawEventMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
 	} else {
-		m.addlisting_id = &u
+		m.addblock_height = &u
 	}
 }
 
-// AddedListingID returns the value that was added to the "listing_id" field in this mutation.
-func (m *ListingMutation) AddedListingID() (r int64, exists bool) {
-	v := m.addlisting_id
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *RawEventMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetListingID resets all changes to the "listing_id" field.
-func (m *ListingMutation) ResetListingID() {
-	m.listing_id = nil
-	m.addlisting_id = nil
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *RawEventMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *ListingMutation) SetPrice(f float64) {
-	m.price = &f
-	m.addprice = nil
+// SetBlockID sets the "block_id" field.
+func (m *RawEventMutation) SetBlockID(s string) {
+	m.block_id = &s
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *ListingMutation) Price() (r float64, exists bool) {
-	v := m.price
+// BlockID returns the value of the "block_id" field in the mutation.
+func (m *RawEventMutation) BlockID() (r string, exists bool) {
+	v := m.block_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockID returns the old "block_id" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldPrice(ctx context.Context) (v float64, err error) {
+func (m *RawEventMutation) OldBlockID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldBlockID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockID: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.BlockID, nil
 }
 
-// AddPrice adds f to the "price" field.
-func (m *ListingMutation) AddPrice(f float64) {
-	if m.addprice != nil {
-		*m.addprice += f
-	} else {
-		m.addprice = &f
-	}
+// ResetBlockID resets all changes to the "block_id" field.
+func (m *RawEventMutation) ResetBlockID() {
+	m.block_id = nil
 }
 
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *ListingMutation) AddedPrice() (r float64, exists bool) {
-	v := m.addprice
+// SetBlockTime sets the "block_time" field.
+func (m *RawEventMutation) SetBlockTime(t time.Time) {
+	m.block_time = &t
+}
+
+// BlockTime returns the value of the "block_time" field in the mutation.
+func (m *RawEventMutation) BlockTime() (r time.Time, exists bool) {
+	v := m.block_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *ListingMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// OldBlockTime returns the old "block_time" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldBlockTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockTime: %w", err)
+	}
+	return oldValue.BlockTime, nil
 }
 
-// SetPaymentVaultType sets the "payment_vault_type" field.
-func (m *ListingMutation) SetPaymentVaultType(s string) {
-	m.payment_vault_type = &s
+// ClearBlockTime clears the value of the "block_time" field.
+func (m *RawEventMutation) ClearBlockTime() {
+	m.block_time = nil
+	m.clearedFields[rawevent.FieldBlockTime] = struct{}{}
 }
 
-// PaymentVaultType returns the value of the "payment_vault_type" field in the mutation.
-func (m *ListingMutation) PaymentVaultType() (r string, exists bool) {
-	v := m.payment_vault_type
+// BlockTimeCleared returns if the "block_time" field was cleared in this mutation.
+func (m *RawEventMutation) BlockTimeCleared() bool {
+	_, ok := m.clearedFields[rawevent.FieldBlockTime]
+	return ok
+}
+
+// ResetBlockTime resets all changes to the "block_time" field.
+func (m *RawEventMutation) ResetBlockTime() {
+	m.block_time = nil
+	delete(m.clearedFields, rawevent.FieldBlockTime)
+}
+
+// SetTxID sets the "tx_id" field.
+func (m *RawEventMutation) SetTxID(s string) {
+	m.tx_id = &s
+}
+
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *RawEventMutation) TxID() (r string, exists bool) {
+	v := m.tx_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPaymentVaultType returns the old "payment_vault_type" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldTxID returns the old "tx_id" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldPaymentVaultType(ctx context.Context) (v string, err error) {
+func (m *RawEventMutation) OldTxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentVaultType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentVaultType requires an ID field in the mutation")
+		return v, errors.New("OldTxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentVaultType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
 	}
-	return oldValue.PaymentVaultType, nil
+	return oldValue.TxID, nil
 }
 
-// ResetPaymentVaultType resets all changes to the "payment_vault_type" field.
-func (m *ListingMutation) ResetPaymentVaultType() {
-	m.payment_vault_type = nil
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *RawEventMutation) ResetTxID() {
+	m.tx_id = nil
 }
 
-// SetCustomID sets the "custom_id" field.
-func (m *ListingMutation) SetCustomID(s string) {
-	m.custom_id = &s
+// SetTxIndex sets the "tx_index" field.
+func (m *RawEventMutation) SetTxIndex(i int) {
+	m.tx_index = &i
+	m.addtx_index = nil
 }
 
-// CustomID returns the value of the "custom_id" field in the mutation.
-func (m *ListingMutation) CustomID() (r string, exists bool) {
-	v := m.custom_id
+// TxIndex returns the value of the "tx_index" field in the mutation.
+func (m *RawEventMutation) TxIndex() (r int, exists bool) {
+	v := m.tx_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCustomID returns the old "custom_id" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldTxIndex returns the old "tx_index" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldCustomID(ctx context.Context) (v *string, err error) {
+func (m *RawEventMutation) OldTxIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCustomID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCustomID requires an ID field in the mutation")
+		return v, errors.New("OldTxIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCustomID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxIndex: %w", err)
 	}
-	return oldValue.CustomID, nil
+	return oldValue.TxIndex, nil
 }
 
-// ClearCustomID clears the value of the "custom_id" field.
-func (m *ListingMutation) ClearCustomID() {
-	m.custom_id = nil
-	m.clearedFields[listing.FieldCustomID] = struct{}{}
+// AddTxIndex adds i to the "tx_index" field.
+func (m *RawEventMutation) AddTxIndex(i int) {
+	if m.addtx_index != nil {
+		*m.addtx_index += i
+	} else {
+		m.addtx_index = &i
+	}
 }
 
-// CustomIDCleared returns if the "custom_id" field was cleared in this mutation.
-func (m *ListingMutation) CustomIDCleared() bool {
-	_, ok := m.clearedFields[listing.FieldCustomID]
-	return ok
+// AddedTxIndex returns the value that was added to the "tx_index" field in this mutation.
+func (m *RawEventMutation) AddedTxIndex() (r int, exists bool) {
+	v := m.addtx_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCustomID resets all changes to the "custom_id" field.
-func (m *ListingMutation) ResetCustomID() {
-	m.custom_id = nil
-	delete(m.clearedFields, listing.FieldCustomID)
+// ResetTxIndex resets all changes to the "tx_index" field.
+func (m *RawEventMutation) ResetTxIndex() {
+	m.tx_index = nil
+	m.addtx_index = nil
 }
 
-// SetExpiry sets the "expiry" field.
-func (m *ListingMutation) SetExpiry(t time.Time) {
-	m.expiry = &t
+// SetEventIndex sets the "event_index" field.
+func (m *RawEventMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
 }
 
-// Expiry returns the value of the "expiry" field in the mutation.
-func (m *ListingMutation) Expiry() (r time.Time, exists bool) {
-	v := m.expiry
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *RawEventMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiry returns the old "expiry" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldEventIndex returns the old "event_index" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldExpiry(ctx context.Context) (v time.Time, err error) {
+func (m *RawEventMutation) OldEventIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiry is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiry requires an ID field in the mutation")
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiry: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
 	}
-	return oldValue.Expiry, nil
+	return oldValue.EventIndex, nil
 }
 
-// ResetExpiry resets all changes to the "expiry" field.
-func (m *ListingMutation) ResetExpiry() {
-	m.expiry = nil
+// AddEventIndex adds i to the "event_index" field.
+func (m *RawEventMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
 }
 
-// SetSellerID sets the "seller" edge to the User entity by id.
-func (m *ListingMutation) SetSellerID(id int) {
-	m.seller = &id
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *RawEventMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSeller clears the "seller" edge to the User entity.
-func (m *ListingMutation) ClearSeller() {
-	m.clearedseller = true
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *RawEventMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
 }
 
-// SellerCleared reports if the "seller" edge to the User entity was cleared.
-func (m *ListingMutation) SellerCleared() bool {
-	return m.clearedseller
+// SetType sets the "type" field.
+func (m *RawEventMutation) SetType(s string) {
+	m._type = &s
 }
 
-// SellerID returns the "seller" edge ID in the mutation.
-func (m *ListingMutation) SellerID() (id int, exists bool) {
-	if m.seller != nil {
-		return *m.seller, true
+// GetType returns the value of the "type" field in the mutation.
+func (m *RawEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SellerIDs returns the "seller" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SellerID instead. It exists only for internal usage by the builders.
-func (m *ListingMutation) SellerIDs() (ids []int) {
-	if id := m.seller; id != nil {
-		ids = append(ids, *id)
+// OldType returns the old "type" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RawEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *RawEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *RawEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// ResetSeller resets all changes to the "seller" edge.
-func (m *ListingMutation) ResetSeller() {
-	m.seller = nil
-	m.clearedseller = false
+// AppendPayload adds jm to the "payload" field.
+func (m *RawEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
 }
 
-// SetNftAccessoryID sets the "nft_accessory" edge to the NFTAccessory entity by id.
-func (m *ListingMutation) SetNftAccessoryID(id int) {
-	m.nft_accessory = &id
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *RawEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
 }
 
-// ClearNftAccessory clears the "nft_accessory" edge to the NFTAccessory entity.
-func (m *ListingMutation) ClearNftAccessory() {
-	m.clearednft_accessory = true
+// ResetPayload resets all changes to the "payload" field.
+func (m *RawEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
 }
 
-// NftAccessoryCleared reports if the "nft_accessory" edge to the NFTAccessory entity was cleared.
-func (m *ListingMutation) NftAccessoryCleared() bool {
-	return m.clearednft_accessory
+// SetCreatedAt sets the "created_at" field.
+func (m *RawEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// NftAccessoryID returns the "nft_accessory" edge ID in the mutation.
-func (m *ListingMutation) NftAccessoryID() (id int, exists bool) {
-	if m.nft_accessory != nil {
-		return *m.nft_accessory, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RawEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// NftAccessoryIDs returns the "nft_accessory" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NftAccessoryID instead. It exists only for internal usage by the builders.
-func (m *ListingMutation) NftAccessoryIDs() (ids []int) {
-	if id := m.nft_accessory; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the RawEvent entity.
+// If the RawEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RawEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetNftAccessory resets all changes to the "nft_accessory" edge.
-func (m *ListingMutation) ResetNftAccessory() {
-	m.nft_accessory = nil
-	m.clearednft_accessory = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RawEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the ListingMutation builder.
-func (m *ListingMutation) Where(ps ...predicate.Listing) {
+// Where appends a list predicates to the RawEventMutation builder.
+func (m *RawEventMutation) Where(ps ...predicate.RawEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ListingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RawEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Listing, len(ps))
+func (m *RawEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RawEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3112,39 +11072,51 @@ func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ListingMutation) Op() Op {
+func (m *RawEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ListingMutation) SetOp(op Op) {
+func (m *RawEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Listing).
-func (m *ListingMutation) Type() string {
+// Type returns the node type of this mutation (RawEvent).
+func (m *RawEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ListingMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.listing_id != nil {
-		fields = append(fields, listing.FieldListingID)
+func (m *RawEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.block_height != nil {
+		fields = append(fields, rawevent.FieldBlockHeight)
 	}
-	if m.price != nil {
-		fields = append(fields, listing.FieldPrice)
+	if m.block_id != nil {
+		fields = append(fields, rawevent.FieldBlockID)
 	}
-	if m.payment_vault_type != nil {
-		fields = append(fields, listing.FieldPaymentVaultType)
+	if m.block_time != nil {
+		fields = append(fields, rawevent.FieldBlockTime)
 	}
-	if m.custom_id != nil {
-		fields = append(fields, listing.FieldCustomID)
+	if m.tx_id != nil {
+		fields = append(fields, rawevent.FieldTxID)
 	}
-	if m.expiry != nil {
-		fields = append(fields, listing.FieldExpiry)
+	if m.tx_index != nil {
+		fields = append(fields, rawevent.FieldTxIndex)
+	}
+	if m.event_index != nil {
+		fields = append(fields, rawevent.FieldEventIndex)
+	}
+	if m._type != nil {
+		fields = append(fields, rawevent.FieldType)
+	}
+	if m.payload != nil {
+		fields = append(fields, rawevent.FieldPayload)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rawevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3152,18 +11124,26 @@ func (m *ListingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+func (m *RawEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case listing.FieldListingID:
-		return m.ListingID()
-	case listing.FieldPrice:
-		return m.Price()
-	case listing.FieldPaymentVaultType:
-		return m.PaymentVaultType()
-	case listing.FieldCustomID:
-		return m.CustomID()
-	case listing.FieldExpiry:
-		return m.Expiry()
+	case rawevent.FieldBlockHeight:
+		return m.BlockHeight()
+	case rawevent.FieldBlockID:
+		return m.BlockID()
+	case rawevent.FieldBlockTime:
+		return m.BlockTime()
+	case rawevent.FieldTxID:
+		return m.TxID()
+	case rawevent.FieldTxIndex:
+		return m.TxIndex()
+	case rawevent.FieldEventIndex:
+		return m.EventIndex()
+	case rawevent.FieldType:
+		return m.GetType()
+	case rawevent.FieldPayload:
+		return m.Payload()
+	case rawevent.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -3171,75 +11151,114 @@ func (m *ListingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RawEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case listing.FieldListingID:
-		return m.OldListingID(ctx)
-	case listing.FieldPrice:
-		return m.OldPrice(ctx)
-	case listing.FieldPaymentVaultType:
-		return m.OldPaymentVaultType(ctx)
-	case listing.FieldCustomID:
-		return m.OldCustomID(ctx)
-	case listing.FieldExpiry:
-		return m.OldExpiry(ctx)
-	}
-	return nil, fmt.Errorf("unknown Listing field %s", name)
+	case rawevent.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case rawevent.FieldBlockID:
+		return m.OldBlockID(ctx)
+	case rawevent.FieldBlockTime:
+		return m.OldBlockTime(ctx)
+	case rawevent.FieldTxID:
+		return m.OldTxID(ctx)
+	case rawevent.FieldTxIndex:
+		return m.OldTxIndex(ctx)
+	case rawevent.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case rawevent.FieldType:
+		return m.OldType(ctx)
+	case rawevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case rawevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RawEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListingMutation) SetField(name string, value ent.Value) error {
+func (m *RawEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case listing.FieldListingID:
+	case rawevent.FieldBlockHeight:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetListingID(v)
+		m.SetBlockHeight(v)
 		return nil
-	case listing.FieldPrice:
-		v, ok := value.(float64)
+	case rawevent.FieldBlockID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetBlockID(v)
 		return nil
-	case listing.FieldPaymentVaultType:
+	case rawevent.FieldBlockTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockTime(v)
+		return nil
+	case rawevent.FieldTxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentVaultType(v)
+		m.SetTxID(v)
 		return nil
-	case listing.FieldCustomID:
+	case rawevent.FieldTxIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxIndex(v)
+		return nil
+	case rawevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case rawevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCustomID(v)
+		m.SetType(v)
 		return nil
-	case listing.FieldExpiry:
+	case rawevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case rawevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiry(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Listing field %s", name)
+	return fmt.Errorf("unknown RawEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ListingMutation) AddedFields() []string {
+func (m *RawEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addlisting_id != nil {
-		fields = append(fields, listing.FieldListingID)
+	if m.addblock_height != nil {
+		fields = append(fields, rawevent.FieldBlockHeight)
 	}
-	if m.addprice != nil {
-		fields = append(fields, listing.FieldPrice)
+	if m.addtx_index != nil {
+		fields = append(fields, rawevent.FieldTxIndex)
+	}
+	if m.addevent_index != nil {
+		fields = append(fields, rawevent.FieldEventIndex)
 	}
 	return fields
 }
@@ -3247,12 +11266,14 @@ func (m *ListingMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RawEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case listing.FieldListingID:
-		return m.AddedListingID()
-	case listing.FieldPrice:
-		return m.AddedPrice()
+	case rawevent.FieldBlockHeight:
+		return m.AddedBlockHeight()
+	case rawevent.FieldTxIndex:
+		return m.AddedTxIndex()
+	case rawevent.FieldEventIndex:
+		return m.AddedEventIndex()
 	}
 	return nil, false
 }
@@ -3260,204 +11281,183 @@ func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListingMutation) AddField(name string, value ent.Value) error {
+func (m *RawEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case listing.FieldListingID:
+	case rawevent.FieldBlockHeight:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddListingID(v)
+		m.AddBlockHeight(v)
 		return nil
-	case listing.FieldPrice:
-		v, ok := value.(float64)
+	case rawevent.FieldTxIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPrice(v)
+		m.AddTxIndex(v)
+		return nil
+	case rawevent.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Listing numeric field %s", name)
+	return fmt.Errorf("unknown RawEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ListingMutation) ClearedFields() []string {
+func (m *RawEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(listing.FieldCustomID) {
-		fields = append(fields, listing.FieldCustomID)
+	if m.FieldCleared(rawevent.FieldBlockTime) {
+		fields = append(fields, rawevent.FieldBlockTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ListingMutation) FieldCleared(name string) bool {
+func (m *RawEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ListingMutation) ClearField(name string) error {
+func (m *RawEventMutation) ClearField(name string) error {
 	switch name {
-	case listing.FieldCustomID:
-		m.ClearCustomID()
+	case rawevent.FieldBlockTime:
+		m.ClearBlockTime()
 		return nil
 	}
-	return fmt.Errorf("unknown Listing nullable field %s", name)
+	return fmt.Errorf("unknown RawEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ListingMutation) ResetField(name string) error {
+func (m *RawEventMutation) ResetField(name string) error {
 	switch name {
-	case listing.FieldListingID:
-		m.ResetListingID()
+	case rawevent.FieldBlockHeight:
+		m.ResetBlockHeight()
 		return nil
-	case listing.FieldPrice:
-		m.ResetPrice()
+	case rawevent.FieldBlockID:
+		m.ResetBlockID()
 		return nil
-	case listing.FieldPaymentVaultType:
-		m.ResetPaymentVaultType()
+	case rawevent.FieldBlockTime:
+		m.ResetBlockTime()
 		return nil
-	case listing.FieldCustomID:
-		m.ResetCustomID()
+	case rawevent.FieldTxID:
+		m.ResetTxID()
 		return nil
-	case listing.FieldExpiry:
-		m.ResetExpiry()
+	case rawevent.FieldTxIndex:
+		m.ResetTxIndex()
+		return nil
+	case rawevent.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case rawevent.FieldType:
+		m.ResetType()
+		return nil
+	case rawevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case rawevent.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Listing field %s", name)
+	return fmt.Errorf("unknown RawEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ListingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.seller != nil {
-		edges = append(edges, listing.EdgeSeller)
-	}
-	if m.nft_accessory != nil {
-		edges = append(edges, listing.EdgeNftAccessory)
-	}
+func (m *RawEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ListingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case listing.EdgeSeller:
-		if id := m.seller; id != nil {
-			return []ent.Value{*id}
-		}
-	case listing.EdgeNftAccessory:
-		if id := m.nft_accessory; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *RawEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ListingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *RawEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+func (m *RawEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ListingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedseller {
-		edges = append(edges, listing.EdgeSeller)
-	}
-	if m.clearednft_accessory {
-		edges = append(edges, listing.EdgeNftAccessory)
-	}
+func (m *RawEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ListingMutation) EdgeCleared(name string) bool {
-	switch name {
-	case listing.EdgeSeller:
-		return m.clearedseller
-	case listing.EdgeNftAccessory:
-		return m.clearednft_accessory
-	}
+func (m *RawEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ListingMutation) ClearEdge(name string) error {
-	switch name {
-	case listing.EdgeSeller:
-		m.ClearSeller()
-		return nil
-	case listing.EdgeNftAccessory:
-		m.ClearNftAccessory()
-		return nil
-	}
-	return fmt.Errorf("unknown Listing unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ListingMutation) ResetEdge(name string) error {
-	switch name {
-	case listing.EdgeSeller:
-		m.ResetSeller()
-		return nil
-	case listing.EdgeNftAccessory:
-		m.ResetNftAccessory()
-		return nil
-	}
-	return fmt.Errorf("unknown Listing edge %s", name)
-}
-
-// NFTAccessoryMutation represents an operation that mutates the NFTAccessory nodes in the graph.
-type NFTAccessoryMutation struct {
-	config
-	op                        Op
-	typ                       string
-	id                        *int
-	nft_id                    *uint64
-	addnft_id                 *int64
-	name                      *string
-	description               *string
-	thumbnail                 *string
-	equipment_type            *string
-	clearedFields             map[string]struct{}
-	owner                     *int
-	clearedowner              bool
-	equipped_on_moment        *int
-	clearedequipped_on_moment bool
-	listing                   *int
-	clearedlisting            bool
-	done                      bool
-	oldValue                  func(context.Context) (*NFTAccessory, error)
-	predicates                []predicate.NFTAccessory
+func (m *RawEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RawEvent unique edge %s", name)
 }
 
-var _ ent.Mutation = (*NFTAccessoryMutation)(nil)
-
-// nftaccessoryOption allows management of the mutation configuration using functional options.
-type nftaccessoryOption func(*NFTAccessoryMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RawEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RawEvent edge %s", name)
+}
 
-// newNFTAccessoryMutation creates new mutation for the NFTAccessory entity.
-func newNFTAccessoryMutation(c config, op Op, opts ...nftaccessoryOption) *NFTAccessoryMutation {
-	m := &NFTAccessoryMutation{
+// SaleMutation represents an operation that mutates the Sale nodes in the graph.
+type SaleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	price              *float64
+	addprice           *float64
+	payment_vault_type *string
+	nft_type           *sale.NftType
+	nft_id             *uint64
+	addnft_id          *int64
+	tx_id              *string
+	block_height       *uint64
+	addblock_height    *int64
+	timestamp          *time.Time
+	clearedFields      map[string]struct{}
+	seller             *int
+	clearedseller      bool
+	buyer              *int
+	clearedbuyer       bool
+	listing            *int
+	clearedlisting     bool
+	done               bool
+	oldValue           func(context.Context) (*Sale, error)
+	predicates         []predicate.Sale
+}
+
+var _ ent.Mutation = (*SaleMutation)(nil)
+
+// saleOption allows management of the mutation configuration using functional options.
+type saleOption func(*SaleMutation)
+
+// newSaleMutation creates new mutation for the Sale entity.
+func newSaleMutation(c config, op Op, opts ...saleOption) *SaleMutation {
+	m := &SaleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNFTAccessory,
+		typ:           TypeSale,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3466,20 +11466,20 @@ func newNFTAccessoryMutation(c config, op Op, opts ...nftaccessoryOption) *NFTAc
 	return m
 }
 
-// withNFTAccessoryID sets the ID field of the mutation.
-func withNFTAccessoryID(id int) nftaccessoryOption {
-	return func(m *NFTAccessoryMutation) {
+// withSaleID sets the ID field of the mutation.
+func withSaleID(id int) saleOption {
+	return func(m *SaleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NFTAccessory
+			value *Sale
 		)
-		m.oldValue = func(ctx context.Context) (*NFTAccessory, error) {
+		m.oldValue = func(ctx context.Context) (*Sale, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NFTAccessory.Get(ctx, id)
+					value, err = m.Client().Sale.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3488,10 +11488,10 @@ func withNFTAccessoryID(id int) nftaccessoryOption {
 	}
 }
 
-// withNFTAccessory sets the old NFTAccessory of the mutation.
-func withNFTAccessory(node *NFTAccessory) nftaccessoryOption {
-	return func(m *NFTAccessoryMutation) {
-		m.oldValue = func(context.Context) (*NFTAccessory, error) {
+// withSale sets the old Sale of the mutation.
+func withSale(node *Sale) saleOption {
+	return func(m *SaleMutation) {
+		m.oldValue = func(context.Context) (*Sale, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3500,7 +11500,7 @@ func withNFTAccessory(node *NFTAccessory) nftaccessoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NFTAccessoryMutation) Client() *Client {
+func (m SaleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3508,7 +11508,7 @@ func (m NFTAccessoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NFTAccessoryMutation) Tx() (*Tx, error) {
+func (m SaleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3519,7 +11519,7 @@ func (m NFTAccessoryMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NFTAccessoryMutation) ID() (id int, exists bool) {
+func (m *SaleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3530,7 +11530,7 @@ func (m *NFTAccessoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NFTAccessoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SaleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3539,20 +11539,148 @@ func (m *NFTAccessoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NFTAccessory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Sale.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetPrice sets the "price" field.
+func (m *SaleMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *SaleMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaleMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *SaleMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *SaleMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *SaleMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (m *SaleMutation) SetPaymentVaultType(s string) {
+	m.payment_vault_type = &s
+}
+
+// PaymentVaultType returns the value of the "payment_vault_type" field in the mutation.
+func (m *SaleMutation) PaymentVaultType() (r string, exists bool) {
+	v := m.payment_vault_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentVaultType returns the old "payment_vault_type" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaleMutation) OldPaymentVaultType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentVaultType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentVaultType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentVaultType: %w", err)
+	}
+	return oldValue.PaymentVaultType, nil
+}
+
+// ResetPaymentVaultType resets all changes to the "payment_vault_type" field.
+func (m *SaleMutation) ResetPaymentVaultType() {
+	m.payment_vault_type = nil
+}
+
+// SetNftType sets the "nft_type" field.
+func (m *SaleMutation) SetNftType(st sale.NftType) {
+	m.nft_type = &st
+}
+
+// NftType returns the value of the "nft_type" field in the mutation.
+func (m *SaleMutation) NftType() (r sale.NftType, exists bool) {
+	v := m.nft_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNftType returns the old "nft_type" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SaleMutation) OldNftType(ctx context.Context) (v sale.NftType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNftType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNftType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNftType: %w", err)
+	}
+	return oldValue.NftType, nil
+}
+
+// ResetNftType resets all changes to the "nft_type" field.
+func (m *SaleMutation) ResetNftType() {
+	m.nft_type = nil
+}
+
 // SetNftID sets the "nft_id" field.
-func (m *NFTAccessoryMutation) SetNftID(u uint64) {
+func (m *SaleMutation) SetNftID(u uint64) {
 	m.nft_id = &u
 	m.addnft_id = nil
 }
 
 // NftID returns the value of the "nft_id" field in the mutation.
-func (m *NFTAccessoryMutation) NftID() (r uint64, exists bool) {
+func (m *SaleMutation) NftID() (r uint64, exists bool) {
 	v := m.nft_id
 	if v == nil {
 		return
@@ -3560,10 +11688,10 @@ func (m *NFTAccessoryMutation) NftID() (r uint64, exists bool) {
 	return *v, true
 }
 
-// OldNftID returns the old "nft_id" field's value of the NFTAccessory entity.
-// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// OldNftID returns the old "nft_id" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTAccessoryMutation) OldNftID(ctx context.Context) (v uint64, err error) {
+func (m *SaleMutation) OldNftID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNftID is only allowed on UpdateOne operations")
 	}
@@ -3578,7 +11706,7 @@ func (m *NFTAccessoryMutation) OldNftID(ctx context.Context) (v uint64, err erro
 }
 
 // AddNftID adds u to the "nft_id" field.
-func (m *NFTAccessoryMutation) AddNftID(u int64) {
+func (m *SaleMutation) AddNftID(u int64) {
 	if m.addnft_id != nil {
 		*m.addnft_id += u
 	} else {
@@ -3587,7 +11715,7 @@ func (m *NFTAccessoryMutation) AddNftID(u int64) {
 }
 
 // AddedNftID returns the value that was added to the "nft_id" field in this mutation.
-func (m *NFTAccessoryMutation) AddedNftID() (r int64, exists bool) {
+func (m *SaleMutation) AddedNftID() (r int64, exists bool) {
 	v := m.addnft_id
 	if v == nil {
 		return
@@ -3596,250 +11724,234 @@ func (m *NFTAccessoryMutation) AddedNftID() (r int64, exists bool) {
 }
 
 // ResetNftID resets all changes to the "nft_id" field.
-func (m *NFTAccessoryMutation) ResetNftID() {
+func (m *SaleMutation) ResetNftID() {
 	m.nft_id = nil
 	m.addnft_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *NFTAccessoryMutation) SetName(s string) {
-	m.name = &s
+// SetTxID sets the "tx_id" field.
+func (m *SaleMutation) SetTxID(s string) {
+	m.tx_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NFTAccessoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *SaleMutation) TxID() (r string, exists bool) {
+	v := m.tx_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NFTAccessory entity.
-// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// OldTxID returns the old "tx_id" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTAccessoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SaleMutation) OldTxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TxID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NFTAccessoryMutation) ResetName() {
-	m.name = nil
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *SaleMutation) ResetTxID() {
+	m.tx_id = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *NFTAccessoryMutation) SetDescription(s string) {
-	m.description = &s
+// SetBlockHeight sets the "block_height" field.
+func (m *SaleMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *NFTAccessoryMutation) Description() (r string, exists bool) {
-	v := m.description
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *SaleMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the NFTAccessory entity.
-// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// OldBlockHeight returns the old "block_height" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTAccessoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *SaleMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *NFTAccessoryMutation) ResetDescription() {
-	m.description = nil
+	return oldValue.BlockHeight, nil
 }
 
-// SetThumbnail sets the "thumbnail" field.
-func (m *NFTAccessoryMutation) SetThumbnail(s string) {
-	m.thumbnail = &s
+// AddBlockHeight adds u to the "block_height" field.
+func (m *SaleMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
+	} else {
+		m.addblock_height = &u
+	}
 }
 
-// Thumbnail returns the value of the "thumbnail" field in the mutation.
-func (m *NFTAccessoryMutation) Thumbnail() (r string, exists bool) {
-	v := m.thumbnail
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *SaleMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnail returns the old "thumbnail" field's value of the NFTAccessory entity.
-// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTAccessoryMutation) OldThumbnail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
-	}
-	return oldValue.Thumbnail, nil
-}
-
-// ResetThumbnail resets all changes to the "thumbnail" field.
-func (m *NFTAccessoryMutation) ResetThumbnail() {
-	m.thumbnail = nil
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *SaleMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// SetEquipmentType sets the "equipment_type" field.
-func (m *NFTAccessoryMutation) SetEquipmentType(s string) {
-	m.equipment_type = &s
+// SetTimestamp sets the "timestamp" field.
+func (m *SaleMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
 }
 
-// EquipmentType returns the value of the "equipment_type" field in the mutation.
-func (m *NFTAccessoryMutation) EquipmentType() (r string, exists bool) {
-	v := m.equipment_type
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *SaleMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEquipmentType returns the old "equipment_type" field's value of the NFTAccessory entity.
-// If the NFTAccessory object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the Sale entity.
+// If the Sale object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTAccessoryMutation) OldEquipmentType(ctx context.Context) (v string, err error) {
+func (m *SaleMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEquipmentType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEquipmentType requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEquipmentType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.EquipmentType, nil
+	return oldValue.Timestamp, nil
 }
 
-// ResetEquipmentType resets all changes to the "equipment_type" field.
-func (m *NFTAccessoryMutation) ResetEquipmentType() {
-	m.equipment_type = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *SaleMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *NFTAccessoryMutation) SetOwnerID(id int) {
-	m.owner = &id
+// SetSellerID sets the "seller" edge to the User entity by id.
+func (m *SaleMutation) SetSellerID(id int) {
+	m.seller = &id
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *NFTAccessoryMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearSeller clears the "seller" edge to the User entity.
+func (m *SaleMutation) ClearSeller() {
+	m.clearedseller = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *NFTAccessoryMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SellerCleared reports if the "seller" edge to the User entity was cleared.
+func (m *SaleMutation) SellerCleared() bool {
+	return m.clearedseller
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *NFTAccessoryMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// SellerID returns the "seller" edge ID in the mutation.
+func (m *SaleMutation) SellerID() (id int, exists bool) {
+	if m.seller != nil {
+		return *m.seller, true
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
+// SellerIDs returns the "seller" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *NFTAccessoryMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
+// SellerID instead. It exists only for internal usage by the builders.
+func (m *SaleMutation) SellerIDs() (ids []int) {
+	if id := m.seller; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *NFTAccessoryMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetSeller resets all changes to the "seller" edge.
+func (m *SaleMutation) ResetSeller() {
+	m.seller = nil
+	m.clearedseller = false
 }
 
-// SetEquippedOnMomentID sets the "equipped_on_moment" edge to the NFTMoment entity by id.
-func (m *NFTAccessoryMutation) SetEquippedOnMomentID(id int) {
-	m.equipped_on_moment = &id
+// SetBuyerID sets the "buyer" edge to the User entity by id.
+func (m *SaleMutation) SetBuyerID(id int) {
+	m.buyer = &id
 }
 
-// ClearEquippedOnMoment clears the "equipped_on_moment" edge to the NFTMoment entity.
-func (m *NFTAccessoryMutation) ClearEquippedOnMoment() {
-	m.clearedequipped_on_moment = true
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (m *SaleMutation) ClearBuyer() {
+	m.clearedbuyer = true
 }
 
-// EquippedOnMomentCleared reports if the "equipped_on_moment" edge to the NFTMoment entity was cleared.
-func (m *NFTAccessoryMutation) EquippedOnMomentCleared() bool {
-	return m.clearedequipped_on_moment
+// BuyerCleared reports if the "buyer" edge to the User entity was cleared.
+func (m *SaleMutation) BuyerCleared() bool {
+	return m.clearedbuyer
 }
 
-// EquippedOnMomentID returns the "equipped_on_moment" edge ID in the mutation.
-func (m *NFTAccessoryMutation) EquippedOnMomentID() (id int, exists bool) {
-	if m.equipped_on_moment != nil {
-		return *m.equipped_on_moment, true
+// BuyerID returns the "buyer" edge ID in the mutation.
+func (m *SaleMutation) BuyerID() (id int, exists bool) {
+	if m.buyer != nil {
+		return *m.buyer, true
 	}
 	return
 }
 
-// EquippedOnMomentIDs returns the "equipped_on_moment" edge IDs in the mutation.
+// BuyerIDs returns the "buyer" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EquippedOnMomentID instead. It exists only for internal usage by the builders.
-func (m *NFTAccessoryMutation) EquippedOnMomentIDs() (ids []int) {
-	if id := m.equipped_on_moment; id != nil {
+// BuyerID instead. It exists only for internal usage by the builders.
+func (m *SaleMutation) BuyerIDs() (ids []int) {
+	if id := m.buyer; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEquippedOnMoment resets all changes to the "equipped_on_moment" edge.
-func (m *NFTAccessoryMutation) ResetEquippedOnMoment() {
-	m.equipped_on_moment = nil
-	m.clearedequipped_on_moment = false
+// ResetBuyer resets all changes to the "buyer" edge.
+func (m *SaleMutation) ResetBuyer() {
+	m.buyer = nil
+	m.clearedbuyer = false
 }
 
 // SetListingID sets the "listing" edge to the Listing entity by id.
-func (m *NFTAccessoryMutation) SetListingID(id int) {
+func (m *SaleMutation) SetListingID(id int) {
 	m.listing = &id
 }
 
 // ClearListing clears the "listing" edge to the Listing entity.
-func (m *NFTAccessoryMutation) ClearListing() {
+func (m *SaleMutation) ClearListing() {
 	m.clearedlisting = true
 }
 
 // ListingCleared reports if the "listing" edge to the Listing entity was cleared.
-func (m *NFTAccessoryMutation) ListingCleared() bool {
+func (m *SaleMutation) ListingCleared() bool {
 	return m.clearedlisting
 }
 
 // ListingID returns the "listing" edge ID in the mutation.
-func (m *NFTAccessoryMutation) ListingID() (id int, exists bool) {
+func (m *SaleMutation) ListingID() (id int, exists bool) {
 	if m.listing != nil {
 		return *m.listing, true
 	}
@@ -3849,7 +11961,7 @@ func (m *NFTAccessoryMutation) ListingID() (id int, exists bool) {
 // ListingIDs returns the "listing" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ListingID instead. It exists only for internal usage by the builders.
-func (m *NFTAccessoryMutation) ListingIDs() (ids []int) {
+func (m *SaleMutation) ListingIDs() (ids []int) {
 	if id := m.listing; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3857,20 +11969,20 @@ func (m *NFTAccessoryMutation) ListingIDs() (ids []int) {
 }
 
 // ResetListing resets all changes to the "listing" edge.
-func (m *NFTAccessoryMutation) ResetListing() {
+func (m *SaleMutation) ResetListing() {
 	m.listing = nil
 	m.clearedlisting = false
 }
 
-// Where appends a list predicates to the NFTAccessoryMutation builder.
-func (m *NFTAccessoryMutation) Where(ps ...predicate.NFTAccessory) {
+// Where appends a list predicates to the SaleMutation builder.
+func (m *SaleMutation) Where(ps ...predicate.Sale) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NFTAccessoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SaleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NFTAccessoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NFTAccessory, len(ps))
+func (m *SaleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sale, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3878,39 +11990,45 @@ func (m *NFTAccessoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NFTAccessoryMutation) Op() Op {
+func (m *SaleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NFTAccessoryMutation) SetOp(op Op) {
+func (m *SaleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NFTAccessory).
-func (m *NFTAccessoryMutation) Type() string {
+// Type returns the node type of this mutation (Sale).
+func (m *SaleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NFTAccessoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.nft_id != nil {
-		fields = append(fields, nftaccessory.FieldNftID)
+func (m *SaleMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.price != nil {
+		fields = append(fields, sale.FieldPrice)
 	}
-	if m.name != nil {
-		fields = append(fields, nftaccessory.FieldName)
+	if m.payment_vault_type != nil {
+		fields = append(fields, sale.FieldPaymentVaultType)
 	}
-	if m.description != nil {
-		fields = append(fields, nftaccessory.FieldDescription)
+	if m.nft_type != nil {
+		fields = append(fields, sale.FieldNftType)
 	}
-	if m.thumbnail != nil {
-		fields = append(fields, nftaccessory.FieldThumbnail)
+	if m.nft_id != nil {
+		fields = append(fields, sale.FieldNftID)
 	}
-	if m.equipment_type != nil {
-		fields = append(fields, nftaccessory.FieldEquipmentType)
+	if m.tx_id != nil {
+		fields = append(fields, sale.FieldTxID)
+	}
+	if m.block_height != nil {
+		fields = append(fields, sale.FieldBlockHeight)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, sale.FieldTimestamp)
 	}
 	return fields
 }
@@ -3918,18 +12036,22 @@ func (m *NFTAccessoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NFTAccessoryMutation) Field(name string) (ent.Value, bool) {
+func (m *SaleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case nftaccessory.FieldNftID:
+	case sale.FieldPrice:
+		return m.Price()
+	case sale.FieldPaymentVaultType:
+		return m.PaymentVaultType()
+	case sale.FieldNftType:
+		return m.NftType()
+	case sale.FieldNftID:
 		return m.NftID()
-	case nftaccessory.FieldName:
-		return m.Name()
-	case nftaccessory.FieldDescription:
-		return m.Description()
-	case nftaccessory.FieldThumbnail:
-		return m.Thumbnail()
-	case nftaccessory.FieldEquipmentType:
-		return m.EquipmentType()
+	case sale.FieldTxID:
+		return m.TxID()
+	case sale.FieldBlockHeight:
+		return m.BlockHeight()
+	case sale.FieldTimestamp:
+		return m.Timestamp()
 	}
 	return nil, false
 }
@@ -3937,72 +12059,96 @@ func (m *NFTAccessoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NFTAccessoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SaleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case nftaccessory.FieldNftID:
+	case sale.FieldPrice:
+		return m.OldPrice(ctx)
+	case sale.FieldPaymentVaultType:
+		return m.OldPaymentVaultType(ctx)
+	case sale.FieldNftType:
+		return m.OldNftType(ctx)
+	case sale.FieldNftID:
 		return m.OldNftID(ctx)
-	case nftaccessory.FieldName:
-		return m.OldName(ctx)
-	case nftaccessory.FieldDescription:
-		return m.OldDescription(ctx)
-	case nftaccessory.FieldThumbnail:
-		return m.OldThumbnail(ctx)
-	case nftaccessory.FieldEquipmentType:
-		return m.OldEquipmentType(ctx)
+	case sale.FieldTxID:
+		return m.OldTxID(ctx)
+	case sale.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case sale.FieldTimestamp:
+		return m.OldTimestamp(ctx)
 	}
-	return nil, fmt.Errorf("unknown NFTAccessory field %s", name)
+	return nil, fmt.Errorf("unknown Sale field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NFTAccessoryMutation) SetField(name string, value ent.Value) error {
+func (m *SaleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case nftaccessory.FieldNftID:
-		v, ok := value.(uint64)
+	case sale.FieldPrice:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNftID(v)
+		m.SetPrice(v)
 		return nil
-	case nftaccessory.FieldName:
+	case sale.FieldPaymentVaultType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPaymentVaultType(v)
 		return nil
-	case nftaccessory.FieldDescription:
-		v, ok := value.(string)
+	case sale.FieldNftType:
+		v, ok := value.(sale.NftType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetNftType(v)
 		return nil
-	case nftaccessory.FieldThumbnail:
-		v, ok := value.(string)
+	case sale.FieldNftID:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnail(v)
+		m.SetNftID(v)
 		return nil
-	case nftaccessory.FieldEquipmentType:
+	case sale.FieldTxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEquipmentType(v)
+		m.SetTxID(v)
+		return nil
+	case sale.FieldBlockHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case sale.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NFTAccessory field %s", name)
+	return fmt.Errorf("unknown Sale field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NFTAccessoryMutation) AddedFields() []string {
+func (m *SaleMutation) AddedFields() []string {
 	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, sale.FieldPrice)
+	}
 	if m.addnft_id != nil {
-		fields = append(fields, nftaccessory.FieldNftID)
+		fields = append(fields, sale.FieldNftID)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, sale.FieldBlockHeight)
 	}
 	return fields
 }
@@ -4010,10 +12156,14 @@ func (m *NFTAccessoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NFTAccessoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SaleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case nftaccessory.FieldNftID:
+	case sale.FieldPrice:
+		return m.AddedPrice()
+	case sale.FieldNftID:
 		return m.AddedNftID()
+	case sale.FieldBlockHeight:
+		return m.AddedBlockHeight()
 	}
 	return nil, false
 }
@@ -4021,89 +12171,109 @@ func (m *NFTAccessoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NFTAccessoryMutation) AddField(name string, value ent.Value) error {
+func (m *SaleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case nftaccessory.FieldNftID:
+	case sale.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case sale.FieldNftID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddNftID(v)
 		return nil
+	case sale.FieldBlockHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NFTAccessory numeric field %s", name)
+	return fmt.Errorf("unknown Sale numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NFTAccessoryMutation) ClearedFields() []string {
+func (m *SaleMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NFTAccessoryMutation) FieldCleared(name string) bool {
+func (m *SaleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NFTAccessoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NFTAccessory nullable field %s", name)
+func (m *SaleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Sale nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NFTAccessoryMutation) ResetField(name string) error {
+func (m *SaleMutation) ResetField(name string) error {
 	switch name {
-	case nftaccessory.FieldNftID:
-		m.ResetNftID()
+	case sale.FieldPrice:
+		m.ResetPrice()
 		return nil
-	case nftaccessory.FieldName:
-		m.ResetName()
+	case sale.FieldPaymentVaultType:
+		m.ResetPaymentVaultType()
 		return nil
-	case nftaccessory.FieldDescription:
-		m.ResetDescription()
+	case sale.FieldNftType:
+		m.ResetNftType()
 		return nil
-	case nftaccessory.FieldThumbnail:
-		m.ResetThumbnail()
+	case sale.FieldNftID:
+		m.ResetNftID()
 		return nil
-	case nftaccessory.FieldEquipmentType:
-		m.ResetEquipmentType()
+	case sale.FieldTxID:
+		m.ResetTxID()
+		return nil
+	case sale.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case sale.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown NFTAccessory field %s", name)
+	return fmt.Errorf("unknown Sale field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NFTAccessoryMutation) AddedEdges() []string {
+func (m *SaleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, nftaccessory.EdgeOwner)
+	if m.seller != nil {
+		edges = append(edges, sale.EdgeSeller)
 	}
-	if m.equipped_on_moment != nil {
-		edges = append(edges, nftaccessory.EdgeEquippedOnMoment)
+	if m.buyer != nil {
+		edges = append(edges, sale.EdgeBuyer)
 	}
 	if m.listing != nil {
-		edges = append(edges, nftaccessory.EdgeListing)
+		edges = append(edges, sale.EdgeListing)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NFTAccessoryMutation) AddedIDs(name string) []ent.Value {
+func (m *SaleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case nftaccessory.EdgeOwner:
-		if id := m.owner; id != nil {
+	case sale.EdgeSeller:
+		if id := m.seller; id != nil {
 			return []ent.Value{*id}
 		}
-	case nftaccessory.EdgeEquippedOnMoment:
-		if id := m.equipped_on_moment; id != nil {
+	case sale.EdgeBuyer:
+		if id := m.buyer; id != nil {
 			return []ent.Value{*id}
 		}
-	case nftaccessory.EdgeListing:
+	case sale.EdgeListing:
 		if id := m.listing; id != nil {
 			return []ent.Value{*id}
 		}
@@ -4112,41 +12282,41 @@ func (m *NFTAccessoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NFTAccessoryMutation) RemovedEdges() []string {
+func (m *SaleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NFTAccessoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *SaleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NFTAccessoryMutation) ClearedEdges() []string {
+func (m *SaleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, nftaccessory.EdgeOwner)
+	if m.clearedseller {
+		edges = append(edges, sale.EdgeSeller)
 	}
-	if m.clearedequipped_on_moment {
-		edges = append(edges, nftaccessory.EdgeEquippedOnMoment)
+	if m.clearedbuyer {
+		edges = append(edges, sale.EdgeBuyer)
 	}
 	if m.clearedlisting {
-		edges = append(edges, nftaccessory.EdgeListing)
+		edges = append(edges, sale.EdgeListing)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NFTAccessoryMutation) EdgeCleared(name string) bool {
+func (m *SaleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case nftaccessory.EdgeOwner:
-		return m.clearedowner
-	case nftaccessory.EdgeEquippedOnMoment:
-		return m.clearedequipped_on_moment
-	case nftaccessory.EdgeListing:
+	case sale.EdgeSeller:
+		return m.clearedseller
+	case sale.EdgeBuyer:
+		return m.clearedbuyer
+	case sale.EdgeListing:
 		return m.clearedlisting
 	}
 	return false
@@ -4154,73 +12324,72 @@ func (m *NFTAccessoryMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NFTAccessoryMutation) ClearEdge(name string) error {
+func (m *SaleMutation) ClearEdge(name string) error {
 	switch name {
-	case nftaccessory.EdgeOwner:
-		m.ClearOwner()
+	case sale.EdgeSeller:
+		m.ClearSeller()
 		return nil
-	case nftaccessory.EdgeEquippedOnMoment:
-		m.ClearEquippedOnMoment()
+	case sale.EdgeBuyer:
+		m.ClearBuyer()
 		return nil
-	case nftaccessory.EdgeListing:
+	case sale.EdgeListing:
 		m.ClearListing()
 		return nil
 	}
-	return fmt.Errorf("unknown NFTAccessory unique edge %s", name)
+	return fmt.Errorf("unknown Sale unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NFTAccessoryMutation) ResetEdge(name string) error {
+func (m *SaleMutation) ResetEdge(name string) error {
 	switch name {
-	case nftaccessory.EdgeOwner:
-		m.ResetOwner()
+	case sale.EdgeSeller:
+		m.ResetSeller()
 		return nil
-	case nftaccessory.EdgeEquippedOnMoment:
-		m.ResetEquippedOnMoment()
+	case sale.EdgeBuyer:
+		m.ResetBuyer()
 		return nil
-	case nftaccessory.EdgeListing:
+	case sale.EdgeListing:
 		m.ResetListing()
 		return nil
 	}
-	return fmt.Errorf("unknown NFTAccessory edge %s", name)
+	return fmt.Errorf("unknown Sale edge %s", name)
 }
 
-// NFTMomentMutation represents an operation that mutates the NFTMoment nodes in the graph.
-type NFTMomentMutation struct {
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
 	config
-	op                          Op
-	typ                         string
-	id                          *int
-	nft_id                      *uint64
-	addnft_id                   *int64
-	name                        *string
-	description                 *string
-	thumbnail                   *string
-	clearedFields               map[string]struct{}
-	owner                       *int
-	clearedowner                bool
-	equipped_accessories        map[int]struct{}
-	removedequipped_accessories map[int]struct{}
-	clearedequipped_accessories bool
-	minted_with_pass            *int
-	clearedminted_with_pass     bool
-	done                        bool
-	oldValue                    func(context.Context) (*NFTMoment, error)
-	predicates                  []predicate.NFTMoment
-}
-
-var _ ent.Mutation = (*NFTMomentMutation)(nil)
-
-// nftmomentOption allows management of the mutation configuration using functional options.
-type nftmomentOption func(*NFTMomentMutation)
-
-// newNFTMomentMutation creates new mutation for the NFTMoment entity.
-func newNFTMomentMutation(c config, op Op, opts ...nftmomentOption) *NFTMomentMutation {
-	m := &NFTMomentMutation{
+	op              Op
+	typ             string
+	id              *int
+	nft_type        *transfer.NftType
+	nft_id          *uint64
+	addnft_id       *int64
+	from            *string
+	to              *string
+	tx_id           *string
+	event_index     *int
+	addevent_index  *int
+	block_height    *uint64
+	addblock_height *int64
+	timestamp       *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Transfer, error)
+	predicates      []predicate.Transfer
+}
+
+var _ ent.Mutation = (*TransferMutation)(nil)
+
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
+
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNFTMoment,
+		typ:           TypeTransfer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4229,20 +12398,20 @@ func newNFTMomentMutation(c config, op Op, opts ...nftmomentOption) *NFTMomentMu
 	return m
 }
 
-// withNFTMomentID sets the ID field of the mutation.
-func withNFTMomentID(id int) nftmomentOption {
-	return func(m *NFTMomentMutation) {
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id int) transferOption {
+	return func(m *TransferMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NFTMoment
+			value *Transfer
 		)
-		m.oldValue = func(ctx context.Context) (*NFTMoment, error) {
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NFTMoment.Get(ctx, id)
+					value, err = m.Client().Transfer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4251,10 +12420,10 @@ func withNFTMomentID(id int) nftmomentOption {
 	}
 }
 
-// withNFTMoment sets the old NFTMoment of the mutation.
-func withNFTMoment(node *NFTMoment) nftmomentOption {
-	return func(m *NFTMomentMutation) {
-		m.oldValue = func(context.Context) (*NFTMoment, error) {
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4263,7 +12432,7 @@ func withNFTMoment(node *NFTMoment) nftmomentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NFTMomentMutation) Client() *Client {
+func (m TransferMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4271,7 +12440,7 @@ func (m NFTMomentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NFTMomentMutation) Tx() (*Tx, error) {
+func (m TransferMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4282,40 +12451,76 @@ func (m NFTMomentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NFTMomentMutation) ID() (id int, exists bool) {
+func (m *TransferMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
-	return *m.id, true
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TransferMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNftType sets the "nft_type" field.
+func (m *TransferMutation) SetNftType(tt transfer.NftType) {
+	m.nft_type = &tt
+}
+
+// NftType returns the value of the "nft_type" field in the mutation.
+func (m *TransferMutation) NftType() (r transfer.NftType, exists bool) {
+	v := m.nft_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNftType returns the old "nft_type" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldNftType(ctx context.Context) (v transfer.NftType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNftType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNftType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNftType: %w", err)
+	}
+	return oldValue.NftType, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *NFTMomentMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NFTMoment.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetNftType resets all changes to the "nft_type" field.
+func (m *TransferMutation) ResetNftType() {
+	m.nft_type = nil
 }
 
 // SetNftID sets the "nft_id" field.
-func (m *NFTMomentMutation) SetNftID(u uint64) {
+func (m *TransferMutation) SetNftID(u uint64) {
 	m.nft_id = &u
 	m.addnft_id = nil
 }
 
 // NftID returns the value of the "nft_id" field in the mutation.
-func (m *NFTMomentMutation) NftID() (r uint64, exists bool) {
+func (m *TransferMutation) NftID() (r uint64, exists bool) {
 	v := m.nft_id
 	if v == nil {
 		return
@@ -4323,10 +12528,10 @@ func (m *NFTMomentMutation) NftID() (r uint64, exists bool) {
 	return *v, true
 }
 
-// OldNftID returns the old "nft_id" field's value of the NFTMoment entity.
-// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// OldNftID returns the old "nft_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTMomentMutation) OldNftID(ctx context.Context) (v uint64, err error) {
+func (m *TransferMutation) OldNftID(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldNftID is only allowed on UpdateOne operations")
 	}
@@ -4341,7 +12546,7 @@ func (m *NFTMomentMutation) OldNftID(ctx context.Context) (v uint64, err error)
 }
 
 // AddNftID adds u to the "nft_id" field.
-func (m *NFTMomentMutation) AddNftID(u int64) {
+func (m *TransferMutation) AddNftID(u int64) {
 	if m.addnft_id != nil {
 		*m.addnft_id += u
 	} else {
@@ -4350,7 +12555,7 @@ func (m *NFTMomentMutation) AddNftID(u int64) {
 }
 
 // AddedNftID returns the value that was added to the "nft_id" field in this mutation.
-func (m *NFTMomentMutation) AddedNftID() (r int64, exists bool) {
+func (m *TransferMutation) AddedNftID() (r int64, exists bool) {
 	v := m.addnft_id
 	if v == nil {
 		return
@@ -4359,260 +12564,302 @@ func (m *NFTMomentMutation) AddedNftID() (r int64, exists bool) {
 }
 
 // ResetNftID resets all changes to the "nft_id" field.
-func (m *NFTMomentMutation) ResetNftID() {
+func (m *TransferMutation) ResetNftID() {
 	m.nft_id = nil
 	m.addnft_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *NFTMomentMutation) SetName(s string) {
-	m.name = &s
+// SetFrom sets the "from" field.
+func (m *TransferMutation) SetFrom(s string) {
+	m.from = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NFTMomentMutation) Name() (r string, exists bool) {
-	v := m.name
+// From returns the value of the "from" field in the mutation.
+func (m *TransferMutation) From() (r string, exists bool) {
+	v := m.from
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NFTMoment entity.
-// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// OldFrom returns the old "from" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTMomentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TransferMutation) OldFrom(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldFrom is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldFrom requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldFrom: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.From, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NFTMomentMutation) ResetName() {
-	m.name = nil
+// ClearFrom clears the value of the "from" field.
+func (m *TransferMutation) ClearFrom() {
+	m.from = nil
+	m.clearedFields[transfer.FieldFrom] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *NFTMomentMutation) SetDescription(s string) {
-	m.description = &s
+// FromCleared returns if the "from" field was cleared in this mutation.
+func (m *TransferMutation) FromCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldFrom]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *NFTMomentMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetFrom resets all changes to the "from" field.
+func (m *TransferMutation) ResetFrom() {
+	m.from = nil
+	delete(m.clearedFields, transfer.FieldFrom)
+}
+
+// SetTo sets the "to" field.
+func (m *TransferMutation) SetTo(s string) {
+	m.to = &s
+}
+
+// To returns the value of the "to" field in the mutation.
+func (m *TransferMutation) To() (r string, exists bool) {
+	v := m.to
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the NFTMoment entity.
-// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// OldTo returns the old "to" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTMomentMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TransferMutation) OldTo(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldTo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldTo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldTo: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.To, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *NFTMomentMutation) ResetDescription() {
-	m.description = nil
+// ClearTo clears the value of the "to" field.
+func (m *TransferMutation) ClearTo() {
+	m.to = nil
+	m.clearedFields[transfer.FieldTo] = struct{}{}
 }
 
-// SetThumbnail sets the "thumbnail" field.
-func (m *NFTMomentMutation) SetThumbnail(s string) {
-	m.thumbnail = &s
+// ToCleared returns if the "to" field was cleared in this mutation.
+func (m *TransferMutation) ToCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldTo]
+	return ok
 }
 
-// Thumbnail returns the value of the "thumbnail" field in the mutation.
-func (m *NFTMomentMutation) Thumbnail() (r string, exists bool) {
-	v := m.thumbnail
+// ResetTo resets all changes to the "to" field.
+func (m *TransferMutation) ResetTo() {
+	m.to = nil
+	delete(m.clearedFields, transfer.FieldTo)
+}
+
+// SetTxID sets the "tx_id" field.
+func (m *TransferMutation) SetTxID(s string) {
+	m.tx_id = &s
+}
+
+// TxID returns the value of the "tx_id" field in the mutation.
+func (m *TransferMutation) TxID() (r string, exists bool) {
+	v := m.tx_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnail returns the old "thumbnail" field's value of the NFTMoment entity.
-// If the NFTMoment object wasn't provided to the builder, the object is fetched from the database.
+// OldTxID returns the old "tx_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NFTMomentMutation) OldThumbnail(ctx context.Context) (v string, err error) {
+func (m *TransferMutation) OldTxID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnail is only allowed on UpdateOne operations")
+		return v, errors.New("OldTxID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnail requires an ID field in the mutation")
+		return v, errors.New("OldTxID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnail: %w", err)
+		return v, fmt.Errorf("querying old value for OldTxID: %w", err)
 	}
-	return oldValue.Thumbnail, nil
-}
-
-// ResetThumbnail resets all changes to the "thumbnail" field.
-func (m *NFTMomentMutation) ResetThumbnail() {
-	m.thumbnail = nil
-}
-
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *NFTMomentMutation) SetOwnerID(id int) {
-	m.owner = &id
+	return oldValue.TxID, nil
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *NFTMomentMutation) ClearOwner() {
-	m.clearedowner = true
+// ResetTxID resets all changes to the "tx_id" field.
+func (m *TransferMutation) ResetTxID() {
+	m.tx_id = nil
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *NFTMomentMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetEventIndex sets the "event_index" field.
+func (m *TransferMutation) SetEventIndex(i int) {
+	m.event_index = &i
+	m.addevent_index = nil
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *NFTMomentMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// EventIndex returns the value of the "event_index" field in the mutation.
+func (m *TransferMutation) EventIndex() (r int, exists bool) {
+	v := m.event_index
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *NFTMomentMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldEventIndex returns the old "event_index" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldEventIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventIndex is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventIndex: %w", err)
+	}
+	return oldValue.EventIndex, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *NFTMomentMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// AddEventIndex adds i to the "event_index" field.
+func (m *TransferMutation) AddEventIndex(i int) {
+	if m.addevent_index != nil {
+		*m.addevent_index += i
+	} else {
+		m.addevent_index = &i
+	}
 }
 
-// AddEquippedAccessoryIDs adds the "equipped_accessories" edge to the NFTAccessory entity by ids.
-func (m *NFTMomentMutation) AddEquippedAccessoryIDs(ids ...int) {
-	if m.equipped_accessories == nil {
-		m.equipped_accessories = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.equipped_accessories[ids[i]] = struct{}{}
+// AddedEventIndex returns the value that was added to the "event_index" field in this mutation.
+func (m *TransferMutation) AddedEventIndex() (r int, exists bool) {
+	v := m.addevent_index
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearEquippedAccessories clears the "equipped_accessories" edge to the NFTAccessory entity.
-func (m *NFTMomentMutation) ClearEquippedAccessories() {
-	m.clearedequipped_accessories = true
+// ResetEventIndex resets all changes to the "event_index" field.
+func (m *TransferMutation) ResetEventIndex() {
+	m.event_index = nil
+	m.addevent_index = nil
 }
 
-// EquippedAccessoriesCleared reports if the "equipped_accessories" edge to the NFTAccessory entity was cleared.
-func (m *NFTMomentMutation) EquippedAccessoriesCleared() bool {
-	return m.clearedequipped_accessories
+// SetBlockHeight sets the "block_height" field.
+func (m *TransferMutation) SetBlockHeight(u uint64) {
+	m.block_height = &u
+	m.addblock_height = nil
 }
 
-// RemoveEquippedAccessoryIDs removes the "equipped_accessories" edge to the NFTAccessory entity by IDs.
-func (m *NFTMomentMutation) RemoveEquippedAccessoryIDs(ids ...int) {
-	if m.removedequipped_accessories == nil {
-		m.removedequipped_accessories = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.equipped_accessories, ids[i])
-		m.removedequipped_accessories[ids[i]] = struct{}{}
+// BlockHeight returns the value of the "block_height" field in the mutation.
+func (m *TransferMutation) BlockHeight() (r uint64, exists bool) {
+	v := m.block_height
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEquippedAccessories returns the removed IDs of the "equipped_accessories" edge to the NFTAccessory entity.
-func (m *NFTMomentMutation) RemovedEquippedAccessoriesIDs() (ids []int) {
-	for id := range m.removedequipped_accessories {
-		ids = append(ids, id)
+// OldBlockHeight returns the old "block_height" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldBlockHeight(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHeight is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// EquippedAccessoriesIDs returns the "equipped_accessories" edge IDs in the mutation.
-func (m *NFTMomentMutation) EquippedAccessoriesIDs() (ids []int) {
-	for id := range m.equipped_accessories {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHeight requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHeight: %w", err)
+	}
+	return oldValue.BlockHeight, nil
 }
 
-// ResetEquippedAccessories resets all changes to the "equipped_accessories" edge.
-func (m *NFTMomentMutation) ResetEquippedAccessories() {
-	m.equipped_accessories = nil
-	m.clearedequipped_accessories = false
-	m.removedequipped_accessories = nil
+// AddBlockHeight adds u to the "block_height" field.
+func (m *TransferMutation) AddBlockHeight(u int64) {
+	if m.addblock_height != nil {
+		*m.addblock_height += u
+	} else {
+		m.addblock_height = &u
+	}
 }
 
-// SetMintedWithPassID sets the "minted_with_pass" edge to the EventPass entity by id.
-func (m *NFTMomentMutation) SetMintedWithPassID(id int) {
-	m.minted_with_pass = &id
+// AddedBlockHeight returns the value that was added to the "block_height" field in this mutation.
+func (m *TransferMutation) AddedBlockHeight() (r int64, exists bool) {
+	v := m.addblock_height
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearMintedWithPass clears the "minted_with_pass" edge to the EventPass entity.
-func (m *NFTMomentMutation) ClearMintedWithPass() {
-	m.clearedminted_with_pass = true
+// ResetBlockHeight resets all changes to the "block_height" field.
+func (m *TransferMutation) ResetBlockHeight() {
+	m.block_height = nil
+	m.addblock_height = nil
 }
 
-// MintedWithPassCleared reports if the "minted_with_pass" edge to the EventPass entity was cleared.
-func (m *NFTMomentMutation) MintedWithPassCleared() bool {
-	return m.clearedminted_with_pass
+// SetTimestamp sets the "timestamp" field.
+func (m *TransferMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
 }
 
-// MintedWithPassID returns the "minted_with_pass" edge ID in the mutation.
-func (m *NFTMomentMutation) MintedWithPassID() (id int, exists bool) {
-	if m.minted_with_pass != nil {
-		return *m.minted_with_pass, true
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *TransferMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MintedWithPassIDs returns the "minted_with_pass" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MintedWithPassID instead. It exists only for internal usage by the builders.
-func (m *NFTMomentMutation) MintedWithPassIDs() (ids []int) {
-	if id := m.minted_with_pass; id != nil {
-		ids = append(ids, *id)
+// OldTimestamp returns the old "timestamp" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
 }
 
-// ResetMintedWithPass resets all changes to the "minted_with_pass" edge.
-func (m *NFTMomentMutation) ResetMintedWithPass() {
-	m.minted_with_pass = nil
-	m.clearedminted_with_pass = false
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *TransferMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// Where appends a list predicates to the NFTMomentMutation builder.
-func (m *NFTMomentMutation) Where(ps ...predicate.NFTMoment) {
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the NFTMomentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NFTMomentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.NFTMoment, len(ps))
+func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transfer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4620,36 +12867,48 @@ func (m *NFTMomentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *NFTMomentMutation) Op() Op {
+func (m *TransferMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *NFTMomentMutation) SetOp(op Op) {
+func (m *TransferMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (NFTMoment).
-func (m *NFTMomentMutation) Type() string {
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NFTMomentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.nft_type != nil {
+		fields = append(fields, transfer.FieldNftType)
+	}
 	if m.nft_id != nil {
-		fields = append(fields, nftmoment.FieldNftID)
+		fields = append(fields, transfer.FieldNftID)
 	}
-	if m.name != nil {
-		fields = append(fields, nftmoment.FieldName)
+	if m.from != nil {
+		fields = append(fields, transfer.FieldFrom)
 	}
-	if m.description != nil {
-		fields = append(fields, nftmoment.FieldDescription)
+	if m.to != nil {
+		fields = append(fields, transfer.FieldTo)
 	}
-	if m.thumbnail != nil {
-		fields = append(fields, nftmoment.FieldThumbnail)
+	if m.tx_id != nil {
+		fields = append(fields, transfer.FieldTxID)
+	}
+	if m.event_index != nil {
+		fields = append(fields, transfer.FieldEventIndex)
+	}
+	if m.block_height != nil {
+		fields = append(fields, transfer.FieldBlockHeight)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, transfer.FieldTimestamp)
 	}
 	return fields
 }
@@ -4657,16 +12916,24 @@ func (m *NFTMomentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NFTMomentMutation) Field(name string) (ent.Value, bool) {
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftType:
+		return m.NftType()
+	case transfer.FieldNftID:
 		return m.NftID()
-	case nftmoment.FieldName:
-		return m.Name()
-	case nftmoment.FieldDescription:
-		return m.Description()
-	case nftmoment.FieldThumbnail:
-		return m.Thumbnail()
+	case transfer.FieldFrom:
+		return m.From()
+	case transfer.FieldTo:
+		return m.To()
+	case transfer.FieldTxID:
+		return m.TxID()
+	case transfer.FieldEventIndex:
+		return m.EventIndex()
+	case transfer.FieldBlockHeight:
+		return m.BlockHeight()
+	case transfer.FieldTimestamp:
+		return m.Timestamp()
 	}
 	return nil, false
 }
@@ -4674,63 +12941,105 @@ func (m *NFTMomentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NFTMomentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftType:
+		return m.OldNftType(ctx)
+	case transfer.FieldNftID:
 		return m.OldNftID(ctx)
-	case nftmoment.FieldName:
-		return m.OldName(ctx)
-	case nftmoment.FieldDescription:
-		return m.OldDescription(ctx)
-	case nftmoment.FieldThumbnail:
-		return m.OldThumbnail(ctx)
-	}
-	return nil, fmt.Errorf("unknown NFTMoment field %s", name)
+	case transfer.FieldFrom:
+		return m.OldFrom(ctx)
+	case transfer.FieldTo:
+		return m.OldTo(ctx)
+	case transfer.FieldTxID:
+		return m.OldTxID(ctx)
+	case transfer.FieldEventIndex:
+		return m.OldEventIndex(ctx)
+	case transfer.FieldBlockHeight:
+		return m.OldBlockHeight(ctx)
+	case transfer.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NFTMomentMutation) SetField(name string, value ent.Value) error {
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftType:
+		v, ok := value.(transfer.NftType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNftType(v)
+		return nil
+	case transfer.FieldNftID:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetNftID(v)
 		return nil
-	case nftmoment.FieldName:
+	case transfer.FieldFrom:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetFrom(v)
 		return nil
-	case nftmoment.FieldDescription:
+	case transfer.FieldTo:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTo(v)
 		return nil
-	case nftmoment.FieldThumbnail:
+	case transfer.FieldTxID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnail(v)
+		m.SetTxID(v)
+		return nil
+	case transfer.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIndex(v)
+		return nil
+	case transfer.FieldBlockHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHeight(v)
+		return nil
+	case transfer.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NFTMoment field %s", name)
+	return fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NFTMomentMutation) AddedFields() []string {
+func (m *TransferMutation) AddedFields() []string {
 	var fields []string
 	if m.addnft_id != nil {
-		fields = append(fields, nftmoment.FieldNftID)
+		fields = append(fields, transfer.FieldNftID)
+	}
+	if m.addevent_index != nil {
+		fields = append(fields, transfer.FieldEventIndex)
+	}
+	if m.addblock_height != nil {
+		fields = append(fields, transfer.FieldBlockHeight)
 	}
 	return fields
 }
@@ -4738,10 +13047,14 @@ func (m *NFTMomentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NFTMomentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftID:
 		return m.AddedNftID()
+	case transfer.FieldEventIndex:
+		return m.AddedEventIndex()
+	case transfer.FieldBlockHeight:
+		return m.AddedBlockHeight()
 	}
 	return nil, false
 }
@@ -4749,176 +13062,145 @@ func (m *NFTMomentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NFTMomentMutation) AddField(name string, value ent.Value) error {
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.AddNftID(v)
 		return nil
+	case transfer.FieldEventIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventIndex(v)
+		return nil
+	case transfer.FieldBlockHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockHeight(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NFTMoment numeric field %s", name)
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NFTMomentMutation) ClearedFields() []string {
-	return nil
+func (m *TransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transfer.FieldFrom) {
+		fields = append(fields, transfer.FieldFrom)
+	}
+	if m.FieldCleared(transfer.FieldTo) {
+		fields = append(fields, transfer.FieldTo)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NFTMomentMutation) FieldCleared(name string) bool {
+func (m *TransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NFTMomentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown NFTMoment nullable field %s", name)
+func (m *TransferMutation) ClearField(name string) error {
+	switch name {
+	case transfer.FieldFrom:
+		m.ClearFrom()
+		return nil
+	case transfer.FieldTo:
+		m.ClearTo()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NFTMomentMutation) ResetField(name string) error {
+func (m *TransferMutation) ResetField(name string) error {
 	switch name {
-	case nftmoment.FieldNftID:
+	case transfer.FieldNftType:
+		m.ResetNftType()
+		return nil
+	case transfer.FieldNftID:
 		m.ResetNftID()
 		return nil
-	case nftmoment.FieldName:
-		m.ResetName()
+	case transfer.FieldFrom:
+		m.ResetFrom()
 		return nil
-	case nftmoment.FieldDescription:
-		m.ResetDescription()
+	case transfer.FieldTo:
+		m.ResetTo()
 		return nil
-	case nftmoment.FieldThumbnail:
-		m.ResetThumbnail()
+	case transfer.FieldTxID:
+		m.ResetTxID()
+		return nil
+	case transfer.FieldEventIndex:
+		m.ResetEventIndex()
+		return nil
+	case transfer.FieldBlockHeight:
+		m.ResetBlockHeight()
+		return nil
+	case transfer.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown NFTMoment field %s", name)
+	return fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NFTMomentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, nftmoment.EdgeOwner)
-	}
-	if m.equipped_accessories != nil {
-		edges = append(edges, nftmoment.EdgeEquippedAccessories)
-	}
-	if m.minted_with_pass != nil {
-		edges = append(edges, nftmoment.EdgeMintedWithPass)
-	}
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NFTMomentMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case nftmoment.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case nftmoment.EdgeEquippedAccessories:
-		ids := make([]ent.Value, 0, len(m.equipped_accessories))
-		for id := range m.equipped_accessories {
-			ids = append(ids, id)
-		}
-		return ids
-	case nftmoment.EdgeMintedWithPass:
-		if id := m.minted_with_pass; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NFTMomentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedequipped_accessories != nil {
-		edges = append(edges, nftmoment.EdgeEquippedAccessories)
-	}
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *NFTMomentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case nftmoment.EdgeEquippedAccessories:
-		ids := make([]ent.Value, 0, len(m.removedequipped_accessories))
-		for id := range m.removedequipped_accessories {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NFTMomentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, nftmoment.EdgeOwner)
-	}
-	if m.clearedequipped_accessories {
-		edges = append(edges, nftmoment.EdgeEquippedAccessories)
-	}
-	if m.clearedminted_with_pass {
-		edges = append(edges, nftmoment.EdgeMintedWithPass)
-	}
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *NFTMomentMutation) EdgeCleared(name string) bool {
-	switch name {
-	case nftmoment.EdgeOwner:
-		return m.clearedowner
-	case nftmoment.EdgeEquippedAccessories:
-		return m.clearedequipped_accessories
-	case nftmoment.EdgeMintedWithPass:
-		return m.clearedminted_with_pass
-	}
+func (m *TransferMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *NFTMomentMutation) ClearEdge(name string) error {
-	switch name {
-	case nftmoment.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case nftmoment.EdgeMintedWithPass:
-		m.ClearMintedWithPass()
-		return nil
-	}
-	return fmt.Errorf("unknown NFTMoment unique edge %s", name)
+func (m *TransferMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *NFTMomentMutation) ResetEdge(name string) error {
-	switch name {
-	case nftmoment.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case nftmoment.EdgeEquippedAccessories:
-		m.ResetEquippedAccessories()
-		return nil
-	case nftmoment.EdgeMintedWithPass:
-		m.ResetMintedWithPass()
-		return nil
-	}
-	return fmt.Errorf("unknown NFTMoment edge %s", name)
+func (m *TransferMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Transfer edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -4938,6 +13220,7 @@ type UserMutation struct {
 	highlighted_moment_id           *uint64
 	addhighlighted_moment_id        *int64
 	socials                         *map[string]string
+	is_verified                     *bool
 	clearedFields                   map[string]struct{}
 	event_passes                    map[int]struct{}
 	removedevent_passes             map[int]struct{}
@@ -4957,6 +13240,12 @@ type UserMutation struct {
 	listings                        map[int]struct{}
 	removedlistings                 map[int]struct{}
 	clearedlistings                 bool
+	sales                           map[int]struct{}
+	removedsales                    map[int]struct{}
+	clearedsales                    bool
+	purchases                       map[int]struct{}
+	removedpurchases                map[int]struct{}
+	clearedpurchases                bool
 	done                            bool
 	oldValue                        func(context.Context) (*User, error)
 	predicates                      []predicate.User
@@ -5525,6 +13814,42 @@ func (m *UserMutation) ResetSocials() {
 	delete(m.clearedFields, user.FieldSocials)
 }
 
+// SetIsVerified sets the "is_verified" field.
+func (m *UserMutation) SetIsVerified(b bool) {
+	m.is_verified = &b
+}
+
+// IsVerified returns the value of the "is_verified" field in the mutation.
+func (m *UserMutation) IsVerified() (r bool, exists bool) {
+	v := m.is_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVerified returns the old "is_verified" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVerified: %w", err)
+	}
+	return oldValue.IsVerified, nil
+}
+
+// ResetIsVerified resets all changes to the "is_verified" field.
+func (m *UserMutation) ResetIsVerified() {
+	m.is_verified = nil
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by ids.
 func (m *UserMutation) AddEventPassIDs(ids ...int) {
 	if m.event_passes == nil {
@@ -5849,6 +14174,114 @@ func (m *UserMutation) ResetListings() {
 	m.removedlistings = nil
 }
 
+// AddSaleIDs adds the "sales" edge to the Sale entity by ids.
+func (m *UserMutation) AddSaleIDs(ids ...int) {
+	if m.sales == nil {
+		m.sales = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.sales[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSales clears the "sales" edge to the Sale entity.
+func (m *UserMutation) ClearSales() {
+	m.clearedsales = true
+}
+
+// SalesCleared reports if the "sales" edge to the Sale entity was cleared.
+func (m *UserMutation) SalesCleared() bool {
+	return m.clearedsales
+}
+
+// RemoveSaleIDs removes the "sales" edge to the Sale entity by IDs.
+func (m *UserMutation) RemoveSaleIDs(ids ...int) {
+	if m.removedsales == nil {
+		m.removedsales = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.sales, ids[i])
+		m.removedsales[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSales returns the removed IDs of the "sales" edge to the Sale entity.
+func (m *UserMutation) RemovedSalesIDs() (ids []int) {
+	for id := range m.removedsales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SalesIDs returns the "sales" edge IDs in the mutation.
+func (m *UserMutation) SalesIDs() (ids []int) {
+	for id := range m.sales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSales resets all changes to the "sales" edge.
+func (m *UserMutation) ResetSales() {
+	m.sales = nil
+	m.clearedsales = false
+	m.removedsales = nil
+}
+
+// AddPurchaseIDs adds the "purchases" edge to the Sale entity by ids.
+func (m *UserMutation) AddPurchaseIDs(ids ...int) {
+	if m.purchases == nil {
+		m.purchases = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.purchases[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPurchases clears the "purchases" edge to the Sale entity.
+func (m *UserMutation) ClearPurchases() {
+	m.clearedpurchases = true
+}
+
+// PurchasesCleared reports if the "purchases" edge to the Sale entity was cleared.
+func (m *UserMutation) PurchasesCleared() bool {
+	return m.clearedpurchases
+}
+
+// RemovePurchaseIDs removes the "purchases" edge to the Sale entity by IDs.
+func (m *UserMutation) RemovePurchaseIDs(ids ...int) {
+	if m.removedpurchases == nil {
+		m.removedpurchases = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.purchases, ids[i])
+		m.removedpurchases[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPurchases returns the removed IDs of the "purchases" edge to the Sale entity.
+func (m *UserMutation) RemovedPurchasesIDs() (ids []int) {
+	for id := range m.removedpurchases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PurchasesIDs returns the "purchases" edge IDs in the mutation.
+func (m *UserMutation) PurchasesIDs() (ids []int) {
+	for id := range m.purchases {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPurchases resets all changes to the "purchases" edge.
+func (m *UserMutation) ResetPurchases() {
+	m.purchases = nil
+	m.clearedpurchases = false
+	m.removedpurchases = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -5883,7 +14316,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 10)
 	if m.address != nil {
 		fields = append(fields, user.FieldAddress)
 	}
@@ -5911,6 +14344,9 @@ func (m *UserMutation) Fields() []string {
 	if m.socials != nil {
 		fields = append(fields, user.FieldSocials)
 	}
+	if m.is_verified != nil {
+		fields = append(fields, user.FieldIsVerified)
+	}
 	return fields
 }
 
@@ -5937,6 +14373,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.HighlightedMomentID()
 	case user.FieldSocials:
 		return m.Socials()
+	case user.FieldIsVerified:
+		return m.IsVerified()
 	}
 	return nil, false
 }
@@ -5964,6 +14402,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldHighlightedMomentID(ctx)
 	case user.FieldSocials:
 		return m.OldSocials(ctx)
+	case user.FieldIsVerified:
+		return m.OldIsVerified(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -6036,6 +14476,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSocials(v)
 		return nil
+	case user.FieldIsVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVerified(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -6178,13 +14625,16 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldSocials:
 		m.ResetSocials()
 		return nil
+	case user.FieldIsVerified:
+		m.ResetIsVerified()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.event_passes != nil {
 		edges = append(edges, user.EdgeEventPasses)
 	}
@@ -6203,6 +14653,12 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.listings != nil {
 		edges = append(edges, user.EdgeListings)
 	}
+	if m.sales != nil {
+		edges = append(edges, user.EdgeSales)
+	}
+	if m.purchases != nil {
+		edges = append(edges, user.EdgePurchases)
+	}
 	return edges
 }
 
@@ -6246,13 +14702,25 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSales:
+		ids := make([]ent.Value, 0, len(m.sales))
+		for id := range m.sales {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePurchases:
+		ids := make([]ent.Value, 0, len(m.purchases))
+		for id := range m.purchases {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.removedevent_passes != nil {
 		edges = append(edges, user.EdgeEventPasses)
 	}
@@ -6271,6 +14739,12 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedlistings != nil {
 		edges = append(edges, user.EdgeListings)
 	}
+	if m.removedsales != nil {
+		edges = append(edges, user.EdgeSales)
+	}
+	if m.removedpurchases != nil {
+		edges = append(edges, user.EdgePurchases)
+	}
 	return edges
 }
 
@@ -6314,13 +14788,25 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeSales:
+		ids := make([]ent.Value, 0, len(m.removedsales))
+		for id := range m.removedsales {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePurchases:
+		ids := make([]ent.Value, 0, len(m.removedpurchases))
+		for id := range m.removedpurchases {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.clearedevent_passes {
 		edges = append(edges, user.EdgeEventPasses)
 	}
@@ -6339,6 +14825,12 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedlistings {
 		edges = append(edges, user.EdgeListings)
 	}
+	if m.clearedsales {
+		edges = append(edges, user.EdgeSales)
+	}
+	if m.clearedpurchases {
+		edges = append(edges, user.EdgePurchases)
+	}
 	return edges
 }
 
@@ -6358,6 +14850,10 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedattendances
 	case user.EdgeListings:
 		return m.clearedlistings
+	case user.EdgeSales:
+		return m.clearedsales
+	case user.EdgePurchases:
+		return m.clearedpurchases
 	}
 	return false
 }
@@ -6392,6 +14888,12 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeListings:
 		m.ResetListings()
 		return nil
+	case user.EdgeSales:
+		m.ResetSales()
+		return nil
+	case user.EdgePurchases:
+		m.ResetPurchases()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -3,7 +3,7 @@
 package ent
 
 import (
-	"backend/ent/listing"
+	"backend/ent/gachareceipt"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/user"
@@ -29,10 +29,16 @@ type NFTAccessory struct {
 	Thumbnail string `json:"thumbnail,omitempty"`
 	// EquipmentType holds the value of the "equipment_type" field.
 	EquipmentType string `json:"equipment_type,omitempty"`
+	// RarityScore holds the value of the "rarity_score" field.
+	RarityScore *float64 `json:"rarity_score,omitempty"`
+	// RarityMax holds the value of the "rarity_max" field.
+	RarityMax *float64 `json:"rarity_max,omitempty"`
+	// RarityDescription holds the value of the "rarity_description" field.
+	RarityDescription string `json:"rarity_description,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NFTAccessoryQuery when eager-loading is set.
 	Edges                           NFTAccessoryEdges `json:"edges"`
-	listing_nft_accessory           *int
+	gacha_receipt_accessory         *int
 	nft_moment_equipped_accessories *int
 	user_accessories                *int
 	selectValues                    sql.SelectValues
@@ -44,11 +50,13 @@ type NFTAccessoryEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// EquippedOnMoment holds the value of the equipped_on_moment edge.
 	EquippedOnMoment *NFTMoment `json:"equipped_on_moment,omitempty"`
-	// Listing holds the value of the listing edge.
-	Listing *Listing `json:"listing,omitempty"`
+	// Listings holds the value of the listings edge.
+	Listings []*Listing `json:"listings,omitempty"`
+	// GachaReceipt holds the value of the gacha_receipt edge.
+	GachaReceipt *GachaReceipt `json:"gacha_receipt,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -73,15 +81,24 @@ func (e NFTAccessoryEdges) EquippedOnMomentOrErr() (*NFTMoment, error) {
 	return nil, &NotLoadedError{edge: "equipped_on_moment"}
 }
 
-// ListingOrErr returns the Listing value or an error if the edge
+// ListingsOrErr returns the Listings value or an error if the edge
+// was not loaded in eager-loading.
+func (e NFTAccessoryEdges) ListingsOrErr() ([]*Listing, error) {
+	if e.loadedTypes[2] {
+		return e.Listings, nil
+	}
+	return nil, &NotLoadedError{edge: "listings"}
+}
+
+// GachaReceiptOrErr returns the GachaReceipt value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
-func (e NFTAccessoryEdges) ListingOrErr() (*Listing, error) {
-	if e.Listing != nil {
-		return e.Listing, nil
-	} else if e.loadedTypes[2] {
-		return nil, &NotFoundError{label: listing.Label}
+func (e NFTAccessoryEdges) GachaReceiptOrErr() (*GachaReceipt, error) {
+	if e.GachaReceipt != nil {
+		return e.GachaReceipt, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: gachareceipt.Label}
 	}
-	return nil, &NotLoadedError{edge: "listing"}
+	return nil, &NotLoadedError{edge: "gacha_receipt"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -89,11 +106,13 @@ func (*NFTAccessory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case nftaccessory.FieldRarityScore, nftaccessory.FieldRarityMax:
+			values[i] = new(sql.NullFloat64)
 		case nftaccessory.FieldID, nftaccessory.FieldNftID:
 			values[i] = new(sql.NullInt64)
-		case nftaccessory.FieldName, nftaccessory.FieldDescription, nftaccessory.FieldThumbnail, nftaccessory.FieldEquipmentType:
+		case nftaccessory.FieldName, nftaccessory.FieldDescription, nftaccessory.FieldThumbnail, nftaccessory.FieldEquipmentType, nftaccessory.FieldRarityDescription:
 			values[i] = new(sql.NullString)
-		case nftaccessory.ForeignKeys[0]: // listing_nft_accessory
+		case nftaccessory.ForeignKeys[0]: // gacha_receipt_accessory
 			values[i] = new(sql.NullInt64)
 		case nftaccessory.ForeignKeys[1]: // nft_moment_equipped_accessories
 			values[i] = new(sql.NullInt64)
@@ -150,12 +169,32 @@ func (_m *NFTAccessory) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EquipmentType = value.String
 			}
+		case nftaccessory.FieldRarityScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rarity_score", values[i])
+			} else if value.Valid {
+				_m.RarityScore = new(float64)
+				*_m.RarityScore = value.Float64
+			}
+		case nftaccessory.FieldRarityMax:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rarity_max", values[i])
+			} else if value.Valid {
+				_m.RarityMax = new(float64)
+				*_m.RarityMax = value.Float64
+			}
+		case nftaccessory.FieldRarityDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rarity_description", values[i])
+			} else if value.Valid {
+				_m.RarityDescription = value.String
+			}
 		case nftaccessory.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field listing_nft_accessory", value)
+				return fmt.Errorf("unexpected type %T for edge-field gacha_receipt_accessory", value)
 			} else if value.Valid {
-				_m.listing_nft_accessory = new(int)
-				*_m.listing_nft_accessory = int(value.Int64)
+				_m.gacha_receipt_accessory = new(int)
+				*_m.gacha_receipt_accessory = int(value.Int64)
 			}
 		case nftaccessory.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -194,9 +233,14 @@ func (_m *NFTAccessory) QueryEquippedOnMoment() *NFTMomentQuery {
 	return NewNFTAccessoryClient(_m.config).QueryEquippedOnMoment(_m)
 }
 
-// QueryListing queries the "listing" edge of the NFTAccessory entity.
-func (_m *NFTAccessory) QueryListing() *ListingQuery {
-	return NewNFTAccessoryClient(_m.config).QueryListing(_m)
+// QueryListings queries the "listings" edge of the NFTAccessory entity.
+func (_m *NFTAccessory) QueryListings() *ListingQuery {
+	return NewNFTAccessoryClient(_m.config).QueryListings(_m)
+}
+
+// QueryGachaReceipt queries the "gacha_receipt" edge of the NFTAccessory entity.
+func (_m *NFTAccessory) QueryGachaReceipt() *GachaReceiptQuery {
+	return NewNFTAccessoryClient(_m.config).QueryGachaReceipt(_m)
 }
 
 // Update returns a builder for updating this NFTAccessory.
@@ -236,6 +280,19 @@ func (_m *NFTAccessory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("equipment_type=")
 	builder.WriteString(_m.EquipmentType)
+	builder.WriteString(", ")
+	if v := _m.RarityScore; v != nil {
+		builder.WriteString("rarity_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RarityMax; v != nil {
+		builder.WriteString("rarity_max=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rarity_description=")
+	builder.WriteString(_m.RarityDescription)
 	builder.WriteByte(')')
 	return builder.String()
 }
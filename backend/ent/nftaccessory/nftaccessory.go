@@ -22,12 +22,20 @@ const (
 	FieldThumbnail = "thumbnail"
 	// FieldEquipmentType holds the string denoting the equipment_type field in the database.
 	FieldEquipmentType = "equipment_type"
+	// FieldRarityScore holds the string denoting the rarity_score field in the database.
+	FieldRarityScore = "rarity_score"
+	// FieldRarityMax holds the string denoting the rarity_max field in the database.
+	FieldRarityMax = "rarity_max"
+	// FieldRarityDescription holds the string denoting the rarity_description field in the database.
+	FieldRarityDescription = "rarity_description"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeEquippedOnMoment holds the string denoting the equipped_on_moment edge name in mutations.
 	EdgeEquippedOnMoment = "equipped_on_moment"
-	// EdgeListing holds the string denoting the listing edge name in mutations.
-	EdgeListing = "listing"
+	// EdgeListings holds the string denoting the listings edge name in mutations.
+	EdgeListings = "listings"
+	// EdgeGachaReceipt holds the string denoting the gacha_receipt edge name in mutations.
+	EdgeGachaReceipt = "gacha_receipt"
 	// Table holds the table name of the nftaccessory in the database.
 	Table = "nft_accessories"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -44,13 +52,20 @@ const (
 	EquippedOnMomentInverseTable = "nft_moments"
 	// EquippedOnMomentColumn is the table column denoting the equipped_on_moment relation/edge.
 	EquippedOnMomentColumn = "nft_moment_equipped_accessories"
-	// ListingTable is the table that holds the listing relation/edge.
-	ListingTable = "nft_accessories"
-	// ListingInverseTable is the table name for the Listing entity.
+	// ListingsTable is the table that holds the listings relation/edge.
+	ListingsTable = "listings"
+	// ListingsInverseTable is the table name for the Listing entity.
 	// It exists in this package in order to avoid circular dependency with the "listing" package.
-	ListingInverseTable = "listings"
-	// ListingColumn is the table column denoting the listing relation/edge.
-	ListingColumn = "listing_nft_accessory"
+	ListingsInverseTable = "listings"
+	// ListingsColumn is the table column denoting the listings relation/edge.
+	ListingsColumn = "listing_nft_accessory"
+	// GachaReceiptTable is the table that holds the gacha_receipt relation/edge.
+	GachaReceiptTable = "nft_accessories"
+	// GachaReceiptInverseTable is the table name for the GachaReceipt entity.
+	// It exists in this package in order to avoid circular dependency with the "gachareceipt" package.
+	GachaReceiptInverseTable = "gacha_receipts"
+	// GachaReceiptColumn is the table column denoting the gacha_receipt relation/edge.
+	GachaReceiptColumn = "gacha_receipt_accessory"
 )
 
 // Columns holds all SQL columns for nftaccessory fields.
@@ -61,12 +76,15 @@ var Columns = []string{
 	FieldDescription,
 	FieldThumbnail,
 	FieldEquipmentType,
+	FieldRarityScore,
+	FieldRarityMax,
+	FieldRarityDescription,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "nft_accessories"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
-	"listing_nft_accessory",
+	"gacha_receipt_accessory",
 	"nft_moment_equipped_accessories",
 	"user_accessories",
 }
@@ -119,6 +137,21 @@ func ByEquipmentType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEquipmentType, opts...).ToFunc()
 }
 
+// ByRarityScore orders the results by the rarity_score field.
+func ByRarityScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRarityScore, opts...).ToFunc()
+}
+
+// ByRarityMax orders the results by the rarity_max field.
+func ByRarityMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRarityMax, opts...).ToFunc()
+}
+
+// ByRarityDescription orders the results by the rarity_description field.
+func ByRarityDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRarityDescription, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -133,10 +166,24 @@ func ByEquippedOnMomentField(field string, opts ...sql.OrderTermOption) OrderOpt
 	}
 }
 
-// ByListingField orders the results by listing field.
-func ByListingField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByListingsCount orders the results by listings count.
+func ByListingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListingsStep(), opts...)
+	}
+}
+
+// ByListings orders the results by listings terms.
+func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGachaReceiptField orders the results by gacha_receipt field.
+func ByGachaReceiptField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newListingStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newGachaReceiptStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newOwnerStep() *sqlgraph.Step {
@@ -153,10 +200,17 @@ func newEquippedOnMomentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, EquippedOnMomentTable, EquippedOnMomentColumn),
 	)
 }
-func newListingStep() *sqlgraph.Step {
+func newListingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ListingsTable, ListingsColumn),
+	)
+}
+func newGachaReceiptStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(ListingInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, true, ListingTable, ListingColumn),
+		sqlgraph.To(GachaReceiptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, GachaReceiptTable, GachaReceiptColumn),
 	)
 }
@@ -79,6 +79,21 @@ func EquipmentType(v string) predicate.NFTAccessory {
 	return predicate.NFTAccessory(sql.FieldEQ(FieldEquipmentType, v))
 }
 
+// RarityScore applies equality check predicate on the "rarity_score" field. It's identical to RarityScoreEQ.
+func RarityScore(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityScore, v))
+}
+
+// RarityMax applies equality check predicate on the "rarity_max" field. It's identical to RarityMaxEQ.
+func RarityMax(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityMax, v))
+}
+
+// RarityDescription applies equality check predicate on the "rarity_description" field. It's identical to RarityDescriptionEQ.
+func RarityDescription(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityDescription, v))
+}
+
 // NftIDEQ applies the EQ predicate on the "nft_id" field.
 func NftIDEQ(v uint64) predicate.NFTAccessory {
 	return predicate.NFTAccessory(sql.FieldEQ(FieldNftID, v))
@@ -379,6 +394,181 @@ func EquipmentTypeContainsFold(v string) predicate.NFTAccessory {
 	return predicate.NFTAccessory(sql.FieldContainsFold(FieldEquipmentType, v))
 }
 
+// RarityScoreEQ applies the EQ predicate on the "rarity_score" field.
+func RarityScoreEQ(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityScore, v))
+}
+
+// RarityScoreNEQ applies the NEQ predicate on the "rarity_score" field.
+func RarityScoreNEQ(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNEQ(FieldRarityScore, v))
+}
+
+// RarityScoreIn applies the In predicate on the "rarity_score" field.
+func RarityScoreIn(vs ...float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIn(FieldRarityScore, vs...))
+}
+
+// RarityScoreNotIn applies the NotIn predicate on the "rarity_score" field.
+func RarityScoreNotIn(vs ...float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotIn(FieldRarityScore, vs...))
+}
+
+// RarityScoreGT applies the GT predicate on the "rarity_score" field.
+func RarityScoreGT(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGT(FieldRarityScore, v))
+}
+
+// RarityScoreGTE applies the GTE predicate on the "rarity_score" field.
+func RarityScoreGTE(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGTE(FieldRarityScore, v))
+}
+
+// RarityScoreLT applies the LT predicate on the "rarity_score" field.
+func RarityScoreLT(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLT(FieldRarityScore, v))
+}
+
+// RarityScoreLTE applies the LTE predicate on the "rarity_score" field.
+func RarityScoreLTE(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLTE(FieldRarityScore, v))
+}
+
+// RarityScoreIsNil applies the IsNil predicate on the "rarity_score" field.
+func RarityScoreIsNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIsNull(FieldRarityScore))
+}
+
+// RarityScoreNotNil applies the NotNil predicate on the "rarity_score" field.
+func RarityScoreNotNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotNull(FieldRarityScore))
+}
+
+// RarityMaxEQ applies the EQ predicate on the "rarity_max" field.
+func RarityMaxEQ(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityMax, v))
+}
+
+// RarityMaxNEQ applies the NEQ predicate on the "rarity_max" field.
+func RarityMaxNEQ(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNEQ(FieldRarityMax, v))
+}
+
+// RarityMaxIn applies the In predicate on the "rarity_max" field.
+func RarityMaxIn(vs ...float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIn(FieldRarityMax, vs...))
+}
+
+// RarityMaxNotIn applies the NotIn predicate on the "rarity_max" field.
+func RarityMaxNotIn(vs ...float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotIn(FieldRarityMax, vs...))
+}
+
+// RarityMaxGT applies the GT predicate on the "rarity_max" field.
+func RarityMaxGT(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGT(FieldRarityMax, v))
+}
+
+// RarityMaxGTE applies the GTE predicate on the "rarity_max" field.
+func RarityMaxGTE(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGTE(FieldRarityMax, v))
+}
+
+// RarityMaxLT applies the LT predicate on the "rarity_max" field.
+func RarityMaxLT(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLT(FieldRarityMax, v))
+}
+
+// RarityMaxLTE applies the LTE predicate on the "rarity_max" field.
+func RarityMaxLTE(v float64) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLTE(FieldRarityMax, v))
+}
+
+// RarityMaxIsNil applies the IsNil predicate on the "rarity_max" field.
+func RarityMaxIsNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIsNull(FieldRarityMax))
+}
+
+// RarityMaxNotNil applies the NotNil predicate on the "rarity_max" field.
+func RarityMaxNotNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotNull(FieldRarityMax))
+}
+
+// RarityDescriptionEQ applies the EQ predicate on the "rarity_description" field.
+func RarityDescriptionEQ(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEQ(FieldRarityDescription, v))
+}
+
+// RarityDescriptionNEQ applies the NEQ predicate on the "rarity_description" field.
+func RarityDescriptionNEQ(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNEQ(FieldRarityDescription, v))
+}
+
+// RarityDescriptionIn applies the In predicate on the "rarity_description" field.
+func RarityDescriptionIn(vs ...string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIn(FieldRarityDescription, vs...))
+}
+
+// RarityDescriptionNotIn applies the NotIn predicate on the "rarity_description" field.
+func RarityDescriptionNotIn(vs ...string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotIn(FieldRarityDescription, vs...))
+}
+
+// RarityDescriptionGT applies the GT predicate on the "rarity_description" field.
+func RarityDescriptionGT(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGT(FieldRarityDescription, v))
+}
+
+// RarityDescriptionGTE applies the GTE predicate on the "rarity_description" field.
+func RarityDescriptionGTE(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldGTE(FieldRarityDescription, v))
+}
+
+// RarityDescriptionLT applies the LT predicate on the "rarity_description" field.
+func RarityDescriptionLT(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLT(FieldRarityDescription, v))
+}
+
+// RarityDescriptionLTE applies the LTE predicate on the "rarity_description" field.
+func RarityDescriptionLTE(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldLTE(FieldRarityDescription, v))
+}
+
+// RarityDescriptionContains applies the Contains predicate on the "rarity_description" field.
+func RarityDescriptionContains(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldContains(FieldRarityDescription, v))
+}
+
+// RarityDescriptionHasPrefix applies the HasPrefix predicate on the "rarity_description" field.
+func RarityDescriptionHasPrefix(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldHasPrefix(FieldRarityDescription, v))
+}
+
+// RarityDescriptionHasSuffix applies the HasSuffix predicate on the "rarity_description" field.
+func RarityDescriptionHasSuffix(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldHasSuffix(FieldRarityDescription, v))
+}
+
+// RarityDescriptionIsNil applies the IsNil predicate on the "rarity_description" field.
+func RarityDescriptionIsNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldIsNull(FieldRarityDescription))
+}
+
+// RarityDescriptionNotNil applies the NotNil predicate on the "rarity_description" field.
+func RarityDescriptionNotNil() predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldNotNull(FieldRarityDescription))
+}
+
+// RarityDescriptionEqualFold applies the EqualFold predicate on the "rarity_description" field.
+func RarityDescriptionEqualFold(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldEqualFold(FieldRarityDescription, v))
+}
+
+// RarityDescriptionContainsFold applies the ContainsFold predicate on the "rarity_description" field.
+func RarityDescriptionContainsFold(v string) predicate.NFTAccessory {
+	return predicate.NFTAccessory(sql.FieldContainsFold(FieldRarityDescription, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.NFTAccessory {
 	return predicate.NFTAccessory(func(s *sql.Selector) {
@@ -425,21 +615,44 @@ func HasEquippedOnMomentWith(preds ...predicate.NFTMoment) predicate.NFTAccessor
 	})
 }
 
-// HasListing applies the HasEdge predicate on the "listing" edge.
-func HasListing() predicate.NFTAccessory {
+// HasListings applies the HasEdge predicate on the "listings" edge.
+func HasListings() predicate.NFTAccessory {
+	return predicate.NFTAccessory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingsWith applies the HasEdge predicate on the "listings" edge with a given conditions (other predicates).
+func HasListingsWith(preds ...predicate.Listing) predicate.NFTAccessory {
+	return predicate.NFTAccessory(func(s *sql.Selector) {
+		step := newListingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGachaReceipt applies the HasEdge predicate on the "gacha_receipt" edge.
+func HasGachaReceipt() predicate.NFTAccessory {
 	return predicate.NFTAccessory(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, ListingTable, ListingColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, GachaReceiptTable, GachaReceiptColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasListingWith applies the HasEdge predicate on the "listing" edge with a given conditions (other predicates).
-func HasListingWith(preds ...predicate.Listing) predicate.NFTAccessory {
+// HasGachaReceiptWith applies the HasEdge predicate on the "gacha_receipt" edge with a given conditions (other predicates).
+func HasGachaReceiptWith(preds ...predicate.GachaReceipt) predicate.NFTAccessory {
 	return predicate.NFTAccessory(func(s *sql.Selector) {
-		step := newListingStep()
+		step := newGachaReceiptStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
@@ -11,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +22,7 @@ type NFTAccessoryCreate struct {
 	config
 	mutation *NFTAccessoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetNftID sets the "nft_id" field.
@@ -52,6 +55,48 @@ func (_c *NFTAccessoryCreate) SetEquipmentType(v string) *NFTAccessoryCreate {
 	return _c
 }
 
+// SetRarityScore sets the "rarity_score" field.
+func (_c *NFTAccessoryCreate) SetRarityScore(v float64) *NFTAccessoryCreate {
+	_c.mutation.SetRarityScore(v)
+	return _c
+}
+
+// SetNillableRarityScore sets the "rarity_score" field if the given value is not nil.
+func (_c *NFTAccessoryCreate) SetNillableRarityScore(v *float64) *NFTAccessoryCreate {
+	if v != nil {
+		_c.SetRarityScore(*v)
+	}
+	return _c
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (_c *NFTAccessoryCreate) SetRarityMax(v float64) *NFTAccessoryCreate {
+	_c.mutation.SetRarityMax(v)
+	return _c
+}
+
+// SetNillableRarityMax sets the "rarity_max" field if the given value is not nil.
+func (_c *NFTAccessoryCreate) SetNillableRarityMax(v *float64) *NFTAccessoryCreate {
+	if v != nil {
+		_c.SetRarityMax(*v)
+	}
+	return _c
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (_c *NFTAccessoryCreate) SetRarityDescription(v string) *NFTAccessoryCreate {
+	_c.mutation.SetRarityDescription(v)
+	return _c
+}
+
+// SetNillableRarityDescription sets the "rarity_description" field if the given value is not nil.
+func (_c *NFTAccessoryCreate) SetNillableRarityDescription(v *string) *NFTAccessoryCreate {
+	if v != nil {
+		_c.SetRarityDescription(*v)
+	}
+	return _c
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_c *NFTAccessoryCreate) SetOwnerID(id int) *NFTAccessoryCreate {
 	_c.mutation.SetOwnerID(id)
@@ -82,23 +127,38 @@ func (_c *NFTAccessoryCreate) SetEquippedOnMoment(v *NFTMoment) *NFTAccessoryCre
 	return _c.SetEquippedOnMomentID(v.ID)
 }
 
-// SetListingID sets the "listing" edge to the Listing entity by ID.
-func (_c *NFTAccessoryCreate) SetListingID(id int) *NFTAccessoryCreate {
-	_c.mutation.SetListingID(id)
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_c *NFTAccessoryCreate) AddListingIDs(ids ...int) *NFTAccessoryCreate {
+	_c.mutation.AddListingIDs(ids...)
+	return _c
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_c *NFTAccessoryCreate) AddListings(v ...*Listing) *NFTAccessoryCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListingIDs(ids...)
+}
+
+// SetGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID.
+func (_c *NFTAccessoryCreate) SetGachaReceiptID(id int) *NFTAccessoryCreate {
+	_c.mutation.SetGachaReceiptID(id)
 	return _c
 }
 
-// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
-func (_c *NFTAccessoryCreate) SetNillableListingID(id *int) *NFTAccessoryCreate {
+// SetNillableGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID if the given value is not nil.
+func (_c *NFTAccessoryCreate) SetNillableGachaReceiptID(id *int) *NFTAccessoryCreate {
 	if id != nil {
-		_c = _c.SetListingID(*id)
+		_c = _c.SetGachaReceiptID(*id)
 	}
 	return _c
 }
 
-// SetListing sets the "listing" edge to the Listing entity.
-func (_c *NFTAccessoryCreate) SetListing(v *Listing) *NFTAccessoryCreate {
-	return _c.SetListingID(v.ID)
+// SetGachaReceipt sets the "gacha_receipt" edge to the GachaReceipt entity.
+func (_c *NFTAccessoryCreate) SetGachaReceipt(v *GachaReceipt) *NFTAccessoryCreate {
+	return _c.SetGachaReceiptID(v.ID)
 }
 
 // Mutation returns the NFTAccessoryMutation object of the builder.
@@ -179,6 +239,7 @@ func (_c *NFTAccessoryCreate) createSpec() (*NFTAccessory, *sqlgraph.CreateSpec)
 		_node = &NFTAccessory{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(nftaccessory.Table, sqlgraph.NewFieldSpec(nftaccessory.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.NftID(); ok {
 		_spec.SetField(nftaccessory.FieldNftID, field.TypeUint64, value)
 		_node.NftID = value
@@ -199,6 +260,18 @@ func (_c *NFTAccessoryCreate) createSpec() (*NFTAccessory, *sqlgraph.CreateSpec)
 		_spec.SetField(nftaccessory.FieldEquipmentType, field.TypeString, value)
 		_node.EquipmentType = value
 	}
+	if value, ok := _c.mutation.RarityScore(); ok {
+		_spec.SetField(nftaccessory.FieldRarityScore, field.TypeFloat64, value)
+		_node.RarityScore = &value
+	}
+	if value, ok := _c.mutation.RarityMax(); ok {
+		_spec.SetField(nftaccessory.FieldRarityMax, field.TypeFloat64, value)
+		_node.RarityMax = &value
+	}
+	if value, ok := _c.mutation.RarityDescription(); ok {
+		_spec.SetField(nftaccessory.FieldRarityDescription, field.TypeString, value)
+		_node.RarityDescription = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -233,12 +306,12 @@ func (_c *NFTAccessoryCreate) createSpec() (*NFTAccessory, *sqlgraph.CreateSpec)
 		_node.nft_moment_equipped_accessories = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+	if nodes := _c.mutation.ListingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   nftaccessory.ListingTable,
-			Columns: []string{nftaccessory.ListingColumn},
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
@@ -247,17 +320,442 @@ func (_c *NFTAccessoryCreate) createSpec() (*NFTAccessory, *sqlgraph.CreateSpec)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.listing_nft_accessory = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GachaReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   nftaccessory.GachaReceiptTable,
+			Columns: []string{nftaccessory.GachaReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.gacha_receipt_accessory = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NFTAccessory.Create().
+//		SetNftID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NFTAccessoryUpsert) {
+//			SetNftID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NFTAccessoryCreate) OnConflict(opts ...sql.ConflictOption) *NFTAccessoryUpsertOne {
+	_c.conflict = opts
+	return &NFTAccessoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NFTAccessoryCreate) OnConflictColumns(columns ...string) *NFTAccessoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NFTAccessoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NFTAccessoryUpsertOne is the builder for "upsert"-ing
+	//  one NFTAccessory node.
+	NFTAccessoryUpsertOne struct {
+		create *NFTAccessoryCreate
+	}
+
+	// NFTAccessoryUpsert is the "OnConflict" setter.
+	NFTAccessoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTAccessoryUpsert) SetNftID(v uint64) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldNftID, v)
+	return u
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateNftID() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldNftID)
+	return u
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTAccessoryUpsert) AddNftID(v uint64) *NFTAccessoryUpsert {
+	u.Add(nftaccessory.FieldNftID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NFTAccessoryUpsert) SetName(v string) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateName() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTAccessoryUpsert) SetDescription(v string) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateDescription() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldDescription)
+	return u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTAccessoryUpsert) SetThumbnail(v string) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldThumbnail, v)
+	return u
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateThumbnail() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldThumbnail)
+	return u
+}
+
+// SetEquipmentType sets the "equipment_type" field.
+func (u *NFTAccessoryUpsert) SetEquipmentType(v string) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldEquipmentType, v)
+	return u
+}
+
+// UpdateEquipmentType sets the "equipment_type" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateEquipmentType() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldEquipmentType)
+	return u
+}
+
+// SetRarityScore sets the "rarity_score" field.
+func (u *NFTAccessoryUpsert) SetRarityScore(v float64) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldRarityScore, v)
+	return u
+}
+
+// UpdateRarityScore sets the "rarity_score" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateRarityScore() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldRarityScore)
+	return u
+}
+
+// AddRarityScore adds v to the "rarity_score" field.
+func (u *NFTAccessoryUpsert) AddRarityScore(v float64) *NFTAccessoryUpsert {
+	u.Add(nftaccessory.FieldRarityScore, v)
+	return u
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (u *NFTAccessoryUpsert) ClearRarityScore() *NFTAccessoryUpsert {
+	u.SetNull(nftaccessory.FieldRarityScore)
+	return u
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (u *NFTAccessoryUpsert) SetRarityMax(v float64) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldRarityMax, v)
+	return u
+}
+
+// UpdateRarityMax sets the "rarity_max" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateRarityMax() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldRarityMax)
+	return u
+}
+
+// AddRarityMax adds v to the "rarity_max" field.
+func (u *NFTAccessoryUpsert) AddRarityMax(v float64) *NFTAccessoryUpsert {
+	u.Add(nftaccessory.FieldRarityMax, v)
+	return u
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (u *NFTAccessoryUpsert) ClearRarityMax() *NFTAccessoryUpsert {
+	u.SetNull(nftaccessory.FieldRarityMax)
+	return u
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (u *NFTAccessoryUpsert) SetRarityDescription(v string) *NFTAccessoryUpsert {
+	u.Set(nftaccessory.FieldRarityDescription, v)
+	return u
+}
+
+// UpdateRarityDescription sets the "rarity_description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsert) UpdateRarityDescription() *NFTAccessoryUpsert {
+	u.SetExcluded(nftaccessory.FieldRarityDescription)
+	return u
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (u *NFTAccessoryUpsert) ClearRarityDescription() *NFTAccessoryUpsert {
+	u.SetNull(nftaccessory.FieldRarityDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NFTAccessoryUpsertOne) UpdateNewValues() *NFTAccessoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NFTAccessoryUpsertOne) Ignore() *NFTAccessoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NFTAccessoryUpsertOne) DoNothing() *NFTAccessoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NFTAccessoryCreate.OnConflict
+// documentation for more info.
+func (u *NFTAccessoryUpsertOne) Update(set func(*NFTAccessoryUpsert)) *NFTAccessoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NFTAccessoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTAccessoryUpsertOne) SetNftID(v uint64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTAccessoryUpsertOne) AddNftID(v uint64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateNftID() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NFTAccessoryUpsertOne) SetName(v string) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateName() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTAccessoryUpsertOne) SetDescription(v string) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateDescription() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTAccessoryUpsertOne) SetThumbnail(v string) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateThumbnail() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEquipmentType sets the "equipment_type" field.
+func (u *NFTAccessoryUpsertOne) SetEquipmentType(v string) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetEquipmentType(v)
+	})
+}
+
+// UpdateEquipmentType sets the "equipment_type" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateEquipmentType() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateEquipmentType()
+	})
+}
+
+// SetRarityScore sets the "rarity_score" field.
+func (u *NFTAccessoryUpsertOne) SetRarityScore(v float64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityScore(v)
+	})
+}
+
+// AddRarityScore adds v to the "rarity_score" field.
+func (u *NFTAccessoryUpsertOne) AddRarityScore(v float64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddRarityScore(v)
+	})
+}
+
+// UpdateRarityScore sets the "rarity_score" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateRarityScore() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityScore()
+	})
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (u *NFTAccessoryUpsertOne) ClearRarityScore() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityScore()
+	})
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (u *NFTAccessoryUpsertOne) SetRarityMax(v float64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityMax(v)
+	})
+}
+
+// AddRarityMax adds v to the "rarity_max" field.
+func (u *NFTAccessoryUpsertOne) AddRarityMax(v float64) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddRarityMax(v)
+	})
+}
+
+// UpdateRarityMax sets the "rarity_max" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateRarityMax() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityMax()
+	})
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (u *NFTAccessoryUpsertOne) ClearRarityMax() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityMax()
+	})
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (u *NFTAccessoryUpsertOne) SetRarityDescription(v string) *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityDescription(v)
+	})
+}
+
+// UpdateRarityDescription sets the "rarity_description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertOne) UpdateRarityDescription() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityDescription()
+	})
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (u *NFTAccessoryUpsertOne) ClearRarityDescription() *NFTAccessoryUpsertOne {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *NFTAccessoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NFTAccessoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NFTAccessoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NFTAccessoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NFTAccessoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // NFTAccessoryCreateBulk is the builder for creating many NFTAccessory entities in bulk.
 type NFTAccessoryCreateBulk struct {
 	config
 	err      error
 	builders []*NFTAccessoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the NFTAccessory entities in the database.
@@ -286,6 +784,7 @@ func (_c *NFTAccessoryCreateBulk) Save(ctx context.Context) ([]*NFTAccessory, er
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -339,3 +838,261 @@ func (_c *NFTAccessoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NFTAccessory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NFTAccessoryUpsert) {
+//			SetNftID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NFTAccessoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *NFTAccessoryUpsertBulk {
+	_c.conflict = opts
+	return &NFTAccessoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NFTAccessoryCreateBulk) OnConflictColumns(columns ...string) *NFTAccessoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NFTAccessoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// NFTAccessoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of NFTAccessory nodes.
+type NFTAccessoryUpsertBulk struct {
+	create *NFTAccessoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NFTAccessoryUpsertBulk) UpdateNewValues() *NFTAccessoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NFTAccessory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NFTAccessoryUpsertBulk) Ignore() *NFTAccessoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NFTAccessoryUpsertBulk) DoNothing() *NFTAccessoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NFTAccessoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *NFTAccessoryUpsertBulk) Update(set func(*NFTAccessoryUpsert)) *NFTAccessoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NFTAccessoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTAccessoryUpsertBulk) SetNftID(v uint64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTAccessoryUpsertBulk) AddNftID(v uint64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateNftID() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NFTAccessoryUpsertBulk) SetName(v string) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateName() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTAccessoryUpsertBulk) SetDescription(v string) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateDescription() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTAccessoryUpsertBulk) SetThumbnail(v string) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateThumbnail() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetEquipmentType sets the "equipment_type" field.
+func (u *NFTAccessoryUpsertBulk) SetEquipmentType(v string) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetEquipmentType(v)
+	})
+}
+
+// UpdateEquipmentType sets the "equipment_type" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateEquipmentType() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateEquipmentType()
+	})
+}
+
+// SetRarityScore sets the "rarity_score" field.
+func (u *NFTAccessoryUpsertBulk) SetRarityScore(v float64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityScore(v)
+	})
+}
+
+// AddRarityScore adds v to the "rarity_score" field.
+func (u *NFTAccessoryUpsertBulk) AddRarityScore(v float64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddRarityScore(v)
+	})
+}
+
+// UpdateRarityScore sets the "rarity_score" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateRarityScore() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityScore()
+	})
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (u *NFTAccessoryUpsertBulk) ClearRarityScore() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityScore()
+	})
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (u *NFTAccessoryUpsertBulk) SetRarityMax(v float64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityMax(v)
+	})
+}
+
+// AddRarityMax adds v to the "rarity_max" field.
+func (u *NFTAccessoryUpsertBulk) AddRarityMax(v float64) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.AddRarityMax(v)
+	})
+}
+
+// UpdateRarityMax sets the "rarity_max" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateRarityMax() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityMax()
+	})
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (u *NFTAccessoryUpsertBulk) ClearRarityMax() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityMax()
+	})
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (u *NFTAccessoryUpsertBulk) SetRarityDescription(v string) *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.SetRarityDescription(v)
+	})
+}
+
+// UpdateRarityDescription sets the "rarity_description" field to the value that was provided on create.
+func (u *NFTAccessoryUpsertBulk) UpdateRarityDescription() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.UpdateRarityDescription()
+	})
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (u *NFTAccessoryUpsertBulk) ClearRarityDescription() *NFTAccessoryUpsertBulk {
+	return u.Update(func(s *NFTAccessoryUpsert) {
+		s.ClearRarityDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *NFTAccessoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NFTAccessoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NFTAccessoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NFTAccessoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
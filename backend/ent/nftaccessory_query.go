@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/predicate"
 	"backend/ent/user"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -27,7 +29,8 @@ type NFTAccessoryQuery struct {
 	predicates           []predicate.NFTAccessory
 	withOwner            *UserQuery
 	withEquippedOnMoment *NFTMomentQuery
-	withListing          *ListingQuery
+	withListings         *ListingQuery
+	withGachaReceipt     *GachaReceiptQuery
 	withFKs              bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -109,8 +112,8 @@ func (_q *NFTAccessoryQuery) QueryEquippedOnMoment() *NFTMomentQuery {
 	return query
 }
 
-// QueryListing chains the current query on the "listing" edge.
-func (_q *NFTAccessoryQuery) QueryListing() *ListingQuery {
+// QueryListings chains the current query on the "listings" edge.
+func (_q *NFTAccessoryQuery) QueryListings() *ListingQuery {
 	query := (&ListingClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
@@ -123,7 +126,29 @@ func (_q *NFTAccessoryQuery) QueryListing() *ListingQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, selector),
 			sqlgraph.To(listing.Table, listing.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, nftaccessory.ListingTable, nftaccessory.ListingColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, nftaccessory.ListingsTable, nftaccessory.ListingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGachaReceipt chains the current query on the "gacha_receipt" edge.
+func (_q *NFTAccessoryQuery) QueryGachaReceipt() *GachaReceiptQuery {
+	query := (&GachaReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nftaccessory.Table, nftaccessory.FieldID, selector),
+			sqlgraph.To(gachareceipt.Table, gachareceipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, nftaccessory.GachaReceiptTable, nftaccessory.GachaReceiptColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -325,7 +350,8 @@ func (_q *NFTAccessoryQuery) Clone() *NFTAccessoryQuery {
 		predicates:           append([]predicate.NFTAccessory{}, _q.predicates...),
 		withOwner:            _q.withOwner.Clone(),
 		withEquippedOnMoment: _q.withEquippedOnMoment.Clone(),
-		withListing:          _q.withListing.Clone(),
+		withListings:         _q.withListings.Clone(),
+		withGachaReceipt:     _q.withGachaReceipt.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -354,14 +380,25 @@ func (_q *NFTAccessoryQuery) WithEquippedOnMoment(opts ...func(*NFTMomentQuery))
 	return _q
 }
 
-// WithListing tells the query-builder to eager-load the nodes that are connected to
-// the "listing" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *NFTAccessoryQuery) WithListing(opts ...func(*ListingQuery)) *NFTAccessoryQuery {
+// WithListings tells the query-builder to eager-load the nodes that are connected to
+// the "listings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NFTAccessoryQuery) WithListings(opts ...func(*ListingQuery)) *NFTAccessoryQuery {
 	query := (&ListingClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withListing = query
+	_q.withListings = query
+	return _q
+}
+
+// WithGachaReceipt tells the query-builder to eager-load the nodes that are connected to
+// the "gacha_receipt" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NFTAccessoryQuery) WithGachaReceipt(opts ...func(*GachaReceiptQuery)) *NFTAccessoryQuery {
+	query := (&GachaReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGachaReceipt = query
 	return _q
 }
 
@@ -444,13 +481,14 @@ func (_q *NFTAccessoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*NFTAccessory{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withOwner != nil,
 			_q.withEquippedOnMoment != nil,
-			_q.withListing != nil,
+			_q.withListings != nil,
+			_q.withGachaReceipt != nil,
 		}
 	)
-	if _q.withOwner != nil || _q.withEquippedOnMoment != nil || _q.withListing != nil {
+	if _q.withOwner != nil || _q.withEquippedOnMoment != nil || _q.withGachaReceipt != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -486,9 +524,16 @@ func (_q *NFTAccessoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
-	if query := _q.withListing; query != nil {
-		if err := _q.loadListing(ctx, query, nodes, nil,
-			func(n *NFTAccessory, e *Listing) { n.Edges.Listing = e }); err != nil {
+	if query := _q.withListings; query != nil {
+		if err := _q.loadListings(ctx, query, nodes,
+			func(n *NFTAccessory) { n.Edges.Listings = []*Listing{} },
+			func(n *NFTAccessory, e *Listing) { n.Edges.Listings = append(n.Edges.Listings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGachaReceipt; query != nil {
+		if err := _q.loadGachaReceipt(ctx, query, nodes, nil,
+			func(n *NFTAccessory, e *GachaReceipt) { n.Edges.GachaReceipt = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -559,14 +604,45 @@ func (_q *NFTAccessoryQuery) loadEquippedOnMoment(ctx context.Context, query *NF
 	}
 	return nil
 }
-func (_q *NFTAccessoryQuery) loadListing(ctx context.Context, query *ListingQuery, nodes []*NFTAccessory, init func(*NFTAccessory), assign func(*NFTAccessory, *Listing)) error {
+func (_q *NFTAccessoryQuery) loadListings(ctx context.Context, query *ListingQuery, nodes []*NFTAccessory, init func(*NFTAccessory), assign func(*NFTAccessory, *Listing)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*NFTAccessory)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(nftaccessory.ListingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.listing_nft_accessory
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "listing_nft_accessory" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_nft_accessory" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *NFTAccessoryQuery) loadGachaReceipt(ctx context.Context, query *GachaReceiptQuery, nodes []*NFTAccessory, init func(*NFTAccessory), assign func(*NFTAccessory, *GachaReceipt)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*NFTAccessory)
 	for i := range nodes {
-		if nodes[i].listing_nft_accessory == nil {
+		if nodes[i].gacha_receipt_accessory == nil {
 			continue
 		}
-		fk := *nodes[i].listing_nft_accessory
+		fk := *nodes[i].gacha_receipt_accessory
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -575,7 +651,7 @@ func (_q *NFTAccessoryQuery) loadListing(ctx context.Context, query *ListingQuer
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(listing.IDIn(ids...))
+	query.Where(gachareceipt.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -583,7 +659,7 @@ func (_q *NFTAccessoryQuery) loadListing(ctx context.Context, query *ListingQuer
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "listing_nft_accessory" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "gacha_receipt_accessory" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
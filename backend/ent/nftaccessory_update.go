@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"backend/ent/gachareceipt"
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
@@ -107,6 +108,80 @@ func (_u *NFTAccessoryUpdate) SetNillableEquipmentType(v *string) *NFTAccessoryU
 	return _u
 }
 
+// SetRarityScore sets the "rarity_score" field.
+func (_u *NFTAccessoryUpdate) SetRarityScore(v float64) *NFTAccessoryUpdate {
+	_u.mutation.ResetRarityScore()
+	_u.mutation.SetRarityScore(v)
+	return _u
+}
+
+// SetNillableRarityScore sets the "rarity_score" field if the given value is not nil.
+func (_u *NFTAccessoryUpdate) SetNillableRarityScore(v *float64) *NFTAccessoryUpdate {
+	if v != nil {
+		_u.SetRarityScore(*v)
+	}
+	return _u
+}
+
+// AddRarityScore adds value to the "rarity_score" field.
+func (_u *NFTAccessoryUpdate) AddRarityScore(v float64) *NFTAccessoryUpdate {
+	_u.mutation.AddRarityScore(v)
+	return _u
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (_u *NFTAccessoryUpdate) ClearRarityScore() *NFTAccessoryUpdate {
+	_u.mutation.ClearRarityScore()
+	return _u
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (_u *NFTAccessoryUpdate) SetRarityMax(v float64) *NFTAccessoryUpdate {
+	_u.mutation.ResetRarityMax()
+	_u.mutation.SetRarityMax(v)
+	return _u
+}
+
+// SetNillableRarityMax sets the "rarity_max" field if the given value is not nil.
+func (_u *NFTAccessoryUpdate) SetNillableRarityMax(v *float64) *NFTAccessoryUpdate {
+	if v != nil {
+		_u.SetRarityMax(*v)
+	}
+	return _u
+}
+
+// AddRarityMax adds value to the "rarity_max" field.
+func (_u *NFTAccessoryUpdate) AddRarityMax(v float64) *NFTAccessoryUpdate {
+	_u.mutation.AddRarityMax(v)
+	return _u
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (_u *NFTAccessoryUpdate) ClearRarityMax() *NFTAccessoryUpdate {
+	_u.mutation.ClearRarityMax()
+	return _u
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (_u *NFTAccessoryUpdate) SetRarityDescription(v string) *NFTAccessoryUpdate {
+	_u.mutation.SetRarityDescription(v)
+	return _u
+}
+
+// SetNillableRarityDescription sets the "rarity_description" field if the given value is not nil.
+func (_u *NFTAccessoryUpdate) SetNillableRarityDescription(v *string) *NFTAccessoryUpdate {
+	if v != nil {
+		_u.SetRarityDescription(*v)
+	}
+	return _u
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (_u *NFTAccessoryUpdate) ClearRarityDescription() *NFTAccessoryUpdate {
+	_u.mutation.ClearRarityDescription()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *NFTAccessoryUpdate) SetOwnerID(id int) *NFTAccessoryUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -137,23 +212,38 @@ func (_u *NFTAccessoryUpdate) SetEquippedOnMoment(v *NFTMoment) *NFTAccessoryUpd
 	return _u.SetEquippedOnMomentID(v.ID)
 }
 
-// SetListingID sets the "listing" edge to the Listing entity by ID.
-func (_u *NFTAccessoryUpdate) SetListingID(id int) *NFTAccessoryUpdate {
-	_u.mutation.SetListingID(id)
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NFTAccessoryUpdate) AddListingIDs(ids ...int) *NFTAccessoryUpdate {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NFTAccessoryUpdate) AddListings(v ...*Listing) *NFTAccessoryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// SetGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID.
+func (_u *NFTAccessoryUpdate) SetGachaReceiptID(id int) *NFTAccessoryUpdate {
+	_u.mutation.SetGachaReceiptID(id)
 	return _u
 }
 
-// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
-func (_u *NFTAccessoryUpdate) SetNillableListingID(id *int) *NFTAccessoryUpdate {
+// SetNillableGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID if the given value is not nil.
+func (_u *NFTAccessoryUpdate) SetNillableGachaReceiptID(id *int) *NFTAccessoryUpdate {
 	if id != nil {
-		_u = _u.SetListingID(*id)
+		_u = _u.SetGachaReceiptID(*id)
 	}
 	return _u
 }
 
-// SetListing sets the "listing" edge to the Listing entity.
-func (_u *NFTAccessoryUpdate) SetListing(v *Listing) *NFTAccessoryUpdate {
-	return _u.SetListingID(v.ID)
+// SetGachaReceipt sets the "gacha_receipt" edge to the GachaReceipt entity.
+func (_u *NFTAccessoryUpdate) SetGachaReceipt(v *GachaReceipt) *NFTAccessoryUpdate {
+	return _u.SetGachaReceiptID(v.ID)
 }
 
 // Mutation returns the NFTAccessoryMutation object of the builder.
@@ -173,9 +263,30 @@ func (_u *NFTAccessoryUpdate) ClearEquippedOnMoment() *NFTAccessoryUpdate {
 	return _u
 }
 
-// ClearListing clears the "listing" edge to the Listing entity.
-func (_u *NFTAccessoryUpdate) ClearListing() *NFTAccessoryUpdate {
-	_u.mutation.ClearListing()
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NFTAccessoryUpdate) ClearListings() *NFTAccessoryUpdate {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NFTAccessoryUpdate) RemoveListingIDs(ids ...int) *NFTAccessoryUpdate {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NFTAccessoryUpdate) RemoveListings(v ...*Listing) *NFTAccessoryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// ClearGachaReceipt clears the "gacha_receipt" edge to the GachaReceipt entity.
+func (_u *NFTAccessoryUpdate) ClearGachaReceipt() *NFTAccessoryUpdate {
+	_u.mutation.ClearGachaReceipt()
 	return _u
 }
 
@@ -244,6 +355,30 @@ func (_u *NFTAccessoryUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.EquipmentType(); ok {
 		_spec.SetField(nftaccessory.FieldEquipmentType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.RarityScore(); ok {
+		_spec.SetField(nftaccessory.FieldRarityScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRarityScore(); ok {
+		_spec.AddField(nftaccessory.FieldRarityScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.RarityScoreCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RarityMax(); ok {
+		_spec.SetField(nftaccessory.FieldRarityMax, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRarityMax(); ok {
+		_spec.AddField(nftaccessory.FieldRarityMax, field.TypeFloat64, value)
+	}
+	if _u.mutation.RarityMaxCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityMax, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RarityDescription(); ok {
+		_spec.SetField(nftaccessory.FieldRarityDescription, field.TypeString, value)
+	}
+	if _u.mutation.RarityDescriptionCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityDescription, field.TypeString)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -302,12 +437,12 @@ func (_u *NFTAccessoryUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ListingCleared() {
+	if _u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   nftaccessory.ListingTable,
-			Columns: []string{nftaccessory.ListingColumn},
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
@@ -315,12 +450,28 @@ func (_u *NFTAccessoryUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   nftaccessory.ListingTable,
-			Columns: []string{nftaccessory.ListingColumn},
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
@@ -331,6 +482,35 @@ func (_u *NFTAccessoryUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GachaReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   nftaccessory.GachaReceiptTable,
+			Columns: []string{nftaccessory.GachaReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GachaReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   nftaccessory.GachaReceiptTable,
+			Columns: []string{nftaccessory.GachaReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{nftaccessory.Label}
@@ -428,6 +608,80 @@ func (_u *NFTAccessoryUpdateOne) SetNillableEquipmentType(v *string) *NFTAccesso
 	return _u
 }
 
+// SetRarityScore sets the "rarity_score" field.
+func (_u *NFTAccessoryUpdateOne) SetRarityScore(v float64) *NFTAccessoryUpdateOne {
+	_u.mutation.ResetRarityScore()
+	_u.mutation.SetRarityScore(v)
+	return _u
+}
+
+// SetNillableRarityScore sets the "rarity_score" field if the given value is not nil.
+func (_u *NFTAccessoryUpdateOne) SetNillableRarityScore(v *float64) *NFTAccessoryUpdateOne {
+	if v != nil {
+		_u.SetRarityScore(*v)
+	}
+	return _u
+}
+
+// AddRarityScore adds value to the "rarity_score" field.
+func (_u *NFTAccessoryUpdateOne) AddRarityScore(v float64) *NFTAccessoryUpdateOne {
+	_u.mutation.AddRarityScore(v)
+	return _u
+}
+
+// ClearRarityScore clears the value of the "rarity_score" field.
+func (_u *NFTAccessoryUpdateOne) ClearRarityScore() *NFTAccessoryUpdateOne {
+	_u.mutation.ClearRarityScore()
+	return _u
+}
+
+// SetRarityMax sets the "rarity_max" field.
+func (_u *NFTAccessoryUpdateOne) SetRarityMax(v float64) *NFTAccessoryUpdateOne {
+	_u.mutation.ResetRarityMax()
+	_u.mutation.SetRarityMax(v)
+	return _u
+}
+
+// SetNillableRarityMax sets the "rarity_max" field if the given value is not nil.
+func (_u *NFTAccessoryUpdateOne) SetNillableRarityMax(v *float64) *NFTAccessoryUpdateOne {
+	if v != nil {
+		_u.SetRarityMax(*v)
+	}
+	return _u
+}
+
+// AddRarityMax adds value to the "rarity_max" field.
+func (_u *NFTAccessoryUpdateOne) AddRarityMax(v float64) *NFTAccessoryUpdateOne {
+	_u.mutation.AddRarityMax(v)
+	return _u
+}
+
+// ClearRarityMax clears the value of the "rarity_max" field.
+func (_u *NFTAccessoryUpdateOne) ClearRarityMax() *NFTAccessoryUpdateOne {
+	_u.mutation.ClearRarityMax()
+	return _u
+}
+
+// SetRarityDescription sets the "rarity_description" field.
+func (_u *NFTAccessoryUpdateOne) SetRarityDescription(v string) *NFTAccessoryUpdateOne {
+	_u.mutation.SetRarityDescription(v)
+	return _u
+}
+
+// SetNillableRarityDescription sets the "rarity_description" field if the given value is not nil.
+func (_u *NFTAccessoryUpdateOne) SetNillableRarityDescription(v *string) *NFTAccessoryUpdateOne {
+	if v != nil {
+		_u.SetRarityDescription(*v)
+	}
+	return _u
+}
+
+// ClearRarityDescription clears the value of the "rarity_description" field.
+func (_u *NFTAccessoryUpdateOne) ClearRarityDescription() *NFTAccessoryUpdateOne {
+	_u.mutation.ClearRarityDescription()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *NFTAccessoryUpdateOne) SetOwnerID(id int) *NFTAccessoryUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -458,23 +712,38 @@ func (_u *NFTAccessoryUpdateOne) SetEquippedOnMoment(v *NFTMoment) *NFTAccessory
 	return _u.SetEquippedOnMomentID(v.ID)
 }
 
-// SetListingID sets the "listing" edge to the Listing entity by ID.
-func (_u *NFTAccessoryUpdateOne) SetListingID(id int) *NFTAccessoryUpdateOne {
-	_u.mutation.SetListingID(id)
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NFTAccessoryUpdateOne) AddListingIDs(ids ...int) *NFTAccessoryUpdateOne {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NFTAccessoryUpdateOne) AddListings(v ...*Listing) *NFTAccessoryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// SetGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID.
+func (_u *NFTAccessoryUpdateOne) SetGachaReceiptID(id int) *NFTAccessoryUpdateOne {
+	_u.mutation.SetGachaReceiptID(id)
 	return _u
 }
 
-// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
-func (_u *NFTAccessoryUpdateOne) SetNillableListingID(id *int) *NFTAccessoryUpdateOne {
+// SetNillableGachaReceiptID sets the "gacha_receipt" edge to the GachaReceipt entity by ID if the given value is not nil.
+func (_u *NFTAccessoryUpdateOne) SetNillableGachaReceiptID(id *int) *NFTAccessoryUpdateOne {
 	if id != nil {
-		_u = _u.SetListingID(*id)
+		_u = _u.SetGachaReceiptID(*id)
 	}
 	return _u
 }
 
-// SetListing sets the "listing" edge to the Listing entity.
-func (_u *NFTAccessoryUpdateOne) SetListing(v *Listing) *NFTAccessoryUpdateOne {
-	return _u.SetListingID(v.ID)
+// SetGachaReceipt sets the "gacha_receipt" edge to the GachaReceipt entity.
+func (_u *NFTAccessoryUpdateOne) SetGachaReceipt(v *GachaReceipt) *NFTAccessoryUpdateOne {
+	return _u.SetGachaReceiptID(v.ID)
 }
 
 // Mutation returns the NFTAccessoryMutation object of the builder.
@@ -494,9 +763,30 @@ func (_u *NFTAccessoryUpdateOne) ClearEquippedOnMoment() *NFTAccessoryUpdateOne
 	return _u
 }
 
-// ClearListing clears the "listing" edge to the Listing entity.
-func (_u *NFTAccessoryUpdateOne) ClearListing() *NFTAccessoryUpdateOne {
-	_u.mutation.ClearListing()
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NFTAccessoryUpdateOne) ClearListings() *NFTAccessoryUpdateOne {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NFTAccessoryUpdateOne) RemoveListingIDs(ids ...int) *NFTAccessoryUpdateOne {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NFTAccessoryUpdateOne) RemoveListings(v ...*Listing) *NFTAccessoryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// ClearGachaReceipt clears the "gacha_receipt" edge to the GachaReceipt entity.
+func (_u *NFTAccessoryUpdateOne) ClearGachaReceipt() *NFTAccessoryUpdateOne {
+	_u.mutation.ClearGachaReceipt()
 	return _u
 }
 
@@ -595,6 +885,30 @@ func (_u *NFTAccessoryUpdateOne) sqlSave(ctx context.Context) (_node *NFTAccesso
 	if value, ok := _u.mutation.EquipmentType(); ok {
 		_spec.SetField(nftaccessory.FieldEquipmentType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.RarityScore(); ok {
+		_spec.SetField(nftaccessory.FieldRarityScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRarityScore(); ok {
+		_spec.AddField(nftaccessory.FieldRarityScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.RarityScoreCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RarityMax(); ok {
+		_spec.SetField(nftaccessory.FieldRarityMax, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRarityMax(); ok {
+		_spec.AddField(nftaccessory.FieldRarityMax, field.TypeFloat64, value)
+	}
+	if _u.mutation.RarityMaxCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityMax, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RarityDescription(); ok {
+		_spec.SetField(nftaccessory.FieldRarityDescription, field.TypeString, value)
+	}
+	if _u.mutation.RarityDescriptionCleared() {
+		_spec.ClearField(nftaccessory.FieldRarityDescription, field.TypeString)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -653,12 +967,12 @@ func (_u *NFTAccessoryUpdateOne) sqlSave(ctx context.Context) (_node *NFTAccesso
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ListingCleared() {
+	if _u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   nftaccessory.ListingTable,
-			Columns: []string{nftaccessory.ListingColumn},
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
@@ -666,12 +980,28 @@ func (_u *NFTAccessoryUpdateOne) sqlSave(ctx context.Context) (_node *NFTAccesso
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: true,
-			Table:   nftaccessory.ListingTable,
-			Columns: []string{nftaccessory.ListingColumn},
+			Table:   nftaccessory.ListingsTable,
+			Columns: []string{nftaccessory.ListingsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
@@ -682,6 +1012,35 @@ func (_u *NFTAccessoryUpdateOne) sqlSave(ctx context.Context) (_node *NFTAccesso
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GachaReceiptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   nftaccessory.GachaReceiptTable,
+			Columns: []string{nftaccessory.GachaReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GachaReceiptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   nftaccessory.GachaReceiptTable,
+			Columns: []string{nftaccessory.GachaReceiptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(gachareceipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &NFTAccessory{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,6 +8,7 @@ import (
 	"backend/ent/user"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,6 +27,14 @@ type NFTMoment struct {
 	Description string `json:"description,omitempty"`
 	// Thumbnail holds the value of the "thumbnail" field.
 	Thumbnail string `json:"thumbnail,omitempty"`
+	// Tier holds the value of the "tier" field.
+	Tier string `json:"tier,omitempty"`
+	// MintedBlock holds the value of the "minted_block" field.
+	MintedBlock *uint64 `json:"minted_block,omitempty"`
+	// MintedTime holds the value of the "minted_time" field.
+	MintedTime *time.Time `json:"minted_time,omitempty"`
+	// PassID holds the value of the "pass_id" field.
+	PassID *uint64 `json:"pass_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NFTMomentQuery when eager-loading is set.
 	Edges             NFTMomentEdges `json:"edges"`
@@ -42,9 +51,11 @@ type NFTMomentEdges struct {
 	EquippedAccessories []*NFTAccessory `json:"equipped_accessories,omitempty"`
 	// MintedWithPass holds the value of the minted_with_pass edge.
 	MintedWithPass *EventPass `json:"minted_with_pass,omitempty"`
+	// Listings holds the value of the listings edge.
+	Listings []*Listing `json:"listings,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -78,15 +89,26 @@ func (e NFTMomentEdges) MintedWithPassOrErr() (*EventPass, error) {
 	return nil, &NotLoadedError{edge: "minted_with_pass"}
 }
 
+// ListingsOrErr returns the Listings value or an error if the edge
+// was not loaded in eager-loading.
+func (e NFTMomentEdges) ListingsOrErr() ([]*Listing, error) {
+	if e.loadedTypes[3] {
+		return e.Listings, nil
+	}
+	return nil, &NotLoadedError{edge: "listings"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*NFTMoment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case nftmoment.FieldID, nftmoment.FieldNftID:
+		case nftmoment.FieldID, nftmoment.FieldNftID, nftmoment.FieldMintedBlock, nftmoment.FieldPassID:
 			values[i] = new(sql.NullInt64)
-		case nftmoment.FieldName, nftmoment.FieldDescription, nftmoment.FieldThumbnail:
+		case nftmoment.FieldName, nftmoment.FieldDescription, nftmoment.FieldThumbnail, nftmoment.FieldTier:
 			values[i] = new(sql.NullString)
+		case nftmoment.FieldMintedTime:
+			values[i] = new(sql.NullTime)
 		case nftmoment.ForeignKeys[0]: // event_pass_moment
 			values[i] = new(sql.NullInt64)
 		case nftmoment.ForeignKeys[1]: // user_moments
@@ -136,6 +158,33 @@ func (_m *NFTMoment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Thumbnail = value.String
 			}
+		case nftmoment.FieldTier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tier", values[i])
+			} else if value.Valid {
+				_m.Tier = value.String
+			}
+		case nftmoment.FieldMintedBlock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field minted_block", values[i])
+			} else if value.Valid {
+				_m.MintedBlock = new(uint64)
+				*_m.MintedBlock = uint64(value.Int64)
+			}
+		case nftmoment.FieldMintedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field minted_time", values[i])
+			} else if value.Valid {
+				_m.MintedTime = new(time.Time)
+				*_m.MintedTime = value.Time
+			}
+		case nftmoment.FieldPassID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pass_id", values[i])
+			} else if value.Valid {
+				_m.PassID = new(uint64)
+				*_m.PassID = uint64(value.Int64)
+			}
 		case nftmoment.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field event_pass_moment", value)
@@ -178,6 +227,11 @@ func (_m *NFTMoment) QueryMintedWithPass() *EventPassQuery {
 	return NewNFTMomentClient(_m.config).QueryMintedWithPass(_m)
 }
 
+// QueryListings queries the "listings" edge of the NFTMoment entity.
+func (_m *NFTMoment) QueryListings() *ListingQuery {
+	return NewNFTMomentClient(_m.config).QueryListings(_m)
+}
+
 // Update returns a builder for updating this NFTMoment.
 // Note that you need to call NFTMoment.Unwrap() before calling this method if this NFTMoment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -212,6 +266,24 @@ func (_m *NFTMoment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("thumbnail=")
 	builder.WriteString(_m.Thumbnail)
+	builder.WriteString(", ")
+	builder.WriteString("tier=")
+	builder.WriteString(_m.Tier)
+	builder.WriteString(", ")
+	if v := _m.MintedBlock; v != nil {
+		builder.WriteString("minted_block=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MintedTime; v != nil {
+		builder.WriteString("minted_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PassID; v != nil {
+		builder.WriteString("pass_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,12 +20,22 @@ const (
 	FieldDescription = "description"
 	// FieldThumbnail holds the string denoting the thumbnail field in the database.
 	FieldThumbnail = "thumbnail"
+	// FieldTier holds the string denoting the tier field in the database.
+	FieldTier = "tier"
+	// FieldMintedBlock holds the string denoting the minted_block field in the database.
+	FieldMintedBlock = "minted_block"
+	// FieldMintedTime holds the string denoting the minted_time field in the database.
+	FieldMintedTime = "minted_time"
+	// FieldPassID holds the string denoting the pass_id field in the database.
+	FieldPassID = "pass_id"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeEquippedAccessories holds the string denoting the equipped_accessories edge name in mutations.
 	EdgeEquippedAccessories = "equipped_accessories"
 	// EdgeMintedWithPass holds the string denoting the minted_with_pass edge name in mutations.
 	EdgeMintedWithPass = "minted_with_pass"
+	// EdgeListings holds the string denoting the listings edge name in mutations.
+	EdgeListings = "listings"
 	// Table holds the table name of the nftmoment in the database.
 	Table = "nft_moments"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -49,6 +59,13 @@ const (
 	MintedWithPassInverseTable = "event_passes"
 	// MintedWithPassColumn is the table column denoting the minted_with_pass relation/edge.
 	MintedWithPassColumn = "event_pass_moment"
+	// ListingsTable is the table that holds the listings relation/edge.
+	ListingsTable = "listings"
+	// ListingsInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingsInverseTable = "listings"
+	// ListingsColumn is the table column denoting the listings relation/edge.
+	ListingsColumn = "listing_nft_moment"
 )
 
 // Columns holds all SQL columns for nftmoment fields.
@@ -58,6 +75,10 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldThumbnail,
+	FieldTier,
+	FieldMintedBlock,
+	FieldMintedTime,
+	FieldPassID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "nft_moments"
@@ -110,6 +131,26 @@ func ByThumbnail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldThumbnail, opts...).ToFunc()
 }
 
+// ByTier orders the results by the tier field.
+func ByTier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTier, opts...).ToFunc()
+}
+
+// ByMintedBlock orders the results by the minted_block field.
+func ByMintedBlock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMintedBlock, opts...).ToFunc()
+}
+
+// ByMintedTime orders the results by the minted_time field.
+func ByMintedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMintedTime, opts...).ToFunc()
+}
+
+// ByPassID orders the results by the pass_id field.
+func ByPassID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPassID, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -137,6 +178,20 @@ func ByMintedWithPassField(field string, opts ...sql.OrderTermOption) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newMintedWithPassStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByListingsCount orders the results by listings count.
+func ByListingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListingsStep(), opts...)
+	}
+}
+
+// ByListings orders the results by listings terms.
+func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -158,3 +213,10 @@ func newMintedWithPassStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, true, MintedWithPassTable, MintedWithPassColumn),
 	)
 }
+func newListingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ListingsTable, ListingsColumn),
+	)
+}
@@ -4,6 +4,7 @@ package nftmoment
 
 import (
 	"backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -74,6 +75,26 @@ func Thumbnail(v string) predicate.NFTMoment {
 	return predicate.NFTMoment(sql.FieldEQ(FieldThumbnail, v))
 }
 
+// Tier applies equality check predicate on the "tier" field. It's identical to TierEQ.
+func Tier(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldTier, v))
+}
+
+// MintedBlock applies equality check predicate on the "minted_block" field. It's identical to MintedBlockEQ.
+func MintedBlock(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldMintedBlock, v))
+}
+
+// MintedTime applies equality check predicate on the "minted_time" field. It's identical to MintedTimeEQ.
+func MintedTime(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldMintedTime, v))
+}
+
+// PassID applies equality check predicate on the "pass_id" field. It's identical to PassIDEQ.
+func PassID(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldPassID, v))
+}
+
 // NftIDEQ applies the EQ predicate on the "nft_id" field.
 func NftIDEQ(v uint64) predicate.NFTMoment {
 	return predicate.NFTMoment(sql.FieldEQ(FieldNftID, v))
@@ -309,6 +330,231 @@ func ThumbnailContainsFold(v string) predicate.NFTMoment {
 	return predicate.NFTMoment(sql.FieldContainsFold(FieldThumbnail, v))
 }
 
+// TierEQ applies the EQ predicate on the "tier" field.
+func TierEQ(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldTier, v))
+}
+
+// TierNEQ applies the NEQ predicate on the "tier" field.
+func TierNEQ(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNEQ(FieldTier, v))
+}
+
+// TierIn applies the In predicate on the "tier" field.
+func TierIn(vs ...string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIn(FieldTier, vs...))
+}
+
+// TierNotIn applies the NotIn predicate on the "tier" field.
+func TierNotIn(vs ...string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotIn(FieldTier, vs...))
+}
+
+// TierGT applies the GT predicate on the "tier" field.
+func TierGT(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGT(FieldTier, v))
+}
+
+// TierGTE applies the GTE predicate on the "tier" field.
+func TierGTE(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGTE(FieldTier, v))
+}
+
+// TierLT applies the LT predicate on the "tier" field.
+func TierLT(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLT(FieldTier, v))
+}
+
+// TierLTE applies the LTE predicate on the "tier" field.
+func TierLTE(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLTE(FieldTier, v))
+}
+
+// TierContains applies the Contains predicate on the "tier" field.
+func TierContains(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldContains(FieldTier, v))
+}
+
+// TierHasPrefix applies the HasPrefix predicate on the "tier" field.
+func TierHasPrefix(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldHasPrefix(FieldTier, v))
+}
+
+// TierHasSuffix applies the HasSuffix predicate on the "tier" field.
+func TierHasSuffix(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldHasSuffix(FieldTier, v))
+}
+
+// TierIsNil applies the IsNil predicate on the "tier" field.
+func TierIsNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIsNull(FieldTier))
+}
+
+// TierNotNil applies the NotNil predicate on the "tier" field.
+func TierNotNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotNull(FieldTier))
+}
+
+// TierEqualFold applies the EqualFold predicate on the "tier" field.
+func TierEqualFold(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEqualFold(FieldTier, v))
+}
+
+// TierContainsFold applies the ContainsFold predicate on the "tier" field.
+func TierContainsFold(v string) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldContainsFold(FieldTier, v))
+}
+
+// MintedBlockEQ applies the EQ predicate on the "minted_block" field.
+func MintedBlockEQ(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldMintedBlock, v))
+}
+
+// MintedBlockNEQ applies the NEQ predicate on the "minted_block" field.
+func MintedBlockNEQ(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNEQ(FieldMintedBlock, v))
+}
+
+// MintedBlockIn applies the In predicate on the "minted_block" field.
+func MintedBlockIn(vs ...uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIn(FieldMintedBlock, vs...))
+}
+
+// MintedBlockNotIn applies the NotIn predicate on the "minted_block" field.
+func MintedBlockNotIn(vs ...uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotIn(FieldMintedBlock, vs...))
+}
+
+// MintedBlockGT applies the GT predicate on the "minted_block" field.
+func MintedBlockGT(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGT(FieldMintedBlock, v))
+}
+
+// MintedBlockGTE applies the GTE predicate on the "minted_block" field.
+func MintedBlockGTE(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGTE(FieldMintedBlock, v))
+}
+
+// MintedBlockLT applies the LT predicate on the "minted_block" field.
+func MintedBlockLT(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLT(FieldMintedBlock, v))
+}
+
+// MintedBlockLTE applies the LTE predicate on the "minted_block" field.
+func MintedBlockLTE(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLTE(FieldMintedBlock, v))
+}
+
+// MintedBlockIsNil applies the IsNil predicate on the "minted_block" field.
+func MintedBlockIsNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIsNull(FieldMintedBlock))
+}
+
+// MintedBlockNotNil applies the NotNil predicate on the "minted_block" field.
+func MintedBlockNotNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotNull(FieldMintedBlock))
+}
+
+// MintedTimeEQ applies the EQ predicate on the "minted_time" field.
+func MintedTimeEQ(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldMintedTime, v))
+}
+
+// MintedTimeNEQ applies the NEQ predicate on the "minted_time" field.
+func MintedTimeNEQ(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNEQ(FieldMintedTime, v))
+}
+
+// MintedTimeIn applies the In predicate on the "minted_time" field.
+func MintedTimeIn(vs ...time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIn(FieldMintedTime, vs...))
+}
+
+// MintedTimeNotIn applies the NotIn predicate on the "minted_time" field.
+func MintedTimeNotIn(vs ...time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotIn(FieldMintedTime, vs...))
+}
+
+// MintedTimeGT applies the GT predicate on the "minted_time" field.
+func MintedTimeGT(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGT(FieldMintedTime, v))
+}
+
+// MintedTimeGTE applies the GTE predicate on the "minted_time" field.
+func MintedTimeGTE(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGTE(FieldMintedTime, v))
+}
+
+// MintedTimeLT applies the LT predicate on the "minted_time" field.
+func MintedTimeLT(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLT(FieldMintedTime, v))
+}
+
+// MintedTimeLTE applies the LTE predicate on the "minted_time" field.
+func MintedTimeLTE(v time.Time) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLTE(FieldMintedTime, v))
+}
+
+// MintedTimeIsNil applies the IsNil predicate on the "minted_time" field.
+func MintedTimeIsNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIsNull(FieldMintedTime))
+}
+
+// MintedTimeNotNil applies the NotNil predicate on the "minted_time" field.
+func MintedTimeNotNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotNull(FieldMintedTime))
+}
+
+// PassIDEQ applies the EQ predicate on the "pass_id" field.
+func PassIDEQ(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldEQ(FieldPassID, v))
+}
+
+// PassIDNEQ applies the NEQ predicate on the "pass_id" field.
+func PassIDNEQ(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNEQ(FieldPassID, v))
+}
+
+// PassIDIn applies the In predicate on the "pass_id" field.
+func PassIDIn(vs ...uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIn(FieldPassID, vs...))
+}
+
+// PassIDNotIn applies the NotIn predicate on the "pass_id" field.
+func PassIDNotIn(vs ...uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotIn(FieldPassID, vs...))
+}
+
+// PassIDGT applies the GT predicate on the "pass_id" field.
+func PassIDGT(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGT(FieldPassID, v))
+}
+
+// PassIDGTE applies the GTE predicate on the "pass_id" field.
+func PassIDGTE(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldGTE(FieldPassID, v))
+}
+
+// PassIDLT applies the LT predicate on the "pass_id" field.
+func PassIDLT(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLT(FieldPassID, v))
+}
+
+// PassIDLTE applies the LTE predicate on the "pass_id" field.
+func PassIDLTE(v uint64) predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldLTE(FieldPassID, v))
+}
+
+// PassIDIsNil applies the IsNil predicate on the "pass_id" field.
+func PassIDIsNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldIsNull(FieldPassID))
+}
+
+// PassIDNotNil applies the NotNil predicate on the "pass_id" field.
+func PassIDNotNil() predicate.NFTMoment {
+	return predicate.NFTMoment(sql.FieldNotNull(FieldPassID))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.NFTMoment {
 	return predicate.NFTMoment(func(s *sql.Selector) {
@@ -378,6 +624,29 @@ func HasMintedWithPassWith(preds ...predicate.EventPass) predicate.NFTMoment {
 	})
 }
 
+// HasListings applies the HasEdge predicate on the "listings" edge.
+func HasListings() predicate.NFTMoment {
+	return predicate.NFTMoment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingsWith applies the HasEdge predicate on the "listings" edge with a given conditions (other predicates).
+func HasListingsWith(preds ...predicate.Listing) predicate.NFTMoment {
+	return predicate.NFTMoment(func(s *sql.Selector) {
+		step := newListingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NFTMoment) predicate.NFTMoment {
 	return predicate.NFTMoment(sql.AndPredicates(predicates...))
@@ -4,13 +4,16 @@ package ent
 
 import (
 	"backend/ent/eventpass"
+	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +23,7 @@ type NFTMomentCreate struct {
 	config
 	mutation *NFTMomentMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetNftID sets the "nft_id" field.
@@ -46,6 +50,62 @@ func (_c *NFTMomentCreate) SetThumbnail(v string) *NFTMomentCreate {
 	return _c
 }
 
+// SetTier sets the "tier" field.
+func (_c *NFTMomentCreate) SetTier(v string) *NFTMomentCreate {
+	_c.mutation.SetTier(v)
+	return _c
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_c *NFTMomentCreate) SetNillableTier(v *string) *NFTMomentCreate {
+	if v != nil {
+		_c.SetTier(*v)
+	}
+	return _c
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (_c *NFTMomentCreate) SetMintedBlock(v uint64) *NFTMomentCreate {
+	_c.mutation.SetMintedBlock(v)
+	return _c
+}
+
+// SetNillableMintedBlock sets the "minted_block" field if the given value is not nil.
+func (_c *NFTMomentCreate) SetNillableMintedBlock(v *uint64) *NFTMomentCreate {
+	if v != nil {
+		_c.SetMintedBlock(*v)
+	}
+	return _c
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (_c *NFTMomentCreate) SetMintedTime(v time.Time) *NFTMomentCreate {
+	_c.mutation.SetMintedTime(v)
+	return _c
+}
+
+// SetNillableMintedTime sets the "minted_time" field if the given value is not nil.
+func (_c *NFTMomentCreate) SetNillableMintedTime(v *time.Time) *NFTMomentCreate {
+	if v != nil {
+		_c.SetMintedTime(*v)
+	}
+	return _c
+}
+
+// SetPassID sets the "pass_id" field.
+func (_c *NFTMomentCreate) SetPassID(v uint64) *NFTMomentCreate {
+	_c.mutation.SetPassID(v)
+	return _c
+}
+
+// SetNillablePassID sets the "pass_id" field if the given value is not nil.
+func (_c *NFTMomentCreate) SetNillablePassID(v *uint64) *NFTMomentCreate {
+	if v != nil {
+		_c.SetPassID(*v)
+	}
+	return _c
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_c *NFTMomentCreate) SetOwnerID(id int) *NFTMomentCreate {
 	_c.mutation.SetOwnerID(id)
@@ -91,6 +151,21 @@ func (_c *NFTMomentCreate) SetMintedWithPass(v *EventPass) *NFTMomentCreate {
 	return _c.SetMintedWithPassID(v.ID)
 }
 
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_c *NFTMomentCreate) AddListingIDs(ids ...int) *NFTMomentCreate {
+	_c.mutation.AddListingIDs(ids...)
+	return _c
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_c *NFTMomentCreate) AddListings(v ...*Listing) *NFTMomentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListingIDs(ids...)
+}
+
 // Mutation returns the NFTMomentMutation object of the builder.
 func (_c *NFTMomentCreate) Mutation() *NFTMomentMutation {
 	return _c.mutation
@@ -166,6 +241,7 @@ func (_c *NFTMomentCreate) createSpec() (*NFTMoment, *sqlgraph.CreateSpec) {
 		_node = &NFTMoment{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(nftmoment.Table, sqlgraph.NewFieldSpec(nftmoment.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.NftID(); ok {
 		_spec.SetField(nftmoment.FieldNftID, field.TypeUint64, value)
 		_node.NftID = value
@@ -182,6 +258,22 @@ func (_c *NFTMomentCreate) createSpec() (*NFTMoment, *sqlgraph.CreateSpec) {
 		_spec.SetField(nftmoment.FieldThumbnail, field.TypeString, value)
 		_node.Thumbnail = value
 	}
+	if value, ok := _c.mutation.Tier(); ok {
+		_spec.SetField(nftmoment.FieldTier, field.TypeString, value)
+		_node.Tier = value
+	}
+	if value, ok := _c.mutation.MintedBlock(); ok {
+		_spec.SetField(nftmoment.FieldMintedBlock, field.TypeUint64, value)
+		_node.MintedBlock = &value
+	}
+	if value, ok := _c.mutation.MintedTime(); ok {
+		_spec.SetField(nftmoment.FieldMintedTime, field.TypeTime, value)
+		_node.MintedTime = &value
+	}
+	if value, ok := _c.mutation.PassID(); ok {
+		_spec.SetField(nftmoment.FieldPassID, field.TypeUint64, value)
+		_node.PassID = &value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -232,14 +324,452 @@ func (_c *NFTMomentCreate) createSpec() (*NFTMoment, *sqlgraph.CreateSpec) {
 		_node.event_pass_moment = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NFTMoment.Create().
+//		SetNftID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NFTMomentUpsert) {
+//			SetNftID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NFTMomentCreate) OnConflict(opts ...sql.ConflictOption) *NFTMomentUpsertOne {
+	_c.conflict = opts
+	return &NFTMomentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NFTMomentCreate) OnConflictColumns(columns ...string) *NFTMomentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NFTMomentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NFTMomentUpsertOne is the builder for "upsert"-ing
+	//  one NFTMoment node.
+	NFTMomentUpsertOne struct {
+		create *NFTMomentCreate
+	}
+
+	// NFTMomentUpsert is the "OnConflict" setter.
+	NFTMomentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTMomentUpsert) SetNftID(v uint64) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldNftID, v)
+	return u
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateNftID() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldNftID)
+	return u
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTMomentUpsert) AddNftID(v uint64) *NFTMomentUpsert {
+	u.Add(nftmoment.FieldNftID, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NFTMomentUpsert) SetName(v string) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateName() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTMomentUpsert) SetDescription(v string) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateDescription() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldDescription)
+	return u
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTMomentUpsert) SetThumbnail(v string) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldThumbnail, v)
+	return u
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateThumbnail() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldThumbnail)
+	return u
+}
+
+// SetTier sets the "tier" field.
+func (u *NFTMomentUpsert) SetTier(v string) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldTier, v)
+	return u
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateTier() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldTier)
+	return u
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *NFTMomentUpsert) ClearTier() *NFTMomentUpsert {
+	u.SetNull(nftmoment.FieldTier)
+	return u
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (u *NFTMomentUpsert) SetMintedBlock(v uint64) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldMintedBlock, v)
+	return u
+}
+
+// UpdateMintedBlock sets the "minted_block" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateMintedBlock() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldMintedBlock)
+	return u
+}
+
+// AddMintedBlock adds v to the "minted_block" field.
+func (u *NFTMomentUpsert) AddMintedBlock(v uint64) *NFTMomentUpsert {
+	u.Add(nftmoment.FieldMintedBlock, v)
+	return u
+}
+
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (u *NFTMomentUpsert) ClearMintedBlock() *NFTMomentUpsert {
+	u.SetNull(nftmoment.FieldMintedBlock)
+	return u
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (u *NFTMomentUpsert) SetMintedTime(v time.Time) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldMintedTime, v)
+	return u
+}
+
+// UpdateMintedTime sets the "minted_time" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdateMintedTime() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldMintedTime)
+	return u
+}
+
+// ClearMintedTime clears the value of the "minted_time" field.
+func (u *NFTMomentUpsert) ClearMintedTime() *NFTMomentUpsert {
+	u.SetNull(nftmoment.FieldMintedTime)
+	return u
+}
+
+// SetPassID sets the "pass_id" field.
+func (u *NFTMomentUpsert) SetPassID(v uint64) *NFTMomentUpsert {
+	u.Set(nftmoment.FieldPassID, v)
+	return u
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *NFTMomentUpsert) UpdatePassID() *NFTMomentUpsert {
+	u.SetExcluded(nftmoment.FieldPassID)
+	return u
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *NFTMomentUpsert) AddPassID(v uint64) *NFTMomentUpsert {
+	u.Add(nftmoment.FieldPassID, v)
+	return u
+}
+
+// ClearPassID clears the value of the "pass_id" field.
+func (u *NFTMomentUpsert) ClearPassID() *NFTMomentUpsert {
+	u.SetNull(nftmoment.FieldPassID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NFTMomentUpsertOne) UpdateNewValues() *NFTMomentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NFTMomentUpsertOne) Ignore() *NFTMomentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NFTMomentUpsertOne) DoNothing() *NFTMomentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NFTMomentCreate.OnConflict
+// documentation for more info.
+func (u *NFTMomentUpsertOne) Update(set func(*NFTMomentUpsert)) *NFTMomentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NFTMomentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTMomentUpsertOne) SetNftID(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTMomentUpsertOne) AddNftID(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateNftID() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NFTMomentUpsertOne) SetName(v string) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateName() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTMomentUpsertOne) SetDescription(v string) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateDescription() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTMomentUpsertOne) SetThumbnail(v string) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateThumbnail() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *NFTMomentUpsertOne) SetTier(v string) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateTier() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *NFTMomentUpsertOne) ClearTier() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (u *NFTMomentUpsertOne) SetMintedBlock(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetMintedBlock(v)
+	})
+}
+
+// AddMintedBlock adds v to the "minted_block" field.
+func (u *NFTMomentUpsertOne) AddMintedBlock(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddMintedBlock(v)
+	})
+}
+
+// UpdateMintedBlock sets the "minted_block" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateMintedBlock() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateMintedBlock()
+	})
+}
+
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (u *NFTMomentUpsertOne) ClearMintedBlock() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearMintedBlock()
+	})
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (u *NFTMomentUpsertOne) SetMintedTime(v time.Time) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetMintedTime(v)
+	})
+}
+
+// UpdateMintedTime sets the "minted_time" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdateMintedTime() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateMintedTime()
+	})
+}
+
+// ClearMintedTime clears the value of the "minted_time" field.
+func (u *NFTMomentUpsertOne) ClearMintedTime() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearMintedTime()
+	})
+}
+
+// SetPassID sets the "pass_id" field.
+func (u *NFTMomentUpsertOne) SetPassID(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetPassID(v)
+	})
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *NFTMomentUpsertOne) AddPassID(v uint64) *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddPassID(v)
+	})
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *NFTMomentUpsertOne) UpdatePassID() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdatePassID()
+	})
+}
+
+// ClearPassID clears the value of the "pass_id" field.
+func (u *NFTMomentUpsertOne) ClearPassID() *NFTMomentUpsertOne {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearPassID()
+	})
+}
+
+// Exec executes the query.
+func (u *NFTMomentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NFTMomentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NFTMomentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NFTMomentUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NFTMomentUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // NFTMomentCreateBulk is the builder for creating many NFTMoment entities in bulk.
 type NFTMomentCreateBulk struct {
 	config
 	err      error
 	builders []*NFTMomentCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the NFTMoment entities in the database.
@@ -268,6 +798,7 @@ func (_c *NFTMomentCreateBulk) Save(ctx context.Context) ([]*NFTMoment, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -321,3 +852,268 @@ func (_c *NFTMomentCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NFTMoment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NFTMomentUpsert) {
+//			SetNftID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NFTMomentCreateBulk) OnConflict(opts ...sql.ConflictOption) *NFTMomentUpsertBulk {
+	_c.conflict = opts
+	return &NFTMomentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NFTMomentCreateBulk) OnConflictColumns(columns ...string) *NFTMomentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NFTMomentUpsertBulk{
+		create: _c,
+	}
+}
+
+// NFTMomentUpsertBulk is the builder for "upsert"-ing
+// a bulk of NFTMoment nodes.
+type NFTMomentUpsertBulk struct {
+	create *NFTMomentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NFTMomentUpsertBulk) UpdateNewValues() *NFTMomentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NFTMoment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NFTMomentUpsertBulk) Ignore() *NFTMomentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NFTMomentUpsertBulk) DoNothing() *NFTMomentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NFTMomentCreateBulk.OnConflict
+// documentation for more info.
+func (u *NFTMomentUpsertBulk) Update(set func(*NFTMomentUpsert)) *NFTMomentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NFTMomentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *NFTMomentUpsertBulk) SetNftID(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *NFTMomentUpsertBulk) AddNftID(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateNftID() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NFTMomentUpsertBulk) SetName(v string) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateName() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NFTMomentUpsertBulk) SetDescription(v string) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateDescription() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetThumbnail sets the "thumbnail" field.
+func (u *NFTMomentUpsertBulk) SetThumbnail(v string) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetThumbnail(v)
+	})
+}
+
+// UpdateThumbnail sets the "thumbnail" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateThumbnail() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateThumbnail()
+	})
+}
+
+// SetTier sets the "tier" field.
+func (u *NFTMomentUpsertBulk) SetTier(v string) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetTier(v)
+	})
+}
+
+// UpdateTier sets the "tier" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateTier() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateTier()
+	})
+}
+
+// ClearTier clears the value of the "tier" field.
+func (u *NFTMomentUpsertBulk) ClearTier() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearTier()
+	})
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (u *NFTMomentUpsertBulk) SetMintedBlock(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetMintedBlock(v)
+	})
+}
+
+// AddMintedBlock adds v to the "minted_block" field.
+func (u *NFTMomentUpsertBulk) AddMintedBlock(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddMintedBlock(v)
+	})
+}
+
+// UpdateMintedBlock sets the "minted_block" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateMintedBlock() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateMintedBlock()
+	})
+}
+
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (u *NFTMomentUpsertBulk) ClearMintedBlock() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearMintedBlock()
+	})
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (u *NFTMomentUpsertBulk) SetMintedTime(v time.Time) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetMintedTime(v)
+	})
+}
+
+// UpdateMintedTime sets the "minted_time" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdateMintedTime() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdateMintedTime()
+	})
+}
+
+// ClearMintedTime clears the value of the "minted_time" field.
+func (u *NFTMomentUpsertBulk) ClearMintedTime() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearMintedTime()
+	})
+}
+
+// SetPassID sets the "pass_id" field.
+func (u *NFTMomentUpsertBulk) SetPassID(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.SetPassID(v)
+	})
+}
+
+// AddPassID adds v to the "pass_id" field.
+func (u *NFTMomentUpsertBulk) AddPassID(v uint64) *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.AddPassID(v)
+	})
+}
+
+// UpdatePassID sets the "pass_id" field to the value that was provided on create.
+func (u *NFTMomentUpsertBulk) UpdatePassID() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.UpdatePassID()
+	})
+}
+
+// ClearPassID clears the value of the "pass_id" field.
+func (u *NFTMomentUpsertBulk) ClearPassID() *NFTMomentUpsertBulk {
+	return u.Update(func(s *NFTMomentUpsert) {
+		s.ClearPassID()
+	})
+}
+
+// Exec executes the query.
+func (u *NFTMomentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NFTMomentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NFTMomentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NFTMomentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
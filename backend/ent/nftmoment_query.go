@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/eventpass"
+	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/predicate"
@@ -29,6 +30,7 @@ type NFTMomentQuery struct {
 	withOwner               *UserQuery
 	withEquippedAccessories *NFTAccessoryQuery
 	withMintedWithPass      *EventPassQuery
+	withListings            *ListingQuery
 	withFKs                 bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -132,6 +134,28 @@ func (_q *NFTMomentQuery) QueryMintedWithPass() *EventPassQuery {
 	return query
 }
 
+// QueryListings chains the current query on the "listings" edge.
+func (_q *NFTMomentQuery) QueryListings() *ListingQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(nftmoment.Table, nftmoment.FieldID, selector),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, nftmoment.ListingsTable, nftmoment.ListingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first NFTMoment entity from the query.
 // Returns a *NotFoundError when no NFTMoment was found.
 func (_q *NFTMomentQuery) First(ctx context.Context) (*NFTMoment, error) {
@@ -327,6 +351,7 @@ func (_q *NFTMomentQuery) Clone() *NFTMomentQuery {
 		withOwner:               _q.withOwner.Clone(),
 		withEquippedAccessories: _q.withEquippedAccessories.Clone(),
 		withMintedWithPass:      _q.withMintedWithPass.Clone(),
+		withListings:            _q.withListings.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -366,6 +391,17 @@ func (_q *NFTMomentQuery) WithMintedWithPass(opts ...func(*EventPassQuery)) *NFT
 	return _q
 }
 
+// WithListings tells the query-builder to eager-load the nodes that are connected to
+// the "listings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *NFTMomentQuery) WithListings(opts ...func(*ListingQuery)) *NFTMomentQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withListings = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +481,11 @@ func (_q *NFTMomentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NF
 		nodes       = []*NFTMoment{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			_q.withOwner != nil,
 			_q.withEquippedAccessories != nil,
 			_q.withMintedWithPass != nil,
+			_q.withListings != nil,
 		}
 	)
 	if _q.withOwner != nil || _q.withMintedWithPass != nil {
@@ -496,6 +533,13 @@ func (_q *NFTMomentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NF
 			return nil, err
 		}
 	}
+	if query := _q.withListings; query != nil {
+		if err := _q.loadListings(ctx, query, nodes,
+			func(n *NFTMoment) { n.Edges.Listings = []*Listing{} },
+			func(n *NFTMoment, e *Listing) { n.Edges.Listings = append(n.Edges.Listings, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -594,6 +638,37 @@ func (_q *NFTMomentQuery) loadMintedWithPass(ctx context.Context, query *EventPa
 	}
 	return nil
 }
+func (_q *NFTMomentQuery) loadListings(ctx context.Context, query *ListingQuery, nodes []*NFTMoment, init func(*NFTMoment), assign func(*NFTMoment, *Listing)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*NFTMoment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(nftmoment.ListingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.listing_nft_moment
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "listing_nft_moment" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_nft_moment" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *NFTMomentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/eventpass"
+	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/predicate"
@@ -11,6 +12,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -93,6 +95,100 @@ func (_u *NFTMomentUpdate) SetNillableThumbnail(v *string) *NFTMomentUpdate {
 	return _u
 }
 
+// SetTier sets the "tier" field.
+func (_u *NFTMomentUpdate) SetTier(v string) *NFTMomentUpdate {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *NFTMomentUpdate) SetNillableTier(v *string) *NFTMomentUpdate {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// ClearTier clears the value of the "tier" field.
+func (_u *NFTMomentUpdate) ClearTier() *NFTMomentUpdate {
+	_u.mutation.ClearTier()
+	return _u
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (_u *NFTMomentUpdate) SetMintedBlock(v uint64) *NFTMomentUpdate {
+	_u.mutation.ResetMintedBlock()
+	_u.mutation.SetMintedBlock(v)
+	return _u
+}
+
+// SetNillableMintedBlock sets the "minted_block" field if the given value is not nil.
+func (_u *NFTMomentUpdate) SetNillableMintedBlock(v *uint64) *NFTMomentUpdate {
+	if v != nil {
+		_u.SetMintedBlock(*v)
+	}
+	return _u
+}
+
+// AddMintedBlock adds value to the "minted_block" field.
+func (_u *NFTMomentUpdate) AddMintedBlock(v int64) *NFTMomentUpdate {
+	_u.mutation.AddMintedBlock(v)
+	return _u
+}
+
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (_u *NFTMomentUpdate) ClearMintedBlock() *NFTMomentUpdate {
+	_u.mutation.ClearMintedBlock()
+	return _u
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (_u *NFTMomentUpdate) SetMintedTime(v time.Time) *NFTMomentUpdate {
+	_u.mutation.SetMintedTime(v)
+	return _u
+}
+
+// SetNillableMintedTime sets the "minted_time" field if the given value is not nil.
+func (_u *NFTMomentUpdate) SetNillableMintedTime(v *time.Time) *NFTMomentUpdate {
+	if v != nil {
+		_u.SetMintedTime(*v)
+	}
+	return _u
+}
+
+// ClearMintedTime clears the value of the "minted_time" field.
+func (_u *NFTMomentUpdate) ClearMintedTime() *NFTMomentUpdate {
+	_u.mutation.ClearMintedTime()
+	return _u
+}
+
+// SetPassID sets the "pass_id" field.
+func (_u *NFTMomentUpdate) SetPassID(v uint64) *NFTMomentUpdate {
+	_u.mutation.ResetPassID()
+	_u.mutation.SetPassID(v)
+	return _u
+}
+
+// SetNillablePassID sets the "pass_id" field if the given value is not nil.
+func (_u *NFTMomentUpdate) SetNillablePassID(v *uint64) *NFTMomentUpdate {
+	if v != nil {
+		_u.SetPassID(*v)
+	}
+	return _u
+}
+
+// AddPassID adds value to the "pass_id" field.
+func (_u *NFTMomentUpdate) AddPassID(v int64) *NFTMomentUpdate {
+	_u.mutation.AddPassID(v)
+	return _u
+}
+
+// ClearPassID clears the value of the "pass_id" field.
+func (_u *NFTMomentUpdate) ClearPassID() *NFTMomentUpdate {
+	_u.mutation.ClearPassID()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *NFTMomentUpdate) SetOwnerID(id int) *NFTMomentUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -138,6 +234,21 @@ func (_u *NFTMomentUpdate) SetMintedWithPass(v *EventPass) *NFTMomentUpdate {
 	return _u.SetMintedWithPassID(v.ID)
 }
 
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NFTMomentUpdate) AddListingIDs(ids ...int) *NFTMomentUpdate {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NFTMomentUpdate) AddListings(v ...*Listing) *NFTMomentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
 // Mutation returns the NFTMomentMutation object of the builder.
 func (_u *NFTMomentUpdate) Mutation() *NFTMomentMutation {
 	return _u.mutation
@@ -176,6 +287,27 @@ func (_u *NFTMomentUpdate) ClearMintedWithPass() *NFTMomentUpdate {
 	return _u
 }
 
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NFTMomentUpdate) ClearListings() *NFTMomentUpdate {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NFTMomentUpdate) RemoveListingIDs(ids ...int) *NFTMomentUpdate {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NFTMomentUpdate) RemoveListings(v ...*Listing) *NFTMomentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *NFTMomentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -238,6 +370,36 @@ func (_u *NFTMomentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Thumbnail(); ok {
 		_spec.SetField(nftmoment.FieldThumbnail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(nftmoment.FieldTier, field.TypeString, value)
+	}
+	if _u.mutation.TierCleared() {
+		_spec.ClearField(nftmoment.FieldTier, field.TypeString)
+	}
+	if value, ok := _u.mutation.MintedBlock(); ok {
+		_spec.SetField(nftmoment.FieldMintedBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMintedBlock(); ok {
+		_spec.AddField(nftmoment.FieldMintedBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.MintedBlockCleared() {
+		_spec.ClearField(nftmoment.FieldMintedBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MintedTime(); ok {
+		_spec.SetField(nftmoment.FieldMintedTime, field.TypeTime, value)
+	}
+	if _u.mutation.MintedTimeCleared() {
+		_spec.ClearField(nftmoment.FieldMintedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PassID(); ok {
+		_spec.SetField(nftmoment.FieldPassID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedPassID(); ok {
+		_spec.AddField(nftmoment.FieldPassID, field.TypeUint64, value)
+	}
+	if _u.mutation.PassIDCleared() {
+		_spec.ClearField(nftmoment.FieldPassID, field.TypeUint64)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -341,6 +503,51 @@ func (_u *NFTMomentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{nftmoment.Label}
@@ -424,6 +631,100 @@ func (_u *NFTMomentUpdateOne) SetNillableThumbnail(v *string) *NFTMomentUpdateOn
 	return _u
 }
 
+// SetTier sets the "tier" field.
+func (_u *NFTMomentUpdateOne) SetTier(v string) *NFTMomentUpdateOne {
+	_u.mutation.SetTier(v)
+	return _u
+}
+
+// SetNillableTier sets the "tier" field if the given value is not nil.
+func (_u *NFTMomentUpdateOne) SetNillableTier(v *string) *NFTMomentUpdateOne {
+	if v != nil {
+		_u.SetTier(*v)
+	}
+	return _u
+}
+
+// ClearTier clears the value of the "tier" field.
+func (_u *NFTMomentUpdateOne) ClearTier() *NFTMomentUpdateOne {
+	_u.mutation.ClearTier()
+	return _u
+}
+
+// SetMintedBlock sets the "minted_block" field.
+func (_u *NFTMomentUpdateOne) SetMintedBlock(v uint64) *NFTMomentUpdateOne {
+	_u.mutation.ResetMintedBlock()
+	_u.mutation.SetMintedBlock(v)
+	return _u
+}
+
+// SetNillableMintedBlock sets the "minted_block" field if the given value is not nil.
+func (_u *NFTMomentUpdateOne) SetNillableMintedBlock(v *uint64) *NFTMomentUpdateOne {
+	if v != nil {
+		_u.SetMintedBlock(*v)
+	}
+	return _u
+}
+
+// AddMintedBlock adds value to the "minted_block" field.
+func (_u *NFTMomentUpdateOne) AddMintedBlock(v int64) *NFTMomentUpdateOne {
+	_u.mutation.AddMintedBlock(v)
+	return _u
+}
+
+// ClearMintedBlock clears the value of the "minted_block" field.
+func (_u *NFTMomentUpdateOne) ClearMintedBlock() *NFTMomentUpdateOne {
+	_u.mutation.ClearMintedBlock()
+	return _u
+}
+
+// SetMintedTime sets the "minted_time" field.
+func (_u *NFTMomentUpdateOne) SetMintedTime(v time.Time) *NFTMomentUpdateOne {
+	_u.mutation.SetMintedTime(v)
+	return _u
+}
+
+// SetNillableMintedTime sets the "minted_time" field if the given value is not nil.
+func (_u *NFTMomentUpdateOne) SetNillableMintedTime(v *time.Time) *NFTMomentUpdateOne {
+	if v != nil {
+		_u.SetMintedTime(*v)
+	}
+	return _u
+}
+
+// ClearMintedTime clears the value of the "minted_time" field.
+func (_u *NFTMomentUpdateOne) ClearMintedTime() *NFTMomentUpdateOne {
+	_u.mutation.ClearMintedTime()
+	return _u
+}
+
+// SetPassID sets the "pass_id" field.
+func (_u *NFTMomentUpdateOne) SetPassID(v uint64) *NFTMomentUpdateOne {
+	_u.mutation.ResetPassID()
+	_u.mutation.SetPassID(v)
+	return _u
+}
+
+// SetNillablePassID sets the "pass_id" field if the given value is not nil.
+func (_u *NFTMomentUpdateOne) SetNillablePassID(v *uint64) *NFTMomentUpdateOne {
+	if v != nil {
+		_u.SetPassID(*v)
+	}
+	return _u
+}
+
+// AddPassID adds value to the "pass_id" field.
+func (_u *NFTMomentUpdateOne) AddPassID(v int64) *NFTMomentUpdateOne {
+	_u.mutation.AddPassID(v)
+	return _u
+}
+
+// ClearPassID clears the value of the "pass_id" field.
+func (_u *NFTMomentUpdateOne) ClearPassID() *NFTMomentUpdateOne {
+	_u.mutation.ClearPassID()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *NFTMomentUpdateOne) SetOwnerID(id int) *NFTMomentUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -469,6 +770,21 @@ func (_u *NFTMomentUpdateOne) SetMintedWithPass(v *EventPass) *NFTMomentUpdateOn
 	return _u.SetMintedWithPassID(v.ID)
 }
 
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NFTMomentUpdateOne) AddListingIDs(ids ...int) *NFTMomentUpdateOne {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NFTMomentUpdateOne) AddListings(v ...*Listing) *NFTMomentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
 // Mutation returns the NFTMomentMutation object of the builder.
 func (_u *NFTMomentUpdateOne) Mutation() *NFTMomentMutation {
 	return _u.mutation
@@ -507,6 +823,27 @@ func (_u *NFTMomentUpdateOne) ClearMintedWithPass() *NFTMomentUpdateOne {
 	return _u
 }
 
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NFTMomentUpdateOne) ClearListings() *NFTMomentUpdateOne {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NFTMomentUpdateOne) RemoveListingIDs(ids ...int) *NFTMomentUpdateOne {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NFTMomentUpdateOne) RemoveListings(v ...*Listing) *NFTMomentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
 // Where appends a list predicates to the NFTMomentUpdate builder.
 func (_u *NFTMomentUpdateOne) Where(ps ...predicate.NFTMoment) *NFTMomentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -599,6 +936,36 @@ func (_u *NFTMomentUpdateOne) sqlSave(ctx context.Context) (_node *NFTMoment, er
 	if value, ok := _u.mutation.Thumbnail(); ok {
 		_spec.SetField(nftmoment.FieldThumbnail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Tier(); ok {
+		_spec.SetField(nftmoment.FieldTier, field.TypeString, value)
+	}
+	if _u.mutation.TierCleared() {
+		_spec.ClearField(nftmoment.FieldTier, field.TypeString)
+	}
+	if value, ok := _u.mutation.MintedBlock(); ok {
+		_spec.SetField(nftmoment.FieldMintedBlock, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMintedBlock(); ok {
+		_spec.AddField(nftmoment.FieldMintedBlock, field.TypeUint64, value)
+	}
+	if _u.mutation.MintedBlockCleared() {
+		_spec.ClearField(nftmoment.FieldMintedBlock, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MintedTime(); ok {
+		_spec.SetField(nftmoment.FieldMintedTime, field.TypeTime, value)
+	}
+	if _u.mutation.MintedTimeCleared() {
+		_spec.ClearField(nftmoment.FieldMintedTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PassID(); ok {
+		_spec.SetField(nftmoment.FieldPassID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedPassID(); ok {
+		_spec.AddField(nftmoment.FieldPassID, field.TypeUint64, value)
+	}
+	if _u.mutation.PassIDCleared() {
+		_spec.ClearField(nftmoment.FieldPassID, field.TypeUint64)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -702,6 +1069,51 @@ func (_u *NFTMomentUpdateOne) sqlSave(ctx context.Context) (_node *NFTMoment, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   nftmoment.ListingsTable,
+			Columns: []string{nftmoment.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &NFTMoment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/pendingevent"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PendingEvent is the model entity for the PendingEvent schema.
+type PendingEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Entity holds the value of the "entity" field.
+	Entity string `json:"entity,omitempty"`
+	// EntityKey holds the value of the "entity_key" field.
+	EntityKey string `json:"entity_key,omitempty"`
+	// BlockHeight holds the value of the "block_height" field.
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// BlockID holds the value of the "block_id" field.
+	BlockID string `json:"block_id,omitempty"`
+	// BlockTime holds the value of the "block_time" field.
+	BlockTime time.Time `json:"block_time,omitempty"`
+	// TxID holds the value of the "tx_id" field.
+	TxID string `json:"tx_id,omitempty"`
+	// TxIndex holds the value of the "tx_index" field.
+	TxIndex int `json:"tx_index,omitempty"`
+	// EventIndex holds the value of the "event_index" field.
+	EventIndex int `json:"event_index,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PendingEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pendingevent.FieldPayload:
+			values[i] = new([]byte)
+		case pendingevent.FieldID, pendingevent.FieldBlockHeight, pendingevent.FieldTxIndex, pendingevent.FieldEventIndex:
+			values[i] = new(sql.NullInt64)
+		case pendingevent.FieldEntity, pendingevent.FieldEntityKey, pendingevent.FieldBlockID, pendingevent.FieldTxID, pendingevent.FieldType:
+			values[i] = new(sql.NullString)
+		case pendingevent.FieldBlockTime, pendingevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PendingEvent fields.
+func (_m *PendingEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pendingevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case pendingevent.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				_m.Entity = value.String
+			}
+		case pendingevent.FieldEntityKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_key", values[i])
+			} else if value.Valid {
+				_m.EntityKey = value.String
+			}
+		case pendingevent.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = uint64(value.Int64)
+			}
+		case pendingevent.FieldBlockID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_id", values[i])
+			} else if value.Valid {
+				_m.BlockID = value.String
+			}
+		case pendingevent.FieldBlockTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field block_time", values[i])
+			} else if value.Valid {
+				_m.BlockTime = value.Time
+			}
+		case pendingevent.FieldTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_id", values[i])
+			} else if value.Valid {
+				_m.TxID = value.String
+			}
+		case pendingevent.FieldTxIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_index", values[i])
+			} else if value.Valid {
+				_m.TxIndex = int(value.Int64)
+			}
+		case pendingevent.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case pendingevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case pendingevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case pendingevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PendingEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *PendingEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PendingEvent.
+// Note that you need to call PendingEvent.Unwrap() before calling this method if this PendingEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PendingEvent) Update() *PendingEventUpdateOne {
+	return NewPendingEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PendingEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PendingEvent) Unwrap() *PendingEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PendingEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PendingEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PendingEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("entity=")
+	builder.WriteString(_m.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_key=")
+	builder.WriteString(_m.EntityKey)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("block_id=")
+	builder.WriteString(_m.BlockID)
+	builder.WriteString(", ")
+	builder.WriteString("block_time=")
+	builder.WriteString(_m.BlockTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tx_id=")
+	builder.WriteString(_m.TxID)
+	builder.WriteString(", ")
+	builder.WriteString("tx_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TxIndex))
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PendingEvents is a parsable slice of PendingEvent.
+type PendingEvents []*PendingEvent
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pendingevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the pendingevent type in the database.
+	Label = "pending_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEntity holds the string denoting the entity field in the database.
+	FieldEntity = "entity"
+	// FieldEntityKey holds the string denoting the entity_key field in the database.
+	FieldEntityKey = "entity_key"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldBlockID holds the string denoting the block_id field in the database.
+	FieldBlockID = "block_id"
+	// FieldBlockTime holds the string denoting the block_time field in the database.
+	FieldBlockTime = "block_time"
+	// FieldTxID holds the string denoting the tx_id field in the database.
+	FieldTxID = "tx_id"
+	// FieldTxIndex holds the string denoting the tx_index field in the database.
+	FieldTxIndex = "tx_index"
+	// FieldEventIndex holds the string denoting the event_index field in the database.
+	FieldEventIndex = "event_index"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the pendingevent in the database.
+	Table = "pending_events"
+)
+
+// Columns holds all SQL columns for pendingevent fields.
+var Columns = []string{
+	FieldID,
+	FieldEntity,
+	FieldEntityKey,
+	FieldBlockHeight,
+	FieldBlockID,
+	FieldBlockTime,
+	FieldTxID,
+	FieldTxIndex,
+	FieldEventIndex,
+	FieldType,
+	FieldPayload,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PendingEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEntity orders the results by the entity field.
+func ByEntity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntity, opts...).ToFunc()
+}
+
+// ByEntityKey orders the results by the entity_key field.
+func ByEntityKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityKey, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByBlockID orders the results by the block_id field.
+func ByBlockID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockID, opts...).ToFunc()
+}
+
+// ByBlockTime orders the results by the block_time field.
+func ByBlockTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockTime, opts...).ToFunc()
+}
+
+// ByTxID orders the results by the tx_id field.
+func ByTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxID, opts...).ToFunc()
+}
+
+// ByTxIndex orders the results by the tx_index field.
+func ByTxIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxIndex, opts...).ToFunc()
+}
+
+// ByEventIndex orders the results by the event_index field.
+func ByEventIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventIndex, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
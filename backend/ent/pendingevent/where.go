@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pendingevent
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Entity applies equality check predicate on the "entity" field. It's identical to EntityEQ.
+func Entity(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityKey applies equality check predicate on the "entity_key" field. It's identical to EntityKeyEQ.
+func EntityKey(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEntityKey, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockID applies equality check predicate on the "block_id" field. It's identical to BlockIDEQ.
+func BlockID(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// TxID applies equality check predicate on the "tx_id" field. It's identical to TxIDEQ.
+func TxID(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldType, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EntityEQ applies the EQ predicate on the "entity" field.
+func EntityEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityNEQ applies the NEQ predicate on the "entity" field.
+func EntityNEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldEntity, v))
+}
+
+// EntityIn applies the In predicate on the "entity" field.
+func EntityIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldEntity, vs...))
+}
+
+// EntityNotIn applies the NotIn predicate on the "entity" field.
+func EntityNotIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldEntity, vs...))
+}
+
+// EntityGT applies the GT predicate on the "entity" field.
+func EntityGT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldEntity, v))
+}
+
+// EntityGTE applies the GTE predicate on the "entity" field.
+func EntityGTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldEntity, v))
+}
+
+// EntityLT applies the LT predicate on the "entity" field.
+func EntityLT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldEntity, v))
+}
+
+// EntityLTE applies the LTE predicate on the "entity" field.
+func EntityLTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldEntity, v))
+}
+
+// EntityContains applies the Contains predicate on the "entity" field.
+func EntityContains(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContains(FieldEntity, v))
+}
+
+// EntityHasPrefix applies the HasPrefix predicate on the "entity" field.
+func EntityHasPrefix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasPrefix(FieldEntity, v))
+}
+
+// EntityHasSuffix applies the HasSuffix predicate on the "entity" field.
+func EntityHasSuffix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasSuffix(FieldEntity, v))
+}
+
+// EntityEqualFold applies the EqualFold predicate on the "entity" field.
+func EntityEqualFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEqualFold(FieldEntity, v))
+}
+
+// EntityContainsFold applies the ContainsFold predicate on the "entity" field.
+func EntityContainsFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContainsFold(FieldEntity, v))
+}
+
+// EntityKeyEQ applies the EQ predicate on the "entity_key" field.
+func EntityKeyEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEntityKey, v))
+}
+
+// EntityKeyNEQ applies the NEQ predicate on the "entity_key" field.
+func EntityKeyNEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldEntityKey, v))
+}
+
+// EntityKeyIn applies the In predicate on the "entity_key" field.
+func EntityKeyIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldEntityKey, vs...))
+}
+
+// EntityKeyNotIn applies the NotIn predicate on the "entity_key" field.
+func EntityKeyNotIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldEntityKey, vs...))
+}
+
+// EntityKeyGT applies the GT predicate on the "entity_key" field.
+func EntityKeyGT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldEntityKey, v))
+}
+
+// EntityKeyGTE applies the GTE predicate on the "entity_key" field.
+func EntityKeyGTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldEntityKey, v))
+}
+
+// EntityKeyLT applies the LT predicate on the "entity_key" field.
+func EntityKeyLT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldEntityKey, v))
+}
+
+// EntityKeyLTE applies the LTE predicate on the "entity_key" field.
+func EntityKeyLTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldEntityKey, v))
+}
+
+// EntityKeyContains applies the Contains predicate on the "entity_key" field.
+func EntityKeyContains(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContains(FieldEntityKey, v))
+}
+
+// EntityKeyHasPrefix applies the HasPrefix predicate on the "entity_key" field.
+func EntityKeyHasPrefix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasPrefix(FieldEntityKey, v))
+}
+
+// EntityKeyHasSuffix applies the HasSuffix predicate on the "entity_key" field.
+func EntityKeyHasSuffix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasSuffix(FieldEntityKey, v))
+}
+
+// EntityKeyEqualFold applies the EqualFold predicate on the "entity_key" field.
+func EntityKeyEqualFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEqualFold(FieldEntityKey, v))
+}
+
+// EntityKeyContainsFold applies the ContainsFold predicate on the "entity_key" field.
+func EntityKeyContainsFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContainsFold(FieldEntityKey, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// BlockIDEQ applies the EQ predicate on the "block_id" field.
+func BlockIDEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockIDNEQ applies the NEQ predicate on the "block_id" field.
+func BlockIDNEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldBlockID, v))
+}
+
+// BlockIDIn applies the In predicate on the "block_id" field.
+func BlockIDIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldBlockID, vs...))
+}
+
+// BlockIDNotIn applies the NotIn predicate on the "block_id" field.
+func BlockIDNotIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldBlockID, vs...))
+}
+
+// BlockIDGT applies the GT predicate on the "block_id" field.
+func BlockIDGT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldBlockID, v))
+}
+
+// BlockIDGTE applies the GTE predicate on the "block_id" field.
+func BlockIDGTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldBlockID, v))
+}
+
+// BlockIDLT applies the LT predicate on the "block_id" field.
+func BlockIDLT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldBlockID, v))
+}
+
+// BlockIDLTE applies the LTE predicate on the "block_id" field.
+func BlockIDLTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldBlockID, v))
+}
+
+// BlockIDContains applies the Contains predicate on the "block_id" field.
+func BlockIDContains(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContains(FieldBlockID, v))
+}
+
+// BlockIDHasPrefix applies the HasPrefix predicate on the "block_id" field.
+func BlockIDHasPrefix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasPrefix(FieldBlockID, v))
+}
+
+// BlockIDHasSuffix applies the HasSuffix predicate on the "block_id" field.
+func BlockIDHasSuffix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasSuffix(FieldBlockID, v))
+}
+
+// BlockIDEqualFold applies the EqualFold predicate on the "block_id" field.
+func BlockIDEqualFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEqualFold(FieldBlockID, v))
+}
+
+// BlockIDContainsFold applies the ContainsFold predicate on the "block_id" field.
+func BlockIDContainsFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContainsFold(FieldBlockID, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// BlockTimeIsNil applies the IsNil predicate on the "block_time" field.
+func BlockTimeIsNil() predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIsNull(FieldBlockTime))
+}
+
+// BlockTimeNotNil applies the NotNil predicate on the "block_time" field.
+func BlockTimeNotNil() predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotNull(FieldBlockTime))
+}
+
+// TxIDEQ applies the EQ predicate on the "tx_id" field.
+func TxIDEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIDNEQ applies the NEQ predicate on the "tx_id" field.
+func TxIDNEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldTxID, v))
+}
+
+// TxIDIn applies the In predicate on the "tx_id" field.
+func TxIDIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldTxID, vs...))
+}
+
+// TxIDNotIn applies the NotIn predicate on the "tx_id" field.
+func TxIDNotIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldTxID, vs...))
+}
+
+// TxIDGT applies the GT predicate on the "tx_id" field.
+func TxIDGT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldTxID, v))
+}
+
+// TxIDGTE applies the GTE predicate on the "tx_id" field.
+func TxIDGTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldTxID, v))
+}
+
+// TxIDLT applies the LT predicate on the "tx_id" field.
+func TxIDLT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldTxID, v))
+}
+
+// TxIDLTE applies the LTE predicate on the "tx_id" field.
+func TxIDLTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldTxID, v))
+}
+
+// TxIDContains applies the Contains predicate on the "tx_id" field.
+func TxIDContains(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContains(FieldTxID, v))
+}
+
+// TxIDHasPrefix applies the HasPrefix predicate on the "tx_id" field.
+func TxIDHasPrefix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasPrefix(FieldTxID, v))
+}
+
+// TxIDHasSuffix applies the HasSuffix predicate on the "tx_id" field.
+func TxIDHasSuffix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasSuffix(FieldTxID, v))
+}
+
+// TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
+func TxIDEqualFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEqualFold(FieldTxID, v))
+}
+
+// TxIDContainsFold applies the ContainsFold predicate on the "tx_id" field.
+func TxIDContainsFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContainsFold(FieldTxID, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PendingEvent) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PendingEvent) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PendingEvent) predicate.PendingEvent {
+	return predicate.PendingEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,1089 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/pendingevent"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PendingEventCreate is the builder for creating a PendingEvent entity.
+type PendingEventCreate struct {
+	config
+	mutation *PendingEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEntity sets the "entity" field.
+func (_c *PendingEventCreate) SetEntity(v string) *PendingEventCreate {
+	_c.mutation.SetEntity(v)
+	return _c
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (_c *PendingEventCreate) SetEntityKey(v string) *PendingEventCreate {
+	_c.mutation.SetEntityKey(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *PendingEventCreate) SetBlockHeight(v uint64) *PendingEventCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetBlockID sets the "block_id" field.
+func (_c *PendingEventCreate) SetBlockID(v string) *PendingEventCreate {
+	_c.mutation.SetBlockID(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *PendingEventCreate) SetBlockTime(v time.Time) *PendingEventCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_c *PendingEventCreate) SetNillableBlockTime(v *time.Time) *PendingEventCreate {
+	if v != nil {
+		_c.SetBlockTime(*v)
+	}
+	return _c
+}
+
+// SetTxID sets the "tx_id" field.
+func (_c *PendingEventCreate) SetTxID(v string) *PendingEventCreate {
+	_c.mutation.SetTxID(v)
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *PendingEventCreate) SetTxIndex(v int) *PendingEventCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *PendingEventCreate) SetEventIndex(v int) *PendingEventCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *PendingEventCreate) SetType(v string) *PendingEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *PendingEventCreate) SetPayload(v json.RawMessage) *PendingEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PendingEventCreate) SetCreatedAt(v time.Time) *PendingEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PendingEventCreate) SetNillableCreatedAt(v *time.Time) *PendingEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PendingEventMutation object of the builder.
+func (_c *PendingEventCreate) Mutation() *PendingEventMutation {
+	return _c.mutation
+}
+
+// Save creates the PendingEvent in the database.
+func (_c *PendingEventCreate) Save(ctx context.Context) (*PendingEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PendingEventCreate) SaveX(ctx context.Context) *PendingEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PendingEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PendingEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PendingEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := pendingevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PendingEventCreate) check() error {
+	if _, ok := _c.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "PendingEvent.entity"`)}
+	}
+	if _, ok := _c.mutation.EntityKey(); !ok {
+		return &ValidationError{Name: "entity_key", err: errors.New(`ent: missing required field "PendingEvent.entity_key"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "PendingEvent.block_height"`)}
+	}
+	if _, ok := _c.mutation.BlockID(); !ok {
+		return &ValidationError{Name: "block_id", err: errors.New(`ent: missing required field "PendingEvent.block_id"`)}
+	}
+	if _, ok := _c.mutation.TxID(); !ok {
+		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "PendingEvent.tx_id"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "PendingEvent.tx_index"`)}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "PendingEvent.event_index"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "PendingEvent.type"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "PendingEvent.payload"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PendingEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PendingEventCreate) sqlSave(ctx context.Context) (*PendingEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PendingEventCreate) createSpec() (*PendingEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PendingEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pendingevent.Table, sqlgraph.NewFieldSpec(pendingevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Entity(); ok {
+		_spec.SetField(pendingevent.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := _c.mutation.EntityKey(); ok {
+		_spec.SetField(pendingevent.FieldEntityKey, field.TypeString, value)
+		_node.EntityKey = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(pendingevent.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.BlockID(); ok {
+		_spec.SetField(pendingevent.FieldBlockID, field.TypeString, value)
+		_node.BlockID = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(pendingevent.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.TxID(); ok {
+		_spec.SetField(pendingevent.FieldTxID, field.TypeString, value)
+		_node.TxID = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(pendingevent.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(pendingevent.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(pendingevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(pendingevent.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pendingevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingEvent.Create().
+//		SetEntity(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingEventUpsert) {
+//			SetEntity(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PendingEventCreate) OnConflict(opts ...sql.ConflictOption) *PendingEventUpsertOne {
+	_c.conflict = opts
+	return &PendingEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PendingEventCreate) OnConflictColumns(columns ...string) *PendingEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PendingEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PendingEventUpsertOne is the builder for "upsert"-ing
+	//  one PendingEvent node.
+	PendingEventUpsertOne struct {
+		create *PendingEventCreate
+	}
+
+	// PendingEventUpsert is the "OnConflict" setter.
+	PendingEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEntity sets the "entity" field.
+func (u *PendingEventUpsert) SetEntity(v string) *PendingEventUpsert {
+	u.Set(pendingevent.FieldEntity, v)
+	return u
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateEntity() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldEntity)
+	return u
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (u *PendingEventUpsert) SetEntityKey(v string) *PendingEventUpsert {
+	u.Set(pendingevent.FieldEntityKey, v)
+	return u
+}
+
+// UpdateEntityKey sets the "entity_key" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateEntityKey() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldEntityKey)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *PendingEventUpsert) SetBlockHeight(v uint64) *PendingEventUpsert {
+	u.Set(pendingevent.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateBlockHeight() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *PendingEventUpsert) AddBlockHeight(v uint64) *PendingEventUpsert {
+	u.Add(pendingevent.FieldBlockHeight, v)
+	return u
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *PendingEventUpsert) SetBlockID(v string) *PendingEventUpsert {
+	u.Set(pendingevent.FieldBlockID, v)
+	return u
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateBlockID() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldBlockID)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *PendingEventUpsert) SetBlockTime(v time.Time) *PendingEventUpsert {
+	u.Set(pendingevent.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateBlockTime() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldBlockTime)
+	return u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *PendingEventUpsert) ClearBlockTime() *PendingEventUpsert {
+	u.SetNull(pendingevent.FieldBlockTime)
+	return u
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *PendingEventUpsert) SetTxID(v string) *PendingEventUpsert {
+	u.Set(pendingevent.FieldTxID, v)
+	return u
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateTxID() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldTxID)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *PendingEventUpsert) SetTxIndex(v int) *PendingEventUpsert {
+	u.Set(pendingevent.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateTxIndex() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *PendingEventUpsert) AddTxIndex(v int) *PendingEventUpsert {
+	u.Add(pendingevent.FieldTxIndex, v)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *PendingEventUpsert) SetEventIndex(v int) *PendingEventUpsert {
+	u.Set(pendingevent.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateEventIndex() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *PendingEventUpsert) AddEventIndex(v int) *PendingEventUpsert {
+	u.Add(pendingevent.FieldEventIndex, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *PendingEventUpsert) SetType(v string) *PendingEventUpsert {
+	u.Set(pendingevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateType() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldType)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *PendingEventUpsert) SetPayload(v json.RawMessage) *PendingEventUpsert {
+	u.Set(pendingevent.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdatePayload() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldPayload)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingEventUpsert) SetCreatedAt(v time.Time) *PendingEventUpsert {
+	u.Set(pendingevent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingEventUpsert) UpdateCreatedAt() *PendingEventUpsert {
+	u.SetExcluded(pendingevent.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PendingEventUpsertOne) UpdateNewValues() *PendingEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PendingEventUpsertOne) Ignore() *PendingEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingEventUpsertOne) DoNothing() *PendingEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingEventCreate.OnConflict
+// documentation for more info.
+func (u *PendingEventUpsertOne) Update(set func(*PendingEventUpsert)) *PendingEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEntity sets the "entity" field.
+func (u *PendingEventUpsertOne) SetEntity(v string) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEntity(v)
+	})
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateEntity() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEntity()
+	})
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (u *PendingEventUpsertOne) SetEntityKey(v string) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEntityKey(v)
+	})
+}
+
+// UpdateEntityKey sets the "entity_key" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateEntityKey() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEntityKey()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *PendingEventUpsertOne) SetBlockHeight(v uint64) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *PendingEventUpsertOne) AddBlockHeight(v uint64) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateBlockHeight() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *PendingEventUpsertOne) SetBlockID(v string) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateBlockID() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *PendingEventUpsertOne) SetBlockTime(v time.Time) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateBlockTime() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *PendingEventUpsertOne) ClearBlockTime() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *PendingEventUpsertOne) SetTxID(v string) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateTxID() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *PendingEventUpsertOne) SetTxIndex(v int) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *PendingEventUpsertOne) AddTxIndex(v int) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateTxIndex() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *PendingEventUpsertOne) SetEventIndex(v int) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *PendingEventUpsertOne) AddEventIndex(v int) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateEventIndex() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PendingEventUpsertOne) SetType(v string) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateType() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *PendingEventUpsertOne) SetPayload(v json.RawMessage) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdatePayload() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingEventUpsertOne) SetCreatedAt(v time.Time) *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingEventUpsertOne) UpdateCreatedAt() *PendingEventUpsertOne {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PendingEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PendingEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PendingEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PendingEventCreateBulk is the builder for creating many PendingEvent entities in bulk.
+type PendingEventCreateBulk struct {
+	config
+	err      error
+	builders []*PendingEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PendingEvent entities in the database.
+func (_c *PendingEventCreateBulk) Save(ctx context.Context) ([]*PendingEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PendingEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PendingEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PendingEventCreateBulk) SaveX(ctx context.Context) []*PendingEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PendingEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PendingEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PendingEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PendingEventUpsert) {
+//			SetEntity(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PendingEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *PendingEventUpsertBulk {
+	_c.conflict = opts
+	return &PendingEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PendingEventCreateBulk) OnConflictColumns(columns ...string) *PendingEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PendingEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// PendingEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of PendingEvent nodes.
+type PendingEventUpsertBulk struct {
+	create *PendingEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PendingEventUpsertBulk) UpdateNewValues() *PendingEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PendingEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PendingEventUpsertBulk) Ignore() *PendingEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PendingEventUpsertBulk) DoNothing() *PendingEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PendingEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *PendingEventUpsertBulk) Update(set func(*PendingEventUpsert)) *PendingEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PendingEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEntity sets the "entity" field.
+func (u *PendingEventUpsertBulk) SetEntity(v string) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEntity(v)
+	})
+}
+
+// UpdateEntity sets the "entity" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateEntity() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEntity()
+	})
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (u *PendingEventUpsertBulk) SetEntityKey(v string) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEntityKey(v)
+	})
+}
+
+// UpdateEntityKey sets the "entity_key" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateEntityKey() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEntityKey()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *PendingEventUpsertBulk) SetBlockHeight(v uint64) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *PendingEventUpsertBulk) AddBlockHeight(v uint64) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateBlockHeight() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *PendingEventUpsertBulk) SetBlockID(v string) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateBlockID() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *PendingEventUpsertBulk) SetBlockTime(v time.Time) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateBlockTime() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *PendingEventUpsertBulk) ClearBlockTime() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *PendingEventUpsertBulk) SetTxID(v string) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateTxID() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *PendingEventUpsertBulk) SetTxIndex(v int) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *PendingEventUpsertBulk) AddTxIndex(v int) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateTxIndex() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *PendingEventUpsertBulk) SetEventIndex(v int) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *PendingEventUpsertBulk) AddEventIndex(v int) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateEventIndex() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *PendingEventUpsertBulk) SetType(v string) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateType() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *PendingEventUpsertBulk) SetPayload(v json.RawMessage) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdatePayload() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *PendingEventUpsertBulk) SetCreatedAt(v time.Time) *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *PendingEventUpsertBulk) UpdateCreatedAt() *PendingEventUpsertBulk {
+	return u.Update(func(s *PendingEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PendingEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PendingEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PendingEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PendingEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/pendingevent"
+	"backend/ent/predicate"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PendingEventUpdate is the builder for updating PendingEvent entities.
+type PendingEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PendingEventMutation
+}
+
+// Where appends a list predicates to the PendingEventUpdate builder.
+func (_u *PendingEventUpdate) Where(ps ...predicate.PendingEvent) *PendingEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEntity sets the "entity" field.
+func (_u *PendingEventUpdate) SetEntity(v string) *PendingEventUpdate {
+	_u.mutation.SetEntity(v)
+	return _u
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableEntity(v *string) *PendingEventUpdate {
+	if v != nil {
+		_u.SetEntity(*v)
+	}
+	return _u
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (_u *PendingEventUpdate) SetEntityKey(v string) *PendingEventUpdate {
+	_u.mutation.SetEntityKey(v)
+	return _u
+}
+
+// SetNillableEntityKey sets the "entity_key" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableEntityKey(v *string) *PendingEventUpdate {
+	if v != nil {
+		_u.SetEntityKey(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *PendingEventUpdate) SetBlockHeight(v uint64) *PendingEventUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableBlockHeight(v *uint64) *PendingEventUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *PendingEventUpdate) AddBlockHeight(v int64) *PendingEventUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *PendingEventUpdate) SetBlockID(v string) *PendingEventUpdate {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableBlockID(v *string) *PendingEventUpdate {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *PendingEventUpdate) SetBlockTime(v time.Time) *PendingEventUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableBlockTime(v *time.Time) *PendingEventUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *PendingEventUpdate) ClearBlockTime() *PendingEventUpdate {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *PendingEventUpdate) SetTxID(v string) *PendingEventUpdate {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableTxID(v *string) *PendingEventUpdate {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *PendingEventUpdate) SetTxIndex(v int) *PendingEventUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableTxIndex(v *int) *PendingEventUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *PendingEventUpdate) AddTxIndex(v int) *PendingEventUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *PendingEventUpdate) SetEventIndex(v int) *PendingEventUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableEventIndex(v *int) *PendingEventUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *PendingEventUpdate) AddEventIndex(v int) *PendingEventUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PendingEventUpdate) SetType(v string) *PendingEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableType(v *string) *PendingEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *PendingEventUpdate) SetPayload(v json.RawMessage) *PendingEventUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *PendingEventUpdate) AppendPayload(v json.RawMessage) *PendingEventUpdate {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PendingEventUpdate) SetCreatedAt(v time.Time) *PendingEventUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PendingEventUpdate) SetNillableCreatedAt(v *time.Time) *PendingEventUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PendingEventMutation object of the builder.
+func (_u *PendingEventUpdate) Mutation() *PendingEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PendingEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PendingEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PendingEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PendingEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PendingEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pendingevent.Table, pendingevent.Columns, sqlgraph.NewFieldSpec(pendingevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Entity(); ok {
+		_spec.SetField(pendingevent.FieldEntity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityKey(); ok {
+		_spec.SetField(pendingevent.FieldEntityKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(pendingevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(pendingevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(pendingevent.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(pendingevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(pendingevent.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(pendingevent.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(pendingevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(pendingevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(pendingevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(pendingevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(pendingevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(pendingevent.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pendingevent.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pendingevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PendingEventUpdateOne is the builder for updating a single PendingEvent entity.
+type PendingEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PendingEventMutation
+}
+
+// SetEntity sets the "entity" field.
+func (_u *PendingEventUpdateOne) SetEntity(v string) *PendingEventUpdateOne {
+	_u.mutation.SetEntity(v)
+	return _u
+}
+
+// SetNillableEntity sets the "entity" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableEntity(v *string) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetEntity(*v)
+	}
+	return _u
+}
+
+// SetEntityKey sets the "entity_key" field.
+func (_u *PendingEventUpdateOne) SetEntityKey(v string) *PendingEventUpdateOne {
+	_u.mutation.SetEntityKey(v)
+	return _u
+}
+
+// SetNillableEntityKey sets the "entity_key" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableEntityKey(v *string) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetEntityKey(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *PendingEventUpdateOne) SetBlockHeight(v uint64) *PendingEventUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableBlockHeight(v *uint64) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *PendingEventUpdateOne) AddBlockHeight(v int64) *PendingEventUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *PendingEventUpdateOne) SetBlockID(v string) *PendingEventUpdateOne {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableBlockID(v *string) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *PendingEventUpdateOne) SetBlockTime(v time.Time) *PendingEventUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableBlockTime(v *time.Time) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *PendingEventUpdateOne) ClearBlockTime() *PendingEventUpdateOne {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *PendingEventUpdateOne) SetTxID(v string) *PendingEventUpdateOne {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableTxID(v *string) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *PendingEventUpdateOne) SetTxIndex(v int) *PendingEventUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableTxIndex(v *int) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *PendingEventUpdateOne) AddTxIndex(v int) *PendingEventUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *PendingEventUpdateOne) SetEventIndex(v int) *PendingEventUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableEventIndex(v *int) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *PendingEventUpdateOne) AddEventIndex(v int) *PendingEventUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PendingEventUpdateOne) SetType(v string) *PendingEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableType(v *string) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *PendingEventUpdateOne) SetPayload(v json.RawMessage) *PendingEventUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *PendingEventUpdateOne) AppendPayload(v json.RawMessage) *PendingEventUpdateOne {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PendingEventUpdateOne) SetCreatedAt(v time.Time) *PendingEventUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PendingEventUpdateOne) SetNillableCreatedAt(v *time.Time) *PendingEventUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PendingEventMutation object of the builder.
+func (_u *PendingEventUpdateOne) Mutation() *PendingEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PendingEventUpdate builder.
+func (_u *PendingEventUpdateOne) Where(ps ...predicate.PendingEvent) *PendingEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PendingEventUpdateOne) Select(field string, fields ...string) *PendingEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PendingEvent entity.
+func (_u *PendingEventUpdateOne) Save(ctx context.Context) (*PendingEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PendingEventUpdateOne) SaveX(ctx context.Context) *PendingEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PendingEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PendingEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PendingEventUpdateOne) sqlSave(ctx context.Context) (_node *PendingEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pendingevent.Table, pendingevent.Columns, sqlgraph.NewFieldSpec(pendingevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PendingEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pendingevent.FieldID)
+		for _, f := range fields {
+			if !pendingevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pendingevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Entity(); ok {
+		_spec.SetField(pendingevent.FieldEntity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityKey(); ok {
+		_spec.SetField(pendingevent.FieldEntityKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(pendingevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(pendingevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(pendingevent.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(pendingevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(pendingevent.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(pendingevent.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(pendingevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(pendingevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(pendingevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(pendingevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(pendingevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(pendingevent.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pendingevent.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pendingevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &PendingEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pendingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
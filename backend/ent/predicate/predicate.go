@@ -9,12 +9,24 @@ import (
 // Attendance is the predicate function for attendance builders.
 type Attendance func(*sql.Selector)
 
+// Checkpoint is the predicate function for checkpoint builders.
+type Checkpoint func(*sql.Selector)
+
+// DeadLetter is the predicate function for deadletter builders.
+type DeadLetter func(*sql.Selector)
+
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
+// EventCount is the predicate function for eventcount builders.
+type EventCount func(*sql.Selector)
+
 // EventPass is the predicate function for eventpass builders.
 type EventPass func(*sql.Selector)
 
+// GachaReceipt is the predicate function for gachareceipt builders.
+type GachaReceipt func(*sql.Selector)
+
 // Listing is the predicate function for listing builders.
 type Listing func(*sql.Selector)
 
@@ -24,5 +36,17 @@ type NFTAccessory func(*sql.Selector)
 // NFTMoment is the predicate function for nftmoment builders.
 type NFTMoment func(*sql.Selector)
 
+// PendingEvent is the predicate function for pendingevent builders.
+type PendingEvent func(*sql.Selector)
+
+// RawEvent is the predicate function for rawevent builders.
+type RawEvent func(*sql.Selector)
+
+// Sale is the predicate function for sale builders.
+type Sale func(*sql.Selector)
+
+// Transfer is the predicate function for transfer builders.
+type Transfer func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
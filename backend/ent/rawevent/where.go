@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rawevent
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldID, id))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockID applies equality check predicate on the "block_id" field. It's identical to BlockIDEQ.
+func BlockID(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockTime applies equality check predicate on the "block_time" field. It's identical to BlockTimeEQ.
+func BlockTime(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// TxID applies equality check predicate on the "tx_id" field. It's identical to TxIDEQ.
+func TxID(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIndex applies equality check predicate on the "tx_index" field. It's identical to TxIndexEQ.
+func TxIndex(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldType, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// BlockIDEQ applies the EQ predicate on the "block_id" field.
+func BlockIDEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockID, v))
+}
+
+// BlockIDNEQ applies the NEQ predicate on the "block_id" field.
+func BlockIDNEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldBlockID, v))
+}
+
+// BlockIDIn applies the In predicate on the "block_id" field.
+func BlockIDIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldBlockID, vs...))
+}
+
+// BlockIDNotIn applies the NotIn predicate on the "block_id" field.
+func BlockIDNotIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldBlockID, vs...))
+}
+
+// BlockIDGT applies the GT predicate on the "block_id" field.
+func BlockIDGT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldBlockID, v))
+}
+
+// BlockIDGTE applies the GTE predicate on the "block_id" field.
+func BlockIDGTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldBlockID, v))
+}
+
+// BlockIDLT applies the LT predicate on the "block_id" field.
+func BlockIDLT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldBlockID, v))
+}
+
+// BlockIDLTE applies the LTE predicate on the "block_id" field.
+func BlockIDLTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldBlockID, v))
+}
+
+// BlockIDContains applies the Contains predicate on the "block_id" field.
+func BlockIDContains(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContains(FieldBlockID, v))
+}
+
+// BlockIDHasPrefix applies the HasPrefix predicate on the "block_id" field.
+func BlockIDHasPrefix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasPrefix(FieldBlockID, v))
+}
+
+// BlockIDHasSuffix applies the HasSuffix predicate on the "block_id" field.
+func BlockIDHasSuffix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasSuffix(FieldBlockID, v))
+}
+
+// BlockIDEqualFold applies the EqualFold predicate on the "block_id" field.
+func BlockIDEqualFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEqualFold(FieldBlockID, v))
+}
+
+// BlockIDContainsFold applies the ContainsFold predicate on the "block_id" field.
+func BlockIDContainsFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContainsFold(FieldBlockID, v))
+}
+
+// BlockTimeEQ applies the EQ predicate on the "block_time" field.
+func BlockTimeEQ(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldBlockTime, v))
+}
+
+// BlockTimeNEQ applies the NEQ predicate on the "block_time" field.
+func BlockTimeNEQ(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldBlockTime, v))
+}
+
+// BlockTimeIn applies the In predicate on the "block_time" field.
+func BlockTimeIn(vs ...time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeNotIn applies the NotIn predicate on the "block_time" field.
+func BlockTimeNotIn(vs ...time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldBlockTime, vs...))
+}
+
+// BlockTimeGT applies the GT predicate on the "block_time" field.
+func BlockTimeGT(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldBlockTime, v))
+}
+
+// BlockTimeGTE applies the GTE predicate on the "block_time" field.
+func BlockTimeGTE(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldBlockTime, v))
+}
+
+// BlockTimeLT applies the LT predicate on the "block_time" field.
+func BlockTimeLT(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldBlockTime, v))
+}
+
+// BlockTimeLTE applies the LTE predicate on the "block_time" field.
+func BlockTimeLTE(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldBlockTime, v))
+}
+
+// BlockTimeIsNil applies the IsNil predicate on the "block_time" field.
+func BlockTimeIsNil() predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIsNull(FieldBlockTime))
+}
+
+// BlockTimeNotNil applies the NotNil predicate on the "block_time" field.
+func BlockTimeNotNil() predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotNull(FieldBlockTime))
+}
+
+// TxIDEQ applies the EQ predicate on the "tx_id" field.
+func TxIDEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIDNEQ applies the NEQ predicate on the "tx_id" field.
+func TxIDNEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldTxID, v))
+}
+
+// TxIDIn applies the In predicate on the "tx_id" field.
+func TxIDIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldTxID, vs...))
+}
+
+// TxIDNotIn applies the NotIn predicate on the "tx_id" field.
+func TxIDNotIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldTxID, vs...))
+}
+
+// TxIDGT applies the GT predicate on the "tx_id" field.
+func TxIDGT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldTxID, v))
+}
+
+// TxIDGTE applies the GTE predicate on the "tx_id" field.
+func TxIDGTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldTxID, v))
+}
+
+// TxIDLT applies the LT predicate on the "tx_id" field.
+func TxIDLT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldTxID, v))
+}
+
+// TxIDLTE applies the LTE predicate on the "tx_id" field.
+func TxIDLTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldTxID, v))
+}
+
+// TxIDContains applies the Contains predicate on the "tx_id" field.
+func TxIDContains(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContains(FieldTxID, v))
+}
+
+// TxIDHasPrefix applies the HasPrefix predicate on the "tx_id" field.
+func TxIDHasPrefix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasPrefix(FieldTxID, v))
+}
+
+// TxIDHasSuffix applies the HasSuffix predicate on the "tx_id" field.
+func TxIDHasSuffix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasSuffix(FieldTxID, v))
+}
+
+// TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
+func TxIDEqualFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEqualFold(FieldTxID, v))
+}
+
+// TxIDContainsFold applies the ContainsFold predicate on the "tx_id" field.
+func TxIDContainsFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContainsFold(FieldTxID, v))
+}
+
+// TxIndexEQ applies the EQ predicate on the "tx_index" field.
+func TxIndexEQ(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldTxIndex, v))
+}
+
+// TxIndexNEQ applies the NEQ predicate on the "tx_index" field.
+func TxIndexNEQ(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldTxIndex, v))
+}
+
+// TxIndexIn applies the In predicate on the "tx_index" field.
+func TxIndexIn(vs ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldTxIndex, vs...))
+}
+
+// TxIndexNotIn applies the NotIn predicate on the "tx_index" field.
+func TxIndexNotIn(vs ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldTxIndex, vs...))
+}
+
+// TxIndexGT applies the GT predicate on the "tx_index" field.
+func TxIndexGT(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldTxIndex, v))
+}
+
+// TxIndexGTE applies the GTE predicate on the "tx_index" field.
+func TxIndexGTE(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldTxIndex, v))
+}
+
+// TxIndexLT applies the LT predicate on the "tx_index" field.
+func TxIndexLT(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldTxIndex, v))
+}
+
+// TxIndexLTE applies the LTE predicate on the "tx_index" field.
+func TxIndexLTE(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldTxIndex, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RawEvent {
+	return predicate.RawEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RawEvent) predicate.RawEvent {
+	return predicate.RawEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RawEvent) predicate.RawEvent {
+	return predicate.RawEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RawEvent) predicate.RawEvent {
+	return predicate.RawEvent(sql.NotPredicates(p))
+}
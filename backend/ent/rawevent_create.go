@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/rawevent"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RawEventCreate is the builder for creating a RawEvent entity.
+type RawEventCreate struct {
+	config
+	mutation *RawEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *RawEventCreate) SetBlockHeight(v uint64) *RawEventCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetBlockID sets the "block_id" field.
+func (_c *RawEventCreate) SetBlockID(v string) *RawEventCreate {
+	_c.mutation.SetBlockID(v)
+	return _c
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_c *RawEventCreate) SetBlockTime(v time.Time) *RawEventCreate {
+	_c.mutation.SetBlockTime(v)
+	return _c
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_c *RawEventCreate) SetNillableBlockTime(v *time.Time) *RawEventCreate {
+	if v != nil {
+		_c.SetBlockTime(*v)
+	}
+	return _c
+}
+
+// SetTxID sets the "tx_id" field.
+func (_c *RawEventCreate) SetTxID(v string) *RawEventCreate {
+	_c.mutation.SetTxID(v)
+	return _c
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_c *RawEventCreate) SetTxIndex(v int) *RawEventCreate {
+	_c.mutation.SetTxIndex(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *RawEventCreate) SetEventIndex(v int) *RawEventCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *RawEventCreate) SetType(v string) *RawEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *RawEventCreate) SetPayload(v json.RawMessage) *RawEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RawEventCreate) SetCreatedAt(v time.Time) *RawEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RawEventCreate) SetNillableCreatedAt(v *time.Time) *RawEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RawEventMutation object of the builder.
+func (_c *RawEventCreate) Mutation() *RawEventMutation {
+	return _c.mutation
+}
+
+// Save creates the RawEvent in the database.
+func (_c *RawEventCreate) Save(ctx context.Context) (*RawEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RawEventCreate) SaveX(ctx context.Context) *RawEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RawEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RawEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RawEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rawevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RawEventCreate) check() error {
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "RawEvent.block_height"`)}
+	}
+	if _, ok := _c.mutation.BlockID(); !ok {
+		return &ValidationError{Name: "block_id", err: errors.New(`ent: missing required field "RawEvent.block_id"`)}
+	}
+	if _, ok := _c.mutation.TxID(); !ok {
+		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "RawEvent.tx_id"`)}
+	}
+	if _, ok := _c.mutation.TxIndex(); !ok {
+		return &ValidationError{Name: "tx_index", err: errors.New(`ent: missing required field "RawEvent.tx_index"`)}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "RawEvent.event_index"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "RawEvent.type"`)}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "RawEvent.payload"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RawEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RawEventCreate) sqlSave(ctx context.Context) (*RawEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RawEventCreate) createSpec() (*RawEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RawEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rawevent.Table, sqlgraph.NewFieldSpec(rawevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(rawevent.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.BlockID(); ok {
+		_spec.SetField(rawevent.FieldBlockID, field.TypeString, value)
+		_node.BlockID = value
+	}
+	if value, ok := _c.mutation.BlockTime(); ok {
+		_spec.SetField(rawevent.FieldBlockTime, field.TypeTime, value)
+		_node.BlockTime = value
+	}
+	if value, ok := _c.mutation.TxID(); ok {
+		_spec.SetField(rawevent.FieldTxID, field.TypeString, value)
+		_node.TxID = value
+	}
+	if value, ok := _c.mutation.TxIndex(); ok {
+		_spec.SetField(rawevent.FieldTxIndex, field.TypeInt, value)
+		_node.TxIndex = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(rawevent.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(rawevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(rawevent.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rawevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RawEvent.Create().
+//		SetBlockHeight(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RawEventUpsert) {
+//			SetBlockHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RawEventCreate) OnConflict(opts ...sql.ConflictOption) *RawEventUpsertOne {
+	_c.conflict = opts
+	return &RawEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RawEventCreate) OnConflictColumns(columns ...string) *RawEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RawEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RawEventUpsertOne is the builder for "upsert"-ing
+	//  one RawEvent node.
+	RawEventUpsertOne struct {
+		create *RawEventCreate
+	}
+
+	// RawEventUpsert is the "OnConflict" setter.
+	RawEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RawEventUpsert) SetBlockHeight(v uint64) *RawEventUpsert {
+	u.Set(rawevent.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateBlockHeight() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RawEventUpsert) AddBlockHeight(v uint64) *RawEventUpsert {
+	u.Add(rawevent.FieldBlockHeight, v)
+	return u
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *RawEventUpsert) SetBlockID(v string) *RawEventUpsert {
+	u.Set(rawevent.FieldBlockID, v)
+	return u
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateBlockID() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldBlockID)
+	return u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RawEventUpsert) SetBlockTime(v time.Time) *RawEventUpsert {
+	u.Set(rawevent.FieldBlockTime, v)
+	return u
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateBlockTime() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldBlockTime)
+	return u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *RawEventUpsert) ClearBlockTime() *RawEventUpsert {
+	u.SetNull(rawevent.FieldBlockTime)
+	return u
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *RawEventUpsert) SetTxID(v string) *RawEventUpsert {
+	u.Set(rawevent.FieldTxID, v)
+	return u
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateTxID() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldTxID)
+	return u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *RawEventUpsert) SetTxIndex(v int) *RawEventUpsert {
+	u.Set(rawevent.FieldTxIndex, v)
+	return u
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateTxIndex() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldTxIndex)
+	return u
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *RawEventUpsert) AddTxIndex(v int) *RawEventUpsert {
+	u.Add(rawevent.FieldTxIndex, v)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *RawEventUpsert) SetEventIndex(v int) *RawEventUpsert {
+	u.Set(rawevent.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateEventIndex() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *RawEventUpsert) AddEventIndex(v int) *RawEventUpsert {
+	u.Add(rawevent.FieldEventIndex, v)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *RawEventUpsert) SetType(v string) *RawEventUpsert {
+	u.Set(rawevent.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateType() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldType)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *RawEventUpsert) SetPayload(v json.RawMessage) *RawEventUpsert {
+	u.Set(rawevent.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdatePayload() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldPayload)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RawEventUpsert) SetCreatedAt(v time.Time) *RawEventUpsert {
+	u.Set(rawevent.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RawEventUpsert) UpdateCreatedAt() *RawEventUpsert {
+	u.SetExcluded(rawevent.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RawEventUpsertOne) UpdateNewValues() *RawEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RawEventUpsertOne) Ignore() *RawEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RawEventUpsertOne) DoNothing() *RawEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RawEventCreate.OnConflict
+// documentation for more info.
+func (u *RawEventUpsertOne) Update(set func(*RawEventUpsert)) *RawEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RawEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RawEventUpsertOne) SetBlockHeight(v uint64) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RawEventUpsertOne) AddBlockHeight(v uint64) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateBlockHeight() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *RawEventUpsertOne) SetBlockID(v string) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateBlockID() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RawEventUpsertOne) SetBlockTime(v time.Time) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateBlockTime() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *RawEventUpsertOne) ClearBlockTime() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *RawEventUpsertOne) SetTxID(v string) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateTxID() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *RawEventUpsertOne) SetTxIndex(v int) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *RawEventUpsertOne) AddTxIndex(v int) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateTxIndex() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *RawEventUpsertOne) SetEventIndex(v int) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *RawEventUpsertOne) AddEventIndex(v int) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateEventIndex() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *RawEventUpsertOne) SetType(v string) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateType() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *RawEventUpsertOne) SetPayload(v json.RawMessage) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdatePayload() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RawEventUpsertOne) SetCreatedAt(v time.Time) *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RawEventUpsertOne) UpdateCreatedAt() *RawEventUpsertOne {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RawEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RawEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RawEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RawEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RawEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RawEventCreateBulk is the builder for creating many RawEvent entities in bulk.
+type RawEventCreateBulk struct {
+	config
+	err      error
+	builders []*RawEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RawEvent entities in the database.
+func (_c *RawEventCreateBulk) Save(ctx context.Context) ([]*RawEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RawEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RawEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RawEventCreateBulk) SaveX(ctx context.Context) []*RawEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RawEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RawEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RawEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RawEventUpsert) {
+//			SetBlockHeight(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RawEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *RawEventUpsertBulk {
+	_c.conflict = opts
+	return &RawEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RawEventCreateBulk) OnConflictColumns(columns ...string) *RawEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RawEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// RawEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of RawEvent nodes.
+type RawEventUpsertBulk struct {
+	create *RawEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RawEventUpsertBulk) UpdateNewValues() *RawEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RawEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RawEventUpsertBulk) Ignore() *RawEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RawEventUpsertBulk) DoNothing() *RawEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RawEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *RawEventUpsertBulk) Update(set func(*RawEventUpsert)) *RawEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RawEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *RawEventUpsertBulk) SetBlockHeight(v uint64) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *RawEventUpsertBulk) AddBlockHeight(v uint64) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateBlockHeight() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetBlockID sets the "block_id" field.
+func (u *RawEventUpsertBulk) SetBlockID(v string) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockID(v)
+	})
+}
+
+// UpdateBlockID sets the "block_id" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateBlockID() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockID()
+	})
+}
+
+// SetBlockTime sets the "block_time" field.
+func (u *RawEventUpsertBulk) SetBlockTime(v time.Time) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetBlockTime(v)
+	})
+}
+
+// UpdateBlockTime sets the "block_time" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateBlockTime() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateBlockTime()
+	})
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (u *RawEventUpsertBulk) ClearBlockTime() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.ClearBlockTime()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *RawEventUpsertBulk) SetTxID(v string) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateTxID() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (u *RawEventUpsertBulk) SetTxIndex(v int) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetTxIndex(v)
+	})
+}
+
+// AddTxIndex adds v to the "tx_index" field.
+func (u *RawEventUpsertBulk) AddTxIndex(v int) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddTxIndex(v)
+	})
+}
+
+// UpdateTxIndex sets the "tx_index" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateTxIndex() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateTxIndex()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *RawEventUpsertBulk) SetEventIndex(v int) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *RawEventUpsertBulk) AddEventIndex(v int) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateEventIndex() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *RawEventUpsertBulk) SetType(v string) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateType() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *RawEventUpsertBulk) SetPayload(v json.RawMessage) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdatePayload() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *RawEventUpsertBulk) SetCreatedAt(v time.Time) *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *RawEventUpsertBulk) UpdateCreatedAt() *RawEventUpsertBulk {
+	return u.Update(func(s *RawEventUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RawEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RawEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RawEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RawEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
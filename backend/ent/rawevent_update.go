@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/rawevent"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// RawEventUpdate is the builder for updating RawEvent entities.
+type RawEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RawEventMutation
+}
+
+// Where appends a list predicates to the RawEventUpdate builder.
+func (_u *RawEventUpdate) Where(ps ...predicate.RawEvent) *RawEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *RawEventUpdate) SetBlockHeight(v uint64) *RawEventUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableBlockHeight(v *uint64) *RawEventUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *RawEventUpdate) AddBlockHeight(v int64) *RawEventUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *RawEventUpdate) SetBlockID(v string) *RawEventUpdate {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableBlockID(v *string) *RawEventUpdate {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *RawEventUpdate) SetBlockTime(v time.Time) *RawEventUpdate {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableBlockTime(v *time.Time) *RawEventUpdate {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *RawEventUpdate) ClearBlockTime() *RawEventUpdate {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *RawEventUpdate) SetTxID(v string) *RawEventUpdate {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableTxID(v *string) *RawEventUpdate {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *RawEventUpdate) SetTxIndex(v int) *RawEventUpdate {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableTxIndex(v *int) *RawEventUpdate {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *RawEventUpdate) AddTxIndex(v int) *RawEventUpdate {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *RawEventUpdate) SetEventIndex(v int) *RawEventUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableEventIndex(v *int) *RawEventUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *RawEventUpdate) AddEventIndex(v int) *RawEventUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RawEventUpdate) SetType(v string) *RawEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableType(v *string) *RawEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *RawEventUpdate) SetPayload(v json.RawMessage) *RawEventUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *RawEventUpdate) AppendPayload(v json.RawMessage) *RawEventUpdate {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RawEventUpdate) SetCreatedAt(v time.Time) *RawEventUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RawEventUpdate) SetNillableCreatedAt(v *time.Time) *RawEventUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RawEventMutation object of the builder.
+func (_u *RawEventUpdate) Mutation() *RawEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RawEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RawEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RawEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RawEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RawEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rawevent.Table, rawevent.Columns, sqlgraph.NewFieldSpec(rawevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(rawevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(rawevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(rawevent.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(rawevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(rawevent.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(rawevent.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(rawevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(rawevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(rawevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(rawevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(rawevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(rawevent.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rawevent.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(rawevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rawevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RawEventUpdateOne is the builder for updating a single RawEvent entity.
+type RawEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RawEventMutation
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *RawEventUpdateOne) SetBlockHeight(v uint64) *RawEventUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableBlockHeight(v *uint64) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *RawEventUpdateOne) AddBlockHeight(v int64) *RawEventUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetBlockID sets the "block_id" field.
+func (_u *RawEventUpdateOne) SetBlockID(v string) *RawEventUpdateOne {
+	_u.mutation.SetBlockID(v)
+	return _u
+}
+
+// SetNillableBlockID sets the "block_id" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableBlockID(v *string) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetBlockID(*v)
+	}
+	return _u
+}
+
+// SetBlockTime sets the "block_time" field.
+func (_u *RawEventUpdateOne) SetBlockTime(v time.Time) *RawEventUpdateOne {
+	_u.mutation.SetBlockTime(v)
+	return _u
+}
+
+// SetNillableBlockTime sets the "block_time" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableBlockTime(v *time.Time) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetBlockTime(*v)
+	}
+	return _u
+}
+
+// ClearBlockTime clears the value of the "block_time" field.
+func (_u *RawEventUpdateOne) ClearBlockTime() *RawEventUpdateOne {
+	_u.mutation.ClearBlockTime()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *RawEventUpdateOne) SetTxID(v string) *RawEventUpdateOne {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableTxID(v *string) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetTxIndex sets the "tx_index" field.
+func (_u *RawEventUpdateOne) SetTxIndex(v int) *RawEventUpdateOne {
+	_u.mutation.ResetTxIndex()
+	_u.mutation.SetTxIndex(v)
+	return _u
+}
+
+// SetNillableTxIndex sets the "tx_index" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableTxIndex(v *int) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetTxIndex(*v)
+	}
+	return _u
+}
+
+// AddTxIndex adds value to the "tx_index" field.
+func (_u *RawEventUpdateOne) AddTxIndex(v int) *RawEventUpdateOne {
+	_u.mutation.AddTxIndex(v)
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *RawEventUpdateOne) SetEventIndex(v int) *RawEventUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableEventIndex(v *int) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *RawEventUpdateOne) AddEventIndex(v int) *RawEventUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RawEventUpdateOne) SetType(v string) *RawEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableType(v *string) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *RawEventUpdateOne) SetPayload(v json.RawMessage) *RawEventUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// AppendPayload appends value to the "payload" field.
+func (_u *RawEventUpdateOne) AppendPayload(v json.RawMessage) *RawEventUpdateOne {
+	_u.mutation.AppendPayload(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RawEventUpdateOne) SetCreatedAt(v time.Time) *RawEventUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RawEventUpdateOne) SetNillableCreatedAt(v *time.Time) *RawEventUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RawEventMutation object of the builder.
+func (_u *RawEventUpdateOne) Mutation() *RawEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RawEventUpdate builder.
+func (_u *RawEventUpdateOne) Where(ps ...predicate.RawEvent) *RawEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RawEventUpdateOne) Select(field string, fields ...string) *RawEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RawEvent entity.
+func (_u *RawEventUpdateOne) Save(ctx context.Context) (*RawEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RawEventUpdateOne) SaveX(ctx context.Context) *RawEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RawEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RawEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *RawEventUpdateOne) sqlSave(ctx context.Context) (_node *RawEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rawevent.Table, rawevent.Columns, sqlgraph.NewFieldSpec(rawevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RawEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rawevent.FieldID)
+		for _, f := range fields {
+			if !rawevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rawevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(rawevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(rawevent.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.BlockID(); ok {
+		_spec.SetField(rawevent.FieldBlockID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockTime(); ok {
+		_spec.SetField(rawevent.FieldBlockTime, field.TypeTime, value)
+	}
+	if _u.mutation.BlockTimeCleared() {
+		_spec.ClearField(rawevent.FieldBlockTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(rawevent.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TxIndex(); ok {
+		_spec.SetField(rawevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTxIndex(); ok {
+		_spec.AddField(rawevent.FieldTxIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(rawevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(rawevent.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(rawevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(rawevent.FieldPayload, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPayload(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rawevent.FieldPayload, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(rawevent.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &RawEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rawevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,8 +4,17 @@ package ent
 
 import (
 	"backend/ent/attendance"
+	"backend/ent/checkpoint"
+	"backend/ent/deadletter"
+	"backend/ent/eventcount"
 	"backend/ent/eventpass"
+	"backend/ent/gachareceipt"
+	"backend/ent/pendingevent"
+	"backend/ent/rawevent"
+	"backend/ent/sale"
 	"backend/ent/schema"
+	"backend/ent/transfer"
+	"backend/ent/user"
 	"time"
 )
 
@@ -23,10 +32,84 @@ func init() {
 	attendanceDescRegistrationTime := attendanceFields[1].Descriptor()
 	// attendance.DefaultRegistrationTime holds the default value on creation for the registration_time field.
 	attendance.DefaultRegistrationTime = attendanceDescRegistrationTime.Default.(func() time.Time)
+	checkpointFields := schema.Checkpoint{}.Fields()
+	_ = checkpointFields
+	// checkpointDescUpdatedAt is the schema descriptor for updated_at field.
+	checkpointDescUpdatedAt := checkpointFields[6].Descriptor()
+	// checkpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	checkpoint.DefaultUpdatedAt = checkpointDescUpdatedAt.Default.(func() time.Time)
+	// checkpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	checkpoint.UpdateDefaultUpdatedAt = checkpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	deadletterFields := schema.DeadLetter{}.Fields()
+	_ = deadletterFields
+	// deadletterDescAttempts is the schema descriptor for attempts field.
+	deadletterDescAttempts := deadletterFields[9].Descriptor()
+	// deadletter.DefaultAttempts holds the default value on creation for the attempts field.
+	deadletter.DefaultAttempts = deadletterDescAttempts.Default.(int)
+	// deadletterDescCreatedAt is the schema descriptor for created_at field.
+	deadletterDescCreatedAt := deadletterFields[11].Descriptor()
+	// deadletter.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deadletter.DefaultCreatedAt = deadletterDescCreatedAt.Default.(func() time.Time)
+	// deadletterDescUpdatedAt is the schema descriptor for updated_at field.
+	deadletterDescUpdatedAt := deadletterFields[12].Descriptor()
+	// deadletter.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deadletter.DefaultUpdatedAt = deadletterDescUpdatedAt.Default.(func() time.Time)
+	// deadletter.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deadletter.UpdateDefaultUpdatedAt = deadletterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	eventcountFields := schema.EventCount{}.Fields()
+	_ = eventcountFields
+	// eventcountDescCount is the schema descriptor for count field.
+	eventcountDescCount := eventcountFields[1].Descriptor()
+	// eventcount.DefaultCount holds the default value on creation for the count field.
+	eventcount.DefaultCount = eventcountDescCount.Default.(int)
+	// eventcountDescUpdatedAt is the schema descriptor for updated_at field.
+	eventcountDescUpdatedAt := eventcountFields[2].Descriptor()
+	// eventcount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventcount.DefaultUpdatedAt = eventcountDescUpdatedAt.Default.(func() time.Time)
+	// eventcount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventcount.UpdateDefaultUpdatedAt = eventcountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	eventpassFields := schema.EventPass{}.Fields()
 	_ = eventpassFields
 	// eventpassDescIsUsed is the schema descriptor for is_used field.
 	eventpassDescIsUsed := eventpassFields[5].Descriptor()
 	// eventpass.DefaultIsUsed holds the default value on creation for the is_used field.
 	eventpass.DefaultIsUsed = eventpassDescIsUsed.Default.(bool)
+	gachareceiptFields := schema.GachaReceipt{}.Fields()
+	_ = gachareceiptFields
+	// gachareceiptDescCreatedAt is the schema descriptor for created_at field.
+	gachareceiptDescCreatedAt := gachareceiptFields[8].Descriptor()
+	// gachareceipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gachareceipt.DefaultCreatedAt = gachareceiptDescCreatedAt.Default.(func() time.Time)
+	listingFields := schema.Listing{}.Fields()
+	_ = listingFields
+	pendingeventFields := schema.PendingEvent{}.Fields()
+	_ = pendingeventFields
+	// pendingeventDescCreatedAt is the schema descriptor for created_at field.
+	pendingeventDescCreatedAt := pendingeventFields[10].Descriptor()
+	// pendingevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pendingevent.DefaultCreatedAt = pendingeventDescCreatedAt.Default.(func() time.Time)
+	raweventFields := schema.RawEvent{}.Fields()
+	_ = raweventFields
+	// raweventDescCreatedAt is the schema descriptor for created_at field.
+	raweventDescCreatedAt := raweventFields[8].Descriptor()
+	// rawevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rawevent.DefaultCreatedAt = raweventDescCreatedAt.Default.(func() time.Time)
+	saleFields := schema.Sale{}.Fields()
+	_ = saleFields
+	// saleDescTimestamp is the schema descriptor for timestamp field.
+	saleDescTimestamp := saleFields[6].Descriptor()
+	// sale.DefaultTimestamp holds the default value on creation for the timestamp field.
+	sale.DefaultTimestamp = saleDescTimestamp.Default.(func() time.Time)
+	transferFields := schema.Transfer{}.Fields()
+	_ = transferFields
+	// transferDescTimestamp is the schema descriptor for timestamp field.
+	transferDescTimestamp := transferFields[7].Descriptor()
+	// transfer.DefaultTimestamp holds the default value on creation for the timestamp field.
+	transfer.DefaultTimestamp = transferDescTimestamp.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescIsVerified is the schema descriptor for is_verified field.
+	userDescIsVerified := userFields[9].Descriptor()
+	// user.DefaultIsVerified holds the default value on creation for the is_verified field.
+	user.DefaultIsVerified = userDescIsVerified.Default.(bool)
 }
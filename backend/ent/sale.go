@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/listing"
+	"backend/ent/sale"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Sale is the model entity for the Sale schema.
+type Sale struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// PaymentVaultType holds the value of the "payment_vault_type" field.
+	PaymentVaultType string `json:"payment_vault_type,omitempty"`
+	// NftType holds the value of the "nft_type" field.
+	NftType sale.NftType `json:"nft_type,omitempty"`
+	// NftID holds the value of the "nft_id" field.
+	NftID uint64 `json:"nft_id,omitempty"`
+	// TxID holds the value of the "tx_id" field.
+	TxID string `json:"tx_id,omitempty"`
+	// BlockHeight holds the value of the "block_height" field.
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SaleQuery when eager-loading is set.
+	Edges          SaleEdges `json:"edges"`
+	listing_sale   *int
+	user_sales     *int
+	user_purchases *int
+	selectValues   sql.SelectValues
+}
+
+// SaleEdges holds the relations/edges for other nodes in the graph.
+type SaleEdges struct {
+	// Seller holds the value of the seller edge.
+	Seller *User `json:"seller,omitempty"`
+	// Buyer holds the value of the buyer edge.
+	Buyer *User `json:"buyer,omitempty"`
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// SellerOrErr returns the Seller value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SaleEdges) SellerOrErr() (*User, error) {
+	if e.Seller != nil {
+		return e.Seller, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "seller"}
+}
+
+// BuyerOrErr returns the Buyer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SaleEdges) BuyerOrErr() (*User, error) {
+	if e.Buyer != nil {
+		return e.Buyer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "buyer"}
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SaleEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Sale) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sale.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case sale.FieldID, sale.FieldNftID, sale.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case sale.FieldPaymentVaultType, sale.FieldNftType, sale.FieldTxID:
+			values[i] = new(sql.NullString)
+		case sale.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		case sale.ForeignKeys[0]: // listing_sale
+			values[i] = new(sql.NullInt64)
+		case sale.ForeignKeys[1]: // user_sales
+			values[i] = new(sql.NullInt64)
+		case sale.ForeignKeys[2]: // user_purchases
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Sale fields.
+func (_m *Sale) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sale.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case sale.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = value.Float64
+			}
+		case sale.FieldPaymentVaultType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_vault_type", values[i])
+			} else if value.Valid {
+				_m.PaymentVaultType = value.String
+			}
+		case sale.FieldNftType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nft_type", values[i])
+			} else if value.Valid {
+				_m.NftType = sale.NftType(value.String)
+			}
+		case sale.FieldNftID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nft_id", values[i])
+			} else if value.Valid {
+				_m.NftID = uint64(value.Int64)
+			}
+		case sale.FieldTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_id", values[i])
+			} else if value.Valid {
+				_m.TxID = value.String
+			}
+		case sale.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = uint64(value.Int64)
+			}
+		case sale.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		case sale.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field listing_sale", value)
+			} else if value.Valid {
+				_m.listing_sale = new(int)
+				*_m.listing_sale = int(value.Int64)
+			}
+		case sale.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_sales", value)
+			} else if value.Valid {
+				_m.user_sales = new(int)
+				*_m.user_sales = int(value.Int64)
+			}
+		case sale.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_purchases", value)
+			} else if value.Valid {
+				_m.user_purchases = new(int)
+				*_m.user_purchases = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Sale.
+// This includes values selected through modifiers, order, etc.
+func (_m *Sale) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySeller queries the "seller" edge of the Sale entity.
+func (_m *Sale) QuerySeller() *UserQuery {
+	return NewSaleClient(_m.config).QuerySeller(_m)
+}
+
+// QueryBuyer queries the "buyer" edge of the Sale entity.
+func (_m *Sale) QueryBuyer() *UserQuery {
+	return NewSaleClient(_m.config).QueryBuyer(_m)
+}
+
+// QueryListing queries the "listing" edge of the Sale entity.
+func (_m *Sale) QueryListing() *ListingQuery {
+	return NewSaleClient(_m.config).QueryListing(_m)
+}
+
+// Update returns a builder for updating this Sale.
+// Note that you need to call Sale.Unwrap() before calling this method if this Sale
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Sale) Update() *SaleUpdateOne {
+	return NewSaleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Sale entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Sale) Unwrap() *Sale {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Sale is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Sale) String() string {
+	var builder strings.Builder
+	builder.WriteString("Sale(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("payment_vault_type=")
+	builder.WriteString(_m.PaymentVaultType)
+	builder.WriteString(", ")
+	builder.WriteString("nft_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NftType))
+	builder.WriteString(", ")
+	builder.WriteString("nft_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NftID))
+	builder.WriteString(", ")
+	builder.WriteString("tx_id=")
+	builder.WriteString(_m.TxID)
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Sales is a parsable slice of Sale.
+type Sales []*Sale
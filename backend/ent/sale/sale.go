@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sale
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sale type in the database.
+	Label = "sale"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldPaymentVaultType holds the string denoting the payment_vault_type field in the database.
+	FieldPaymentVaultType = "payment_vault_type"
+	// FieldNftType holds the string denoting the nft_type field in the database.
+	FieldNftType = "nft_type"
+	// FieldNftID holds the string denoting the nft_id field in the database.
+	FieldNftID = "nft_id"
+	// FieldTxID holds the string denoting the tx_id field in the database.
+	FieldTxID = "tx_id"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// EdgeSeller holds the string denoting the seller edge name in mutations.
+	EdgeSeller = "seller"
+	// EdgeBuyer holds the string denoting the buyer edge name in mutations.
+	EdgeBuyer = "buyer"
+	// EdgeListing holds the string denoting the listing edge name in mutations.
+	EdgeListing = "listing"
+	// Table holds the table name of the sale in the database.
+	Table = "sales"
+	// SellerTable is the table that holds the seller relation/edge.
+	SellerTable = "sales"
+	// SellerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	SellerInverseTable = "users"
+	// SellerColumn is the table column denoting the seller relation/edge.
+	SellerColumn = "user_sales"
+	// BuyerTable is the table that holds the buyer relation/edge.
+	BuyerTable = "sales"
+	// BuyerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	BuyerInverseTable = "users"
+	// BuyerColumn is the table column denoting the buyer relation/edge.
+	BuyerColumn = "user_purchases"
+	// ListingTable is the table that holds the listing relation/edge.
+	ListingTable = "sales"
+	// ListingInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingInverseTable = "listings"
+	// ListingColumn is the table column denoting the listing relation/edge.
+	ListingColumn = "listing_sale"
+)
+
+// Columns holds all SQL columns for sale fields.
+var Columns = []string{
+	FieldID,
+	FieldPrice,
+	FieldPaymentVaultType,
+	FieldNftType,
+	FieldNftID,
+	FieldTxID,
+	FieldBlockHeight,
+	FieldTimestamp,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sales"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"listing_sale",
+	"user_sales",
+	"user_purchases",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+)
+
+// NftType defines the type for the "nft_type" enum field.
+type NftType string
+
+// NftType values.
+const (
+	NftTypeMoment    NftType = "moment"
+	NftTypeAccessory NftType = "accessory"
+)
+
+func (nt NftType) String() string {
+	return string(nt)
+}
+
+// NftTypeValidator is a validator for the "nft_type" field enum values. It is called by the builders before save.
+func NftTypeValidator(nt NftType) error {
+	switch nt {
+	case NftTypeMoment, NftTypeAccessory:
+		return nil
+	default:
+		return fmt.Errorf("sale: invalid enum value for nft_type field: %q", nt)
+	}
+}
+
+// OrderOption defines the ordering options for the Sale queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByPaymentVaultType orders the results by the payment_vault_type field.
+func ByPaymentVaultType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentVaultType, opts...).ToFunc()
+}
+
+// ByNftType orders the results by the nft_type field.
+func ByNftType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNftType, opts...).ToFunc()
+}
+
+// ByNftID orders the results by the nft_id field.
+func ByNftID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNftID, opts...).ToFunc()
+}
+
+// ByTxID orders the results by the tx_id field.
+func ByTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxID, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// BySellerField orders the results by seller field.
+func BySellerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSellerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBuyerField orders the results by buyer field.
+func ByBuyerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuyerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByListingField orders the results by listing field.
+func ByListingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSellerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SellerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SellerTable, SellerColumn),
+	)
+}
+func newBuyerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuyerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BuyerTable, BuyerColumn),
+	)
+}
+func newListingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, ListingTable, ListingColumn),
+	)
+}
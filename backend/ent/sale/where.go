@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sale
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldID, id))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldPrice, v))
+}
+
+// PaymentVaultType applies equality check predicate on the "payment_vault_type" field. It's identical to PaymentVaultTypeEQ.
+func PaymentVaultType(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldPaymentVaultType, v))
+}
+
+// NftID applies equality check predicate on the "nft_id" field. It's identical to NftIDEQ.
+func NftID(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldNftID, v))
+}
+
+// TxID applies equality check predicate on the "tx_id" field. It's identical to TxIDEQ.
+func TxID(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldTxID, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldPrice, v))
+}
+
+// PaymentVaultTypeEQ applies the EQ predicate on the "payment_vault_type" field.
+func PaymentVaultTypeEQ(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeNEQ applies the NEQ predicate on the "payment_vault_type" field.
+func PaymentVaultTypeNEQ(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeIn applies the In predicate on the "payment_vault_type" field.
+func PaymentVaultTypeIn(vs ...string) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldPaymentVaultType, vs...))
+}
+
+// PaymentVaultTypeNotIn applies the NotIn predicate on the "payment_vault_type" field.
+func PaymentVaultTypeNotIn(vs ...string) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldPaymentVaultType, vs...))
+}
+
+// PaymentVaultTypeGT applies the GT predicate on the "payment_vault_type" field.
+func PaymentVaultTypeGT(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeGTE applies the GTE predicate on the "payment_vault_type" field.
+func PaymentVaultTypeGTE(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeLT applies the LT predicate on the "payment_vault_type" field.
+func PaymentVaultTypeLT(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeLTE applies the LTE predicate on the "payment_vault_type" field.
+func PaymentVaultTypeLTE(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeContains applies the Contains predicate on the "payment_vault_type" field.
+func PaymentVaultTypeContains(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldContains(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeHasPrefix applies the HasPrefix predicate on the "payment_vault_type" field.
+func PaymentVaultTypeHasPrefix(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldHasPrefix(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeHasSuffix applies the HasSuffix predicate on the "payment_vault_type" field.
+func PaymentVaultTypeHasSuffix(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldHasSuffix(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeEqualFold applies the EqualFold predicate on the "payment_vault_type" field.
+func PaymentVaultTypeEqualFold(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEqualFold(FieldPaymentVaultType, v))
+}
+
+// PaymentVaultTypeContainsFold applies the ContainsFold predicate on the "payment_vault_type" field.
+func PaymentVaultTypeContainsFold(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldContainsFold(FieldPaymentVaultType, v))
+}
+
+// NftTypeEQ applies the EQ predicate on the "nft_type" field.
+func NftTypeEQ(v NftType) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldNftType, v))
+}
+
+// NftTypeNEQ applies the NEQ predicate on the "nft_type" field.
+func NftTypeNEQ(v NftType) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldNftType, v))
+}
+
+// NftTypeIn applies the In predicate on the "nft_type" field.
+func NftTypeIn(vs ...NftType) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldNftType, vs...))
+}
+
+// NftTypeNotIn applies the NotIn predicate on the "nft_type" field.
+func NftTypeNotIn(vs ...NftType) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldNftType, vs...))
+}
+
+// NftIDEQ applies the EQ predicate on the "nft_id" field.
+func NftIDEQ(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldNftID, v))
+}
+
+// NftIDNEQ applies the NEQ predicate on the "nft_id" field.
+func NftIDNEQ(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldNftID, v))
+}
+
+// NftIDIn applies the In predicate on the "nft_id" field.
+func NftIDIn(vs ...uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldNftID, vs...))
+}
+
+// NftIDNotIn applies the NotIn predicate on the "nft_id" field.
+func NftIDNotIn(vs ...uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldNftID, vs...))
+}
+
+// NftIDGT applies the GT predicate on the "nft_id" field.
+func NftIDGT(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldNftID, v))
+}
+
+// NftIDGTE applies the GTE predicate on the "nft_id" field.
+func NftIDGTE(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldNftID, v))
+}
+
+// NftIDLT applies the LT predicate on the "nft_id" field.
+func NftIDLT(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldNftID, v))
+}
+
+// NftIDLTE applies the LTE predicate on the "nft_id" field.
+func NftIDLTE(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldNftID, v))
+}
+
+// TxIDEQ applies the EQ predicate on the "tx_id" field.
+func TxIDEQ(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIDNEQ applies the NEQ predicate on the "tx_id" field.
+func TxIDNEQ(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldTxID, v))
+}
+
+// TxIDIn applies the In predicate on the "tx_id" field.
+func TxIDIn(vs ...string) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldTxID, vs...))
+}
+
+// TxIDNotIn applies the NotIn predicate on the "tx_id" field.
+func TxIDNotIn(vs ...string) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldTxID, vs...))
+}
+
+// TxIDGT applies the GT predicate on the "tx_id" field.
+func TxIDGT(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldTxID, v))
+}
+
+// TxIDGTE applies the GTE predicate on the "tx_id" field.
+func TxIDGTE(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldTxID, v))
+}
+
+// TxIDLT applies the LT predicate on the "tx_id" field.
+func TxIDLT(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldTxID, v))
+}
+
+// TxIDLTE applies the LTE predicate on the "tx_id" field.
+func TxIDLTE(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldTxID, v))
+}
+
+// TxIDContains applies the Contains predicate on the "tx_id" field.
+func TxIDContains(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldContains(FieldTxID, v))
+}
+
+// TxIDHasPrefix applies the HasPrefix predicate on the "tx_id" field.
+func TxIDHasPrefix(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldHasPrefix(FieldTxID, v))
+}
+
+// TxIDHasSuffix applies the HasSuffix predicate on the "tx_id" field.
+func TxIDHasSuffix(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldHasSuffix(FieldTxID, v))
+}
+
+// TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
+func TxIDEqualFold(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldEqualFold(FieldTxID, v))
+}
+
+// TxIDContainsFold applies the ContainsFold predicate on the "tx_id" field.
+func TxIDContainsFold(v string) predicate.Sale {
+	return predicate.Sale(sql.FieldContainsFold(FieldTxID, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.Sale {
+	return predicate.Sale(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// HasSeller applies the HasEdge predicate on the "seller" edge.
+func HasSeller() predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SellerTable, SellerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSellerWith applies the HasEdge predicate on the "seller" edge with a given conditions (other predicates).
+func HasSellerWith(preds ...predicate.User) predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := newSellerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuyer applies the HasEdge predicate on the "buyer" edge.
+func HasBuyer() predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BuyerTable, BuyerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuyerWith applies the HasEdge predicate on the "buyer" edge with a given conditions (other predicates).
+func HasBuyerWith(preds ...predicate.User) predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := newBuyerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasListing applies the HasEdge predicate on the "listing" edge.
+func HasListing() predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ListingTable, ListingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingWith applies the HasEdge predicate on the "listing" edge with a given conditions (other predicates).
+func HasListingWith(preds ...predicate.Listing) predicate.Sale {
+	return predicate.Sale(func(s *sql.Selector) {
+		step := newListingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sale) predicate.Sale {
+	return predicate.Sale(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sale) predicate.Sale {
+	return predicate.Sale(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sale) predicate.Sale {
+	return predicate.Sale(sql.NotPredicates(p))
+}
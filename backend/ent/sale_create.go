@@ -0,0 +1,961 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/listing"
+	"backend/ent/sale"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SaleCreate is the builder for creating a Sale entity.
+type SaleCreate struct {
+	config
+	mutation *SaleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPrice sets the "price" field.
+func (_c *SaleCreate) SetPrice(v float64) *SaleCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (_c *SaleCreate) SetPaymentVaultType(v string) *SaleCreate {
+	_c.mutation.SetPaymentVaultType(v)
+	return _c
+}
+
+// SetNftType sets the "nft_type" field.
+func (_c *SaleCreate) SetNftType(v sale.NftType) *SaleCreate {
+	_c.mutation.SetNftType(v)
+	return _c
+}
+
+// SetNftID sets the "nft_id" field.
+func (_c *SaleCreate) SetNftID(v uint64) *SaleCreate {
+	_c.mutation.SetNftID(v)
+	return _c
+}
+
+// SetTxID sets the "tx_id" field.
+func (_c *SaleCreate) SetTxID(v string) *SaleCreate {
+	_c.mutation.SetTxID(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *SaleCreate) SetBlockHeight(v uint64) *SaleCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *SaleCreate) SetTimestamp(v time.Time) *SaleCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_c *SaleCreate) SetNillableTimestamp(v *time.Time) *SaleCreate {
+	if v != nil {
+		_c.SetTimestamp(*v)
+	}
+	return _c
+}
+
+// SetSellerID sets the "seller" edge to the User entity by ID.
+func (_c *SaleCreate) SetSellerID(id int) *SaleCreate {
+	_c.mutation.SetSellerID(id)
+	return _c
+}
+
+// SetSeller sets the "seller" edge to the User entity.
+func (_c *SaleCreate) SetSeller(v *User) *SaleCreate {
+	return _c.SetSellerID(v.ID)
+}
+
+// SetBuyerID sets the "buyer" edge to the User entity by ID.
+func (_c *SaleCreate) SetBuyerID(id int) *SaleCreate {
+	_c.mutation.SetBuyerID(id)
+	return _c
+}
+
+// SetNillableBuyerID sets the "buyer" edge to the User entity by ID if the given value is not nil.
+func (_c *SaleCreate) SetNillableBuyerID(id *int) *SaleCreate {
+	if id != nil {
+		_c = _c.SetBuyerID(*id)
+	}
+	return _c
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_c *SaleCreate) SetBuyer(v *User) *SaleCreate {
+	return _c.SetBuyerID(v.ID)
+}
+
+// SetListingID sets the "listing" edge to the Listing entity by ID.
+func (_c *SaleCreate) SetListingID(id int) *SaleCreate {
+	_c.mutation.SetListingID(id)
+	return _c
+}
+
+// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
+func (_c *SaleCreate) SetNillableListingID(id *int) *SaleCreate {
+	if id != nil {
+		_c = _c.SetListingID(*id)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *SaleCreate) SetListing(v *Listing) *SaleCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// Mutation returns the SaleMutation object of the builder.
+func (_c *SaleCreate) Mutation() *SaleMutation {
+	return _c.mutation
+}
+
+// Save creates the Sale in the database.
+func (_c *SaleCreate) Save(ctx context.Context) (*Sale, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SaleCreate) SaveX(ctx context.Context) *Sale {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SaleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SaleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SaleCreate) defaults() {
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		v := sale.DefaultTimestamp()
+		_c.mutation.SetTimestamp(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SaleCreate) check() error {
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Sale.price"`)}
+	}
+	if _, ok := _c.mutation.PaymentVaultType(); !ok {
+		return &ValidationError{Name: "payment_vault_type", err: errors.New(`ent: missing required field "Sale.payment_vault_type"`)}
+	}
+	if _, ok := _c.mutation.NftType(); !ok {
+		return &ValidationError{Name: "nft_type", err: errors.New(`ent: missing required field "Sale.nft_type"`)}
+	}
+	if v, ok := _c.mutation.NftType(); ok {
+		if err := sale.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Sale.nft_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NftID(); !ok {
+		return &ValidationError{Name: "nft_id", err: errors.New(`ent: missing required field "Sale.nft_id"`)}
+	}
+	if _, ok := _c.mutation.TxID(); !ok {
+		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "Sale.tx_id"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "Sale.block_height"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Sale.timestamp"`)}
+	}
+	if len(_c.mutation.SellerIDs()) == 0 {
+		return &ValidationError{Name: "seller", err: errors.New(`ent: missing required edge "Sale.seller"`)}
+	}
+	return nil
+}
+
+func (_c *SaleCreate) sqlSave(ctx context.Context) (*Sale, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SaleCreate) createSpec() (*Sale, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sale{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sale.Table, sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(sale.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.PaymentVaultType(); ok {
+		_spec.SetField(sale.FieldPaymentVaultType, field.TypeString, value)
+		_node.PaymentVaultType = value
+	}
+	if value, ok := _c.mutation.NftType(); ok {
+		_spec.SetField(sale.FieldNftType, field.TypeEnum, value)
+		_node.NftType = value
+	}
+	if value, ok := _c.mutation.NftID(); ok {
+		_spec.SetField(sale.FieldNftID, field.TypeUint64, value)
+		_node.NftID = value
+	}
+	if value, ok := _c.mutation.TxID(); ok {
+		_spec.SetField(sale.FieldTxID, field.TypeString, value)
+		_node.TxID = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(sale.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(sale.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if nodes := _c.mutation.SellerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.SellerTable,
+			Columns: []string{sale.SellerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sales = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.BuyerTable,
+			Columns: []string{sale.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_purchases = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sale.ListingTable,
+			Columns: []string{sale.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.listing_sale = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sale.Create().
+//		SetPrice(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SaleUpsert) {
+//			SetPrice(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SaleCreate) OnConflict(opts ...sql.ConflictOption) *SaleUpsertOne {
+	_c.conflict = opts
+	return &SaleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SaleCreate) OnConflictColumns(columns ...string) *SaleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SaleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SaleUpsertOne is the builder for "upsert"-ing
+	//  one Sale node.
+	SaleUpsertOne struct {
+		create *SaleCreate
+	}
+
+	// SaleUpsert is the "OnConflict" setter.
+	SaleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPrice sets the "price" field.
+func (u *SaleUpsert) SetPrice(v float64) *SaleUpsert {
+	u.Set(sale.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SaleUpsert) UpdatePrice() *SaleUpsert {
+	u.SetExcluded(sale.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SaleUpsert) AddPrice(v float64) *SaleUpsert {
+	u.Add(sale.FieldPrice, v)
+	return u
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *SaleUpsert) SetPaymentVaultType(v string) *SaleUpsert {
+	u.Set(sale.FieldPaymentVaultType, v)
+	return u
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *SaleUpsert) UpdatePaymentVaultType() *SaleUpsert {
+	u.SetExcluded(sale.FieldPaymentVaultType)
+	return u
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *SaleUpsert) SetNftType(v sale.NftType) *SaleUpsert {
+	u.Set(sale.FieldNftType, v)
+	return u
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *SaleUpsert) UpdateNftType() *SaleUpsert {
+	u.SetExcluded(sale.FieldNftType)
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *SaleUpsert) SetNftID(v uint64) *SaleUpsert {
+	u.Set(sale.FieldNftID, v)
+	return u
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *SaleUpsert) UpdateNftID() *SaleUpsert {
+	u.SetExcluded(sale.FieldNftID)
+	return u
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *SaleUpsert) AddNftID(v uint64) *SaleUpsert {
+	u.Add(sale.FieldNftID, v)
+	return u
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *SaleUpsert) SetTxID(v string) *SaleUpsert {
+	u.Set(sale.FieldTxID, v)
+	return u
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *SaleUpsert) UpdateTxID() *SaleUpsert {
+	u.SetExcluded(sale.FieldTxID)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *SaleUpsert) SetBlockHeight(v uint64) *SaleUpsert {
+	u.Set(sale.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *SaleUpsert) UpdateBlockHeight() *SaleUpsert {
+	u.SetExcluded(sale.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *SaleUpsert) AddBlockHeight(v uint64) *SaleUpsert {
+	u.Add(sale.FieldBlockHeight, v)
+	return u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *SaleUpsert) SetTimestamp(v time.Time) *SaleUpsert {
+	u.Set(sale.FieldTimestamp, v)
+	return u
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *SaleUpsert) UpdateTimestamp() *SaleUpsert {
+	u.SetExcluded(sale.FieldTimestamp)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SaleUpsertOne) UpdateNewValues() *SaleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SaleUpsertOne) Ignore() *SaleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SaleUpsertOne) DoNothing() *SaleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SaleCreate.OnConflict
+// documentation for more info.
+func (u *SaleUpsertOne) Update(set func(*SaleUpsert)) *SaleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SaleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *SaleUpsertOne) SetPrice(v float64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SaleUpsertOne) AddPrice(v float64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdatePrice() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *SaleUpsertOne) SetPaymentVaultType(v string) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetPaymentVaultType(v)
+	})
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdatePaymentVaultType() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdatePaymentVaultType()
+	})
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *SaleUpsertOne) SetNftType(v sale.NftType) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdateNftType() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *SaleUpsertOne) SetNftID(v uint64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *SaleUpsertOne) AddNftID(v uint64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdateNftID() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *SaleUpsertOne) SetTxID(v string) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdateTxID() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *SaleUpsertOne) SetBlockHeight(v uint64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *SaleUpsertOne) AddBlockHeight(v uint64) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdateBlockHeight() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *SaleUpsertOne) SetTimestamp(v time.Time) *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *SaleUpsertOne) UpdateTimestamp() *SaleUpsertOne {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *SaleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SaleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SaleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SaleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SaleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SaleCreateBulk is the builder for creating many Sale entities in bulk.
+type SaleCreateBulk struct {
+	config
+	err      error
+	builders []*SaleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Sale entities in the database.
+func (_c *SaleCreateBulk) Save(ctx context.Context) ([]*Sale, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Sale, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SaleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SaleCreateBulk) SaveX(ctx context.Context) []*Sale {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SaleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SaleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Sale.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SaleUpsert) {
+//			SetPrice(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SaleCreateBulk) OnConflict(opts ...sql.ConflictOption) *SaleUpsertBulk {
+	_c.conflict = opts
+	return &SaleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SaleCreateBulk) OnConflictColumns(columns ...string) *SaleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SaleUpsertBulk{
+		create: _c,
+	}
+}
+
+// SaleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Sale nodes.
+type SaleUpsertBulk struct {
+	create *SaleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SaleUpsertBulk) UpdateNewValues() *SaleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Sale.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SaleUpsertBulk) Ignore() *SaleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SaleUpsertBulk) DoNothing() *SaleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SaleCreateBulk.OnConflict
+// documentation for more info.
+func (u *SaleUpsertBulk) Update(set func(*SaleUpsert)) *SaleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SaleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *SaleUpsertBulk) SetPrice(v float64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *SaleUpsertBulk) AddPrice(v float64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdatePrice() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (u *SaleUpsertBulk) SetPaymentVaultType(v string) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetPaymentVaultType(v)
+	})
+}
+
+// UpdatePaymentVaultType sets the "payment_vault_type" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdatePaymentVaultType() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdatePaymentVaultType()
+	})
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *SaleUpsertBulk) SetNftType(v sale.NftType) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdateNftType() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *SaleUpsertBulk) SetNftID(v uint64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *SaleUpsertBulk) AddNftID(v uint64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdateNftID() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *SaleUpsertBulk) SetTxID(v string) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdateTxID() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *SaleUpsertBulk) SetBlockHeight(v uint64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *SaleUpsertBulk) AddBlockHeight(v uint64) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdateBlockHeight() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *SaleUpsertBulk) SetTimestamp(v time.Time) *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *SaleUpsertBulk) UpdateTimestamp() *SaleUpsertBulk {
+	return u.Update(func(s *SaleUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *SaleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SaleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SaleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SaleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,816 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/listing"
+	"backend/ent/predicate"
+	"backend/ent/sale"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SaleUpdate is the builder for updating Sale entities.
+type SaleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SaleMutation
+}
+
+// Where appends a list predicates to the SaleUpdate builder.
+func (_u *SaleUpdate) Where(ps ...predicate.Sale) *SaleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *SaleUpdate) SetPrice(v float64) *SaleUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillablePrice(v *float64) *SaleUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SaleUpdate) AddPrice(v float64) *SaleUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (_u *SaleUpdate) SetPaymentVaultType(v string) *SaleUpdate {
+	_u.mutation.SetPaymentVaultType(v)
+	return _u
+}
+
+// SetNillablePaymentVaultType sets the "payment_vault_type" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillablePaymentVaultType(v *string) *SaleUpdate {
+	if v != nil {
+		_u.SetPaymentVaultType(*v)
+	}
+	return _u
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *SaleUpdate) SetNftType(v sale.NftType) *SaleUpdate {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillableNftType(v *sale.NftType) *SaleUpdate {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
+// SetNftID sets the "nft_id" field.
+func (_u *SaleUpdate) SetNftID(v uint64) *SaleUpdate {
+	_u.mutation.ResetNftID()
+	_u.mutation.SetNftID(v)
+	return _u
+}
+
+// SetNillableNftID sets the "nft_id" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillableNftID(v *uint64) *SaleUpdate {
+	if v != nil {
+		_u.SetNftID(*v)
+	}
+	return _u
+}
+
+// AddNftID adds value to the "nft_id" field.
+func (_u *SaleUpdate) AddNftID(v int64) *SaleUpdate {
+	_u.mutation.AddNftID(v)
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *SaleUpdate) SetTxID(v string) *SaleUpdate {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillableTxID(v *string) *SaleUpdate {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *SaleUpdate) SetBlockHeight(v uint64) *SaleUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillableBlockHeight(v *uint64) *SaleUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *SaleUpdate) AddBlockHeight(v int64) *SaleUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *SaleUpdate) SetTimestamp(v time.Time) *SaleUpdate {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *SaleUpdate) SetNillableTimestamp(v *time.Time) *SaleUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetSellerID sets the "seller" edge to the User entity by ID.
+func (_u *SaleUpdate) SetSellerID(id int) *SaleUpdate {
+	_u.mutation.SetSellerID(id)
+	return _u
+}
+
+// SetSeller sets the "seller" edge to the User entity.
+func (_u *SaleUpdate) SetSeller(v *User) *SaleUpdate {
+	return _u.SetSellerID(v.ID)
+}
+
+// SetBuyerID sets the "buyer" edge to the User entity by ID.
+func (_u *SaleUpdate) SetBuyerID(id int) *SaleUpdate {
+	_u.mutation.SetBuyerID(id)
+	return _u
+}
+
+// SetNillableBuyerID sets the "buyer" edge to the User entity by ID if the given value is not nil.
+func (_u *SaleUpdate) SetNillableBuyerID(id *int) *SaleUpdate {
+	if id != nil {
+		_u = _u.SetBuyerID(*id)
+	}
+	return _u
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_u *SaleUpdate) SetBuyer(v *User) *SaleUpdate {
+	return _u.SetBuyerID(v.ID)
+}
+
+// SetListingID sets the "listing" edge to the Listing entity by ID.
+func (_u *SaleUpdate) SetListingID(id int) *SaleUpdate {
+	_u.mutation.SetListingID(id)
+	return _u
+}
+
+// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
+func (_u *SaleUpdate) SetNillableListingID(id *int) *SaleUpdate {
+	if id != nil {
+		_u = _u.SetListingID(*id)
+	}
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *SaleUpdate) SetListing(v *Listing) *SaleUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the SaleMutation object of the builder.
+func (_u *SaleUpdate) Mutation() *SaleMutation {
+	return _u.mutation
+}
+
+// ClearSeller clears the "seller" edge to the User entity.
+func (_u *SaleUpdate) ClearSeller() *SaleUpdate {
+	_u.mutation.ClearSeller()
+	return _u
+}
+
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (_u *SaleUpdate) ClearBuyer() *SaleUpdate {
+	_u.mutation.ClearBuyer()
+	return _u
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *SaleUpdate) ClearListing() *SaleUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SaleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SaleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SaleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SaleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SaleUpdate) check() error {
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := sale.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Sale.nft_type": %w`, err)}
+		}
+	}
+	if _u.mutation.SellerCleared() && len(_u.mutation.SellerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sale.seller"`)
+	}
+	return nil
+}
+
+func (_u *SaleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sale.Table, sale.Columns, sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(sale.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(sale.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentVaultType(); ok {
+		_spec.SetField(sale.FieldPaymentVaultType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(sale.FieldNftType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftID(); ok {
+		_spec.SetField(sale.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedNftID(); ok {
+		_spec.AddField(sale.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(sale.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(sale.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(sale.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(sale.FieldTimestamp, field.TypeTime, value)
+	}
+	if _u.mutation.SellerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.SellerTable,
+			Columns: []string{sale.SellerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SellerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.SellerTable,
+			Columns: []string{sale.SellerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.BuyerTable,
+			Columns: []string{sale.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.BuyerTable,
+			Columns: []string{sale.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sale.ListingTable,
+			Columns: []string{sale.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sale.ListingTable,
+			Columns: []string{sale.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sale.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SaleUpdateOne is the builder for updating a single Sale entity.
+type SaleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SaleMutation
+}
+
+// SetPrice sets the "price" field.
+func (_u *SaleUpdateOne) SetPrice(v float64) *SaleUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillablePrice(v *float64) *SaleUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *SaleUpdateOne) AddPrice(v float64) *SaleUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetPaymentVaultType sets the "payment_vault_type" field.
+func (_u *SaleUpdateOne) SetPaymentVaultType(v string) *SaleUpdateOne {
+	_u.mutation.SetPaymentVaultType(v)
+	return _u
+}
+
+// SetNillablePaymentVaultType sets the "payment_vault_type" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillablePaymentVaultType(v *string) *SaleUpdateOne {
+	if v != nil {
+		_u.SetPaymentVaultType(*v)
+	}
+	return _u
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *SaleUpdateOne) SetNftType(v sale.NftType) *SaleUpdateOne {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableNftType(v *sale.NftType) *SaleUpdateOne {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
+// SetNftID sets the "nft_id" field.
+func (_u *SaleUpdateOne) SetNftID(v uint64) *SaleUpdateOne {
+	_u.mutation.ResetNftID()
+	_u.mutation.SetNftID(v)
+	return _u
+}
+
+// SetNillableNftID sets the "nft_id" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableNftID(v *uint64) *SaleUpdateOne {
+	if v != nil {
+		_u.SetNftID(*v)
+	}
+	return _u
+}
+
+// AddNftID adds value to the "nft_id" field.
+func (_u *SaleUpdateOne) AddNftID(v int64) *SaleUpdateOne {
+	_u.mutation.AddNftID(v)
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *SaleUpdateOne) SetTxID(v string) *SaleUpdateOne {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableTxID(v *string) *SaleUpdateOne {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *SaleUpdateOne) SetBlockHeight(v uint64) *SaleUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableBlockHeight(v *uint64) *SaleUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *SaleUpdateOne) AddBlockHeight(v int64) *SaleUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *SaleUpdateOne) SetTimestamp(v time.Time) *SaleUpdateOne {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableTimestamp(v *time.Time) *SaleUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetSellerID sets the "seller" edge to the User entity by ID.
+func (_u *SaleUpdateOne) SetSellerID(id int) *SaleUpdateOne {
+	_u.mutation.SetSellerID(id)
+	return _u
+}
+
+// SetSeller sets the "seller" edge to the User entity.
+func (_u *SaleUpdateOne) SetSeller(v *User) *SaleUpdateOne {
+	return _u.SetSellerID(v.ID)
+}
+
+// SetBuyerID sets the "buyer" edge to the User entity by ID.
+func (_u *SaleUpdateOne) SetBuyerID(id int) *SaleUpdateOne {
+	_u.mutation.SetBuyerID(id)
+	return _u
+}
+
+// SetNillableBuyerID sets the "buyer" edge to the User entity by ID if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableBuyerID(id *int) *SaleUpdateOne {
+	if id != nil {
+		_u = _u.SetBuyerID(*id)
+	}
+	return _u
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_u *SaleUpdateOne) SetBuyer(v *User) *SaleUpdateOne {
+	return _u.SetBuyerID(v.ID)
+}
+
+// SetListingID sets the "listing" edge to the Listing entity by ID.
+func (_u *SaleUpdateOne) SetListingID(id int) *SaleUpdateOne {
+	_u.mutation.SetListingID(id)
+	return _u
+}
+
+// SetNillableListingID sets the "listing" edge to the Listing entity by ID if the given value is not nil.
+func (_u *SaleUpdateOne) SetNillableListingID(id *int) *SaleUpdateOne {
+	if id != nil {
+		_u = _u.SetListingID(*id)
+	}
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *SaleUpdateOne) SetListing(v *Listing) *SaleUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the SaleMutation object of the builder.
+func (_u *SaleUpdateOne) Mutation() *SaleMutation {
+	return _u.mutation
+}
+
+// ClearSeller clears the "seller" edge to the User entity.
+func (_u *SaleUpdateOne) ClearSeller() *SaleUpdateOne {
+	_u.mutation.ClearSeller()
+	return _u
+}
+
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (_u *SaleUpdateOne) ClearBuyer() *SaleUpdateOne {
+	_u.mutation.ClearBuyer()
+	return _u
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *SaleUpdateOne) ClearListing() *SaleUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Where appends a list predicates to the SaleUpdate builder.
+func (_u *SaleUpdateOne) Where(ps ...predicate.Sale) *SaleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SaleUpdateOne) Select(field string, fields ...string) *SaleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Sale entity.
+func (_u *SaleUpdateOne) Save(ctx context.Context) (*Sale, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SaleUpdateOne) SaveX(ctx context.Context) *Sale {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SaleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SaleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SaleUpdateOne) check() error {
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := sale.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Sale.nft_type": %w`, err)}
+		}
+	}
+	if _u.mutation.SellerCleared() && len(_u.mutation.SellerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Sale.seller"`)
+	}
+	return nil
+}
+
+func (_u *SaleUpdateOne) sqlSave(ctx context.Context) (_node *Sale, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sale.Table, sale.Columns, sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sale.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sale.FieldID)
+		for _, f := range fields {
+			if !sale.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sale.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(sale.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(sale.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentVaultType(); ok {
+		_spec.SetField(sale.FieldPaymentVaultType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(sale.FieldNftType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftID(); ok {
+		_spec.SetField(sale.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedNftID(); ok {
+		_spec.AddField(sale.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(sale.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(sale.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(sale.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(sale.FieldTimestamp, field.TypeTime, value)
+	}
+	if _u.mutation.SellerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.SellerTable,
+			Columns: []string{sale.SellerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SellerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.SellerTable,
+			Columns: []string{sale.SellerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.BuyerTable,
+			Columns: []string{sale.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sale.BuyerTable,
+			Columns: []string{sale.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sale.ListingTable,
+			Columns: []string{sale.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sale.ListingTable,
+			Columns: []string{sale.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Sale{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sale.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
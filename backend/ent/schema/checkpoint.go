@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Checkpoint menyimpan posisi terakhir indexer di chain,
+// supaya indexer bisa melanjutkan dari block terakhir setelah restart.
+type Checkpoint struct {
+	ent.Schema
+}
+
+// Fields dari Checkpoint.
+func (Checkpoint) Fields() []ent.Field {
+	return []ent.Field{
+		// Nama proses yang memiliki checkpoint ini (misal: "indexer")
+		field.String("name").
+			Unique(),
+
+		// Height & ID dari block terakhir yang SELESAI diproses
+		field.Uint64("block_height"),
+		field.String("block_id"),
+		field.Time("block_time").
+			Optional(),
+
+		// Error terakhir yang menghentikan subscription (misal block gagal diproses)
+		field.Text("last_error").
+			Optional(),
+		field.Time("last_error_at").
+			Optional().
+			Nillable(),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges dari Checkpoint.
+func (Checkpoint) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeadLetter menyimpan event yang handler-nya gagal (misal: user/NFT
+// yang direferensikan belum ada), supaya bisa dicoba ulang dan tidak hilang diam-diam.
+type DeadLetter struct {
+	ent.Schema
+}
+
+// Fields dari DeadLetter.
+func (DeadLetter) Fields() []ent.Field {
+	return []ent.Field{
+		// Posisi event di chain (sama seperti RawEvent)
+		field.Uint64("block_height"),
+		field.String("block_id"),
+		field.Time("block_time").
+			Optional(),
+		field.String("tx_id"),
+		field.Int("tx_index"),
+		field.Int("event_index"),
+		field.String("type"),
+
+		// Payload event dalam format JSON-CDC
+		field.JSON("payload", json.RawMessage{}),
+
+		// Error terakhir dari handler
+		field.Text("error"),
+
+		// Berapa kali event ini sudah dicoba (termasuk percobaan pertama)
+		field.Int("attempts").
+			Default(1),
+
+		// Kapan retrier boleh mencoba lagi
+		field.Time("next_retry_at"),
+
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges dari DeadLetter.
+func (DeadLetter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes dari DeadLetter.
+func (DeadLetter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tx_id", "event_index").
+			Unique(),
+		index.Fields("next_retry_at"),
+	}
+}
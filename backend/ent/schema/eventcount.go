@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EventCount adalah jumlah event per tipe yang sudah dijurnal indexer,
+// ditambah setiap block diproses supaya API status tidak perlu
+// menghitung ulang seluruh 'raw_events'.
+type EventCount struct {
+	ent.Schema
+}
+
+// Fields dari EventCount.
+func (EventCount) Fields() []ent.Field {
+	return []ent.Field{
+		// Tipe event lengkap (misal: "A.1bb6b1e0a5170088.NFTMoment.Minted")
+		field.String("type").
+			Unique(),
+		field.Int("count").
+			Default(0),
+
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges dari EventCount.
+func (EventCount) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GachaReceipt memegang siklus commit/reveal satu gacha AccessoryPack:
+// dibuka (AccessoryPackOpened), di-reveal (AccessoryPackRevealed),
+// lalu menghasilkan satu aksesori (AccessoryDistributed di transaksi yang sama).
+type GachaReceipt struct {
+	ent.Schema
+}
+
+// Fields dari GachaReceipt.
+func (GachaReceipt) Fields() []ent.Field {
+	return []ent.Field{
+		// uuid resource Receipt on-chain
+		field.Uint64("receipt_id").
+			Unique(),
+
+		// Alamat yang membuka gacha (authorizer transaksi open,
+		// atau penerima aksesori saat reveal). Kosong jika belum diketahui.
+		field.String("opener").
+			Optional(),
+
+		// Block yang di-commit; reveal baru bisa dilakukan setelah block ini
+		field.Uint64("commit_block"),
+		field.String("open_tx_id"),
+		field.Uint64("open_block").
+			Optional(),
+
+		// Diisi saat reveal
+		field.Uint64("reveal_block").
+			Optional().
+			Nillable(),
+		field.String("reveal_tx_id").
+			Optional(),
+		field.Uint8("rarity").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("revealed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges (relasi) dari GachaReceipt.
+func (GachaReceipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Relasi One-to-One
+		// Satu receipt menghasilkan satu aksesori
+		edge.To("accessory", NFTAccessory.Type).
+			Unique(),
+	}
+}
+
+// Indexes dari GachaReceipt.
+func (GachaReceipt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("opener"),
+		index.Fields("reveal_tx_id"),
+	}
+}
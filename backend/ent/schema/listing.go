@@ -32,6 +32,20 @@ func (Listing) Fields() []ent.Field {
 		// Waktu kedaluwarsa (disimpan sebagai time.Time)
 		field.Time("expiry"),
 
+		// Status listing; listing yang sudah selesai tidak dihapus
+		// supaya riwayatnya tetap ada.
+		// 'ghost': listing masih ada on-chain tapi NFT-nya sudah tidak
+		// ada di koleksi penjual (dipindah/di-equip), jadi tidak bisa dibeli.
+		field.Enum("status").
+			Values("active", "sold", "cancelled", "expired", "ghost").
+			Default("active"),
+
+		// Jenis NFT yang dijual; menentukan edge mana yang terisi
+		// (nft_accessory atau nft_moment). Listing lama semuanya aksesori.
+		field.Enum("nft_type").
+			Values("moment", "accessory").
+			Default("accessory"),
+
 		// (Kita tidak perlu menyimpan 'nftID' atau 'sellerAddress' di sini
 		// karena itu akan ditangani oleh 'Edges' (Relasi) di bawah)
 	}
@@ -46,13 +60,17 @@ func (Listing) Edges() []ent.Edge {
 			Unique().        // Satu listing hanya punya satu penjual
 			Required(),      // Wajib ada penjual
 
-		// Relasi One-to-One (Satu Listing untuk 1 NFT)
-		// (Saya asumsikan Anda ingin menjual 'NFTAccessory' di sini)
+		// Relasi Many-to-One (Satu Listing untuk 1 NFT, tapi satu NFT
+		// bisa di-list berkali-kali setelah terjual/dibatalkan)
+		// Hanya salah satu yang terisi, sesuai 'nft_type'
 		edge.To("nft_accessory", NFTAccessory.Type).
-			Unique().   // Satu listing hanya untuk satu aksesori
-			Required(), // Wajib ada NFT yang dijual
+			Unique(), // Satu listing hanya untuk satu aksesori
+		edge.To("nft_moment", NFTMoment.Type).
+			Unique(), // ...atau satu momen
 
-		// (Jika Anda juga ingin menjual 'NFTMoment', tambahkan edge
-		//  opsional lain ke 'NFTMoment' di sini)
+		// Relasi One-to-One
+		// Listing yang terjual punya satu catatan Sale
+		edge.To("sale", Sale.Type).
+			Unique(),
 	}
 }
@@ -20,6 +20,16 @@ func (NFTAccessory) Fields() []ent.Field {
 		field.String("description"),
 		field.String("thumbnail"),
 		field.String("equipment_type"),
+
+		// Rarity dari MetadataViews (Traits), diisi setelah mint
+		field.Float("rarity_score").
+			Optional().
+			Nillable(),
+		field.Float("rarity_max").
+			Optional().
+			Nillable(),
+		field.String("rarity_description").
+			Optional(),
 	}
 }
 
@@ -36,8 +46,13 @@ func (NFTAccessory) Edges() []ent.Edge {
 			Ref("equipped_accessories"). // Merujuk ke edge di NFTMoment
 			Unique(),
 
-		edge.From("listing", Listing.Type).
-			Ref("nft_accessory").
+		// Semua listing untuk aksesori ini (aktif maupun yang sudah selesai)
+		edge.From("listings", Listing.Type).
+			Ref("nft_accessory"),
+
+		// Receipt gacha yang menghasilkan aksesori ini (jika dari AccessoryPack)
+		edge.From("gacha_receipt", GachaReceipt.Type).
+			Ref("accessory").
 			Unique(),
 	}
 }
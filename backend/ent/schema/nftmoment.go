@@ -19,6 +19,22 @@ func (NFTMoment) Fields() []ent.Field {
 		field.String("name"),
 		field.String("description"),
 		field.String("thumbnail"),
+
+		// Dari MetadataViews (Traits), diisi setelah mint
+		field.String("tier").
+			Optional(),
+		field.Uint64("minted_block").
+			Optional().
+			Nillable(),
+		field.Time("minted_time").
+			Optional().
+			Nillable(),
+
+		// ID EventPass dari argumen transaksi mint. Edge 'minted_with_pass'
+		// diisi saat pass-nya terindeks (bisa setelah momen ini).
+		field.Uint64("pass_id").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -36,5 +52,9 @@ func (NFTMoment) Edges() []ent.Edge {
 		edge.From("minted_with_pass", EventPass.Type).
 			Ref("moment").
 			Unique(),
+
+		// Semua listing untuk momen ini (aktif maupun yang sudah selesai)
+		edge.From("listings", Listing.Type).
+			Ref("nft_moment"),
 	}
 }
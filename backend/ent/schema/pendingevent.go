@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PendingEvent menyimpan event yang datang lebih dulu dari baris yang
+// dibutuhkannya (misal EventPass.Minted sebelum EventCreated-nya).
+// Event ini diterapkan ulang otomatis saat baris tersebut terindeks.
+type PendingEvent struct {
+	ent.Schema
+}
+
+// Fields dari PendingEvent.
+func (PendingEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Baris yang ditunggu, misal entity "event" dengan key "12"
+		field.String("entity"),
+		field.String("entity_key"),
+
+		// Posisi event di chain (sama seperti RawEvent)
+		field.Uint64("block_height"),
+		field.String("block_id"),
+		field.Time("block_time").
+			Optional(),
+		field.String("tx_id"),
+		field.Int("tx_index"),
+		field.Int("event_index"),
+		field.String("type"),
+
+		// Payload event dalam format JSON-CDC
+		field.JSON("payload", json.RawMessage{}),
+
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges dari PendingEvent.
+func (PendingEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes dari PendingEvent.
+func (PendingEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tx_id", "event_index").
+			Unique(),
+		index.Fields("entity", "entity_key"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RawEvent adalah jurnal mentah dari setiap event yang diterima indexer,
+// disimpan apa adanya SEBELUM handler proyeksi dijalankan.
+type RawEvent struct {
+	ent.Schema
+}
+
+// Fields dari RawEvent.
+func (RawEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Posisi event di chain
+		field.Uint64("block_height"),
+		field.String("block_id"),
+		field.Time("block_time").
+			Optional(),
+		field.String("tx_id"),
+		field.Int("tx_index"),
+		field.Int("event_index"),
+
+		// Tipe event lengkap (misal: "A.1bb6b1e0a5170088.NFTMoment.Minted")
+		field.String("type"),
+
+		// Payload event dalam format JSON-CDC
+		field.JSON("payload", json.RawMessage{}),
+
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges dari RawEvent.
+func (RawEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes dari RawEvent.
+func (RawEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Satu event unik berdasarkan transaksi + urutannya di transaksi
+		index.Fields("tx_id", "event_index").
+			Unique(),
+		index.Fields("block_height"),
+		index.Fields("type"),
+	}
+}
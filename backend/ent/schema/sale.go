@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Sale menyimpan satu penjualan di marketplace (ListingCompleted dengan purchased = true).
+type Sale struct {
+	ent.Schema
+}
+
+// Fields dari Sale.
+func (Sale) Fields() []ent.Field {
+	return []ent.Field{
+		// Harga jual & tipe vault pembayaran (sama seperti di Listing)
+		field.Float("price"),
+		field.String("payment_vault_type"),
+
+		// NFT yang terjual
+		field.Enum("nft_type").
+			Values("moment", "accessory"),
+		field.Uint64("nft_id"),
+
+		// Posisi di chain
+		field.String("tx_id"),
+		field.Uint64("block_height"),
+		field.Time("timestamp").
+			Default(time.Now),
+	}
+}
+
+// Edges (relasi) dari Sale.
+func (Sale) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("seller", User.Type).
+			Ref("sales").
+			Unique().
+			Required(),
+
+		// Pembeli diisi dari event Deposited di transaksi yang sama
+		edge.From("buyer", User.Type).
+			Ref("purchases").
+			Unique(),
+
+		// Listing yang terjual (satu listing hanya bisa terjual sekali)
+		edge.From("listing", Listing.Type).
+			Ref("sale").
+			Unique(),
+	}
+}
+
+// Indexes dari Sale.
+func (Sale) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tx_id"),
+		index.Fields("timestamp"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Transfer menyimpan satu perpindahan kepemilikan NFT (riwayat/provenance),
+// dibangun dari pasangan event NonFungibleToken.Withdrawn & Deposited.
+type Transfer struct {
+	ent.Schema
+}
+
+// Fields dari Transfer.
+func (Transfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("nft_type").
+			Values("moment", "accessory"),
+		// ID NFT on-chain
+		field.Uint64("nft_id"),
+
+		// Alamat pengirim; kosong untuk mint
+		field.String("from").
+			Optional().
+			Nillable(),
+		// Alamat penerima; kosong jika NFT di-withdraw tanpa di-deposit (misal burn)
+		field.String("to").
+			Optional().
+			Nillable(),
+
+		// Posisi di chain
+		field.String("tx_id"),
+		field.Int("event_index"),
+		field.Uint64("block_height"),
+		field.Time("timestamp").
+			Default(time.Now),
+	}
+}
+
+// Edges dari Transfer.
+func (Transfer) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes dari Transfer.
+func (Transfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("nft_type", "nft_id"),
+		index.Fields("tx_id"),
+		// Satu event hanya menghasilkan satu transfer
+		index.Fields("tx_id", "event_index").
+			Unique(),
+	}
+}
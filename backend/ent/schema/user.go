@@ -29,6 +29,10 @@ func (User) Fields() []ent.Field {
 		field.JSON("highlighted_eventPass_ids", []uint64{}).Optional(),
 		field.Uint64("highlighted_moment_id").Optional(),
 		field.JSON("socials", map[string]string{}).Optional(),
+		// Diisi oleh event UserVerified (tanpa omitempty: false juga ditampilkan)
+		field.Bool("is_verified").
+			Default(false).
+			StructTag(`json:"isVerified"`),
 	}
 }
 
@@ -41,5 +45,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("accessories", NFTAccessory.Type),
 		edge.To("attendances", Attendance.Type),
 		edge.To("listings", Listing.Type),
+		edge.To("sales", Sale.Type),
+		edge.To("purchases", Sale.Type),
 	}
 }
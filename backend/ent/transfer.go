@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/transfer"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Transfer is the model entity for the Transfer schema.
+type Transfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// NftType holds the value of the "nft_type" field.
+	NftType transfer.NftType `json:"nft_type,omitempty"`
+	// NftID holds the value of the "nft_id" field.
+	NftID uint64 `json:"nft_id,omitempty"`
+	// From holds the value of the "from" field.
+	From *string `json:"from,omitempty"`
+	// To holds the value of the "to" field.
+	To *string `json:"to,omitempty"`
+	// TxID holds the value of the "tx_id" field.
+	TxID string `json:"tx_id,omitempty"`
+	// EventIndex holds the value of the "event_index" field.
+	EventIndex int `json:"event_index,omitempty"`
+	// BlockHeight holds the value of the "block_height" field.
+	BlockHeight uint64 `json:"block_height,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp    time.Time `json:"timestamp,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Transfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID, transfer.FieldNftID, transfer.FieldEventIndex, transfer.FieldBlockHeight:
+			values[i] = new(sql.NullInt64)
+		case transfer.FieldNftType, transfer.FieldFrom, transfer.FieldTo, transfer.FieldTxID:
+			values[i] = new(sql.NullString)
+		case transfer.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Transfer fields.
+func (_m *Transfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case transfer.FieldNftType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nft_type", values[i])
+			} else if value.Valid {
+				_m.NftType = transfer.NftType(value.String)
+			}
+		case transfer.FieldNftID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nft_id", values[i])
+			} else if value.Valid {
+				_m.NftID = uint64(value.Int64)
+			}
+		case transfer.FieldFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from", values[i])
+			} else if value.Valid {
+				_m.From = new(string)
+				*_m.From = value.String
+			}
+		case transfer.FieldTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to", values[i])
+			} else if value.Valid {
+				_m.To = new(string)
+				*_m.To = value.String
+			}
+		case transfer.FieldTxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tx_id", values[i])
+			} else if value.Valid {
+				_m.TxID = value.String
+			}
+		case transfer.FieldEventIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field event_index", values[i])
+			} else if value.Valid {
+				_m.EventIndex = int(value.Int64)
+			}
+		case transfer.FieldBlockHeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_height", values[i])
+			} else if value.Valid {
+				_m.BlockHeight = uint64(value.Int64)
+			}
+		case transfer.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Transfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Transfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Transfer.
+// Note that you need to call Transfer.Unwrap() before calling this method if this Transfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Transfer) Update() *TransferUpdateOne {
+	return NewTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Transfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Transfer) Unwrap() *Transfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Transfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Transfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Transfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("nft_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NftType))
+	builder.WriteString(", ")
+	builder.WriteString("nft_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NftID))
+	builder.WriteString(", ")
+	if v := _m.From; v != nil {
+		builder.WriteString("from=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.To; v != nil {
+		builder.WriteString("to=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tx_id=")
+	builder.WriteString(_m.TxID)
+	builder.WriteString(", ")
+	builder.WriteString("event_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventIndex))
+	builder.WriteString(", ")
+	builder.WriteString("block_height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlockHeight))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Transfers is a parsable slice of Transfer.
+type Transfers []*Transfer
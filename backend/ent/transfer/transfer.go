@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the transfer type in the database.
+	Label = "transfer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNftType holds the string denoting the nft_type field in the database.
+	FieldNftType = "nft_type"
+	// FieldNftID holds the string denoting the nft_id field in the database.
+	FieldNftID = "nft_id"
+	// FieldFrom holds the string denoting the from field in the database.
+	FieldFrom = "from"
+	// FieldTo holds the string denoting the to field in the database.
+	FieldTo = "to"
+	// FieldTxID holds the string denoting the tx_id field in the database.
+	FieldTxID = "tx_id"
+	// FieldEventIndex holds the string denoting the event_index field in the database.
+	FieldEventIndex = "event_index"
+	// FieldBlockHeight holds the string denoting the block_height field in the database.
+	FieldBlockHeight = "block_height"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// Table holds the table name of the transfer in the database.
+	Table = "transfers"
+)
+
+// Columns holds all SQL columns for transfer fields.
+var Columns = []string{
+	FieldID,
+	FieldNftType,
+	FieldNftID,
+	FieldFrom,
+	FieldTo,
+	FieldTxID,
+	FieldEventIndex,
+	FieldBlockHeight,
+	FieldTimestamp,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+)
+
+// NftType defines the type for the "nft_type" enum field.
+type NftType string
+
+// NftType values.
+const (
+	NftTypeMoment    NftType = "moment"
+	NftTypeAccessory NftType = "accessory"
+)
+
+func (nt NftType) String() string {
+	return string(nt)
+}
+
+// NftTypeValidator is a validator for the "nft_type" field enum values. It is called by the builders before save.
+func NftTypeValidator(nt NftType) error {
+	switch nt {
+	case NftTypeMoment, NftTypeAccessory:
+		return nil
+	default:
+		return fmt.Errorf("transfer: invalid enum value for nft_type field: %q", nt)
+	}
+}
+
+// OrderOption defines the ordering options for the Transfer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNftType orders the results by the nft_type field.
+func ByNftType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNftType, opts...).ToFunc()
+}
+
+// ByNftID orders the results by the nft_id field.
+func ByNftID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNftID, opts...).ToFunc()
+}
+
+// ByFrom orders the results by the from field.
+func ByFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrom, opts...).ToFunc()
+}
+
+// ByTo orders the results by the to field.
+func ByTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTo, opts...).ToFunc()
+}
+
+// ByTxID orders the results by the tx_id field.
+func ByTxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxID, opts...).ToFunc()
+}
+
+// ByEventIndex orders the results by the event_index field.
+func ByEventIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventIndex, opts...).ToFunc()
+}
+
+// ByBlockHeight orders the results by the block_height field.
+func ByBlockHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHeight, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
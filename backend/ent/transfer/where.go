@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package transfer
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldID, id))
+}
+
+// NftID applies equality check predicate on the "nft_id" field. It's identical to NftIDEQ.
+func NftID(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldNftID, v))
+}
+
+// From applies equality check predicate on the "from" field. It's identical to FromEQ.
+func From(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFrom, v))
+}
+
+// To applies equality check predicate on the "to" field. It's identical to ToEQ.
+func To(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTo, v))
+}
+
+// TxID applies equality check predicate on the "tx_id" field. It's identical to TxIDEQ.
+func TxID(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTxID, v))
+}
+
+// EventIndex applies equality check predicate on the "event_index" field. It's identical to EventIndexEQ.
+func EventIndex(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// BlockHeight applies equality check predicate on the "block_height" field. It's identical to BlockHeightEQ.
+func BlockHeight(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// NftTypeEQ applies the EQ predicate on the "nft_type" field.
+func NftTypeEQ(v NftType) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldNftType, v))
+}
+
+// NftTypeNEQ applies the NEQ predicate on the "nft_type" field.
+func NftTypeNEQ(v NftType) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldNftType, v))
+}
+
+// NftTypeIn applies the In predicate on the "nft_type" field.
+func NftTypeIn(vs ...NftType) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldNftType, vs...))
+}
+
+// NftTypeNotIn applies the NotIn predicate on the "nft_type" field.
+func NftTypeNotIn(vs ...NftType) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldNftType, vs...))
+}
+
+// NftIDEQ applies the EQ predicate on the "nft_id" field.
+func NftIDEQ(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldNftID, v))
+}
+
+// NftIDNEQ applies the NEQ predicate on the "nft_id" field.
+func NftIDNEQ(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldNftID, v))
+}
+
+// NftIDIn applies the In predicate on the "nft_id" field.
+func NftIDIn(vs ...uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldNftID, vs...))
+}
+
+// NftIDNotIn applies the NotIn predicate on the "nft_id" field.
+func NftIDNotIn(vs ...uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldNftID, vs...))
+}
+
+// NftIDGT applies the GT predicate on the "nft_id" field.
+func NftIDGT(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldNftID, v))
+}
+
+// NftIDGTE applies the GTE predicate on the "nft_id" field.
+func NftIDGTE(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldNftID, v))
+}
+
+// NftIDLT applies the LT predicate on the "nft_id" field.
+func NftIDLT(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldNftID, v))
+}
+
+// NftIDLTE applies the LTE predicate on the "nft_id" field.
+func NftIDLTE(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldNftID, v))
+}
+
+// FromEQ applies the EQ predicate on the "from" field.
+func FromEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldFrom, v))
+}
+
+// FromNEQ applies the NEQ predicate on the "from" field.
+func FromNEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldFrom, v))
+}
+
+// FromIn applies the In predicate on the "from" field.
+func FromIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldFrom, vs...))
+}
+
+// FromNotIn applies the NotIn predicate on the "from" field.
+func FromNotIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldFrom, vs...))
+}
+
+// FromGT applies the GT predicate on the "from" field.
+func FromGT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldFrom, v))
+}
+
+// FromGTE applies the GTE predicate on the "from" field.
+func FromGTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldFrom, v))
+}
+
+// FromLT applies the LT predicate on the "from" field.
+func FromLT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldFrom, v))
+}
+
+// FromLTE applies the LTE predicate on the "from" field.
+func FromLTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldFrom, v))
+}
+
+// FromContains applies the Contains predicate on the "from" field.
+func FromContains(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContains(FieldFrom, v))
+}
+
+// FromHasPrefix applies the HasPrefix predicate on the "from" field.
+func FromHasPrefix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasPrefix(FieldFrom, v))
+}
+
+// FromHasSuffix applies the HasSuffix predicate on the "from" field.
+func FromHasSuffix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasSuffix(FieldFrom, v))
+}
+
+// FromIsNil applies the IsNil predicate on the "from" field.
+func FromIsNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldIsNull(FieldFrom))
+}
+
+// FromNotNil applies the NotNil predicate on the "from" field.
+func FromNotNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotNull(FieldFrom))
+}
+
+// FromEqualFold applies the EqualFold predicate on the "from" field.
+func FromEqualFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEqualFold(FieldFrom, v))
+}
+
+// FromContainsFold applies the ContainsFold predicate on the "from" field.
+func FromContainsFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContainsFold(FieldFrom, v))
+}
+
+// ToEQ applies the EQ predicate on the "to" field.
+func ToEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTo, v))
+}
+
+// ToNEQ applies the NEQ predicate on the "to" field.
+func ToNEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldTo, v))
+}
+
+// ToIn applies the In predicate on the "to" field.
+func ToIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldTo, vs...))
+}
+
+// ToNotIn applies the NotIn predicate on the "to" field.
+func ToNotIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldTo, vs...))
+}
+
+// ToGT applies the GT predicate on the "to" field.
+func ToGT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldTo, v))
+}
+
+// ToGTE applies the GTE predicate on the "to" field.
+func ToGTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldTo, v))
+}
+
+// ToLT applies the LT predicate on the "to" field.
+func ToLT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldTo, v))
+}
+
+// ToLTE applies the LTE predicate on the "to" field.
+func ToLTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldTo, v))
+}
+
+// ToContains applies the Contains predicate on the "to" field.
+func ToContains(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContains(FieldTo, v))
+}
+
+// ToHasPrefix applies the HasPrefix predicate on the "to" field.
+func ToHasPrefix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasPrefix(FieldTo, v))
+}
+
+// ToHasSuffix applies the HasSuffix predicate on the "to" field.
+func ToHasSuffix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasSuffix(FieldTo, v))
+}
+
+// ToIsNil applies the IsNil predicate on the "to" field.
+func ToIsNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldIsNull(FieldTo))
+}
+
+// ToNotNil applies the NotNil predicate on the "to" field.
+func ToNotNil() predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotNull(FieldTo))
+}
+
+// ToEqualFold applies the EqualFold predicate on the "to" field.
+func ToEqualFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEqualFold(FieldTo, v))
+}
+
+// ToContainsFold applies the ContainsFold predicate on the "to" field.
+func ToContainsFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContainsFold(FieldTo, v))
+}
+
+// TxIDEQ applies the EQ predicate on the "tx_id" field.
+func TxIDEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTxID, v))
+}
+
+// TxIDNEQ applies the NEQ predicate on the "tx_id" field.
+func TxIDNEQ(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldTxID, v))
+}
+
+// TxIDIn applies the In predicate on the "tx_id" field.
+func TxIDIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldTxID, vs...))
+}
+
+// TxIDNotIn applies the NotIn predicate on the "tx_id" field.
+func TxIDNotIn(vs ...string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldTxID, vs...))
+}
+
+// TxIDGT applies the GT predicate on the "tx_id" field.
+func TxIDGT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldTxID, v))
+}
+
+// TxIDGTE applies the GTE predicate on the "tx_id" field.
+func TxIDGTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldTxID, v))
+}
+
+// TxIDLT applies the LT predicate on the "tx_id" field.
+func TxIDLT(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldTxID, v))
+}
+
+// TxIDLTE applies the LTE predicate on the "tx_id" field.
+func TxIDLTE(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldTxID, v))
+}
+
+// TxIDContains applies the Contains predicate on the "tx_id" field.
+func TxIDContains(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContains(FieldTxID, v))
+}
+
+// TxIDHasPrefix applies the HasPrefix predicate on the "tx_id" field.
+func TxIDHasPrefix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasPrefix(FieldTxID, v))
+}
+
+// TxIDHasSuffix applies the HasSuffix predicate on the "tx_id" field.
+func TxIDHasSuffix(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldHasSuffix(FieldTxID, v))
+}
+
+// TxIDEqualFold applies the EqualFold predicate on the "tx_id" field.
+func TxIDEqualFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEqualFold(FieldTxID, v))
+}
+
+// TxIDContainsFold applies the ContainsFold predicate on the "tx_id" field.
+func TxIDContainsFold(v string) predicate.Transfer {
+	return predicate.Transfer(sql.FieldContainsFold(FieldTxID, v))
+}
+
+// EventIndexEQ applies the EQ predicate on the "event_index" field.
+func EventIndexEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldEventIndex, v))
+}
+
+// EventIndexNEQ applies the NEQ predicate on the "event_index" field.
+func EventIndexNEQ(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldEventIndex, v))
+}
+
+// EventIndexIn applies the In predicate on the "event_index" field.
+func EventIndexIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldEventIndex, vs...))
+}
+
+// EventIndexNotIn applies the NotIn predicate on the "event_index" field.
+func EventIndexNotIn(vs ...int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldEventIndex, vs...))
+}
+
+// EventIndexGT applies the GT predicate on the "event_index" field.
+func EventIndexGT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldEventIndex, v))
+}
+
+// EventIndexGTE applies the GTE predicate on the "event_index" field.
+func EventIndexGTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldEventIndex, v))
+}
+
+// EventIndexLT applies the LT predicate on the "event_index" field.
+func EventIndexLT(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldEventIndex, v))
+}
+
+// EventIndexLTE applies the LTE predicate on the "event_index" field.
+func EventIndexLTE(v int) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldEventIndex, v))
+}
+
+// BlockHeightEQ applies the EQ predicate on the "block_height" field.
+func BlockHeightEQ(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightNEQ applies the NEQ predicate on the "block_height" field.
+func BlockHeightNEQ(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldBlockHeight, v))
+}
+
+// BlockHeightIn applies the In predicate on the "block_height" field.
+func BlockHeightIn(vs ...uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightNotIn applies the NotIn predicate on the "block_height" field.
+func BlockHeightNotIn(vs ...uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldBlockHeight, vs...))
+}
+
+// BlockHeightGT applies the GT predicate on the "block_height" field.
+func BlockHeightGT(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldBlockHeight, v))
+}
+
+// BlockHeightGTE applies the GTE predicate on the "block_height" field.
+func BlockHeightGTE(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldBlockHeight, v))
+}
+
+// BlockHeightLT applies the LT predicate on the "block_height" field.
+func BlockHeightLT(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldBlockHeight, v))
+}
+
+// BlockHeightLTE applies the LTE predicate on the "block_height" field.
+func BlockHeightLTE(v uint64) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldBlockHeight, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.Transfer {
+	return predicate.Transfer(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Transfer) predicate.Transfer {
+	return predicate.Transfer(sql.NotPredicates(p))
+}
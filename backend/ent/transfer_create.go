@@ -0,0 +1,959 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferCreate is the builder for creating a Transfer entity.
+type TransferCreate struct {
+	config
+	mutation *TransferMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetNftType sets the "nft_type" field.
+func (_c *TransferCreate) SetNftType(v transfer.NftType) *TransferCreate {
+	_c.mutation.SetNftType(v)
+	return _c
+}
+
+// SetNftID sets the "nft_id" field.
+func (_c *TransferCreate) SetNftID(v uint64) *TransferCreate {
+	_c.mutation.SetNftID(v)
+	return _c
+}
+
+// SetFrom sets the "from" field.
+func (_c *TransferCreate) SetFrom(v string) *TransferCreate {
+	_c.mutation.SetFrom(v)
+	return _c
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableFrom(v *string) *TransferCreate {
+	if v != nil {
+		_c.SetFrom(*v)
+	}
+	return _c
+}
+
+// SetTo sets the "to" field.
+func (_c *TransferCreate) SetTo(v string) *TransferCreate {
+	_c.mutation.SetTo(v)
+	return _c
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableTo(v *string) *TransferCreate {
+	if v != nil {
+		_c.SetTo(*v)
+	}
+	return _c
+}
+
+// SetTxID sets the "tx_id" field.
+func (_c *TransferCreate) SetTxID(v string) *TransferCreate {
+	_c.mutation.SetTxID(v)
+	return _c
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_c *TransferCreate) SetEventIndex(v int) *TransferCreate {
+	_c.mutation.SetEventIndex(v)
+	return _c
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_c *TransferCreate) SetBlockHeight(v uint64) *TransferCreate {
+	_c.mutation.SetBlockHeight(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *TransferCreate) SetTimestamp(v time.Time) *TransferCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_c *TransferCreate) SetNillableTimestamp(v *time.Time) *TransferCreate {
+	if v != nil {
+		_c.SetTimestamp(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_c *TransferCreate) Mutation() *TransferMutation {
+	return _c.mutation
+}
+
+// Save creates the Transfer in the database.
+func (_c *TransferCreate) Save(ctx context.Context) (*Transfer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TransferCreate) SaveX(ctx context.Context) *Transfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TransferCreate) defaults() {
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		v := transfer.DefaultTimestamp()
+		_c.mutation.SetTimestamp(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TransferCreate) check() error {
+	if _, ok := _c.mutation.NftType(); !ok {
+		return &ValidationError{Name: "nft_type", err: errors.New(`ent: missing required field "Transfer.nft_type"`)}
+	}
+	if v, ok := _c.mutation.NftType(); ok {
+		if err := transfer.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Transfer.nft_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NftID(); !ok {
+		return &ValidationError{Name: "nft_id", err: errors.New(`ent: missing required field "Transfer.nft_id"`)}
+	}
+	if _, ok := _c.mutation.TxID(); !ok {
+		return &ValidationError{Name: "tx_id", err: errors.New(`ent: missing required field "Transfer.tx_id"`)}
+	}
+	if _, ok := _c.mutation.EventIndex(); !ok {
+		return &ValidationError{Name: "event_index", err: errors.New(`ent: missing required field "Transfer.event_index"`)}
+	}
+	if _, ok := _c.mutation.BlockHeight(); !ok {
+		return &ValidationError{Name: "block_height", err: errors.New(`ent: missing required field "Transfer.block_height"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Transfer.timestamp"`)}
+	}
+	return nil
+}
+
+func (_c *TransferCreate) sqlSave(ctx context.Context) (*Transfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Transfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(transfer.Table, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.NftType(); ok {
+		_spec.SetField(transfer.FieldNftType, field.TypeEnum, value)
+		_node.NftType = value
+	}
+	if value, ok := _c.mutation.NftID(); ok {
+		_spec.SetField(transfer.FieldNftID, field.TypeUint64, value)
+		_node.NftID = value
+	}
+	if value, ok := _c.mutation.From(); ok {
+		_spec.SetField(transfer.FieldFrom, field.TypeString, value)
+		_node.From = &value
+	}
+	if value, ok := _c.mutation.To(); ok {
+		_spec.SetField(transfer.FieldTo, field.TypeString, value)
+		_node.To = &value
+	}
+	if value, ok := _c.mutation.TxID(); ok {
+		_spec.SetField(transfer.FieldTxID, field.TypeString, value)
+		_node.TxID = value
+	}
+	if value, ok := _c.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+		_node.EventIndex = value
+	}
+	if value, ok := _c.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeUint64, value)
+		_node.BlockHeight = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(transfer.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transfer.Create().
+//		SetNftType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransferUpsert) {
+//			SetNftType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransferCreate) OnConflict(opts ...sql.ConflictOption) *TransferUpsertOne {
+	_c.conflict = opts
+	return &TransferUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransferCreate) OnConflictColumns(columns ...string) *TransferUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransferUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TransferUpsertOne is the builder for "upsert"-ing
+	//  one Transfer node.
+	TransferUpsertOne struct {
+		create *TransferCreate
+	}
+
+	// TransferUpsert is the "OnConflict" setter.
+	TransferUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNftType sets the "nft_type" field.
+func (u *TransferUpsert) SetNftType(v transfer.NftType) *TransferUpsert {
+	u.Set(transfer.FieldNftType, v)
+	return u
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateNftType() *TransferUpsert {
+	u.SetExcluded(transfer.FieldNftType)
+	return u
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *TransferUpsert) SetNftID(v uint64) *TransferUpsert {
+	u.Set(transfer.FieldNftID, v)
+	return u
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateNftID() *TransferUpsert {
+	u.SetExcluded(transfer.FieldNftID)
+	return u
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *TransferUpsert) AddNftID(v uint64) *TransferUpsert {
+	u.Add(transfer.FieldNftID, v)
+	return u
+}
+
+// SetFrom sets the "from" field.
+func (u *TransferUpsert) SetFrom(v string) *TransferUpsert {
+	u.Set(transfer.FieldFrom, v)
+	return u
+}
+
+// UpdateFrom sets the "from" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateFrom() *TransferUpsert {
+	u.SetExcluded(transfer.FieldFrom)
+	return u
+}
+
+// ClearFrom clears the value of the "from" field.
+func (u *TransferUpsert) ClearFrom() *TransferUpsert {
+	u.SetNull(transfer.FieldFrom)
+	return u
+}
+
+// SetTo sets the "to" field.
+func (u *TransferUpsert) SetTo(v string) *TransferUpsert {
+	u.Set(transfer.FieldTo, v)
+	return u
+}
+
+// UpdateTo sets the "to" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateTo() *TransferUpsert {
+	u.SetExcluded(transfer.FieldTo)
+	return u
+}
+
+// ClearTo clears the value of the "to" field.
+func (u *TransferUpsert) ClearTo() *TransferUpsert {
+	u.SetNull(transfer.FieldTo)
+	return u
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *TransferUpsert) SetTxID(v string) *TransferUpsert {
+	u.Set(transfer.FieldTxID, v)
+	return u
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateTxID() *TransferUpsert {
+	u.SetExcluded(transfer.FieldTxID)
+	return u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsert) SetEventIndex(v int) *TransferUpsert {
+	u.Set(transfer.FieldEventIndex, v)
+	return u
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateEventIndex() *TransferUpsert {
+	u.SetExcluded(transfer.FieldEventIndex)
+	return u
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsert) AddEventIndex(v int) *TransferUpsert {
+	u.Add(transfer.FieldEventIndex, v)
+	return u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsert) SetBlockHeight(v uint64) *TransferUpsert {
+	u.Set(transfer.FieldBlockHeight, v)
+	return u
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateBlockHeight() *TransferUpsert {
+	u.SetExcluded(transfer.FieldBlockHeight)
+	return u
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsert) AddBlockHeight(v uint64) *TransferUpsert {
+	u.Add(transfer.FieldBlockHeight, v)
+	return u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *TransferUpsert) SetTimestamp(v time.Time) *TransferUpsert {
+	u.Set(transfer.FieldTimestamp, v)
+	return u
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateTimestamp() *TransferUpsert {
+	u.SetExcluded(transfer.FieldTimestamp)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TransferUpsertOne) UpdateNewValues() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransferUpsertOne) Ignore() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransferUpsertOne) DoNothing() *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransferCreate.OnConflict
+// documentation for more info.
+func (u *TransferUpsertOne) Update(set func(*TransferUpsert)) *TransferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *TransferUpsertOne) SetNftType(v transfer.NftType) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateNftType() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *TransferUpsertOne) SetNftID(v uint64) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *TransferUpsertOne) AddNftID(v uint64) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateNftID() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetFrom sets the "from" field.
+func (u *TransferUpsertOne) SetFrom(v string) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetFrom(v)
+	})
+}
+
+// UpdateFrom sets the "from" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateFrom() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateFrom()
+	})
+}
+
+// ClearFrom clears the value of the "from" field.
+func (u *TransferUpsertOne) ClearFrom() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearFrom()
+	})
+}
+
+// SetTo sets the "to" field.
+func (u *TransferUpsertOne) SetTo(v string) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTo(v)
+	})
+}
+
+// UpdateTo sets the "to" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateTo() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTo()
+	})
+}
+
+// ClearTo clears the value of the "to" field.
+func (u *TransferUpsertOne) ClearTo() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearTo()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *TransferUpsertOne) SetTxID(v string) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateTxID() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsertOne) SetEventIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsertOne) AddEventIndex(v int) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateEventIndex() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsertOne) SetBlockHeight(v uint64) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsertOne) AddBlockHeight(v uint64) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateBlockHeight() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *TransferUpsertOne) SetTimestamp(v time.Time) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateTimestamp() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *TransferUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransferCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransferUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransferUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransferUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransferCreateBulk is the builder for creating many Transfer entities in bulk.
+type TransferCreateBulk struct {
+	config
+	err      error
+	builders []*TransferCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Transfer entities in the database.
+func (_c *TransferCreateBulk) Save(ctx context.Context) ([]*Transfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Transfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TransferCreateBulk) SaveX(ctx context.Context) []*Transfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Transfer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransferUpsert) {
+//			SetNftType(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TransferCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransferUpsertBulk {
+	_c.conflict = opts
+	return &TransferUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TransferCreateBulk) OnConflictColumns(columns ...string) *TransferUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TransferUpsertBulk{
+		create: _c,
+	}
+}
+
+// TransferUpsertBulk is the builder for "upsert"-ing
+// a bulk of Transfer nodes.
+type TransferUpsertBulk struct {
+	create *TransferCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TransferUpsertBulk) UpdateNewValues() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Transfer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransferUpsertBulk) Ignore() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransferUpsertBulk) DoNothing() *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransferCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransferUpsertBulk) Update(set func(*TransferUpsert)) *TransferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNftType sets the "nft_type" field.
+func (u *TransferUpsertBulk) SetNftType(v transfer.NftType) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetNftType(v)
+	})
+}
+
+// UpdateNftType sets the "nft_type" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateNftType() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateNftType()
+	})
+}
+
+// SetNftID sets the "nft_id" field.
+func (u *TransferUpsertBulk) SetNftID(v uint64) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetNftID(v)
+	})
+}
+
+// AddNftID adds v to the "nft_id" field.
+func (u *TransferUpsertBulk) AddNftID(v uint64) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddNftID(v)
+	})
+}
+
+// UpdateNftID sets the "nft_id" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateNftID() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateNftID()
+	})
+}
+
+// SetFrom sets the "from" field.
+func (u *TransferUpsertBulk) SetFrom(v string) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetFrom(v)
+	})
+}
+
+// UpdateFrom sets the "from" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateFrom() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateFrom()
+	})
+}
+
+// ClearFrom clears the value of the "from" field.
+func (u *TransferUpsertBulk) ClearFrom() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearFrom()
+	})
+}
+
+// SetTo sets the "to" field.
+func (u *TransferUpsertBulk) SetTo(v string) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTo(v)
+	})
+}
+
+// UpdateTo sets the "to" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateTo() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTo()
+	})
+}
+
+// ClearTo clears the value of the "to" field.
+func (u *TransferUpsertBulk) ClearTo() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearTo()
+	})
+}
+
+// SetTxID sets the "tx_id" field.
+func (u *TransferUpsertBulk) SetTxID(v string) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTxID(v)
+	})
+}
+
+// UpdateTxID sets the "tx_id" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateTxID() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTxID()
+	})
+}
+
+// SetEventIndex sets the "event_index" field.
+func (u *TransferUpsertBulk) SetEventIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetEventIndex(v)
+	})
+}
+
+// AddEventIndex adds v to the "event_index" field.
+func (u *TransferUpsertBulk) AddEventIndex(v int) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddEventIndex(v)
+	})
+}
+
+// UpdateEventIndex sets the "event_index" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateEventIndex() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateEventIndex()
+	})
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (u *TransferUpsertBulk) SetBlockHeight(v uint64) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBlockHeight(v)
+	})
+}
+
+// AddBlockHeight adds v to the "block_height" field.
+func (u *TransferUpsertBulk) AddBlockHeight(v uint64) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.AddBlockHeight(v)
+	})
+}
+
+// UpdateBlockHeight sets the "block_height" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateBlockHeight() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBlockHeight()
+	})
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (u *TransferUpsertBulk) SetTimestamp(v time.Time) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetTimestamp(v)
+	})
+}
+
+// UpdateTimestamp sets the "timestamp" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateTimestamp() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *TransferUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TransferCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TransferCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransferUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
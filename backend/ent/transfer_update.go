@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/transfer"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TransferUpdate is the builder for updating Transfer entities.
+type TransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TransferMutation
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (_u *TransferUpdate) Where(ps ...predicate.Transfer) *TransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *TransferUpdate) SetNftType(v transfer.NftType) *TransferUpdate {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableNftType(v *transfer.NftType) *TransferUpdate {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
+// SetNftID sets the "nft_id" field.
+func (_u *TransferUpdate) SetNftID(v uint64) *TransferUpdate {
+	_u.mutation.ResetNftID()
+	_u.mutation.SetNftID(v)
+	return _u
+}
+
+// SetNillableNftID sets the "nft_id" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableNftID(v *uint64) *TransferUpdate {
+	if v != nil {
+		_u.SetNftID(*v)
+	}
+	return _u
+}
+
+// AddNftID adds value to the "nft_id" field.
+func (_u *TransferUpdate) AddNftID(v int64) *TransferUpdate {
+	_u.mutation.AddNftID(v)
+	return _u
+}
+
+// SetFrom sets the "from" field.
+func (_u *TransferUpdate) SetFrom(v string) *TransferUpdate {
+	_u.mutation.SetFrom(v)
+	return _u
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableFrom(v *string) *TransferUpdate {
+	if v != nil {
+		_u.SetFrom(*v)
+	}
+	return _u
+}
+
+// ClearFrom clears the value of the "from" field.
+func (_u *TransferUpdate) ClearFrom() *TransferUpdate {
+	_u.mutation.ClearFrom()
+	return _u
+}
+
+// SetTo sets the "to" field.
+func (_u *TransferUpdate) SetTo(v string) *TransferUpdate {
+	_u.mutation.SetTo(v)
+	return _u
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableTo(v *string) *TransferUpdate {
+	if v != nil {
+		_u.SetTo(*v)
+	}
+	return _u
+}
+
+// ClearTo clears the value of the "to" field.
+func (_u *TransferUpdate) ClearTo() *TransferUpdate {
+	_u.mutation.ClearTo()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *TransferUpdate) SetTxID(v string) *TransferUpdate {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableTxID(v *string) *TransferUpdate {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *TransferUpdate) SetEventIndex(v int) *TransferUpdate {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableEventIndex(v *int) *TransferUpdate {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *TransferUpdate) AddEventIndex(v int) *TransferUpdate {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *TransferUpdate) SetBlockHeight(v uint64) *TransferUpdate {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableBlockHeight(v *uint64) *TransferUpdate {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *TransferUpdate) AddBlockHeight(v int64) *TransferUpdate {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *TransferUpdate) SetTimestamp(v time.Time) *TransferUpdate {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *TransferUpdate) SetNillableTimestamp(v *time.Time) *TransferUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_u *TransferUpdate) Mutation() *TransferMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferUpdate) check() error {
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := transfer.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Transfer.nft_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(transfer.FieldNftType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftID(); ok {
+		_spec.SetField(transfer.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedNftID(); ok {
+		_spec.AddField(transfer.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.From(); ok {
+		_spec.SetField(transfer.FieldFrom, field.TypeString, value)
+	}
+	if _u.mutation.FromCleared() {
+		_spec.ClearField(transfer.FieldFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.To(); ok {
+		_spec.SetField(transfer.FieldTo, field.TypeString, value)
+	}
+	if _u.mutation.ToCleared() {
+		_spec.ClearField(transfer.FieldTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(transfer.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(transfer.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(transfer.FieldTimestamp, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TransferUpdateOne is the builder for updating a single Transfer entity.
+type TransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TransferMutation
+}
+
+// SetNftType sets the "nft_type" field.
+func (_u *TransferUpdateOne) SetNftType(v transfer.NftType) *TransferUpdateOne {
+	_u.mutation.SetNftType(v)
+	return _u
+}
+
+// SetNillableNftType sets the "nft_type" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableNftType(v *transfer.NftType) *TransferUpdateOne {
+	if v != nil {
+		_u.SetNftType(*v)
+	}
+	return _u
+}
+
+// SetNftID sets the "nft_id" field.
+func (_u *TransferUpdateOne) SetNftID(v uint64) *TransferUpdateOne {
+	_u.mutation.ResetNftID()
+	_u.mutation.SetNftID(v)
+	return _u
+}
+
+// SetNillableNftID sets the "nft_id" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableNftID(v *uint64) *TransferUpdateOne {
+	if v != nil {
+		_u.SetNftID(*v)
+	}
+	return _u
+}
+
+// AddNftID adds value to the "nft_id" field.
+func (_u *TransferUpdateOne) AddNftID(v int64) *TransferUpdateOne {
+	_u.mutation.AddNftID(v)
+	return _u
+}
+
+// SetFrom sets the "from" field.
+func (_u *TransferUpdateOne) SetFrom(v string) *TransferUpdateOne {
+	_u.mutation.SetFrom(v)
+	return _u
+}
+
+// SetNillableFrom sets the "from" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableFrom(v *string) *TransferUpdateOne {
+	if v != nil {
+		_u.SetFrom(*v)
+	}
+	return _u
+}
+
+// ClearFrom clears the value of the "from" field.
+func (_u *TransferUpdateOne) ClearFrom() *TransferUpdateOne {
+	_u.mutation.ClearFrom()
+	return _u
+}
+
+// SetTo sets the "to" field.
+func (_u *TransferUpdateOne) SetTo(v string) *TransferUpdateOne {
+	_u.mutation.SetTo(v)
+	return _u
+}
+
+// SetNillableTo sets the "to" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableTo(v *string) *TransferUpdateOne {
+	if v != nil {
+		_u.SetTo(*v)
+	}
+	return _u
+}
+
+// ClearTo clears the value of the "to" field.
+func (_u *TransferUpdateOne) ClearTo() *TransferUpdateOne {
+	_u.mutation.ClearTo()
+	return _u
+}
+
+// SetTxID sets the "tx_id" field.
+func (_u *TransferUpdateOne) SetTxID(v string) *TransferUpdateOne {
+	_u.mutation.SetTxID(v)
+	return _u
+}
+
+// SetNillableTxID sets the "tx_id" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableTxID(v *string) *TransferUpdateOne {
+	if v != nil {
+		_u.SetTxID(*v)
+	}
+	return _u
+}
+
+// SetEventIndex sets the "event_index" field.
+func (_u *TransferUpdateOne) SetEventIndex(v int) *TransferUpdateOne {
+	_u.mutation.ResetEventIndex()
+	_u.mutation.SetEventIndex(v)
+	return _u
+}
+
+// SetNillableEventIndex sets the "event_index" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableEventIndex(v *int) *TransferUpdateOne {
+	if v != nil {
+		_u.SetEventIndex(*v)
+	}
+	return _u
+}
+
+// AddEventIndex adds value to the "event_index" field.
+func (_u *TransferUpdateOne) AddEventIndex(v int) *TransferUpdateOne {
+	_u.mutation.AddEventIndex(v)
+	return _u
+}
+
+// SetBlockHeight sets the "block_height" field.
+func (_u *TransferUpdateOne) SetBlockHeight(v uint64) *TransferUpdateOne {
+	_u.mutation.ResetBlockHeight()
+	_u.mutation.SetBlockHeight(v)
+	return _u
+}
+
+// SetNillableBlockHeight sets the "block_height" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableBlockHeight(v *uint64) *TransferUpdateOne {
+	if v != nil {
+		_u.SetBlockHeight(*v)
+	}
+	return _u
+}
+
+// AddBlockHeight adds value to the "block_height" field.
+func (_u *TransferUpdateOne) AddBlockHeight(v int64) *TransferUpdateOne {
+	_u.mutation.AddBlockHeight(v)
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *TransferUpdateOne) SetTimestamp(v time.Time) *TransferUpdateOne {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *TransferUpdateOne) SetNillableTimestamp(v *time.Time) *TransferUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TransferMutation object of the builder.
+func (_u *TransferUpdateOne) Mutation() *TransferMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TransferUpdate builder.
+func (_u *TransferUpdateOne) Where(ps ...predicate.Transfer) *TransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TransferUpdateOne) Select(field string, fields ...string) *TransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Transfer entity.
+func (_u *TransferUpdateOne) Save(ctx context.Context) (*Transfer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TransferUpdateOne) SaveX(ctx context.Context) *Transfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TransferUpdateOne) check() error {
+	if v, ok := _u.mutation.NftType(); ok {
+		if err := transfer.NftTypeValidator(v); err != nil {
+			return &ValidationError{Name: "nft_type", err: fmt.Errorf(`ent: validator failed for field "Transfer.nft_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transfer.Table, transfer.Columns, sqlgraph.NewFieldSpec(transfer.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Transfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transfer.FieldID)
+		for _, f := range fields {
+			if !transfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != transfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NftType(); ok {
+		_spec.SetField(transfer.FieldNftType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.NftID(); ok {
+		_spec.SetField(transfer.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedNftID(); ok {
+		_spec.AddField(transfer.FieldNftID, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.From(); ok {
+		_spec.SetField(transfer.FieldFrom, field.TypeString, value)
+	}
+	if _u.mutation.FromCleared() {
+		_spec.ClearField(transfer.FieldFrom, field.TypeString)
+	}
+	if value, ok := _u.mutation.To(); ok {
+		_spec.SetField(transfer.FieldTo, field.TypeString, value)
+	}
+	if _u.mutation.ToCleared() {
+		_spec.ClearField(transfer.FieldTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.TxID(); ok {
+		_spec.SetField(transfer.FieldTxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventIndex(); ok {
+		_spec.SetField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEventIndex(); ok {
+		_spec.AddField(transfer.FieldEventIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BlockHeight(); ok {
+		_spec.SetField(transfer.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedBlockHeight(); ok {
+		_spec.AddField(transfer.FieldBlockHeight, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(transfer.FieldTimestamp, field.TypeTime, value)
+	}
+	_node = &Transfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,16 +16,32 @@ type Tx struct {
 	config
 	// Attendance is the client for interacting with the Attendance builders.
 	Attendance *AttendanceClient
+	// Checkpoint is the client for interacting with the Checkpoint builders.
+	Checkpoint *CheckpointClient
+	// DeadLetter is the client for interacting with the DeadLetter builders.
+	DeadLetter *DeadLetterClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// EventCount is the client for interacting with the EventCount builders.
+	EventCount *EventCountClient
 	// EventPass is the client for interacting with the EventPass builders.
 	EventPass *EventPassClient
+	// GachaReceipt is the client for interacting with the GachaReceipt builders.
+	GachaReceipt *GachaReceiptClient
 	// Listing is the client for interacting with the Listing builders.
 	Listing *ListingClient
 	// NFTAccessory is the client for interacting with the NFTAccessory builders.
 	NFTAccessory *NFTAccessoryClient
 	// NFTMoment is the client for interacting with the NFTMoment builders.
 	NFTMoment *NFTMomentClient
+	// PendingEvent is the client for interacting with the PendingEvent builders.
+	PendingEvent *PendingEventClient
+	// RawEvent is the client for interacting with the RawEvent builders.
+	RawEvent *RawEventClient
+	// Sale is the client for interacting with the Sale builders.
+	Sale *SaleClient
+	// Transfer is the client for interacting with the Transfer builders.
+	Transfer *TransferClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -158,11 +176,19 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Attendance = NewAttendanceClient(tx.config)
+	tx.Checkpoint = NewCheckpointClient(tx.config)
+	tx.DeadLetter = NewDeadLetterClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
+	tx.EventCount = NewEventCountClient(tx.config)
 	tx.EventPass = NewEventPassClient(tx.config)
+	tx.GachaReceipt = NewGachaReceiptClient(tx.config)
 	tx.Listing = NewListingClient(tx.config)
 	tx.NFTAccessory = NewNFTAccessoryClient(tx.config)
 	tx.NFTMoment = NewNFTMomentClient(tx.config)
+	tx.PendingEvent = NewPendingEventClient(tx.config)
+	tx.RawEvent = NewRawEventClient(tx.config)
+	tx.Sale = NewSaleClient(tx.config)
+	tx.Transfer = NewTransferClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -226,3 +252,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
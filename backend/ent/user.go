@@ -35,6 +35,8 @@ type User struct {
 	HighlightedMomentID uint64 `json:"highlighted_moment_id,omitempty"`
 	// Socials holds the value of the "socials" field.
 	Socials map[string]string `json:"socials,omitempty"`
+	// IsVerified holds the value of the "is_verified" field.
+	IsVerified bool `json:"isVerified"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -55,9 +57,13 @@ type UserEdges struct {
 	Attendances []*Attendance `json:"attendances,omitempty"`
 	// Listings holds the value of the listings edge.
 	Listings []*Listing `json:"listings,omitempty"`
+	// Sales holds the value of the sales edge.
+	Sales []*Sale `json:"sales,omitempty"`
+	// Purchases holds the value of the purchases edge.
+	Purchases []*Sale `json:"purchases,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [8]bool
 }
 
 // EventPassesOrErr returns the EventPasses value or an error if the edge
@@ -114,6 +120,24 @@ func (e UserEdges) ListingsOrErr() ([]*Listing, error) {
 	return nil, &NotLoadedError{edge: "listings"}
 }
 
+// SalesOrErr returns the Sales value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SalesOrErr() ([]*Sale, error) {
+	if e.loadedTypes[6] {
+		return e.Sales, nil
+	}
+	return nil, &NotLoadedError{edge: "sales"}
+}
+
+// PurchasesOrErr returns the Purchases value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PurchasesOrErr() ([]*Sale, error) {
+	if e.loadedTypes[7] {
+		return e.Purchases, nil
+	}
+	return nil, &NotLoadedError{edge: "purchases"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -121,6 +145,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldHighlightedEventPassIds, user.FieldSocials:
 			values[i] = new([]byte)
+		case user.FieldIsVerified:
+			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldHighlightedMomentID:
 			values[i] = new(sql.NullInt64)
 		case user.FieldAddress, user.FieldNickname, user.FieldBio, user.FieldPfp, user.FieldShortDescription, user.FieldBgImage:
@@ -204,6 +230,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field socials: %w", err)
 				}
 			}
+		case user.FieldIsVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_verified", values[i])
+			} else if value.Valid {
+				_m.IsVerified = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -247,6 +279,16 @@ func (_m *User) QueryListings() *ListingQuery {
 	return NewUserClient(_m.config).QueryListings(_m)
 }
 
+// QuerySales queries the "sales" edge of the User entity.
+func (_m *User) QuerySales() *SaleQuery {
+	return NewUserClient(_m.config).QuerySales(_m)
+}
+
+// QueryPurchases queries the "purchases" edge of the User entity.
+func (_m *User) QueryPurchases() *SaleQuery {
+	return NewUserClient(_m.config).QueryPurchases(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -296,6 +338,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("socials=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Socials))
+	builder.WriteString(", ")
+	builder.WriteString("is_verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsVerified))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,6 +30,8 @@ const (
 	FieldHighlightedMomentID = "highlighted_moment_id"
 	// FieldSocials holds the string denoting the socials field in the database.
 	FieldSocials = "socials"
+	// FieldIsVerified holds the string denoting the is_verified field in the database.
+	FieldIsVerified = "is_verified"
 	// EdgeEventPasses holds the string denoting the event_passes edge name in mutations.
 	EdgeEventPasses = "event_passes"
 	// EdgeHostedEvents holds the string denoting the hosted_events edge name in mutations.
@@ -42,6 +44,10 @@ const (
 	EdgeAttendances = "attendances"
 	// EdgeListings holds the string denoting the listings edge name in mutations.
 	EdgeListings = "listings"
+	// EdgeSales holds the string denoting the sales edge name in mutations.
+	EdgeSales = "sales"
+	// EdgePurchases holds the string denoting the purchases edge name in mutations.
+	EdgePurchases = "purchases"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// EventPassesTable is the table that holds the event_passes relation/edge.
@@ -86,6 +92,20 @@ const (
 	ListingsInverseTable = "listings"
 	// ListingsColumn is the table column denoting the listings relation/edge.
 	ListingsColumn = "user_listings"
+	// SalesTable is the table that holds the sales relation/edge.
+	SalesTable = "sales"
+	// SalesInverseTable is the table name for the Sale entity.
+	// It exists in this package in order to avoid circular dependency with the "sale" package.
+	SalesInverseTable = "sales"
+	// SalesColumn is the table column denoting the sales relation/edge.
+	SalesColumn = "user_sales"
+	// PurchasesTable is the table that holds the purchases relation/edge.
+	PurchasesTable = "sales"
+	// PurchasesInverseTable is the table name for the Sale entity.
+	// It exists in this package in order to avoid circular dependency with the "sale" package.
+	PurchasesInverseTable = "sales"
+	// PurchasesColumn is the table column denoting the purchases relation/edge.
+	PurchasesColumn = "user_purchases"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -100,6 +120,7 @@ var Columns = []string{
 	FieldHighlightedEventPassIds,
 	FieldHighlightedMomentID,
 	FieldSocials,
+	FieldIsVerified,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -112,6 +133,11 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultIsVerified holds the default value on creation for the "is_verified" field.
+	DefaultIsVerified bool
+)
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -155,6 +181,11 @@ func ByHighlightedMomentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHighlightedMomentID, opts...).ToFunc()
 }
 
+// ByIsVerified orders the results by the is_verified field.
+func ByIsVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsVerified, opts...).ToFunc()
+}
+
 // ByEventPassesCount orders the results by event_passes count.
 func ByEventPassesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -238,6 +269,34 @@ func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySalesCount orders the results by sales count.
+func BySalesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSalesStep(), opts...)
+	}
+}
+
+// BySales orders the results by sales terms.
+func BySales(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSalesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPurchasesCount orders the results by purchases count.
+func ByPurchasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPurchasesStep(), opts...)
+	}
+}
+
+// ByPurchases orders the results by purchases terms.
+func ByPurchases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPurchasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEventPassesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -280,3 +339,17 @@ func newListingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
 	)
 }
+func newSalesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SalesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SalesTable, SalesColumn),
+	)
+}
+func newPurchasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PurchasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PurchasesTable, PurchasesColumn),
+	)
+}
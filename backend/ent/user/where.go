@@ -89,6 +89,11 @@ func HighlightedMomentID(v uint64) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldHighlightedMomentID, v))
 }
 
+// IsVerified applies equality check predicate on the "is_verified" field. It's identical to IsVerifiedEQ.
+func IsVerified(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsVerified, v))
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAddress, v))
@@ -599,6 +604,16 @@ func SocialsNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldSocials))
 }
 
+// IsVerifiedEQ applies the EQ predicate on the "is_verified" field.
+func IsVerifiedEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldIsVerified, v))
+}
+
+// IsVerifiedNEQ applies the NEQ predicate on the "is_verified" field.
+func IsVerifiedNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldIsVerified, v))
+}
+
 // HasEventPasses applies the HasEdge predicate on the "event_passes" edge.
 func HasEventPasses() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -737,6 +752,52 @@ func HasListingsWith(preds ...predicate.Listing) predicate.User {
 	})
 }
 
+// HasSales applies the HasEdge predicate on the "sales" edge.
+func HasSales() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SalesTable, SalesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSalesWith applies the HasEdge predicate on the "sales" edge with a given conditions (other predicates).
+func HasSalesWith(preds ...predicate.Sale) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSalesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPurchases applies the HasEdge predicate on the "purchases" edge.
+func HasPurchases() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PurchasesTable, PurchasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPurchasesWith applies the HasEdge predicate on the "purchases" edge with a given conditions (other predicates).
+func HasPurchasesWith(preds ...predicate.Sale) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPurchasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -9,11 +9,13 @@ import (
 	"backend/ent/listing"
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -23,6 +25,7 @@ type UserCreate struct {
 	config
 	mutation *UserMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetAddress sets the "address" field.
@@ -127,6 +130,20 @@ func (_c *UserCreate) SetSocials(v map[string]string) *UserCreate {
 	return _c
 }
 
+// SetIsVerified sets the "is_verified" field.
+func (_c *UserCreate) SetIsVerified(v bool) *UserCreate {
+	_c.mutation.SetIsVerified(v)
+	return _c
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (_c *UserCreate) SetNillableIsVerified(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetIsVerified(*v)
+	}
+	return _c
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_c *UserCreate) AddEventPassIDs(ids ...int) *UserCreate {
 	_c.mutation.AddEventPassIDs(ids...)
@@ -217,6 +234,36 @@ func (_c *UserCreate) AddListings(v ...*Listing) *UserCreate {
 	return _c.AddListingIDs(ids...)
 }
 
+// AddSaleIDs adds the "sales" edge to the Sale entity by IDs.
+func (_c *UserCreate) AddSaleIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSaleIDs(ids...)
+	return _c
+}
+
+// AddSales adds the "sales" edges to the Sale entity.
+func (_c *UserCreate) AddSales(v ...*Sale) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSaleIDs(ids...)
+}
+
+// AddPurchaseIDs adds the "purchases" edge to the Sale entity by IDs.
+func (_c *UserCreate) AddPurchaseIDs(ids ...int) *UserCreate {
+	_c.mutation.AddPurchaseIDs(ids...)
+	return _c
+}
+
+// AddPurchases adds the "purchases" edges to the Sale entity.
+func (_c *UserCreate) AddPurchases(v ...*Sale) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPurchaseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -224,6 +271,7 @@ func (_c *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (_c *UserCreate) Save(ctx context.Context) (*User, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -249,11 +297,22 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.IsVerified(); !ok {
+		v := user.DefaultIsVerified
+		_c.mutation.SetIsVerified(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Address(); !ok {
 		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "User.address"`)}
 	}
+	if _, ok := _c.mutation.IsVerified(); !ok {
+		return &ValidationError{Name: "is_verified", err: errors.New(`ent: missing required field "User.is_verified"`)}
+	}
 	return nil
 }
 
@@ -280,6 +339,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_node = &User{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(user.Table, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.Address(); ok {
 		_spec.SetField(user.FieldAddress, field.TypeString, value)
 		_node.Address = value
@@ -316,6 +376,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSocials, field.TypeJSON, value)
 		_node.Socials = value
 	}
+	if value, ok := _c.mutation.IsVerified(); ok {
+		_spec.SetField(user.FieldIsVerified, field.TypeBool, value)
+		_node.IsVerified = value
+	}
 	if nodes := _c.mutation.EventPassesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -412,14 +476,546 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PurchasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.Create().
+//		SetAddress(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflict(opts ...sql.ConflictOption) *UserUpsertOne {
+	_c.conflict = opts
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreate) OnConflictColumns(columns ...string) *UserUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserUpsertOne is the builder for "upsert"-ing
+	//  one User node.
+	UserUpsertOne struct {
+		create *UserCreate
+	}
+
+	// UserUpsert is the "OnConflict" setter.
+	UserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAddress sets the "address" field.
+func (u *UserUpsert) SetAddress(v string) *UserUpsert {
+	u.Set(user.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *UserUpsert) UpdateAddress() *UserUpsert {
+	u.SetExcluded(user.FieldAddress)
+	return u
+}
+
+// SetNickname sets the "nickname" field.
+func (u *UserUpsert) SetNickname(v string) *UserUpsert {
+	u.Set(user.FieldNickname, v)
+	return u
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsert) UpdateNickname() *UserUpsert {
+	u.SetExcluded(user.FieldNickname)
+	return u
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsert) ClearNickname() *UserUpsert {
+	u.SetNull(user.FieldNickname)
+	return u
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsert) SetBio(v string) *UserUpsert {
+	u.Set(user.FieldBio, v)
+	return u
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBio() *UserUpsert {
+	u.SetExcluded(user.FieldBio)
+	return u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsert) ClearBio() *UserUpsert {
+	u.SetNull(user.FieldBio)
+	return u
+}
+
+// SetPfp sets the "pfp" field.
+func (u *UserUpsert) SetPfp(v string) *UserUpsert {
+	u.Set(user.FieldPfp, v)
+	return u
+}
+
+// UpdatePfp sets the "pfp" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePfp() *UserUpsert {
+	u.SetExcluded(user.FieldPfp)
+	return u
+}
+
+// ClearPfp clears the value of the "pfp" field.
+func (u *UserUpsert) ClearPfp() *UserUpsert {
+	u.SetNull(user.FieldPfp)
+	return u
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *UserUpsert) SetShortDescription(v string) *UserUpsert {
+	u.Set(user.FieldShortDescription, v)
+	return u
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *UserUpsert) UpdateShortDescription() *UserUpsert {
+	u.SetExcluded(user.FieldShortDescription)
+	return u
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *UserUpsert) ClearShortDescription() *UserUpsert {
+	u.SetNull(user.FieldShortDescription)
+	return u
+}
+
+// SetBgImage sets the "bg_image" field.
+func (u *UserUpsert) SetBgImage(v string) *UserUpsert {
+	u.Set(user.FieldBgImage, v)
+	return u
+}
+
+// UpdateBgImage sets the "bg_image" field to the value that was provided on create.
+func (u *UserUpsert) UpdateBgImage() *UserUpsert {
+	u.SetExcluded(user.FieldBgImage)
+	return u
+}
+
+// ClearBgImage clears the value of the "bg_image" field.
+func (u *UserUpsert) ClearBgImage() *UserUpsert {
+	u.SetNull(user.FieldBgImage)
+	return u
+}
+
+// SetHighlightedEventPassIds sets the "highlighted_eventPass_ids" field.
+func (u *UserUpsert) SetHighlightedEventPassIds(v []uint64) *UserUpsert {
+	u.Set(user.FieldHighlightedEventPassIds, v)
+	return u
+}
+
+// UpdateHighlightedEventPassIds sets the "highlighted_eventPass_ids" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHighlightedEventPassIds() *UserUpsert {
+	u.SetExcluded(user.FieldHighlightedEventPassIds)
+	return u
+}
+
+// ClearHighlightedEventPassIds clears the value of the "highlighted_eventPass_ids" field.
+func (u *UserUpsert) ClearHighlightedEventPassIds() *UserUpsert {
+	u.SetNull(user.FieldHighlightedEventPassIds)
+	return u
+}
+
+// SetHighlightedMomentID sets the "highlighted_moment_id" field.
+func (u *UserUpsert) SetHighlightedMomentID(v uint64) *UserUpsert {
+	u.Set(user.FieldHighlightedMomentID, v)
+	return u
+}
+
+// UpdateHighlightedMomentID sets the "highlighted_moment_id" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHighlightedMomentID() *UserUpsert {
+	u.SetExcluded(user.FieldHighlightedMomentID)
+	return u
+}
+
+// AddHighlightedMomentID adds v to the "highlighted_moment_id" field.
+func (u *UserUpsert) AddHighlightedMomentID(v uint64) *UserUpsert {
+	u.Add(user.FieldHighlightedMomentID, v)
+	return u
+}
+
+// ClearHighlightedMomentID clears the value of the "highlighted_moment_id" field.
+func (u *UserUpsert) ClearHighlightedMomentID() *UserUpsert {
+	u.SetNull(user.FieldHighlightedMomentID)
+	return u
+}
+
+// SetSocials sets the "socials" field.
+func (u *UserUpsert) SetSocials(v map[string]string) *UserUpsert {
+	u.Set(user.FieldSocials, v)
+	return u
+}
+
+// UpdateSocials sets the "socials" field to the value that was provided on create.
+func (u *UserUpsert) UpdateSocials() *UserUpsert {
+	u.SetExcluded(user.FieldSocials)
+	return u
+}
+
+// ClearSocials clears the value of the "socials" field.
+func (u *UserUpsert) ClearSocials() *UserUpsert {
+	u.SetNull(user.FieldSocials)
+	return u
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *UserUpsert) SetIsVerified(v bool) *UserUpsert {
+	u.Set(user.FieldIsVerified, v)
+	return u
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *UserUpsert) UpdateIsVerified() *UserUpsert {
+	u.SetExcluded(user.FieldIsVerified)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertOne) UpdateNewValues() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserUpsertOne) Ignore() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertOne) DoNothing() *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreate.OnConflict
+// documentation for more info.
+func (u *UserUpsertOne) Update(set func(*UserUpsert)) *UserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *UserUpsertOne) SetAddress(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateAddress() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNickname sets the "nickname" field.
+func (u *UserUpsertOne) SetNickname(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateNickname() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsertOne) ClearNickname() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsertOne) SetBio(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBio(v)
+	})
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBio() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBio()
+	})
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsertOne) ClearBio() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBio()
+	})
+}
+
+// SetPfp sets the "pfp" field.
+func (u *UserUpsertOne) SetPfp(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPfp(v)
+	})
+}
+
+// UpdatePfp sets the "pfp" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePfp() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePfp()
+	})
+}
+
+// ClearPfp clears the value of the "pfp" field.
+func (u *UserUpsertOne) ClearPfp() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPfp()
+	})
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *UserUpsertOne) SetShortDescription(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetShortDescription(v)
+	})
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateShortDescription() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateShortDescription()
+	})
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *UserUpsertOne) ClearShortDescription() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearShortDescription()
+	})
+}
+
+// SetBgImage sets the "bg_image" field.
+func (u *UserUpsertOne) SetBgImage(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBgImage(v)
+	})
+}
+
+// UpdateBgImage sets the "bg_image" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateBgImage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBgImage()
+	})
+}
+
+// ClearBgImage clears the value of the "bg_image" field.
+func (u *UserUpsertOne) ClearBgImage() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBgImage()
+	})
+}
+
+// SetHighlightedEventPassIds sets the "highlighted_eventPass_ids" field.
+func (u *UserUpsertOne) SetHighlightedEventPassIds(v []uint64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHighlightedEventPassIds(v)
+	})
+}
+
+// UpdateHighlightedEventPassIds sets the "highlighted_eventPass_ids" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHighlightedEventPassIds() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHighlightedEventPassIds()
+	})
+}
+
+// ClearHighlightedEventPassIds clears the value of the "highlighted_eventPass_ids" field.
+func (u *UserUpsertOne) ClearHighlightedEventPassIds() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHighlightedEventPassIds()
+	})
+}
+
+// SetHighlightedMomentID sets the "highlighted_moment_id" field.
+func (u *UserUpsertOne) SetHighlightedMomentID(v uint64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHighlightedMomentID(v)
+	})
+}
+
+// AddHighlightedMomentID adds v to the "highlighted_moment_id" field.
+func (u *UserUpsertOne) AddHighlightedMomentID(v uint64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddHighlightedMomentID(v)
+	})
+}
+
+// UpdateHighlightedMomentID sets the "highlighted_moment_id" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHighlightedMomentID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHighlightedMomentID()
+	})
+}
+
+// ClearHighlightedMomentID clears the value of the "highlighted_moment_id" field.
+func (u *UserUpsertOne) ClearHighlightedMomentID() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHighlightedMomentID()
+	})
+}
+
+// SetSocials sets the "socials" field.
+func (u *UserUpsertOne) SetSocials(v map[string]string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSocials(v)
+	})
+}
+
+// UpdateSocials sets the "socials" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateSocials() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSocials()
+	})
+}
+
+// ClearSocials clears the value of the "socials" field.
+func (u *UserUpsertOne) ClearSocials() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSocials()
+	})
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *UserUpsertOne) SetIsVerified(v bool) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsVerified(v)
+	})
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateIsVerified() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsVerified()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // UserCreateBulk is the builder for creating many User entities in bulk.
 type UserCreateBulk struct {
 	config
 	err      error
 	builders []*UserCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the User entities in the database.
@@ -433,6 +1029,7 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserMutation)
 				if !ok {
@@ -448,6 +1045,7 @@ func (_c *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -501,3 +1099,310 @@ func (_c *UserCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.User.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserUpsert) {
+//			SetAddress(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserUpsertBulk {
+	_c.conflict = opts
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserCreateBulk) OnConflictColumns(columns ...string) *UserUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserUpsertBulk is the builder for "upsert"-ing
+// a bulk of User nodes.
+type UserUpsertBulk struct {
+	create *UserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.User.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserUpsertBulk) Ignore() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserUpsertBulk) Update(set func(*UserUpsert)) *UserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *UserUpsertBulk) SetAddress(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateAddress() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetNickname sets the "nickname" field.
+func (u *UserUpsertBulk) SetNickname(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateNickname() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *UserUpsertBulk) ClearNickname() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetBio sets the "bio" field.
+func (u *UserUpsertBulk) SetBio(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBio(v)
+	})
+}
+
+// UpdateBio sets the "bio" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBio() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBio()
+	})
+}
+
+// ClearBio clears the value of the "bio" field.
+func (u *UserUpsertBulk) ClearBio() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBio()
+	})
+}
+
+// SetPfp sets the "pfp" field.
+func (u *UserUpsertBulk) SetPfp(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPfp(v)
+	})
+}
+
+// UpdatePfp sets the "pfp" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePfp() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePfp()
+	})
+}
+
+// ClearPfp clears the value of the "pfp" field.
+func (u *UserUpsertBulk) ClearPfp() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearPfp()
+	})
+}
+
+// SetShortDescription sets the "short_description" field.
+func (u *UserUpsertBulk) SetShortDescription(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetShortDescription(v)
+	})
+}
+
+// UpdateShortDescription sets the "short_description" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateShortDescription() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateShortDescription()
+	})
+}
+
+// ClearShortDescription clears the value of the "short_description" field.
+func (u *UserUpsertBulk) ClearShortDescription() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearShortDescription()
+	})
+}
+
+// SetBgImage sets the "bg_image" field.
+func (u *UserUpsertBulk) SetBgImage(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetBgImage(v)
+	})
+}
+
+// UpdateBgImage sets the "bg_image" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateBgImage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateBgImage()
+	})
+}
+
+// ClearBgImage clears the value of the "bg_image" field.
+func (u *UserUpsertBulk) ClearBgImage() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearBgImage()
+	})
+}
+
+// SetHighlightedEventPassIds sets the "highlighted_eventPass_ids" field.
+func (u *UserUpsertBulk) SetHighlightedEventPassIds(v []uint64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHighlightedEventPassIds(v)
+	})
+}
+
+// UpdateHighlightedEventPassIds sets the "highlighted_eventPass_ids" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHighlightedEventPassIds() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHighlightedEventPassIds()
+	})
+}
+
+// ClearHighlightedEventPassIds clears the value of the "highlighted_eventPass_ids" field.
+func (u *UserUpsertBulk) ClearHighlightedEventPassIds() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHighlightedEventPassIds()
+	})
+}
+
+// SetHighlightedMomentID sets the "highlighted_moment_id" field.
+func (u *UserUpsertBulk) SetHighlightedMomentID(v uint64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHighlightedMomentID(v)
+	})
+}
+
+// AddHighlightedMomentID adds v to the "highlighted_moment_id" field.
+func (u *UserUpsertBulk) AddHighlightedMomentID(v uint64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddHighlightedMomentID(v)
+	})
+}
+
+// UpdateHighlightedMomentID sets the "highlighted_moment_id" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHighlightedMomentID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHighlightedMomentID()
+	})
+}
+
+// ClearHighlightedMomentID clears the value of the "highlighted_moment_id" field.
+func (u *UserUpsertBulk) ClearHighlightedMomentID() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearHighlightedMomentID()
+	})
+}
+
+// SetSocials sets the "socials" field.
+func (u *UserUpsertBulk) SetSocials(v map[string]string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetSocials(v)
+	})
+}
+
+// UpdateSocials sets the "socials" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateSocials() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateSocials()
+	})
+}
+
+// ClearSocials clears the value of the "socials" field.
+func (u *UserUpsertBulk) ClearSocials() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearSocials()
+	})
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (u *UserUpsertBulk) SetIsVerified(v bool) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetIsVerified(v)
+	})
+}
+
+// UpdateIsVerified sets the "is_verified" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateIsVerified() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateIsVerified()
+	})
+}
+
+// Exec executes the query.
+func (u *UserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
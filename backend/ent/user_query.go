@@ -10,6 +10,7 @@ import (
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/predicate"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"database/sql/driver"
@@ -35,6 +36,8 @@ type UserQuery struct {
 	withAccessories  *NFTAccessoryQuery
 	withAttendances  *AttendanceQuery
 	withListings     *ListingQuery
+	withSales        *SaleQuery
+	withPurchases    *SaleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -203,6 +206,50 @@ func (_q *UserQuery) QueryListings() *ListingQuery {
 	return query
 }
 
+// QuerySales chains the current query on the "sales" edge.
+func (_q *UserQuery) QuerySales() *SaleQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(sale.Table, sale.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SalesTable, user.SalesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPurchases chains the current query on the "purchases" edge.
+func (_q *UserQuery) QueryPurchases() *SaleQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(sale.Table, sale.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PurchasesTable, user.PurchasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -401,6 +448,8 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withAccessories:  _q.withAccessories.Clone(),
 		withAttendances:  _q.withAttendances.Clone(),
 		withListings:     _q.withListings.Clone(),
+		withSales:        _q.withSales.Clone(),
+		withPurchases:    _q.withPurchases.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -473,6 +522,28 @@ func (_q *UserQuery) WithListings(opts ...func(*ListingQuery)) *UserQuery {
 	return _q
 }
 
+// WithSales tells the query-builder to eager-load the nodes that are connected to
+// the "sales" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSales(opts ...func(*SaleQuery)) *UserQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSales = query
+	return _q
+}
+
+// WithPurchases tells the query-builder to eager-load the nodes that are connected to
+// the "purchases" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithPurchases(opts ...func(*SaleQuery)) *UserQuery {
+	query := (&SaleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPurchases = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -551,13 +622,15 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [8]bool{
 			_q.withEventPasses != nil,
 			_q.withHostedEvents != nil,
 			_q.withMoments != nil,
 			_q.withAccessories != nil,
 			_q.withAttendances != nil,
 			_q.withListings != nil,
+			_q.withSales != nil,
+			_q.withPurchases != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -620,6 +693,20 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withSales; query != nil {
+		if err := _q.loadSales(ctx, query, nodes,
+			func(n *User) { n.Edges.Sales = []*Sale{} },
+			func(n *User, e *Sale) { n.Edges.Sales = append(n.Edges.Sales, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPurchases; query != nil {
+		if err := _q.loadPurchases(ctx, query, nodes,
+			func(n *User) { n.Edges.Purchases = []*Sale{} },
+			func(n *User, e *Sale) { n.Edges.Purchases = append(n.Edges.Purchases, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -809,6 +896,68 @@ func (_q *UserQuery) loadListings(ctx context.Context, query *ListingQuery, node
 	}
 	return nil
 }
+func (_q *UserQuery) loadSales(ctx context.Context, query *SaleQuery, nodes []*User, init func(*User), assign func(*User, *Sale)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sale(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SalesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sales
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sales" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sales" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadPurchases(ctx context.Context, query *SaleQuery, nodes []*User, init func(*User), assign func(*User, *Sale)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Sale(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PurchasesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_purchases
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_purchases" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_purchases" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,7 @@ import (
 	"backend/ent/nftaccessory"
 	"backend/ent/nftmoment"
 	"backend/ent/predicate"
+	"backend/ent/sale"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -205,6 +206,20 @@ func (_u *UserUpdate) ClearSocials() *UserUpdate {
 	return _u
 }
 
+// SetIsVerified sets the "is_verified" field.
+func (_u *UserUpdate) SetIsVerified(v bool) *UserUpdate {
+	_u.mutation.SetIsVerified(v)
+	return _u
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableIsVerified(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetIsVerified(*v)
+	}
+	return _u
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_u *UserUpdate) AddEventPassIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddEventPassIDs(ids...)
@@ -295,6 +310,36 @@ func (_u *UserUpdate) AddListings(v ...*Listing) *UserUpdate {
 	return _u.AddListingIDs(ids...)
 }
 
+// AddSaleIDs adds the "sales" edge to the Sale entity by IDs.
+func (_u *UserUpdate) AddSaleIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddSaleIDs(ids...)
+	return _u
+}
+
+// AddSales adds the "sales" edges to the Sale entity.
+func (_u *UserUpdate) AddSales(v ...*Sale) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSaleIDs(ids...)
+}
+
+// AddPurchaseIDs adds the "purchases" edge to the Sale entity by IDs.
+func (_u *UserUpdate) AddPurchaseIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddPurchaseIDs(ids...)
+	return _u
+}
+
+// AddPurchases adds the "purchases" edges to the Sale entity.
+func (_u *UserUpdate) AddPurchases(v ...*Sale) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPurchaseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -426,6 +471,48 @@ func (_u *UserUpdate) RemoveListings(v ...*Listing) *UserUpdate {
 	return _u.RemoveListingIDs(ids...)
 }
 
+// ClearSales clears all "sales" edges to the Sale entity.
+func (_u *UserUpdate) ClearSales() *UserUpdate {
+	_u.mutation.ClearSales()
+	return _u
+}
+
+// RemoveSaleIDs removes the "sales" edge to Sale entities by IDs.
+func (_u *UserUpdate) RemoveSaleIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveSaleIDs(ids...)
+	return _u
+}
+
+// RemoveSales removes "sales" edges to Sale entities.
+func (_u *UserUpdate) RemoveSales(v ...*Sale) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaleIDs(ids...)
+}
+
+// ClearPurchases clears all "purchases" edges to the Sale entity.
+func (_u *UserUpdate) ClearPurchases() *UserUpdate {
+	_u.mutation.ClearPurchases()
+	return _u
+}
+
+// RemovePurchaseIDs removes the "purchases" edge to Sale entities by IDs.
+func (_u *UserUpdate) RemovePurchaseIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemovePurchaseIDs(ids...)
+	return _u
+}
+
+// RemovePurchases removes "purchases" edges to Sale entities.
+func (_u *UserUpdate) RemovePurchases(v ...*Sale) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePurchaseIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -521,6 +608,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SocialsCleared() {
 		_spec.ClearField(user.FieldSocials, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.IsVerified(); ok {
+		_spec.SetField(user.FieldIsVerified, field.TypeBool, value)
+	}
 	if _u.mutation.EventPassesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -791,6 +881,96 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSalesIDs(); len(nodes) > 0 && !_u.mutation.SalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPurchasesIDs(); len(nodes) > 0 && !_u.mutation.PurchasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -982,6 +1162,20 @@ func (_u *UserUpdateOne) ClearSocials() *UserUpdateOne {
 	return _u
 }
 
+// SetIsVerified sets the "is_verified" field.
+func (_u *UserUpdateOne) SetIsVerified(v bool) *UserUpdateOne {
+	_u.mutation.SetIsVerified(v)
+	return _u
+}
+
+// SetNillableIsVerified sets the "is_verified" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsVerified(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsVerified(*v)
+	}
+	return _u
+}
+
 // AddEventPassIDs adds the "event_passes" edge to the EventPass entity by IDs.
 func (_u *UserUpdateOne) AddEventPassIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddEventPassIDs(ids...)
@@ -1072,6 +1266,36 @@ func (_u *UserUpdateOne) AddListings(v ...*Listing) *UserUpdateOne {
 	return _u.AddListingIDs(ids...)
 }
 
+// AddSaleIDs adds the "sales" edge to the Sale entity by IDs.
+func (_u *UserUpdateOne) AddSaleIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSaleIDs(ids...)
+	return _u
+}
+
+// AddSales adds the "sales" edges to the Sale entity.
+func (_u *UserUpdateOne) AddSales(v ...*Sale) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSaleIDs(ids...)
+}
+
+// AddPurchaseIDs adds the "purchases" edge to the Sale entity by IDs.
+func (_u *UserUpdateOne) AddPurchaseIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddPurchaseIDs(ids...)
+	return _u
+}
+
+// AddPurchases adds the "purchases" edges to the Sale entity.
+func (_u *UserUpdateOne) AddPurchases(v ...*Sale) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPurchaseIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1203,6 +1427,48 @@ func (_u *UserUpdateOne) RemoveListings(v ...*Listing) *UserUpdateOne {
 	return _u.RemoveListingIDs(ids...)
 }
 
+// ClearSales clears all "sales" edges to the Sale entity.
+func (_u *UserUpdateOne) ClearSales() *UserUpdateOne {
+	_u.mutation.ClearSales()
+	return _u
+}
+
+// RemoveSaleIDs removes the "sales" edge to Sale entities by IDs.
+func (_u *UserUpdateOne) RemoveSaleIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSaleIDs(ids...)
+	return _u
+}
+
+// RemoveSales removes "sales" edges to Sale entities.
+func (_u *UserUpdateOne) RemoveSales(v ...*Sale) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSaleIDs(ids...)
+}
+
+// ClearPurchases clears all "purchases" edges to the Sale entity.
+func (_u *UserUpdateOne) ClearPurchases() *UserUpdateOne {
+	_u.mutation.ClearPurchases()
+	return _u
+}
+
+// RemovePurchaseIDs removes the "purchases" edge to Sale entities by IDs.
+func (_u *UserUpdateOne) RemovePurchaseIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemovePurchaseIDs(ids...)
+	return _u
+}
+
+// RemovePurchases removes "purchases" edges to Sale entities.
+func (_u *UserUpdateOne) RemovePurchases(v ...*Sale) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePurchaseIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1328,6 +1594,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.SocialsCleared() {
 		_spec.ClearField(user.FieldSocials, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.IsVerified(); ok {
+		_spec.SetField(user.FieldIsVerified, field.TypeBool, value)
+	}
 	if _u.mutation.EventPassesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1598,6 +1867,96 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSalesIDs(); len(nodes) > 0 && !_u.mutation.SalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SalesTable,
+			Columns: []string{user.SalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PurchasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPurchasesIDs(); len(nodes) > 0 && !_u.mutation.PurchasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PurchasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PurchasesTable,
+			Columns: []string{user.PurchasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sale.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
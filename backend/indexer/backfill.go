@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	grpcOpts "google.golang.org/grpc"
+)
+
+const (
+	// Nama checkpoint untuk progres backfill (terpisah dari indexer live)
+	backfillCheckpoint = "backfill"
+
+	// Access node membatasi range GetEventsForHeightRange (maks 250 block)
+	maxChunkSize = 250
+
+	// Berapa kali satu chunk dicoba ulang sebelum backfill dihentikan
+	maxFetchAttempts = 5
+)
+
+// chunkResult adalah hasil fetch satu range height, sudah diurutkan per block.
+type chunkResult struct {
+	from, to uint64
+	blocks   []flow.BlockEvents
+	err      error
+}
+
+// runBackfill mengambil event historis lewat GetEventsForHeightRange
+// dan menerapkannya secara berurutan lewat handler yang sama dengan indexer live.
+func runBackfill(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+	from := fs.Uint64("from", 0, "block height awal (inklusif)")
+	to := fs.Uint64("to", 0, "block height akhir (inklusif)")
+	chunkSize := fs.Uint64("chunk", 200, "jumlah block per request")
+	workers := fs.Int("workers", 4, "jumlah worker fetch paralel")
+	fs.Parse(args)
+
+	if *from == 0 || *to == 0 || *from > *to {
+		log.Fatalf("Range tidak valid: --from %d --to %d", *from, *to)
+	}
+	if *chunkSize == 0 || *chunkSize > maxChunkSize {
+		log.Fatalf("--chunk harus di antara 1 dan %d", maxChunkSize)
+	}
+	if *workers < 1 {
+		*workers = 1
+	}
+
+	client := openDatabase(ctx)
+	defer client.Close()
+
+	grpcClient, err := grpc.NewBaseClient(
+		network.AccessHost,
+		grpcOpts.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatal("Gagal terhubung ke access node:", err)
+	}
+	defer grpcClient.Close()
+
+	log.Printf("Backfill block %d - %d (chunk %d, %d worker)", *from, *to, *chunkSize, *workers)
+
+	// 1. Bagi range menjadi chunk, dikirim ke worker secara berurutan
+	type job struct {
+		from, to uint64
+		result   chan chunkResult
+	}
+	jobs := make(chan job)
+	// 'ordered' menjaga urutan chunk; kapasitasnya membatasi chunk yang sedang di-fetch
+	ordered := make(chan chan chunkResult, *workers*2)
+
+	go func() {
+		defer close(jobs)
+		defer close(ordered)
+		for start := *from; start <= *to; start += *chunkSize {
+			end := start + *chunkSize - 1
+			if end > *to {
+				end = *to
+			}
+			result := make(chan chunkResult, 1)
+			ordered <- result
+			jobs <- job{from: start, to: end, result: result}
+		}
+	}()
+
+	// 2. Worker fetch paralel
+	for i := 0; i < *workers; i++ {
+		go func() {
+			for j := range jobs {
+				blocks, err := fetchChunk(ctx, grpcClient, j.from, j.to)
+				j.result <- chunkResult{from: j.from, to: j.to, blocks: blocks, err: err}
+			}
+		}()
+	}
+
+	// 3. Terapkan hasil chunk satu per satu sesuai urutan height
+	started := time.Now()
+	for result := range ordered {
+		chunk := <-result
+		if ctx.Err() != nil {
+			log.Printf("Backfill dihentikan, lanjutkan dengan --from %d", chunk.from)
+			return
+		}
+		if chunk.err != nil {
+			log.Fatalf("Backfill berhenti di block %d - %d: %v", chunk.from, chunk.to, chunk.err)
+		}
+
+		events := 0
+		for i, block := range chunk.blocks {
+			if ctx.Err() != nil {
+				log.Printf("Backfill dihentikan, lanjutkan dengan --from %d", block.Height)
+				return
+			}
+			// Block kosong dilewati, kecuali block terakhir untuk mencatat progres
+			if len(block.Events) == 0 && i != len(chunk.blocks)-1 {
+				continue
+			}
+			if err := processBlock(context.WithoutCancel(ctx), client, backfillCheckpoint, block); err != nil {
+				log.Fatalf("Gagal memproses block %d: %v", block.Height, err)
+			}
+			events += len(block.Events)
+		}
+		log.Printf("Backfill block %d - %d selesai (%d event)", chunk.from, chunk.to, events)
+	}
+
+	log.Printf("Backfill selesai dalam %s", time.Since(started).Round(time.Second))
+}
+
+// fetchChunk mengambil semua tipe event di range [from, to], dengan retry,
+// lalu menggabungkannya per block dengan urutan transaksi & event yang benar.
+func fetchChunk(ctx context.Context, grpcClient *grpc.BaseClient, from, to uint64) ([]flow.BlockEvents, error) {
+	byHeight := make(map[uint64]*flow.BlockEvents)
+
+	for _, eventType := range registry.EventTypes() {
+		var (
+			blocks []flow.BlockEvents
+			err    error
+		)
+		for attempt := 1; attempt <= maxFetchAttempts; attempt++ {
+			blocks, err = grpcClient.GetEventsForHeightRange(ctx, grpc.EventRangeQuery{
+				Type:        eventType,
+				StartHeight: from,
+				EndHeight:   to,
+			})
+			if err == nil {
+				break
+			}
+			log.Printf("Gagal fetch %s (%d - %d), percobaan %d: %v", eventType, from, to, attempt, err)
+
+			// Jeda antar percobaan ikut berhenti saat SIGINT/SIGTERM
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("gagal fetch %s: %w", eventType, err)
+		}
+
+		for _, block := range blocks {
+			merged, ok := byHeight[block.Height]
+			if !ok {
+				merged = &flow.BlockEvents{
+					BlockID:        block.BlockID,
+					Height:         block.Height,
+					BlockTimestamp: block.BlockTimestamp,
+				}
+				byHeight[block.Height] = merged
+			}
+			merged.Events = append(merged.Events, block.Events...)
+		}
+	}
+
+	result := make([]flow.BlockEvents, 0, len(byHeight))
+	for _, block := range byHeight {
+		// Urutkan sesuai urutan eksekusi di chain
+		sort.SliceStable(block.Events, func(i, j int) bool {
+			a, b := block.Events[i], block.Events[j]
+			if a.TransactionIndex != b.TransactionIndex {
+				return a.TransactionIndex < b.TransactionIndex
+			}
+			return a.EventIndex < b.EventIndex
+		})
+		result = append(result, *block)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Height < result[j].Height
+	})
+
+	return result, nil
+}
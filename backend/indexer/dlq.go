@@ -0,0 +1,157 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/deadletter"
+	"backend/utils"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// Interval default retrier dead letter (bisa diubah lewat DLQ_RETRY_INTERVAL)
+const defaultDeadLetterRetryInterval = 1 * time.Minute
+
+// runDeadLetterRetrier mencoba ulang dead letter yang sudah waktunya secara berkala.
+func runDeadLetterRetrier(ctx context.Context, client *ent.Client) {
+	interval := defaultDeadLetterRetryInterval
+	if value := os.Getenv("DLQ_RETRY_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("DLQ_RETRY_INTERVAL tidak valid '%s', memakai %s", value, interval)
+		} else {
+			interval = parsed
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			retried, failed, err := utils.RetryDueDeadLetters(ctx, client, registry)
+			if err != nil {
+				log.Println("Retrier dead letter gagal:", err)
+				continue
+			}
+			if retried > 0 || failed > 0 {
+				log.Printf("Retrier dead letter: %d berhasil, %d masih gagal", retried, failed)
+			}
+		}
+	}
+}
+
+// Pemakaian:
+//
+//	indexer dlq list [--limit N] [--type T]
+//	indexer dlq retry (--id N | --all)
+//	indexer dlq drop (--id N | --all)
+func runDeadLetter(ctx context.Context, args []string) {
+	if len(args) == 0 {
+		log.Fatal("Pemakaian: indexer dlq list|retry|drop [flag]")
+	}
+	command, args := args[0], args[1:]
+
+	fs := flag.NewFlagSet("dlq "+command, flag.ExitOnError)
+	id := fs.Int("id", 0, "ID dead letter")
+	all := fs.Bool("all", false, "terapkan ke semua dead letter")
+	limit := fs.Int("limit", 50, "jumlah baris yang ditampilkan (list)")
+	eventType := fs.String("type", "", "filter suffix tipe event (list)")
+	fs.Parse(args)
+
+	client := openDatabase(ctx)
+	defer client.Close()
+
+	switch command {
+	case "list":
+		listDeadLetters(ctx, client, *limit, *eventType)
+
+	case "retry":
+		query := client.DeadLetter.Query()
+		switch {
+		case *id > 0:
+			query = query.Where(deadletter.IDEQ(*id))
+		case *all:
+		default:
+			log.Fatal("Gunakan --id N atau --all")
+		}
+		letters, err := query.
+			Order(
+				ent.Asc(deadletter.FieldBlockHeight),
+				ent.Asc(deadletter.FieldTxIndex),
+				ent.Asc(deadletter.FieldEventIndex),
+			).
+			All(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		retried, failed := 0, 0
+		for _, dl := range letters {
+			ok, err := utils.RetryDeadLetter(ctx, client, registry, dl)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if ok {
+				retried++
+			} else {
+				failed++
+				log.Printf("Dead letter %d masih gagal", dl.ID)
+			}
+		}
+		log.Printf("Retry selesai: %d berhasil, %d masih gagal", retried, failed)
+
+	case "drop":
+		del := client.DeadLetter.Delete()
+		switch {
+		case *id > 0:
+			del = del.Where(deadletter.IDEQ(*id))
+		case *all:
+		default:
+			log.Fatal("Gunakan --id N atau --all")
+		}
+		n, err := del.Exec(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("%d dead letter dihapus", n)
+
+	default:
+		log.Fatalf("Perintah dlq tidak dikenal: %s", command)
+	}
+}
+
+// listDeadLetters menampilkan dead letter dalam bentuk tabel.
+func listDeadLetters(ctx context.Context, client *ent.Client, limit int, eventType string) {
+	query := client.DeadLetter.Query()
+	if eventType != "" {
+		query = query.Where(deadletter.TypeHasSuffix(eventType))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	letters, err := query.
+		Order(ent.Asc(deadletter.FieldBlockHeight)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tBLOCK\tTYPE\tTX\tATTEMPTS\tNEXT RETRY\tERROR")
+	for _, dl := range letters {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s#%d\t%d\t%s\t%s\n",
+			dl.ID, dl.BlockHeight, dl.Type, dl.TxID, dl.EventIndex,
+			dl.Attempts, dl.NextRetryAt.Format(time.RFC3339), dl.Error)
+	}
+	w.Flush()
+	fmt.Printf("%d dari %d dead letter ditampilkan\n", len(letters), total)
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/utils"
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// Interval default pengecekan listing kedaluwarsa (bisa diubah lewat LISTING_SWEEP_INTERVAL)
+const defaultListingSweepInterval = 5 * time.Minute
+
+// runListingSweeper menandai listing yang sudah lewat expiry secara berkala,
+// karena kontrak tidak meng-emit event saat listing kedaluwarsa.
+func runListingSweeper(ctx context.Context, client *ent.Client) {
+	interval := defaultListingSweepInterval
+	if value := os.Getenv("LISTING_SWEEP_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("LISTING_SWEEP_INTERVAL tidak valid '%s', memakai %s", value, interval)
+		} else {
+			interval = parsed
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := utils.ExpireListings(ctx, client, time.Now())
+			if err != nil {
+				log.Println("Sweeper listing gagal:", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("Sweeper listing: %d listing kedaluwarsa", n)
+			}
+		}
+	}
+}
@@ -2,133 +2,269 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/flow-go-sdk/access/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-
-	grpcOpts "google.golang.org/grpc"
 
+	"backend/ent"
 	"backend/utils"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 const (
-	ContractAddress = "1b7f070ebf7d0431" // Alamat tempat kontrak di-deploy
+	// Height awal jika belum ada checkpoint dan tidak ada override
+	defaultStartHeight uint64 = 290706055
 )
 
 var (
-	FlowCapabilityControllerIssued = "flow.StorageCapabilityControllerIssued"
-	NFTMomentMinted                = "A.1bb6b1e0a5170088.NFTMoment.Minted"
-	NFTAccessoryMinted             = "A.1bb6b1e0a5170088.AccessoryPack.AccessoryDistributed"
-	NFTMomentEquipAccessory        = "A.1bb6b1e0a5170088.NFTMoment.AccessoryEquipped"
-	NFTMomentUnequipAccessory      = "A.1bb6b1e0a5170088.NFTMoment.AccessoryUnequipped"
-	EventCreated                   = "A.1bb6b1e0a5170088.EventManager.EventCreated"
-	ProfileUpdated                 = "A.1bb6b1e0a5170088.UserProfile.ProfileUpdated"
-	UserRegisteredEvent            = "A.1bb6b1e0a5170088.EventManager.UserRegistered"
-	UserCheckedInEvent             = "A.1bb6b1e0a5170088.EventManager.UserCheckedIn"
-	EventPassMinted                = "A.1bb6b1e0a5170088.EventPass.Minted"
-	ListingAvailable               = "A.2d55b98eb200daef.NFTStorefrontV2.ListingAvailable"
-	ListingCompleted               = "A.2d55b98eb200daef.NFTStorefrontV2.ListingCompleted"
-	NFTDeposited                   = "A.631e88ae7f1d7c20.NonFungibleToken.Deposited"
+	// network adalah profil jaringan Flow dari flow.json (FLOW_NETWORK)
+	network *utils.Network
+
+	// registry berisi semua handler event, dibangun sekali saat start
+	registry *utils.Registry
 )
 
+// resolveStartHeight menentukan dari block mana subscription dimulai.
+// Urutan prioritas: flag -start-height, env START_BLOCK_HEIGHT,
+// checkpoint terakhir + 1, lalu defaultStartHeight.
+func resolveStartHeight(ctx context.Context, client *ent.Client, flagHeight uint64) (uint64, error) {
+	if flagHeight > 0 {
+		log.Printf("Memakai start height dari flag: %d", flagHeight)
+		return flagHeight, nil
+	}
+
+	if envHeight := os.Getenv("START_BLOCK_HEIGHT"); envHeight != "" {
+		height, err := strconv.ParseUint(envHeight, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("START_BLOCK_HEIGHT tidak valid '%s': %w", envHeight, err)
+		}
+		log.Printf("Memakai start height dari env: %d", height)
+		return height, nil
+	}
+
+	cp, err := utils.GetCheckpoint(ctx, client, utils.IndexerCheckpoint)
+	if err != nil {
+		return 0, err
+	}
+	if cp != nil {
+		log.Printf("Melanjutkan dari checkpoint: block %d (%s)", cp.BlockHeight, cp.BlockID)
+		return cp.BlockHeight + 1, nil
+	}
+
+	log.Printf("Checkpoint belum ada, mulai dari height default: %d", defaultStartHeight)
+	return defaultStartHeight, nil
+}
+
+// Pemakaian:
+//
+//	indexer [-start-height N]            -> subscribe event secara live
+//	indexer backfill --from N --to M     -> isi ulang event historis
+//	indexer reindex [--type T] [--from N] [--restart] -> bangun ulang proyeksi dari jurnal
+//	indexer dlq list|retry|drop           -> kelola event di dead letter
+//	indexer reconcile [--address A] [--repair] -> bandingkan kepemilikan on-chain dengan DB
 func main() {
-	ctx := context.Background()
 	// Load .env file if it exists (optional, environment variables can be set by Docker/system)
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("Warning: .env file not found, using environment variables from system:", err)
 	}
 
+	// Profil jaringan menentukan access node & alamat kontrak
+	network, err = utils.LoadNetwork()
+	if err != nil {
+		log.Fatal("Gagal memuat profil jaringan: ", err)
+	}
+
+	registry, err = utils.NewRegistry(network)
+	if err != nil {
+		log.Fatal("Gagal membangun registry handler: ", err)
+	}
+
+	// Access node untuk lookup transaksi (pembuka receipt gacha, EventPass yang dipakai mint)
+	lookupClient, err := grpc.NewClient(network.AccessHost)
+	if err != nil {
+		log.Fatal("Gagal membuat client access node: ", err)
+	}
+	defer lookupClient.Close()
+	utils.SetTxLookup(lookupClient.GetTransaction)
+
+	// MetadataViews (tier, rarity, ...) dibaca lewat access node yang sama
+	// (tanpa script-nya, NFT tetap di-indeks tanpa data tambahan)
+	if metadataLookup, err := utils.NewScriptMetadataLookup(lookupClient, network); err != nil {
+		log.Println("Lookup MetadataViews tidak aktif:", err)
+	} else {
+		utils.SetMetadataLookup(metadataLookup)
+	}
+
+	// SIGINT/SIGTERM membatalkan ctx: block yang sedang diproses diselesaikan dulu
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	args := os.Args[1:]
+	command := "run"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	switch command {
+	case "run":
+		runLive(ctx, args)
+	case "backfill":
+		runBackfill(ctx, args)
+	case "reindex":
+		runReindex(ctx, args)
+	case "dlq":
+		runDeadLetter(ctx, args)
+	case "reconcile":
+		runReconcile(ctx, args)
+	default:
+		log.Fatalf("Perintah tidak dikenal: %s", command)
+	}
+}
+
+// openDatabase membuka koneksi DB dan memastikan skema sudah dibuat.
+func openDatabase(ctx context.Context) *ent.Client {
 	client := utils.Open(os.Getenv("DATABASE_URL"))
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := utils.Migrate(ctx, client); err != nil {
 		log.Fatal(err)
 	}
+	client.Use(dbWriteHook())
+	return client
+}
+
+// runLive menjalankan subscription live dari checkpoint terakhir
+// sampai ctx dibatalkan (SIGINT/SIGTERM).
+func runLive(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	startHeightFlag := fs.Uint64("start-height", 0, "override block height awal (0 = pakai checkpoint)")
+	fs.Parse(args)
 
-	grpcClient, err := grpc.NewBaseClient(
-		grpc.TestnetHost,
-		grpcOpts.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	client := openDatabase(ctx)
+	defer client.Close()
 
+	startHeight, err := resolveStartHeight(ctx, client, *startHeightFlag)
 	if err != nil {
-		log.Println("Gagal terhubung ke emulator gRPC:", err)
+		log.Fatal(err)
 	}
 
-	grpcBlock, err := grpcClient.GetLatestBlockHeader(ctx, true)
+	filter := flow.EventFilter{EventTypes: registry.EventTypes()}
 
-	if err != nil {
-		log.Println("Gagal gRPC get latest block:", err)
-	}
-	fmt.Println("Block ID:", grpcBlock.ID.String(), grpcBlock.Height)
-
-	dataCh, errCh, initErr := grpcClient.SubscribeEventsByBlockHeight(
-		ctx,
-		290706055,
-		flow.EventFilter{
-			EventTypes: []string{
-				NFTMomentMinted, NFTAccessoryMinted, NFTMomentEquipAccessory, NFTMomentUnequipAccessory,
-				FlowCapabilityControllerIssued, EventCreated, UserRegisteredEvent, UserCheckedInEvent,
-				EventPassMinted, ProfileUpdated, ListingAvailable, NFTDeposited, ListingCompleted,
-			},
-		},
-	)
-	if initErr != nil {
-		// handle init error
-		log.Println("Gagal subscribe ke event init err:", initErr.Error())
-	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			// graceful shutdown
-			return
-		case data, ok := <-dataCh:
-			if !ok {
-				panic("data subscription closed")
+	sub := newSubscriber(network.AccessHost, filter, startHeight, func(ctx context.Context, data flow.BlockEvents) error {
+		started := time.Now()
+		if err := processBlock(ctx, client, utils.IndexerCheckpoint, data); err != nil {
+			return err
+		}
+		blockCommitDuration.Observe(time.Since(started).Seconds())
+		setProcessedHeight(data.Height)
+		return nil
+	})
+	sub.onError = func(err error) {
+		if err := utils.RecordCheckpointError(context.WithoutCancel(ctx), client, utils.IndexerCheckpoint, err); err != nil {
+			log.Println(err)
+		}
+	}
+	if err := sub.connect(); err != nil {
+		log.Println(err)
+	}
+
+	// Retrier dead letter, sweeper listing, reconcile & metrics berjalan di background
+	var background sync.WaitGroup
+	for _, run := range []func(context.Context){
+		func(ctx context.Context) { runDeadLetterRetrier(ctx, client) },
+		func(ctx context.Context) { runListingSweeper(ctx, client) },
+		func(ctx context.Context) { runReconcileJob(ctx, client) },
+		runMetricsServer,
+		func(ctx context.Context) { runSealedHeightPoller(ctx, network.AccessHost) },
+	} {
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			run(ctx)
+		}()
+	}
+
+	sub.run(ctx)
+
+	// Tunggu proses background selesai sebelum koneksi DB ditutup
+	log.Println("Menghentikan indexer...")
+	sub.close()
+	background.Wait()
+	log.Println("Indexer berhenti.")
+}
+
+// processBlock menerapkan semua event di block ke handler-nya, bersama
+// checkpoint block tersebut (dengan nama 'checkpointName'), dalam SATU transaksi DB.
+// Jika ada handler yang gagal karena DB, seluruh block di-rollback
+// dan error dikembalikan supaya block dicoba ulang secara utuh.
+func processBlock(ctx context.Context, client *ent.Client, checkpointName string, data flow.BlockEvents) error {
+	block := utils.Block{
+		Height:    data.Height,
+		ID:        data.BlockID,
+		Timestamp: data.BlockTimestamp,
+	}
+	ctx = utils.WithBlock(ctx, block)
+
+	// Panggilan access node dilakukan di luar transaksi DB:
+	// transaksi mint diambil sebelumnya, MetadataViews setelah commit
+	ctx = utils.PrefetchTransactions(ctx, network, data.Events)
+	ctx, enrichQueue := utils.WithEnrichQueue(ctx)
+
+	err := utils.WithTx(ctx, client, func(tx *ent.Tx) error {
+		txClient := tx.Client()
+
+		// Jumlah event baru per tipe, untuk API status
+		counts := make(map[string]int)
+		for _, ev := range data.Events {
+			// Jurnal event mentah dulu; jika sudah ada berarti event ini duplikat
+			isNew, err := utils.JournalEvent(ctx, txClient, block, ev)
+			if err != nil {
+				return err
 			}
-			for _, ev := range data.Events {
-				fmt.Println("Type:", ev.Type)
-
-				switch ev.Type {
-				case FlowCapabilityControllerIssued:
-					utils.HandleCapabilityIssued(ctx, ev, client)
-				case NFTMomentMinted:
-					utils.NFTMomentMinted(ctx, ev, client)
-				case NFTAccessoryMinted:
-					utils.NFTAccessoryMinted(ctx, ev, client)
-				case NFTMomentEquipAccessory:
-					utils.NFTMomentEquipAccessory(ctx, ev, client)
-				case NFTMomentUnequipAccessory:
-					utils.NFTMomentUnequipAccessory(ctx, ev, client)
-				case EventCreated:
-					utils.EventCreated(ctx, ev, client)
-				case UserRegisteredEvent:
-					utils.UserRegistered(ctx, ev, client)
-				case UserCheckedInEvent:
-					utils.UserCheckedIn(ctx, ev, client)
-				case EventPassMinted:
-					utils.EventPassMinted(ctx, ev, client)
-				case ProfileUpdated:
-					utils.ProfileUpdated(ctx, ev, client)
-				case ListingAvailable:
-					utils.ListingAvailable(ctx, ev, client)
-				case ListingCompleted:
-					utils.ListingCompleted(ctx, ev, client)
-				case NFTDeposited:
-					utils.NFTDeposited(ctx, ev, client)
-				}
+			if !isNew {
+				log.Printf("Event %s (tx %s #%d) sudah pernah diproses, dilewati.", ev.Type, ev.TransactionID, ev.EventIndex)
+				continue
 			}
-		case err := <-errCh:
-			if err != nil {
-				// handle streaming error (log, reconnect / exponential back-off)
-				log.Println("errorCh")
+			counts[ev.Type]++
+
+			if err := utils.ApplyEvent(ctx, txClient, registry, block, ev); err != nil {
+				// Event yang datanya tidak valid masuk dead letter, tidak menggagalkan block
+				if errors.Is(err, utils.ErrInvalidEvent) {
+					if err := utils.DeadLetterEvent(ctx, txClient, block, ev, err); err != nil {
+						return err
+					}
+					continue
+				}
+				return fmt.Errorf("handler %s (tx %s) gagal: %w", ev.Type, ev.TransactionID, err)
 			}
 		}
+
+		if err := utils.CountEvents(ctx, txClient, counts); err != nil {
+			return err
+		}
+
+		// Block selesai diproses, simpan checkpoint
+		if err := utils.SaveCheckpoint(ctx, txClient, checkpointName, block); err != nil {
+			return fmt.Errorf("gagal menyimpan checkpoint block %d: %w", data.Height, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
+	enrichQueue.Run(ctx, client)
+	return nil
 }
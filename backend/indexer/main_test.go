@@ -0,0 +1,53 @@
+package main
+
+import (
+	"backend/testdb"
+	"backend/utils"
+	"context"
+	"testing"
+)
+
+func TestResolveStartHeight(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		flag       uint64
+		env        string
+		checkpoint uint64 // 0 = belum ada checkpoint
+		want       uint64
+		wantErr    bool
+	}{
+		{name: "default tanpa checkpoint", want: defaultStartHeight},
+		{name: "lanjut dari checkpoint", checkpoint: 500, want: 501},
+		{name: "env mengalahkan checkpoint", env: "300", checkpoint: 500, want: 300},
+		{name: "flag mengalahkan env & checkpoint", flag: 100, env: "300", checkpoint: 500, want: 100},
+		{name: "env tidak valid", env: "abc", checkpoint: 500, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testdb.Open(t)
+			t.Setenv("START_BLOCK_HEIGHT", tt.env)
+			if tt.checkpoint > 0 {
+				if err := utils.SaveCheckpoint(ctx, client, utils.IndexerCheckpoint, utils.Block{Height: tt.checkpoint}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := resolveStartHeight(ctx, client, tt.flag)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("resolveStartHeight = %d, ingin error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveStartHeight = %d, ingin %d", got, tt.want)
+			}
+		})
+	}
+}
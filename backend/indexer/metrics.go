@@ -0,0 +1,161 @@
+package main
+
+import (
+	"backend/ent"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// Alamat default endpoint /metrics (bisa diubah lewat METRICS_ADDR)
+	defaultMetricsAddr = ":2112"
+
+	// Seberapa sering height sealed terbaru diambil dari access node
+	sealedHeightInterval = 15 * time.Second
+)
+
+var (
+	lastProcessedHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "indexer_last_processed_height",
+		Help: "Height block terakhir yang selesai diproses.",
+	})
+
+	latestSealedHeight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "indexer_latest_sealed_height",
+		Help: "Height block sealed terbaru menurut access node.",
+	})
+
+	lagBlocks = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "indexer_lag_blocks",
+		Help: "Selisih height sealed terbaru dengan height terakhir yang diproses.",
+	})
+
+	reconnects = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "indexer_reconnects_total",
+		Help: "Jumlah reconnect subscription ke access node.",
+	})
+
+	dbWriteDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "indexer_db_write_duration_seconds",
+		Help:    "Lama satu mutasi ent (create/update/delete), per tabel & operasi.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"table", "op"})
+
+	blockCommitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "indexer_block_commit_duration_seconds",
+		Help:    "Lama transaksi DB untuk satu block (semua event + checkpoint).",
+		Buckets: prometheus.DefBuckets,
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		lastProcessedHeight,
+		latestSealedHeight,
+		lagBlocks,
+		reconnects,
+		dbWriteDuration,
+		blockCommitDuration,
+	)
+}
+
+// Height terakhir yang diketahui, untuk menghitung lag.
+// Diisi dari subscriber & poller yang berjalan di goroutine berbeda.
+var (
+	heightsMu                     sync.Mutex
+	processedHeight, sealedHeight uint64
+)
+
+func setProcessedHeight(height uint64) {
+	heightsMu.Lock()
+	defer heightsMu.Unlock()
+	processedHeight = height
+	lastProcessedHeight.Set(float64(height))
+	updateLag()
+}
+
+func setSealedHeight(height uint64) {
+	heightsMu.Lock()
+	defer heightsMu.Unlock()
+	sealedHeight = height
+	latestSealedHeight.Set(float64(height))
+	updateLag()
+}
+
+// updateLag harus dipanggil dengan heightsMu terkunci.
+func updateLag() {
+	if processedHeight > 0 && sealedHeight > 0 {
+		lagBlocks.Set(float64(sealedHeight) - float64(processedHeight))
+	}
+}
+
+// dbWriteHook mengukur lama setiap mutasi ent.
+func dbWriteHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			timer := prometheus.NewTimer(dbWriteDuration.WithLabelValues(m.Type(), m.Op().String()))
+			defer timer.ObserveDuration()
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// runMetricsServer menyajikan /metrics untuk Prometheus sampai ctx dibatalkan.
+func runMetricsServer(ctx context.Context) {
+	addr := os.Getenv("METRICS_ADDR")
+	if addr == "" {
+		addr = defaultMetricsAddr
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+
+	log.Printf("Metrics tersedia di %s/metrics", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Println("Server metrics berhenti:", err)
+	}
+}
+
+// runSealedHeightPoller memperbarui height sealed terbaru secara berkala
+// supaya lag indexer bisa dihitung.
+func runSealedHeightPoller(ctx context.Context, host string) {
+	client, err := grpc.NewClient(host)
+	if err != nil {
+		log.Println("Gagal membuat client untuk metrics height sealed:", err)
+		return
+	}
+	defer client.Close()
+
+	ticker := time.NewTicker(sealedHeightInterval)
+	defer ticker.Stop()
+
+	for {
+		header, err := client.GetLatestBlockHeader(ctx, true)
+		if err != nil {
+			log.Println("Gagal mengambil height sealed terbaru:", err)
+		} else {
+			setSealedHeight(header.Height)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
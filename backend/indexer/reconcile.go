@@ -0,0 +1,159 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/utils"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onflow/flow-go-sdk/access/grpc"
+)
+
+// Interval default reconcile terjadwal (bisa diubah lewat RECONCILE_INTERVAL, "0" = mati)
+const defaultReconcileInterval = 6 * time.Hour
+
+// Pemakaian:
+//
+//	indexer reconcile [--address 0xabc,0xdef] [--repair]
+//
+// Tanpa --address, semua user di DB diperiksa. Dengan --repair, metadata NFT
+// (tier, rarity) yang gagal dibaca saat indexing juga diisi ulang.
+func runReconcile(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	addresses := fs.String("address", "", "alamat yang diperiksa, dipisah koma (default: semua user)")
+	repair := fs.Bool("repair", false, "perbaiki pemilik & equipment di DB sesuai chain")
+	fs.Parse(args)
+
+	client := openDatabase(ctx)
+	defer client.Close()
+
+	flowClient, err := grpc.NewClient(network.AccessHost)
+	if err != nil {
+		log.Fatal("Gagal membuat client access node: ", err)
+	}
+	defer flowClient.Close()
+
+	var list []string
+	if *addresses != "" {
+		for _, address := range strings.Split(*addresses, ",") {
+			list = append(list, strings.TrimSpace(address))
+		}
+	}
+
+	reconciler := &utils.Reconciler{DB: client, Flow: flowClient, Network: network, Repair: *repair}
+	mismatches, err := reconciler.Reconcile(ctx, list)
+	if err != nil {
+		log.Fatal("Reconcile gagal: ", err)
+	}
+	printMismatches(mismatches)
+
+	// Metadata NFT yang gagal dibaca saat indexing diisi ulang
+	if *repair {
+		enrichMissing(ctx, client)
+	}
+}
+
+// enrichMissing menjalankan utils.EnrichMissing dan mencatat hasilnya.
+func enrichMissing(ctx context.Context, client *ent.Client) {
+	enriched, failed, err := utils.EnrichMissing(ctx, client)
+	if err != nil {
+		log.Println("Gagal mengisi ulang metadata NFT:", err)
+		return
+	}
+	if enriched > 0 || failed > 0 {
+		log.Printf("Metadata NFT diisi ulang: %d berhasil, %d gagal", enriched, failed)
+	}
+}
+
+// printMismatches menampilkan hasil reconcile dalam bentuk tabel.
+func printMismatches(mismatches []utils.Mismatch) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNFT\tADDRESS\tCHAIN\tDB\tREPAIRED")
+	repaired := 0
+	for _, m := range mismatches {
+		fmt.Fprintf(w, "%s\t%s %d\t%s\t%s\t%s\t%t\n",
+			m.Kind, m.NFTType, m.NFTID, m.Address, orDash(m.Chain), orDash(m.DB), m.Repaired)
+		if m.Repaired {
+			repaired++
+		}
+	}
+	w.Flush()
+	fmt.Printf("%d ketidaksesuaian, %d diperbaiki\n", len(mismatches), repaired)
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// runReconcileJob menjalankan reconcile untuk semua user secara berkala.
+// Perbaikan otomatis hanya jika RECONCILE_REPAIR=true.
+func runReconcileJob(ctx context.Context, client *ent.Client) {
+	interval := defaultReconcileInterval
+	if value := os.Getenv("RECONCILE_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("RECONCILE_INTERVAL tidak valid '%s', memakai %s", value, interval)
+		} else {
+			interval = parsed
+		}
+	}
+	if interval <= 0 {
+		log.Println("Reconcile terjadwal dimatikan")
+		return
+	}
+	repair, _ := strconv.ParseBool(os.Getenv("RECONCILE_REPAIR"))
+
+	flowClient, err := grpc.NewClient(network.AccessHost)
+	if err != nil {
+		log.Println("Gagal membuat client access node untuk reconcile:", err)
+		return
+	}
+	defer flowClient.Close()
+
+	reconciler := &utils.Reconciler{DB: client, Flow: flowClient, Network: network, Repair: repair}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Chain dibaca di height yang sudah di-indeks, supaya event yang
+			// belum diproses indexer tidak terhitung sebagai ketidaksesuaian
+			cp, err := utils.GetCheckpoint(ctx, client, utils.IndexerCheckpoint)
+			if err != nil {
+				log.Println("Reconcile terjadwal gagal:", err)
+				continue
+			}
+			if cp == nil {
+				log.Println("Reconcile terjadwal dilewati: indexer belum punya checkpoint")
+				continue
+			}
+			reconciler.BlockHeight = cp.BlockHeight
+
+			mismatches, err := reconciler.Reconcile(ctx, nil)
+			if err != nil {
+				log.Println("Reconcile terjadwal gagal:", err)
+				continue
+			}
+			for _, m := range mismatches {
+				log.Printf("Reconcile: %s %s %d di %s (chain: %s, db: %s, diperbaiki: %t)",
+					m.Kind, m.NFTType, m.NFTID, m.Address, orDash(m.Chain), orDash(m.DB), m.Repaired)
+			}
+			log.Printf("Reconcile terjadwal selesai di block %d: %d ketidaksesuaian", cp.BlockHeight, len(mismatches))
+			enrichMissing(ctx, client)
+		}
+	}
+}
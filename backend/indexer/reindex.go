@@ -0,0 +1,238 @@
+package main
+
+import (
+	"backend/ent"
+	"backend/ent/predicate"
+	"backend/ent/rawevent"
+	"backend/utils"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+const (
+	// Nama checkpoint progres reindex; ada selama reindex belum selesai
+	reindexCheckpoint = "reindex"
+
+	// Jumlah baris jurnal yang dibaca per batch saat replay
+	reindexBatchSize = 1000
+)
+
+// runReindex mengosongkan tabel proyeksi lalu memutar ulang event dari
+// jurnal 'raw_events' lewat handler yang sama dengan indexer live.
+//
+// Setiap block di-commit dalam transaksinya sendiri bersama checkpoint
+// 'reindex'. Reindex yang terhenti (gagal atau SIGINT/SIGTERM) dilanjutkan
+// dari checkpoint tersebut saat dijalankan lagi, tanpa mengosongkan tabel.
+func runReindex(ctx context.Context, args []string) {
+	fs := flag.NewFlagSet("reindex", flag.ExitOnError)
+	types := fs.String("type", "", "filter tipe event, dipisah koma (boleh suffix, misal: NFTMoment.Minted)")
+	from := fs.Uint64("from", 0, "block height awal (inklusif)")
+	to := fs.Uint64("to", 0, "block height akhir (inklusif, 0 = tanpa batas)")
+	truncate := fs.Bool("truncate", true, "kosongkan tabel proyeksi sebelum replay")
+	restart := fs.Bool("restart", false, "abaikan reindex yang terhenti dan mulai dari awal")
+	fs.Parse(args)
+
+	// Dengan filter, default-nya TIDAK mengosongkan tabel
+	// (kecuali -truncate diberikan secara eksplisit). Event diputar di atas
+	// proyeksi yang ada, jadi handler harus idempoten: baris yang sudah ada dilewati.
+	filtered := *types != "" || *from > 0 || *to > 0
+	truncateSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "truncate" {
+			truncateSet = true
+		}
+	})
+	if filtered && !truncateSet {
+		*truncate = false
+	}
+
+	client := openDatabase(ctx)
+	defer client.Close()
+
+	// Lanjutkan reindex yang terhenti: proyeksi sudah dikosongkan sebelumnya
+	cp, err := utils.GetCheckpoint(ctx, client, reindexCheckpoint)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if cp != nil && !*restart {
+		log.Printf("Melanjutkan reindex yang terhenti setelah block %d (-restart untuk mulai dari awal)", cp.BlockHeight)
+		*from = cp.BlockHeight + 1
+		*truncate = false
+	}
+
+	// Siapkan filter jurnal
+	var where []predicate.RawEvent
+	if *types != "" {
+		var typePreds []predicate.RawEvent
+		for _, t := range strings.Split(*types, ",") {
+			typePreds = append(typePreds, rawevent.TypeHasSuffix(strings.TrimSpace(t)))
+		}
+		where = append(where, rawevent.Or(typePreds...))
+	}
+	if *from > 0 {
+		where = append(where, rawevent.BlockHeightGTE(*from))
+	}
+	if *to > 0 {
+		where = append(where, rawevent.BlockHeightLTE(*to))
+	}
+
+	if *truncate || (cp != nil && *restart) {
+		err := utils.WithTx(ctx, client, func(tx *ent.Tx) error {
+			txClient := tx.Client()
+			if err := utils.DeleteCheckpoint(ctx, txClient, reindexCheckpoint); err != nil {
+				return err
+			}
+			if !*truncate {
+				return nil
+			}
+			log.Println("Mengosongkan tabel proyeksi...")
+			return truncateProjections(ctx, txClient)
+		})
+		if err != nil {
+			log.Fatal("Reindex gagal, tidak ada perubahan yang disimpan: ", err)
+		}
+	}
+
+	// SIGINT/SIGTERM hanya menghentikan replay di antara block,
+	// bukan membatalkan query atau transaksi yang sedang berjalan
+	dbCtx := context.WithoutCancel(ctx)
+	started := time.Now()
+	replayed := 0
+
+	// Event dikumpulkan per block, lalu setiap block di-commit sendiri
+	var (
+		block  utils.Block
+		events []flow.Event
+	)
+	flush := func() {
+		if len(events) == 0 {
+			return
+		}
+		n, err := replayBlock(dbCtx, client, block, events)
+		if err != nil {
+			log.Fatalf("Reindex berhenti di block %d, jalankan lagi untuk melanjutkan: %v", block.Height, err)
+		}
+		replayed += n
+		events = events[:0]
+	}
+
+	for offset := 0; ; offset += reindexBatchSize {
+		batch, err := client.RawEvent.Query().
+			Where(where...).
+			Order(
+				ent.Asc(rawevent.FieldBlockHeight),
+				ent.Asc(rawevent.FieldTxIndex),
+				ent.Asc(rawevent.FieldEventIndex),
+			).
+			Limit(reindexBatchSize).
+			Offset(offset).
+			All(dbCtx)
+		if err != nil {
+			log.Fatal("Gagal membaca jurnal: ", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, raw := range batch {
+			if raw.BlockHeight != block.Height {
+				flush()
+				if ctx.Err() != nil {
+					log.Printf("Reindex dihentikan setelah block %d, jalankan lagi untuk melanjutkan", block.Height)
+					return
+				}
+				block = utils.RawEventBlock(raw)
+			}
+			ev, err := utils.RawEventToFlowEvent(raw)
+			if err != nil {
+				log.Fatalf("Jurnal block %d tidak valid: %v", raw.BlockHeight, err)
+			}
+			events = append(events, ev)
+		}
+		log.Printf("Replay %d event...", replayed)
+	}
+	flush()
+
+	// Reindex selesai, tidak ada yang perlu dilanjutkan
+	if err := utils.DeleteCheckpoint(dbCtx, client, reindexCheckpoint); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Reindex selesai: %d event diputar ulang dalam %s", replayed, time.Since(started).Round(time.Second))
+}
+
+// replayBlock menerapkan ulang event satu block dalam SATU transaksi DB,
+// bersama checkpoint 'reindex'. Seperti processBlock, transaksi yang
+// dibutuhkan handler diambil sebelum transaksi DB dibuka dan MetadataViews
+// dibaca setelah commit.
+func replayBlock(ctx context.Context, client *ent.Client, block utils.Block, events []flow.Event) (int, error) {
+	ctx = utils.WithBlock(ctx, block)
+	ctx = utils.PrefetchTransactions(ctx, network, events)
+	ctx, enrichQueue := utils.WithEnrichQueue(ctx)
+
+	replayed := 0
+	err := utils.WithTx(ctx, client, func(tx *ent.Tx) error {
+		txClient := tx.Client()
+
+		for _, ev := range events {
+			if err := utils.ApplyEvent(ctx, txClient, registry, block, ev); err != nil {
+				if errors.Is(err, utils.ErrInvalidEvent) {
+					if err := utils.DeadLetterEvent(ctx, txClient, block, ev, err); err != nil {
+						return err
+					}
+					continue
+				}
+				return fmt.Errorf("handler %s (tx %s) gagal: %w", ev.Type, ev.TransactionID, err)
+			}
+
+			// Event sudah berhasil diterapkan, tidak perlu di-retry lagi
+			if err := utils.ClearDeadLetter(ctx, txClient, ev); err != nil {
+				return err
+			}
+			replayed++
+		}
+		return utils.SaveCheckpoint(ctx, txClient, reindexCheckpoint, block)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	enrichQueue.Run(ctx, client)
+	return replayed, nil
+}
+
+// truncateProjections menghapus semua baris di tabel proyeksi.
+// Urutannya mengikuti foreign key: tabel yang mereferensikan dihapus lebih dulu.
+func truncateProjections(ctx context.Context, client *ent.Client) error {
+	steps := []struct {
+		name string
+		del  func() (int, error)
+	}{
+		{"pending_events", func() (int, error) { return client.PendingEvent.Delete().Exec(ctx) }},
+		{"attendances", func() (int, error) { return client.Attendance.Delete().Exec(ctx) }},
+		{"sales", func() (int, error) { return client.Sale.Delete().Exec(ctx) }},
+		{"listings", func() (int, error) { return client.Listing.Delete().Exec(ctx) }},
+		{"nft_accessories", func() (int, error) { return client.NFTAccessory.Delete().Exec(ctx) }},
+		{"gacha_receipts", func() (int, error) { return client.GachaReceipt.Delete().Exec(ctx) }},
+		{"transfers", func() (int, error) { return client.Transfer.Delete().Exec(ctx) }},
+		{"nft_moments", func() (int, error) { return client.NFTMoment.Delete().Exec(ctx) }},
+		{"event_passes", func() (int, error) { return client.EventPass.Delete().Exec(ctx) }},
+		{"events", func() (int, error) { return client.Event.Delete().Exec(ctx) }},
+		{"users", func() (int, error) { return client.User.Delete().Exec(ctx) }},
+	}
+
+	for _, step := range steps {
+		n, err := step.del()
+		if err != nil {
+			return fmt.Errorf("gagal mengosongkan %s: %w", step.name, err)
+		}
+		log.Printf("  %s: %d baris dihapus", step.name, n)
+	}
+	return nil
+}
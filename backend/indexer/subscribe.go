@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	grpcOpts "google.golang.org/grpc"
+)
+
+const (
+	// Batas waktu tunggu sebelum reconnect (exponential back-off)
+	minReconnectDelay = 1 * time.Second
+	maxReconnectDelay = 60 * time.Second
+)
+
+// errStreamClosed dikembalikan jika access node menutup stream tanpa error
+var errStreamClosed = errors.New("stream ditutup oleh access node")
+
+// blockProcessor memproses satu block berisi event yang sudah difilter.
+type blockProcessor func(ctx context.Context, block flow.BlockEvents) error
+
+// subscriber menjaga subscription event tetap hidup:
+// jika stream putus, ia reconnect dengan back-off dan melanjutkan
+// dari height terakhir yang sudah diproses.
+type subscriber struct {
+	host       string
+	filter     flow.EventFilter
+	process    blockProcessor
+	grpcClient *grpc.BaseClient
+
+	// Dipanggil setiap kali subscription berakhir karena error (opsional)
+	onError func(err error)
+
+	// Height berikutnya yang harus diminta ke access node
+	nextHeight uint64
+}
+
+func newSubscriber(host string, filter flow.EventFilter, startHeight uint64, process blockProcessor) *subscriber {
+	return &subscriber{
+		host:       host,
+		filter:     filter,
+		process:    process,
+		nextHeight: startHeight,
+	}
+}
+
+// close menutup gRPC client (jika ada).
+func (s *subscriber) close() {
+	if s.grpcClient != nil {
+		s.grpcClient.Close()
+		s.grpcClient = nil
+	}
+}
+
+// connect membuat gRPC client baru (menutup yang lama jika ada).
+func (s *subscriber) connect() error {
+	s.close()
+
+	grpcClient, err := grpc.NewBaseClient(
+		s.host,
+		grpcOpts.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return fmt.Errorf("gagal terhubung ke access node %s: %w", s.host, err)
+	}
+	s.grpcClient = grpcClient
+	return nil
+}
+
+// run menjalankan subscription sampai ctx dibatalkan.
+func (s *subscriber) run(ctx context.Context) {
+	delay := minReconnectDelay
+
+	for {
+		received, err := s.subscribeOnce(ctx)
+		if ctx.Err() != nil {
+			log.Println("Subscription dihentikan:", ctx.Err())
+			return
+		}
+
+		// Jika stream sempat mengirim block, reset back-off
+		if received {
+			delay = minReconnectDelay
+		}
+
+		log.Printf("Subscription berakhir (lanjut dari block %d): %v", s.nextHeight, err)
+		if s.onError != nil && err != nil {
+			s.onError(err)
+		}
+
+		// Tambahkan jitter agar reconnect tidak serempak
+		wait := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		log.Printf("Reconnect dalam %s...", wait)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+
+		// Buat ulang gRPC client, koneksi lama mungkin sudah rusak
+		reconnects.Inc()
+		if err := s.connect(); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// subscribeOnce membuka satu stream dan memproses block sampai stream berakhir.
+// Mengembalikan apakah ada block yang diterima, dan alasan stream berakhir.
+func (s *subscriber) subscribeOnce(ctx context.Context) (bool, error) {
+	if s.grpcClient == nil {
+		if err := s.connect(); err != nil {
+			return false, err
+		}
+	}
+
+	// Stream punya context sendiri supaya bisa ditutup saat keluar
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	log.Printf("Subscribe event mulai dari block %d", s.nextHeight)
+	dataCh, errCh, err := s.grpcClient.SubscribeEventsByBlockHeight(streamCtx, s.nextHeight, s.filter)
+	if err != nil {
+		return false, fmt.Errorf("gagal subscribe ke event: %w", err)
+	}
+
+	received := false
+	for {
+		select {
+		case <-ctx.Done():
+			return received, ctx.Err()
+
+		case data, ok := <-dataCh:
+			if !ok {
+				// Stream ditutup; cek apakah ada error yang menyertainya
+				select {
+				case err, ok := <-errCh:
+					if ok && err != nil {
+						return received, err
+					}
+				default:
+				}
+				return received, errStreamClosed
+			}
+			received = true
+
+			// Jangan mulai block baru jika sedang berhenti
+			if ctx.Err() != nil {
+				return received, ctx.Err()
+			}
+
+			// Block yang sudah dimulai diselesaikan (beserta checkpoint-nya)
+			// walaupun ctx dibatalkan di tengah jalan
+			if err := s.process(context.WithoutCancel(ctx), data); err != nil {
+				return received, fmt.Errorf("gagal memproses block %d: %w", data.Height, err)
+			}
+			s.nextHeight = data.Height + 1
+
+		case err, ok := <-errCh:
+			if !ok {
+				return received, errStreamClosed
+			}
+			if err != nil {
+				return received, err
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Package testdb menyediakan DB sqlite in-memory untuk test yang butuh ent.
+package testdb
+
+import (
+	"backend/ent"
+	"backend/ent/enttest"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open membuka DB sqlite in-memory dengan skema lengkap.
+// Setiap test mendapat DB sendiri dan DB ditutup saat test selesai.
+func Open(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
@@ -1,5 +0,0 @@
-package transactions
-
-const (
-	deployerAddress = "1bb6b1e0a5170088"
-)
@@ -1,27 +1,20 @@
 package transactions
 
 import (
-	"backend/utils" // Asumsi dari file Anda sebelumnya (untuk WaitForSeal)
 	"context"
-	"fmt"
 	"log"
-	"os"
 
-	"github.com/joho/godotenv"
 	"github.com/onflow/cadence"
 	"github.com/onflow/flow-go-sdk"
-	"github.com/onflow/flow-go-sdk/access"
-	"github.com/onflow/flow-go-sdk/access/http" // Menggunakan klien HTTP
-	"github.com/onflow/flow-go-sdk/crypto"
 )
 
 // Ini adalah skrip transaksi minting Anda
-// Saya telah menambahkan '0x%s' agar kita bisa menyuntikkan alamat kontrak
+// Import gaya flow.json, alamatnya di-resolve oleh TxService sesuai jaringan
 const mintFreeNFTMomentScriptTemplate = `
-import NonFungibleToken from 0x%s
-import NFTMoment from 0x%s
-import MetadataViews from 0x%s
-import EventPass from 0x%s
+import "NonFungibleToken"
+import "NFTMoment"
+import "MetadataViews"
+import "EventPass"
 
 transaction(
     recipient: Address,
@@ -73,120 +66,38 @@ transaction(
 }
 `
 
-func FreeMintNFTMoment(
+// FreeMintNFTMoment me-mint momen gratis (free mint) untuk 'recipient'.
+func (s *TxService) FreeMintNFTMoment(
+	ctx context.Context,
 	recipientAddressString string,
 	name string,
 	description string,
 	thumbnail string,
-) error {
-
-	// Muat .env
-	err := godotenv.Load()
-	if err != nil {
-		log.Println("Peringatan: Error loading .env file:", err)
-	}
-
-	ctx := context.Background()
-	var flowClient access.Client
-
-	// Koneksi Flow ke Emulator HTTP port
-	flowClient, err = http.NewClient(http.TestnetHost)
-	if err != nil {
-		return fmt.Errorf("gagal membuat flow client: %w", err)
-	}
-
-	// 1. SIAPKAN SIGNER (ADMIN/MINTER)
-	privateKeyHex := os.Getenv("PRIVATE_KEY") // Ambil dari .env
-	if privateKeyHex == "" {
-		return fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
-	}
-
-	// Gunakan alamat minter dari konstanta
-	minterFlowAddress := flow.HexToAddress(deployerAddress)
-	platformKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
-	if err != nil {
-		return fmt.Errorf("gagal decode private key: %w", err)
-	}
-
-	platformAccount, err := flowClient.GetAccount(ctx, minterFlowAddress)
-	if err != nil {
-		return fmt.Errorf("gagal mendapatkan akun minter %s: %w", minterFlowAddress.String(), err)
-	}
-
-	// Asumsi kita menggunakan key pertama (index 0)
-	key := platformAccount.Keys[0]
-	signer, err := crypto.NewInMemorySigner(platformKey, key.HashAlgo)
-	if err != nil {
-		return fmt.Errorf("gagal memuat signer: %w", err)
-	}
-
-	// 2. BUAT SKRIP TRANSAKSI
-	// Kita suntikkan alamat minter (yang juga alamat deployer) 2x
-	// 1x untuk 'NFTMoment' dan 1x untuk 'MetadataViews'
-	script := []byte(fmt.Sprintf(mintFreeNFTMomentScriptTemplate, deployerAddress, deployerAddress, deployerAddress, deployerAddress))
-
-	// 3. SIAPKAN ARGUMEN (4 Argumen)
-
-	// Helper function untuk argumen String (sama seperti template Anda)
-
-	// --- Buat Argumen ---
-	recipientAddressArg := cadence.NewAddress(flow.HexToAddress(recipientAddressString))
-
+) (flow.Identifier, error) {
 	nameArg, err := MakeStrArg(name)
 	if err != nil {
-		return err
+		return flow.EmptyID, err
 	}
-
 	descriptionArg, err := MakeStrArg(description)
 	if err != nil {
-		return err
+		return flow.EmptyID, err
 	}
-
 	thumbnailArg, err := MakeStrArg(thumbnail)
 	if err != nil {
-		return err
-	}
-
-	// 4. BUAT TRANSAKSI
-	latestBlock, err := flowClient.GetLatestBlock(ctx, true)
-	if err != nil {
-		return fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+		return flow.EmptyID, err
 	}
 
-	tx := flow.NewTransaction().
-		SetScript(script).
-		SetReferenceBlockID(latestBlock.ID).
-		SetPayer(minterFlowAddress). // Admin adalah 'Payer'
-		SetProposalKey(minterFlowAddress, key.Index, key.SequenceNumber).
-		AddAuthorizer(minterFlowAddress) // Admin adalah 'Authorizer'
-
-	// 5. TAMBAHKAN ARGUMEN
-	_ = tx.AddArgument(recipientAddressArg)
-	_ = tx.AddArgument(nameArg)
-	_ = tx.AddArgument(descriptionArg)
-	_ = tx.AddArgument(thumbnailArg)
-
-	// 6. TANDA TANGANI TRANSAKSI
-	err = tx.SignEnvelope(minterFlowAddress, key.Index, signer)
-	if err != nil {
-		return fmt.Errorf("gagal menandatangani transaksi: %w", err)
-	}
-
-	// 7. KIRIM TRANSAKSI
 	log.Println("Mengirim transaksi 'mint_nft_moment'...")
-	err = flowClient.SendTransaction(ctx, *tx)
-	if err != nil {
-		return fmt.Errorf("gagal mengirim transaksi: %w", err)
-	}
-
-	// 8. TUNGGU HASILNYA (SEAL)
-	// (Menggunakan 'utils.WaitForSeal' dari template Anda)
-	result, err := utils.WaitForSeal(ctx, flowClient, tx.ID())
+	txID, result, err := s.Submit(ctx, mintFreeNFTMomentScriptTemplate,
+		cadence.NewAddress(flow.HexToAddress(recipientAddressString)),
+		nameArg,
+		descriptionArg,
+		thumbnailArg,
+	)
 	if err != nil {
-		log.Printf("Transaksi %s gagal: %v\n", tx.ID(), err)
-		return fmt.Errorf("transaksi %s gagal: %w", tx.ID(), err)
+		return txID, err
 	}
 
-	log.Printf("Transaksi Mint NFTMoment Berhasil! 🔥 Status: %s. TX ID: %s", result.Status, tx.ID())
-	return nil // Sukses
+	log.Printf("Transaksi Mint NFTMoment Berhasil! 🔥 Status: %s. TX ID: %s", result.Status, txID)
+	return txID, nil
 }
@@ -0,0 +1,193 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// TxService mengirim transaksi admin (minter) ke Flow.
+// Dibuat sekali saat start: access client, signer & profil jaringan dipakai
+// bersama oleh semua transaksi admin.
+type TxService struct {
+	client     access.Client
+	network    *utils.Network
+	privateKey crypto.PrivateKey
+
+	// Akun admin/minter, yaitu akun tempat NFTMoment di-deploy
+	address flow.Address
+
+	mu sync.Mutex
+
+	// Key akun admin dimuat saat transaksi pertama (dan dicoba lagi jika
+	// gagal), supaya access node yang mati saat start tidak mematikan mint
+	ready    bool
+	keyIndex uint32
+	signer   crypto.Signer
+
+	// Sequence number proposal key disimpan lokal, supaya request yang
+	// berjalan bersamaan tidak memakai nomor yang sama.
+	// Nomor di chain baru cocok lagi jika tidak ada transaksi yang belum
+	// di-seal, jadi resync ditunda sampai inFlight = 0.
+	sequence uint64
+	inFlight int
+	resync   bool
+}
+
+// NewTxService menyiapkan TxService dengan private key admin (hex, ECDSA_P256).
+// Tidak menghubungi access node; key akun (index 0) dimuat saat Submit pertama.
+func NewTxService(client access.Client, network *utils.Network, privateKeyHex string) (*TxService, error) {
+	if privateKeyHex == "" {
+		return nil, fmt.Errorf("PRIVATE_KEY tidak ditemukan di environment variables")
+	}
+
+	minterAddress, err := network.ContractAddress("NFTMoment")
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, privateKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("gagal decode private key: %w", err)
+	}
+
+	return &TxService{
+		client:     client,
+		network:    network,
+		privateKey: privateKey,
+		address:    flow.HexToAddress(minterAddress),
+	}, nil
+}
+
+// Submit mengirim transaksi dengan admin sebagai proposer, payer & authorizer,
+// lalu menunggu sampai di-seal.
+// Import di template memakai gaya flow.json (`import "NFTMoment"`) dan
+// di-resolve sesuai jaringan.
+func (s *TxService) Submit(ctx context.Context, template string, args ...cadence.Value) (flow.Identifier, *flow.TransactionResult, error) {
+	script, err := utils.ResolveImports(s.network, []byte(template))
+	if err != nil {
+		return flow.EmptyID, nil, err
+	}
+
+	txID, err := s.send(ctx, script, args)
+	if err != nil {
+		return flow.EmptyID, nil, err
+	}
+
+	result, err := utils.WaitForSeal(ctx, s.client, txID)
+	s.finish(err)
+	if err != nil {
+		return txID, result, fmt.Errorf("transaksi %s gagal: %w", txID, err)
+	}
+	return txID, result, nil
+}
+
+// send membangun, menandatangani & mengirim transaksi.
+// Dikunci supaya sequence number dipakai berurutan.
+func (s *TxService) send(ctx context.Context, script []byte, args []cadence.Value) (flow.Identifier, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadKey(ctx); err != nil {
+		return flow.EmptyID, err
+	}
+	if s.resync && s.inFlight == 0 {
+		if err := s.refreshSequence(ctx); err != nil {
+			return flow.EmptyID, err
+		}
+	}
+
+	latestBlock, err := s.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return flow.EmptyID, fmt.Errorf("gagal mendapatkan block terbaru: %w", err)
+	}
+
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetReferenceBlockID(latestBlock.ID).
+		SetPayer(s.address).
+		SetProposalKey(s.address, s.keyIndex, s.sequence).
+		AddAuthorizer(s.address)
+
+	for _, arg := range args {
+		if err := tx.AddArgument(arg); err != nil {
+			return flow.EmptyID, fmt.Errorf("gagal menambahkan argumen: %w", err)
+		}
+	}
+
+	if err := tx.SignEnvelope(s.address, s.keyIndex, s.signer); err != nil {
+		return flow.EmptyID, fmt.Errorf("gagal menandatangani transaksi: %w", err)
+	}
+
+	if err := s.client.SendTransaction(ctx, *tx); err != nil {
+		// Sequence number lokal mungkin tertinggal/terlewat: ambil ulang dari chain
+		// begitu tidak ada transaksi lain yang masih berjalan
+		s.resync = true
+		return flow.EmptyID, fmt.Errorf("gagal mengirim transaksi: %w", err)
+	}
+	s.sequence++
+	s.inFlight++
+
+	log.Printf("Transaksi %s terkirim", tx.ID())
+	return tx.ID(), nil
+}
+
+// finish dipanggil setelah transaksi yang terkirim selesai ditunggu.
+// Transaksi yang tidak pernah masuk block (expired, timeout) tidak menaikkan
+// sequence number di chain, jadi sequence lokal perlu di-resync.
+func (s *TxService) finish(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight--
+	if err != nil {
+		s.resync = true
+	}
+}
+
+// loadKey memuat key akun admin & signer jika belum.
+// Harus dipanggil dengan s.mu terkunci.
+func (s *TxService) loadKey(ctx context.Context) error {
+	if s.ready {
+		return nil
+	}
+
+	account, err := s.client.GetAccount(ctx, s.address)
+	if err != nil {
+		return fmt.Errorf("gagal mendapatkan akun minter %s: %w", s.address, err)
+	}
+	if len(account.Keys) == 0 {
+		return fmt.Errorf("akun minter %s tidak punya key", s.address)
+	}
+
+	key := account.Keys[0]
+	signer, err := crypto.NewInMemorySigner(s.privateKey, key.HashAlgo)
+	if err != nil {
+		return fmt.Errorf("gagal memuat signer: %w", err)
+	}
+
+	s.keyIndex = key.Index
+	s.signer = signer
+	s.sequence = key.SequenceNumber
+	s.ready = true
+	return nil
+}
+
+// refreshSequence mengambil sequence number proposal key dari chain.
+// Harus dipanggil dengan s.mu terkunci dan tanpa transaksi yang berjalan.
+func (s *TxService) refreshSequence(ctx context.Context) error {
+	key, err := s.client.GetAccountKeyAtLatestBlock(ctx, s.address, s.keyIndex)
+	if err != nil {
+		return fmt.Errorf("gagal mengambil sequence number akun %s: %w", s.address, err)
+	}
+	s.sequence = key.SequenceNumber
+	s.resync = false
+	return nil
+}
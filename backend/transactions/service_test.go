@@ -0,0 +1,181 @@
+package transactions
+
+import (
+	"backend/utils"
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// fakeAccessClient hanya mengimplementasikan method yang dipakai TxService.send;
+// method lain panic (nil interface).
+type fakeAccessClient struct {
+	access.Client
+
+	mu         sync.Mutex
+	chainSeq   uint64 // sequence number proposal key menurut chain
+	accountErr error  // error GetAccount (simulasi access node mati)
+	sendErr    error  // error SendTransaction berikutnya
+	sent       []uint64
+}
+
+func (f *fakeAccessClient) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.accountErr != nil {
+		return nil, f.accountErr
+	}
+	return &flow.Account{
+		Address: address,
+		Keys:    []*flow.AccountKey{{Index: 0, HashAlgo: crypto.SHA3_256, SequenceNumber: f.chainSeq}},
+	}, nil
+}
+
+func (f *fakeAccessClient) GetAccountKeyAtLatestBlock(ctx context.Context, address flow.Address, keyIndex uint32) (*flow.AccountKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &flow.AccountKey{Index: keyIndex, SequenceNumber: f.chainSeq}, nil
+}
+
+func (f *fakeAccessClient) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{}, nil
+}
+
+func (f *fakeAccessClient) SendTransaction(ctx context.Context, tx flow.Transaction) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sendErr != nil {
+		err := f.sendErr
+		f.sendErr = nil
+		return err
+	}
+	f.sent = append(f.sent, tx.ProposalKey.SequenceNumber)
+	return nil
+}
+
+func (f *fakeAccessClient) lastSent(t *testing.T) uint64 {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.sent) == 0 {
+		t.Fatal("belum ada transaksi terkirim")
+	}
+	return f.sent[len(f.sent)-1]
+}
+
+func newTestService(t *testing.T, client *fakeAccessClient) *TxService {
+	t.Helper()
+	key, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, make([]byte, crypto.MinSeedLength))
+	if err != nil {
+		t.Fatal(err)
+	}
+	network := &utils.Network{Contracts: map[string]string{"NFTMoment": "f8d6e0586b0a20c7"}}
+	s, err := NewTxService(client, network, hex.EncodeToString(key.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTxServiceSequence(t *testing.T) {
+	ctx := context.Background()
+	script := []byte("transaction {}")
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, s *TxService, client *fakeAccessClient)
+	}{
+		{
+			name: "transaksi berurutan memakai sequence berikutnya",
+			run: func(t *testing.T, s *TxService, client *fakeAccessClient) {
+				for want := uint64(5); want < 8; want++ {
+					if _, err := s.send(ctx, script, nil); err != nil {
+						t.Fatal(err)
+					}
+					if got := client.lastSent(t); got != want {
+						t.Fatalf("sequence = %d, ingin %d", got, want)
+					}
+				}
+			},
+		},
+		{
+			name: "gagal seal tidak resync selama transaksi lain berjalan",
+			run: func(t *testing.T, s *TxService, client *fakeAccessClient) {
+				for i := 0; i < 3; i++ { // sequence 5, 6, 7
+					if _, err := s.send(ctx, script, nil); err != nil {
+						t.Fatal(err)
+					}
+				}
+				// Transaksi 5 expired; 6 & 7 masih berjalan, chain masih di 5
+				s.finish(errors.New("timeout"))
+
+				if _, err := s.send(ctx, script, nil); err != nil {
+					t.Fatal(err)
+				}
+				if got := client.lastSent(t); got != 8 {
+					t.Fatalf("sequence = %d, ingin 8 (tidak boleh memakai ulang nomor yang berjalan)", got)
+				}
+
+				// Semua selesai; chain ternyata di 7
+				s.finish(nil)
+				s.finish(nil)
+				s.finish(nil)
+				client.chainSeq = 7
+
+				if _, err := s.send(ctx, script, nil); err != nil {
+					t.Fatal(err)
+				}
+				if got := client.lastSent(t); got != 7 {
+					t.Fatalf("sequence = %d, ingin 7 (resync dari chain)", got)
+				}
+			},
+		},
+		{
+			name: "gagal kirim tanpa transaksi berjalan langsung resync",
+			run: func(t *testing.T, s *TxService, client *fakeAccessClient) {
+				client.sendErr = errors.New("invalid proposal key sequence number")
+				if _, err := s.send(ctx, script, nil); err == nil {
+					t.Fatal("ingin error kirim")
+				}
+
+				client.chainSeq = 9
+				if _, err := s.send(ctx, script, nil); err != nil {
+					t.Fatal(err)
+				}
+				if got := client.lastSent(t); got != 9 {
+					t.Fatalf("sequence = %d, ingin 9", got)
+				}
+			},
+		},
+		{
+			name: "akun admin dimuat ulang jika access node sempat mati",
+			run: func(t *testing.T, s *TxService, client *fakeAccessClient) {
+				client.accountErr = errors.New("unavailable")
+				if _, err := s.send(ctx, script, nil); err == nil {
+					t.Fatal("ingin error saat access node mati")
+				}
+
+				client.accountErr = nil
+				if _, err := s.send(ctx, script, nil); err != nil {
+					t.Fatal(err)
+				}
+				if got := client.lastSent(t); got != 5 {
+					t.Fatalf("sequence = %d, ingin 5", got)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeAccessClient{chainSeq: 5}
+			tt.run(t, newTestService(t, client), client)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/nftaccessory"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("AccessoryPack.AccessoryDistributed", NFTAccessoryMinted))
+}
+
+// AccessoryDistributedEvent: AccessoryPack.AccessoryDistributed
+type AccessoryDistributedEvent struct {
+	Recipient     cadence.Address `address:"recipient"`
+	ID            uint64          `cadence:"id"`
+	Name          string          `cadence:"name"`
+	Description   string          `cadence:"description"`
+	Thumbnail     string          `cadence:"thumbnail"`
+	EquipmentType string          `cadence:"equipmentType"`
+}
+
+func NFTAccessoryMinted(ctx context.Context, client *ent.Client, ev flow.Event, data *AccessoryDistributedEvent) error {
+	ownerAddress := data.Recipient.String()
+
+	// Replay (reindex tanpa truncate): aksesori yang sudah ada dilewati
+	exists, err := client.NFTAccessory.Query().Where(nftaccessory.NftIDEQ(data.ID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query NFTAccessory %d: %w", data.ID, err)
+	}
+	if exists {
+		log.Printf("NFTAccessory %d sudah ada di database, dilewati.", data.ID)
+		markIndexed(ctx, entityAccessory, data.ID)
+		return nil
+	}
+
+	isUserFound, err := getOrCreateUser(ctx, client, ownerAddress)
+	if err != nil {
+		return err
+	}
+
+	log.Println("User found", isUserFound)
+	nftMinted, err := client.NFTAccessory.Create().
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetThumbnail(data.Thumbnail).
+		SetNftID(data.ID).
+		SetOwnerID(isUserFound.ID).
+		SetEquipmentType(data.EquipmentType).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("error when create insert NFT: %w", err)
+	}
+	log.Println("nft minted", nftMinted)
+	markIndexed(ctx, entityAccessory, data.ID)
+
+	// Rarity hanya ada di MetadataViews, dibaca setelah commit
+	queueEnrichment(ctx, transfer.NftTypeAccessory, data.ID, data.Recipient)
+
+	// Aksesori dari gacha: tautkan ke receipt-nya
+	return linkGachaAccessory(ctx, client, ev, nftMinted, ownerAddress)
+}
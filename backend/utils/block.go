@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Block adalah info block tempat sebuah event terjadi.
+// Diteruskan ke handler lewat context karena flow.Event sendiri
+// tidak membawa height maupun timestamp.
+type Block struct {
+	Height    uint64
+	ID        flow.Identifier
+	Timestamp time.Time
+}
+
+type blockKey struct{}
+
+// WithBlock menyimpan info block ke context sebelum event di block itu diterapkan.
+func WithBlock(ctx context.Context, block Block) context.Context {
+	return context.WithValue(ctx, blockKey{}, block)
+}
+
+// BlockFromContext mengambil info block dari context (false jika tidak ada).
+func BlockFromContext(ctx context.Context) (Block, bool) {
+	block, ok := ctx.Value(blockKey{}).(Block)
+	return block, ok
+}
+
+// blockTime mengembalikan timestamp block dari context, atau waktu sekarang
+// jika tidak diketahui.
+func blockTime(ctx context.Context) time.Time {
+	if block, ok := BlockFromContext(ctx); ok && !block.Timestamp.IsZero() {
+		return block.Timestamp
+	}
+	return time.Now()
+}
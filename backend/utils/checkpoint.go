@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/checkpoint"
+	"context"
+	"fmt"
+	"time"
+)
+
+// IndexerCheckpoint adalah nama checkpoint milik proses indexer utama
+const IndexerCheckpoint = "indexer"
+
+// GetCheckpoint mengambil checkpoint berdasarkan nama.
+// Mengembalikan (nil, nil) jika checkpoint belum pernah disimpan.
+func GetCheckpoint(ctx context.Context, client *ent.Client, name string) (*ent.Checkpoint, error) {
+	cp, err := client.Checkpoint.Query().
+		Where(checkpoint.NameEQ(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("gagal query checkpoint %s: %w", name, err)
+	}
+	return cp, nil
+}
+
+// SaveCheckpoint menyimpan height, ID & waktu block terakhir yang sudah selesai diproses.
+// Error terakhir yang dicatat RecordCheckpointError dihapus, karena block
+// berikutnya sudah berhasil diproses.
+func SaveCheckpoint(ctx context.Context, client *ent.Client, name string, block Block) error {
+	cp, err := GetCheckpoint(ctx, client, name)
+	if err != nil {
+		return err
+	}
+
+	// Checkpoint belum ada, buat baru
+	if cp == nil {
+		_, err = client.Checkpoint.Create().
+			SetName(name).
+			SetBlockHeight(block.Height).
+			SetBlockID(block.ID.String()).
+			SetBlockTime(block.Timestamp).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("gagal membuat checkpoint %s: %w", name, err)
+		}
+		return nil
+	}
+
+	_, err = cp.Update().
+		SetBlockHeight(block.Height).
+		SetBlockID(block.ID.String()).
+		SetBlockTime(block.Timestamp).
+		ClearLastError().
+		ClearLastErrorAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengupdate checkpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// RecordCheckpointError mencatat error terakhir proses pemilik checkpoint,
+// supaya bisa dilihat lewat API status. Tidak melakukan apa-apa jika
+// checkpoint belum ada.
+func RecordCheckpointError(ctx context.Context, client *ent.Client, name string, cause error) error {
+	_, err := client.Checkpoint.Update().
+		Where(checkpoint.NameEQ(name)).
+		SetLastError(cause.Error()).
+		SetLastErrorAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mencatat error checkpoint %s: %w", name, err)
+	}
+	return nil
+}
+
+// DeleteCheckpoint menghapus checkpoint, misal setelah proses sekali jalan
+// (reindex) selesai sehingga tidak ada lagi yang perlu dilanjutkan.
+func DeleteCheckpoint(ctx context.Context, client *ent.Client, name string) error {
+	if _, err := client.Checkpoint.Delete().Where(checkpoint.NameEQ(name)).Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menghapus checkpoint %s: %w", name, err)
+	}
+	return nil
+}
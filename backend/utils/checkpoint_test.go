@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"backend/testdb"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSaveCheckpointClearsError(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+
+	if err := SaveCheckpoint(ctx, client, IndexerCheckpoint, Block{Height: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := RecordCheckpointError(ctx, client, IndexerCheckpoint, errors.New("block 11 gagal")); err != nil {
+		t.Fatal(err)
+	}
+	cp, err := GetCheckpoint(ctx, client, IndexerCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.LastError != "block 11 gagal" || cp.LastErrorAt == nil {
+		t.Fatalf("error terakhir = %q (%v), ingin tercatat", cp.LastError, cp.LastErrorAt)
+	}
+
+	// Block berikutnya berhasil: error terakhir tidak relevan lagi
+	if err := SaveCheckpoint(ctx, client, IndexerCheckpoint, Block{Height: 11}); err != nil {
+		t.Fatal(err)
+	}
+	cp, err = GetCheckpoint(ctx, client, IndexerCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.BlockHeight != 11 || cp.LastError != "" || cp.LastErrorAt != nil {
+		t.Errorf("checkpoint = %d, error %q (%v); ingin 11 tanpa error", cp.BlockHeight, cp.LastError, cp.LastErrorAt)
+	}
+}
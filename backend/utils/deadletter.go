@@ -0,0 +1,179 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/deadletter"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+const (
+	// Jeda retry pertama; setiap percobaan berikutnya dikali 2
+	deadLetterBaseDelay = 1 * time.Minute
+	// Jeda retry maksimum
+	deadLetterMaxDelay = 24 * time.Hour
+)
+
+// nextRetryDelay menghitung jeda sebelum percobaan ke-(attempts+1)
+func nextRetryDelay(attempts int) time.Duration {
+	delay := deadLetterBaseDelay
+	for i := 1; i < attempts && delay < deadLetterMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > deadLetterMaxDelay {
+		delay = deadLetterMaxDelay
+	}
+	return delay
+}
+
+// DeadLetterEvent menyimpan event yang gagal diterapkan ke tabel 'dead_letters'.
+// Jika event ini sudah ada di sana, jumlah percobaan & error-nya diperbarui.
+func DeadLetterEvent(ctx context.Context, client *ent.Client, block Block, ev flow.Event, cause error) error {
+	txID := ev.TransactionID.String()
+
+	existing, err := client.DeadLetter.Query().
+		Where(
+			deadletter.TxIDEQ(txID),
+			deadletter.EventIndexEQ(ev.EventIndex),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("gagal query dead letter %s #%d: %w", txID, ev.EventIndex, err)
+	}
+
+	// Sudah ada: anggap sebagai percobaan berikutnya
+	if existing != nil {
+		return markRetryFailed(ctx, client, existing, cause)
+	}
+
+	payload, err := encodePayload(ev)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DeadLetter.Create().
+		SetBlockHeight(block.Height).
+		SetBlockID(block.ID.String()).
+		SetBlockTime(block.Timestamp).
+		SetTxID(txID).
+		SetTxIndex(ev.TransactionIndex).
+		SetEventIndex(ev.EventIndex).
+		SetType(ev.Type).
+		SetPayload(payload).
+		SetError(cause.Error()).
+		SetNextRetryAt(time.Now().Add(nextRetryDelay(1))).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan dead letter %s #%d: %w", txID, ev.EventIndex, err)
+	}
+
+	log.Printf("Event %s (tx %s #%d) masuk dead letter: %v", ev.Type, txID, ev.EventIndex, cause)
+	return nil
+}
+
+// ClearDeadLetter menghapus event dari dead letter (jika ada),
+// misal setelah event tersebut berhasil diterapkan lewat reindex.
+func ClearDeadLetter(ctx context.Context, client *ent.Client, ev flow.Event) error {
+	_, err := client.DeadLetter.Delete().
+		Where(
+			deadletter.TxIDEQ(ev.TransactionID.String()),
+			deadletter.EventIndexEQ(ev.EventIndex),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menghapus dead letter %s #%d: %w", ev.TransactionID, ev.EventIndex, err)
+	}
+	return nil
+}
+
+// markRetryFailed mencatat percobaan yang gagal dan menjadwalkan retry berikutnya.
+func markRetryFailed(ctx context.Context, client *ent.Client, dl *ent.DeadLetter, cause error) error {
+	attempts := dl.Attempts + 1
+	_, err := dl.Update().
+		SetAttempts(attempts).
+		SetError(cause.Error()).
+		SetNextRetryAt(time.Now().Add(nextRetryDelay(attempts))).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengupdate dead letter %d: %w", dl.ID, err)
+	}
+	return nil
+}
+
+// DeadLetterToFlowEvent mengubah baris dead letter kembali menjadi flow.Event.
+func DeadLetterToFlowEvent(dl *ent.DeadLetter) (flow.Event, error) {
+	return decodeEvent(dl.Type, dl.TxID, dl.TxIndex, dl.EventIndex, dl.Payload)
+}
+
+// RetryDeadLetter mencoba menerapkan ulang satu event dari dead letter.
+// Jika berhasil, baris dead letter dihapus (dalam transaksi yang sama).
+// Jika handler masih menolak event-nya, percobaan dicatat dan dijadwalkan ulang.
+// Error hanya dikembalikan untuk masalah DB.
+func RetryDeadLetter(ctx context.Context, client *ent.Client, registry *Registry, dl *ent.DeadLetter) (bool, error) {
+	ev, err := DeadLetterToFlowEvent(dl)
+	if err != nil {
+		return false, markRetryFailed(ctx, client, dl, err)
+	}
+
+	block := Block{
+		Height:    dl.BlockHeight,
+		ID:        flow.HexToID(dl.BlockID),
+		Timestamp: dl.BlockTime,
+	}
+
+	var handlerErr error
+	ctx, enrichQueue := WithEnrichQueue(ctx)
+	err = WithTx(ctx, client, func(tx *ent.Tx) error {
+		txClient := tx.Client()
+		// Event yang kini menunggu dependensi dipindah ke 'pending_events'
+		if err := ApplyEvent(ctx, txClient, registry, block, ev); err != nil {
+			handlerErr = err
+			return err
+		}
+		return txClient.DeadLetter.DeleteOneID(dl.ID).Exec(ctx)
+	})
+	if err == nil {
+		log.Printf("Dead letter %d (%s) berhasil diterapkan ulang", dl.ID, dl.Type)
+		enrichQueue.Run(ctx, client)
+		return true, nil
+	}
+
+	// Handler masih gagal karena datanya: jadwalkan ulang
+	if handlerErr != nil && errors.Is(handlerErr, ErrInvalidEvent) {
+		return false, markRetryFailed(ctx, client, dl, handlerErr)
+	}
+	return false, err
+}
+
+// RetryDueDeadLetters mencoba ulang semua dead letter yang sudah waktunya.
+func RetryDueDeadLetters(ctx context.Context, client *ent.Client, registry *Registry) (retried int, failed int, err error) {
+	due, err := client.DeadLetter.Query().
+		Where(deadletter.NextRetryAtLTE(time.Now())).
+		Order(
+			ent.Asc(deadletter.FieldBlockHeight),
+			ent.Asc(deadletter.FieldTxIndex),
+			ent.Asc(deadletter.FieldEventIndex),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gagal query dead letter: %w", err)
+	}
+
+	for _, dl := range due {
+		ok, err := RetryDeadLetter(ctx, client, registry, dl)
+		if err != nil {
+			return retried, failed, err
+		}
+		if ok {
+			retried++
+		} else {
+			failed++
+		}
+	}
+	return retried, failed, nil
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"backend/ent/nftaccessory"
+	"backend/testdb"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNextRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Minute},
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 3, want: 4 * time.Minute},
+		{attempts: 11, want: 1024 * time.Minute},
+		{attempts: 12, want: 24 * time.Hour},
+		{attempts: 1000, want: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.attempts), func(t *testing.T) {
+			if got := nextRetryDelay(tt.attempts); got != tt.want {
+				t.Errorf("nextRetryDelay(%d) = %s, ingin %s", tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeadLetterEvent(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+	newTestRegistry(t)
+	ev := testEvent(t, "0a", 0, momentMintedPayload(1, alice))
+
+	// Percobaan pertama: dijadwalkan ulang setelah jeda awal
+	started := time.Now()
+	if err := DeadLetterEvent(ctx, client, Block{Height: 10}, ev, errors.New("gagal pertama")); err != nil {
+		t.Fatal(err)
+	}
+	dl := client.DeadLetter.Query().OnlyX(ctx)
+	if dl.Attempts != 1 {
+		t.Errorf("attempts = %d, ingin 1", dl.Attempts)
+	}
+	assertRetryAfter(t, dl.NextRetryAt, started, deadLetterBaseDelay)
+
+	// Event yang sama gagal lagi: dihitung sebagai percobaan berikutnya
+	started = time.Now()
+	if err := DeadLetterEvent(ctx, client, Block{Height: 10}, ev, errors.New("gagal kedua")); err != nil {
+		t.Fatal(err)
+	}
+	dl = client.DeadLetter.Query().OnlyX(ctx)
+	if dl.Attempts != 2 || dl.Error != "gagal kedua" {
+		t.Errorf("attempts = %d, error = %q; ingin 2, \"gagal kedua\"", dl.Attempts, dl.Error)
+	}
+	assertRetryAfter(t, dl.NextRetryAt, started, 2*deadLetterBaseDelay)
+}
+
+func TestRetryDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	// Aksesori 2 dipasang ke momen 1
+	equipPayload := `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.AccessoryEquipped","fields":[
+		{"name":"NftMomentId","value":{"type":"UInt64","value":"1"}},
+		{"name":"NftAccessoryId","value":{"type":"Optional","value":{"type":"UInt64","value":"2"}}},
+		{"name":"prevNFTAccessoryId","value":{"type":"Optional","value":null}}]}}`
+	// Tanpa aksesori: ditolak Validate, tidak pernah bisa diterapkan
+	invalidPayload := `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.AccessoryEquipped","fields":[
+		{"name":"NftMomentId","value":{"type":"UInt64","value":"1"}},
+		{"name":"NftAccessoryId","value":{"type":"Optional","value":null}},
+		{"name":"prevNFTAccessoryId","value":{"type":"Optional","value":null}}]}}`
+
+	t.Run("event yang masih tidak valid dijadwalkan ulang", func(t *testing.T) {
+		client := testdb.Open(t)
+		registry := newTestRegistry(t)
+		ev := testEvent(t, "0b", 0, invalidPayload)
+		if err := DeadLetterEvent(ctx, client, Block{Height: 10}, ev, errors.New("gagal")); err != nil {
+			t.Fatal(err)
+		}
+
+		started := time.Now()
+		ok, err := RetryDeadLetter(ctx, client, registry, client.DeadLetter.Query().OnlyX(ctx))
+		if ok || err != nil {
+			t.Fatalf("RetryDeadLetter = %t, %v; ingin false, nil", ok, err)
+		}
+		dl := client.DeadLetter.Query().OnlyX(ctx)
+		if dl.Attempts != 2 {
+			t.Errorf("attempts = %d, ingin 2", dl.Attempts)
+		}
+		assertRetryAfter(t, dl.NextRetryAt, started, 2*deadLetterBaseDelay)
+	})
+
+	t.Run("event yang berhasil dihapus dari dead letter", func(t *testing.T) {
+		client := testdb.Open(t)
+		registry := newTestRegistry(t)
+		ev := testEvent(t, "0c", 0, equipPayload)
+		if err := DeadLetterEvent(ctx, client, Block{Height: 10}, ev, errors.New("gagal")); err != nil {
+			t.Fatal(err)
+		}
+
+		// Penyebab gagalnya sudah tidak ada (momen & aksesori sudah terindeks)
+		owner := client.User.Create().SetAddress(alice).SaveX(ctx)
+		client.NFTMoment.Create().SetNftID(1).SetName("Sunset").SetDescription("").SetThumbnail("").SetOwner(owner).ExecX(ctx)
+		client.NFTAccessory.Create().SetNftID(2).SetName("Frame").SetDescription("").SetThumbnail("").
+			SetEquipmentType("frame").SetOwner(owner).ExecX(ctx)
+
+		ok, err := RetryDeadLetter(ctx, client, registry, client.DeadLetter.Query().OnlyX(ctx))
+		if !ok || err != nil {
+			t.Fatalf("RetryDeadLetter = %t, %v; ingin true, nil", ok, err)
+		}
+		if n := client.DeadLetter.Query().CountX(ctx); n != 0 {
+			t.Errorf("%d dead letter tersisa, ingin 0", n)
+		}
+		equipped := client.NFTAccessory.Query().Where(nftaccessory.NftIDEQ(2)).QueryEquippedOnMoment().OnlyX(ctx)
+		if equipped.NftID != 1 {
+			t.Errorf("aksesori 2 terpasang di momen %d, ingin 1", equipped.NftID)
+		}
+	})
+}
+
+// assertRetryAfter memastikan retry dijadwalkan 'delay' setelah 'started'.
+func assertRetryAfter(t *testing.T, nextRetryAt, started time.Time, delay time.Duration) {
+	t.Helper()
+	earliest := started.Add(delay)
+	latest := time.Now().Add(delay)
+	if nextRetryAt.Before(earliest.Add(-time.Second)) || nextRetryAt.After(latest.Add(time.Second)) {
+		t.Errorf("next_retry_at = %s, ingin sekitar %s", nextRetryAt, earliest)
+	}
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEvent menandai event yang tidak bisa diterapkan karena datanya
+// (field tidak valid, relasi belum ada di DB), bukan karena DB bermasalah.
+// Event seperti ini dilewati; mengulang block tidak akan membantu.
+var ErrInvalidEvent = errors.New("event tidak bisa diterapkan")
+
+func invalidEvent(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidEvent, fmt.Sprintf(format, args...))
+}
+
+// DependencyError menandai event yang membutuhkan baris yang belum terindeks
+// (misal EventPass.Minted sebelum EventCreated-nya). Event seperti ini
+// diparkir di 'pending_events' dan diterapkan ulang saat barisnya ada.
+type DependencyError struct {
+	Entity string
+	Key    string
+}
+
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("%v: menunggu %s %s", ErrInvalidEvent, e.Entity, e.Key)
+}
+
+// Unwrap membuat DependencyError tetap dikenali sebagai ErrInvalidEvent
+// oleh pemanggil yang tidak mendukung parkir (misal: masuk dead letter).
+func (e *DependencyError) Unwrap() error {
+	return ErrInvalidEvent
+}
+
+func missingDependency(entity string, key any) error {
+	return &DependencyError{Entity: entity, Key: fmt.Sprint(key)}
+}
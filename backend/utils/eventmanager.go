@@ -0,0 +1,209 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/attendance"
+	"backend/ent/event"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("EventManager.EventCreated", EventCreated))
+	RegisterHandler(newHandler("EventManager.UserRegistered", UserRegistered))
+	RegisterHandler(newHandler("EventManager.UserCheckedIn", UserCheckedIn))
+}
+
+// EventCreatedEvent: EventManager.EventCreated
+type EventCreatedEvent struct {
+	EventID      uint64          `cadence:"eventID"`
+	HostAddress  cadence.Address `address:"hostAddress"`
+	EventName    string          `cadence:"eventName"`
+	Description  string          `cadence:"description"`
+	ThumbnailURL string          `cadence:"thumbnailURL"`
+	EventType    uint8           `cadence:"eventType"`
+	Location     string          `cadence:"location"`
+	Lat          cadence.Fix64   `cadence:"lat"`
+	Long         cadence.Fix64   `cadence:"long"`
+	StartDate    cadence.UFix64  `cadence:"startDate"`
+	EndDate      cadence.UFix64  `cadence:"endDate"`
+	Quota        uint64          `cadence:"quota"`
+}
+
+func EventCreated(ctx context.Context, client *ent.Client, ev flow.Event, data *EventCreatedEvent) error {
+
+	// --- 1. Konversi Tipe Cadence ke Tipe Go ---
+
+	// Alamat
+	hostAddress := data.HostAddress.String()
+	eventID := data.EventID
+	// Fix64 ke Float64
+	latFloat := fix64ToFloat(data.Lat)
+	longFloat := fix64ToFloat(data.Long)
+	// UFix64 (Timestamp) ke time.Time
+	startDate := ufix64ToTime(data.StartDate)
+	endDate := ufix64ToTime(data.EndDate)
+
+	// --- 2. Cari Host (User) ---
+	// Jika User (Host) belum ada, buat baru
+	hostUser, err := getOrCreateUser(ctx, client, hostAddress)
+	if err != nil {
+		return err
+	}
+
+	// --- 3. Simpan Event Baru ke Database ---
+
+	// Cek dulu apakah event ini sudah kita indeks
+	_, err = client.Event.Query().
+		Where(event.EventIDEQ(eventID)).
+		Only(ctx)
+
+	// Jika 'err' BUKAN nil, berarti event belum ada (atau ada error lain)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Event belum ada, kita buat
+			newEvent, createErr := client.Event.Create().
+				SetEventID(eventID). // <-- Field unik Anda
+				SetName(data.EventName).
+				SetDescription(data.Description).
+				SetThumbnail(data.ThumbnailURL).
+				SetEventType(data.EventType).
+				SetLocation(data.Location).
+				SetLat(latFloat).
+				SetLong(longFloat).
+				SetStartDate(startDate).
+				SetEndDate(endDate).
+				SetQuota(data.Quota).
+				SetHost(hostUser). // <-- Tautkan ke User (Host)
+				Save(ctx)
+
+			if createErr != nil {
+				return fmt.Errorf("gagal menyimpan event baru ID %d: %w", eventID, createErr)
+			}
+			log.Printf("Event baru berhasil di-indeks: %s (ID: %d)", newEvent.Name, newEvent.EventID)
+			markIndexed(ctx, entityEvent, eventID)
+			return nil
+		}
+		// Error DB lain
+		return fmt.Errorf("error saat query event ID %d: %w", eventID, err)
+	}
+
+	// Jika err == nil, 'existingEvent' ditemukan
+	log.Printf("Event ID %d sudah ada di database, dilewati.", eventID)
+	return nil
+}
+
+// AttendanceEvent: EventManager.UserRegistered & EventManager.UserCheckedIn
+type AttendanceEvent struct {
+	EventID     uint64          `cadence:"eventID"`
+	UserAddress cadence.Address `address:"userAddress"`
+}
+
+// (Handler untuk event 'UserRegistered')
+func UserRegistered(ctx context.Context, client *ent.Client, ev flow.Event, data *AttendanceEvent) error {
+	userAddress := data.UserAddress.String()
+
+	// 1. Dapatkan 'User'
+	user, err := getOrCreateUser(ctx, client, userAddress)
+	if err != nil {
+		return err
+	}
+	// 2. Dapatkan 'Event'
+	event, err := client.Event.Query().Where(event.EventIDEQ(data.EventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return missingDependency(entityEvent, data.EventID)
+		}
+		return fmt.Errorf("error query event %d: %w", data.EventID, err)
+	}
+
+	// 3. Replay (reindex tanpa truncate): pendaftaran yang sudah ada dilewati
+	registered, err := attendanceExists(ctx, client, user.ID, event.ID)
+	if err != nil {
+		return fmt.Errorf("error query 'Attendance' event %d: %w", data.EventID, err)
+	}
+	if registered {
+		log.Printf("User %s sudah terdaftar di event %d, dilewati.", userAddress, data.EventID)
+		markIndexed(ctx, entityAttendance, attendanceKey(data.EventID, userAddress))
+		return nil
+	}
+
+	// 4. BUAT ENTRI 'ATTENDANCE' BARU
+	// Ini adalah "lem" (perekat) yang menghubungkan keduanya
+	_, err = client.Attendance.Create().
+		SetUser(user).       // Tautkan ke User
+		SetEvent(event).     // Tautkan ke Event
+		SetCheckedIn(false). // Set status (sesuai kontrak Anda)
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan 'Attendance': %w", err)
+	}
+	log.Println("User", user.Address, "berhasil mendaftar ke", event.Name)
+	markIndexed(ctx, entityAttendance, attendanceKey(data.EventID, userAddress))
+	return nil
+}
+
+// attendanceExists mengecek apakah user (ID DB) sudah terdaftar di event (ID DB).
+func attendanceExists(ctx context.Context, client *ent.Client, userID, eventID int) (bool, error) {
+	return client.Attendance.Query().
+		Where(
+			attendance.HasUserWith(user.ID(userID)),
+			attendance.HasEventWith(event.ID(eventID)),
+		).
+		Exist(ctx)
+}
+
+func UserCheckedIn(ctx context.Context, client *ent.Client, ev flow.Event, data *AttendanceEvent) error {
+	// --- 1. Konversi Tipe Go (Sama seperti 'Registered') ---
+	userAddress := data.UserAddress.String()
+	eventID := data.EventID
+
+	// --- 2. Cari 'Attendance' Record yang Spesifik ---
+	// Kita perlu mencari 'Attendance' yang menghubungkan User DAN Event ini.
+	// Kita bisa menggunakan 'WhereHas' untuk memfilter berdasarkan relasi.
+
+	attendanceRecord, err := client.Attendance.Query().
+		Where(
+			// Cari 'Attendance' yang...
+			// ...memiliki 'event' di mana 'event_id' cocok
+			attendance.HasEventWith(event.EventIDEQ(eventID)),
+			// ...DAN memiliki 'user' di mana 'address' cocok
+			attendance.HasUserWith(user.AddressEQ(userAddress)),
+		).
+		Only(ctx) // Kita harapkan hanya ada 1 hasil
+
+	if err != nil {
+		// Jika 'IsNotFound', berarti user ini tidak terdaftar
+		// atau event/user tidak ada.
+		// Check-in bisa datang lebih dulu dari register-nya (backfill), tunggu.
+		if ent.IsNotFound(err) {
+			return missingDependency(entityAttendance, attendanceKey(eventID, userAddress))
+		}
+		// Error database lain
+		return fmt.Errorf("error query 'Attendance': %w", err)
+	}
+
+	// (Opsional) Cek apakah sudah check-in agar tidak kerja dua kali
+	if attendanceRecord.CheckedIn {
+		log.Printf("User %s sudah check-in ke event %d, dilewati.", userAddress, eventID)
+		return nil
+	}
+
+	// --- 3. UPDATE 'Attendance' Record ---
+	// Kita sudah dapat 'attendanceRecord', sekarang kita update
+	_, err = attendanceRecord.Update().
+		SetCheckedIn(true). // Set status menjadi 'true'
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("gagal mengupdate 'Attendance' ke checked-in: %w", err)
+	}
+	log.Printf("User %s berhasil CHECK-IN ke event %d", userAddress, eventID)
+	return nil
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/event"
+	"backend/ent/eventpass"
+	"backend/ent/nftmoment"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("EventPass.Minted", EventPassMinted))
+}
+
+// EventPassMintedEvent: EventPass.Minted
+type EventPassMintedEvent struct {
+	ID          uint64          `cadence:"id"`
+	Owner       cadence.Address `address:"owner"`
+	Name        string          `cadence:"name"`
+	Description string          `cadence:"description"`
+	Thumbnail   string          `cadence:"thumbnail"`
+	EventType   uint8           `cadence:"eventType"`
+	EventID     uint64          `cadence:"eventID"`
+}
+
+func EventPassMinted(ctx context.Context, client *ent.Client, ev flow.Event, data *EventPassMintedEvent) error {
+
+	// --- 1. Konversi Tipe Go ---
+	recipientAddress := data.Owner.String()
+	// 'id' adalah ID unik dari pass SBT
+	passID := data.ID
+	// 'eventID' adalah ID dari 'EventManager'
+	eventID := data.EventID
+
+	// --- 2. Dapatkan Relasi (User & Event) ---
+
+	// Dapatkan 'User' (Pemilik)
+	ownerUser, err := getOrCreateUser(ctx, client, recipientAddress)
+	if err != nil {
+		return err
+	}
+
+	// Dapatkan 'Event' (Sumber)
+	sourceEvent, err := client.Event.Query().Where(event.EventIDEQ(eventID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Kita tidak bisa melanjutkan tanpa event: tunggu EventCreated-nya
+			return missingDependency(entityEvent, eventID)
+		}
+		return fmt.Errorf("error query event %d: %w", eventID, err)
+	}
+
+	// --- 3. Buat (atau Cek) 'EventPass' ---
+
+	// Cek dulu apakah 'EventPass' ini sudah ada
+	_, err = client.EventPass.Query().
+		Where(eventpass.PassIDEQ(passID)).
+		Only(ctx)
+
+	// Jika 'err' BUKAN nil (artinya 'Not Found' atau error lain)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Ini adalah alur yang baik (happy path), pass belum ada
+
+			// Buat 'EventPass' baru
+			newPass, createErr := client.EventPass.Create().
+				SetPassID(passID).
+				SetName(data.Name).
+				SetDescription(data.Description).
+				SetThumbnail(data.Thumbnail).
+				SetEventType(data.EventType).
+				SetIsUsed(false).      // Set default
+				SetOwner(ownerUser).   // <-- Tautkan ke User (Pemilik)
+				SetEvent(sourceEvent). // <-- Tautkan ke Event (Sumber)
+				Save(ctx)
+
+			if createErr != nil {
+				return fmt.Errorf("gagal menyimpan 'EventPass' baru (ID: %d): %w", passID, createErr)
+			}
+			log.Printf("Berhasil mengindeks 'EventPass' baru (ID: %d) untuk user %s", newPass.PassID, ownerUser.Address)
+
+			// Momen yang di-mint dengan pass ini sebelum pass-nya terindeks
+			waiting, err := client.NFTMoment.Query().
+				Where(
+					nftmoment.PassIDEQ(passID),
+					nftmoment.Not(nftmoment.HasMintedWithPass()),
+				).
+				Order(ent.Asc(nftmoment.FieldID)).
+				First(ctx)
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("error query momen untuk EventPass %d: %w", passID, err)
+			}
+			return linkMomentPass(ctx, client, waiting, newPass)
+		}
+		// Error database lain
+		return fmt.Errorf("error saat query EventPass %d: %w", passID, err)
+	}
+
+	// Jika err == nil, berarti pass sudah ada
+	log.Printf("EventPass (ID: %d) sudah ada di database, dilewati.", passID)
+	return nil
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/nftmoment"
+	"backend/testdb"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// mintWithPassTx adalah transaksi mintNFTWithEventPass dengan argumen 'eventPassID'.
+func mintWithPassTx(passID string) *flow.Transaction {
+	return &flow.Transaction{
+		Script:    []byte("transaction(eventPassID: UInt64) {}"),
+		Arguments: [][]byte{[]byte(`{"type":"UInt64","value":"` + passID + `"}`)},
+	}
+}
+
+func TestMomentMintedWithPass(t *testing.T) {
+	ctx := context.Background()
+	mintTx := flow.HexToID("0a")
+	minted := &NFTMomentMintedEvent{Recipient: mustAddress(alice), ID: 1, Name: "Sunset"}
+	passMinted := &EventPassMintedEvent{ID: 7, Owner: mustAddress(alice), Name: "Pass", EventID: 3}
+
+	// seedEvent membuat event sumber pass 7
+	seedEvent := func(t *testing.T, client *ent.Client) {
+		host := seedUser(t, client, bob)
+		client.Event.Create().SetEventID(3).SetName("Konser").SetDescription("").SetThumbnail("").
+			SetEventType(0).SetLocation("").SetLat(0).SetLong(0).
+			SetStartDate(time.Unix(0, 0)).SetEndDate(time.Unix(0, 0)).SetQuota(10).
+			SetHost(host).ExecX(ctx)
+	}
+	// assertLinked memastikan momen 1 tertaut ke pass 7 (atau tidak tertaut sama sekali)
+	assertLinked := func(t *testing.T, client *ent.Client, want bool) {
+		t.Helper()
+		moment := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(1)).WithMintedWithPass().OnlyX(ctx)
+		pass := moment.Edges.MintedWithPass
+		if want && (pass == nil || pass.PassID != 7 || !pass.IsUsed) {
+			t.Errorf("pass momen 1 = %+v, ingin pass 7 terpakai", pass)
+		}
+		if !want && pass != nil {
+			t.Errorf("momen 1 tertaut ke pass %d, ingin tidak tertaut", pass.PassID)
+		}
+	}
+	t.Cleanup(func() { SetTxLookup(nil) })
+
+	t.Run("pass sudah terindeks langsung ditautkan", func(t *testing.T) {
+		client := testdb.Open(t)
+		SetTxLookup(func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+			return mintWithPassTx("7"), nil
+		})
+		seedEvent(t, client)
+		if err := EventPassMinted(ctx, client, flow.Event{}, passMinted); err != nil {
+			t.Fatal(err)
+		}
+		if err := NFTMomentMinted(ctx, client, flow.Event{TransactionID: mintTx}, minted); err != nil {
+			t.Fatal(err)
+		}
+		assertLinked(t, client, true)
+	})
+
+	t.Run("pass belum terindeks ditautkan saat pass-nya terindeks", func(t *testing.T) {
+		client := testdb.Open(t)
+		SetTxLookup(func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+			return mintWithPassTx("7"), nil
+		})
+
+		// Momen tetap disimpan walau pass-nya belum ada
+		if err := NFTMomentMinted(ctx, client, flow.Event{TransactionID: mintTx}, minted); err != nil {
+			t.Fatal(err)
+		}
+		if n := client.PendingEvent.Query().CountX(ctx); n != 0 {
+			t.Fatalf("%d pending event, ingin 0", n)
+		}
+		moment := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(1)).OnlyX(ctx)
+		if moment.PassID == nil || *moment.PassID != 7 {
+			t.Fatalf("pass_id momen 1 = %v, ingin 7", moment.PassID)
+		}
+		assertLinked(t, client, false)
+
+		seedEvent(t, client)
+		if err := EventPassMinted(ctx, client, flow.Event{}, passMinted); err != nil {
+			t.Fatal(err)
+		}
+		assertLinked(t, client, true)
+	})
+
+	t.Run("gagal lookup menyimpan momen tanpa tautan", func(t *testing.T) {
+		client := testdb.Open(t)
+		SetTxLookup(func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+			return nil, errors.New("unavailable")
+		})
+		seedEvent(t, client)
+		if err := EventPassMinted(ctx, client, flow.Event{}, passMinted); err != nil {
+			t.Fatal(err)
+		}
+		if err := NFTMomentMinted(ctx, client, flow.Event{TransactionID: mintTx}, minted); err != nil {
+			t.Fatal(err)
+		}
+		assertLinked(t, client, false)
+	})
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Struct event (ditulis di file handler masing-masing, misal gacha.go) memetakan
+// event kontrak ke tipe Go lewat tag `cadence:"..."`.
+// Field wajib ditulis sebagai nilai biasa, field opsional (T?) sebagai pointer
+// sehingga nil di Cadence menjadi nil di Go (bukan panic saat type assertion).
+//
+// Field Address memakai tag `address:"..."`: cadence.DecodeFields tidak bisa
+// men-decode ke cadence.Address (array [8]byte), jadi diisi oleh decodeAddressFields.
+
+// validator diimplementasikan event yang butuh pengecekan tambahan setelah decode.
+type validator interface {
+	Validate() error
+}
+
+// decodeEventFields mengisi 'target' (pointer ke struct) dari field event,
+// lalu menjalankan Validate() jika ada.
+func decodeEventFields(ev flow.Event, target any) error {
+	if ev.Value.EventType == nil {
+		return fmt.Errorf("event %s tidak punya payload", ev.Type)
+	}
+	if err := cadence.DecodeFields(ev.Value, target); err != nil {
+		return err
+	}
+	if err := decodeAddressFields(ev.Value, target); err != nil {
+		return err
+	}
+	if v, ok := target.(validator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+var (
+	addressType    = reflect.TypeOf(cadence.Address{})
+	addressPtrType = reflect.TypeOf(&cadence.Address{})
+)
+
+// decodeAddressFields mengisi field bertag `address:"..."` (cadence.Address,
+// atau *cadence.Address untuk Address?) dari field composite.
+func decodeAddressFields(composite cadence.Composite, target any) error {
+	v := reflect.ValueOf(target).Elem()
+	t := v.Type()
+	fields := cadence.FieldsMappedByName(composite)
+
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("address")
+		if name == "" {
+			continue
+		}
+		value, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("%s field not found", name)
+		}
+
+		field := v.Field(i)
+		switch field.Type() {
+		case addressPtrType:
+			optional, ok := value.(cadence.Optional)
+			if !ok {
+				return fmt.Errorf("field %s bukan optional (tipe: %T)", name, value)
+			}
+			if optional.Value == nil {
+				field.SetZero()
+				continue
+			}
+			address, ok := optional.Value.(cadence.Address)
+			if !ok {
+				return fmt.Errorf("field %s bukan Address? (tipe: %T)", name, optional.Value)
+			}
+			field.Set(reflect.ValueOf(&address))
+		case addressType:
+			address, ok := value.(cadence.Address)
+			if !ok {
+				return fmt.Errorf("field %s bukan Address (tipe: %T)", name, value)
+			}
+			field.Set(reflect.ValueOf(address))
+		default:
+			return fmt.Errorf("tag address hanya untuk cadence.Address, bukan %s", field.Type())
+		}
+	}
+	return nil
+}
+
+// ufix64ToTime mengubah timestamp UFix64 (detik, 8 desimal) ke time.Time.
+func ufix64ToTime(v cadence.UFix64) time.Time {
+	return time.Unix(int64(uint64(v)/1e8), 0)
+}
+
+// ufix64ToFloat mengubah UFix64 ke float64 (misal harga).
+func ufix64ToFloat(v cadence.UFix64) float64 {
+	return float64(v) / 1e8
+}
+
+// fix64ToFloat mengubah Fix64 ke float64 (misal koordinat).
+func fix64ToFloat(v cadence.Fix64) float64 {
+	return float64(v) / 1e8
+}
+
+// typeID mengambil ID tipe dari nilai Type Cadence (misal "A.xxx.NFTMoment.NFT").
+func typeID(v cadence.TypeValue) string {
+	if v.StaticType == nil {
+		return ""
+	}
+	return v.StaticType.ID()
+}
@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+)
+
+func TestDecodeEventFields(t *testing.T) {
+	recipient := mustAddress("0x01cf0e2f2f715450")
+
+	tests := []struct {
+		name    string
+		payload string
+		target  any
+		want    any
+		// check dipakai jika 'want' tidak bisa dibandingkan langsung (TypeValue)
+		check   func(t *testing.T, got any)
+		wantErr string
+	}{
+		{
+			name: "NFTMoment.Minted",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.Minted","fields":[
+				{"name":"recipient","value":{"type":"Address","value":"0x01cf0e2f2f715450"}},
+				{"name":"id","value":{"type":"UInt64","value":"42"}},
+				{"name":"name","value":{"type":"String","value":"Sunset"}},
+				{"name":"description","value":{"type":"String","value":"Pantai"}},
+				{"name":"thumbnail","value":{"type":"String","value":"ipfs://moment"}}]}}`,
+			target: &NFTMomentMintedEvent{},
+			want: &NFTMomentMintedEvent{
+				Recipient:   recipient,
+				ID:          42,
+				Name:        "Sunset",
+				Description: "Pantai",
+				Thumbnail:   "ipfs://moment",
+			},
+		},
+		{
+			name: "EventManager.EventCreated",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.EventManager.EventCreated","fields":[
+				{"name":"eventID","value":{"type":"UInt64","value":"7"}},
+				{"name":"hostAddress","value":{"type":"Address","value":"0x01cf0e2f2f715450"}},
+				{"name":"eventName","value":{"type":"String","value":"Meetup"}},
+				{"name":"description","value":{"type":"String","value":"Flow"}},
+				{"name":"thumbnailURL","value":{"type":"String","value":"ipfs://event"}},
+				{"name":"eventType","value":{"type":"UInt8","value":"1"}},
+				{"name":"location","value":{"type":"String","value":"Jakarta"}},
+				{"name":"lat","value":{"type":"Fix64","value":"-6.20000000"}},
+				{"name":"long","value":{"type":"Fix64","value":"106.81666000"}},
+				{"name":"startDate","value":{"type":"UFix64","value":"1700000000.00000000"}},
+				{"name":"endDate","value":{"type":"UFix64","value":"1700003600.00000000"}},
+				{"name":"quota","value":{"type":"UInt64","value":"100"}}]}}`,
+			target: &EventCreatedEvent{},
+			want: &EventCreatedEvent{
+				EventID:      7,
+				HostAddress:  recipient,
+				EventName:    "Meetup",
+				Description:  "Flow",
+				ThumbnailURL: "ipfs://event",
+				EventType:    1,
+				Location:     "Jakarta",
+				Lat:          cadence.Fix64(-620000000),
+				Long:         cadence.Fix64(10681666000),
+				StartDate:    cadence.UFix64(170000000000000000),
+				EndDate:      cadence.UFix64(170000360000000000),
+				Quota:        100,
+			},
+		},
+		{
+			name: "UserProfile.ProfileUpdated dengan optional kosong",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.UserProfile.ProfileUpdated","fields":[
+				{"name":"address","value":{"type":"Address","value":"0x01cf0e2f2f715450"}},
+				{"name":"nickname","value":{"type":"Optional","value":{"type":"String","value":"budi"}}},
+				{"name":"bio","value":{"type":"String","value":"halo"}},
+				{"name":"socials","value":{"type":"Dictionary","value":[
+					{"key":{"type":"String","value":"x"},"value":{"type":"String","value":"@budi"}}]}},
+				{"name":"pfp","value":{"type":"Optional","value":null}},
+				{"name":"shortDescription","value":{"type":"Optional","value":null}},
+				{"name":"bgImage","value":{"type":"Optional","value":null}},
+				{"name":"highlightedEventPassIds","value":{"type":"Array","value":[
+					{"type":"Optional","value":{"type":"UInt64","value":"3"}},
+					{"type":"Optional","value":null}]}},
+				{"name":"highlightedMomentID","value":{"type":"Optional","value":null}}]}}`,
+			target: &ProfileUpdatedEvent{},
+			want: &ProfileUpdatedEvent{
+				Address:                 recipient,
+				Nickname:                ptr("budi"),
+				Bio:                     "halo",
+				Socials:                 map[string]string{"x": "@budi"},
+				HighlightedEventPassIDs: []*uint64{ptr(uint64(3)), nil},
+			},
+		},
+		{
+			name: "NonFungibleToken.Deposited ke alamat",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NonFungibleToken.Deposited","fields":[
+				{"name":"type","value":{"type":"String","value":"A.f8d6e0586b0a20c7.NFTMoment.NFT"}},
+				{"name":"id","value":{"type":"UInt64","value":"42"}},
+				{"name":"to","value":{"type":"Optional","value":{"type":"Address","value":"0x01cf0e2f2f715450"}}}]}}`,
+			target: &DepositedEvent{},
+			want: &DepositedEvent{
+				Type: "A.f8d6e0586b0a20c7.NFTMoment.NFT",
+				ID:   42,
+				To:   &recipient,
+			},
+		},
+		{
+			name: "NonFungibleToken.Withdrawn tanpa alamat",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NonFungibleToken.Withdrawn","fields":[
+				{"name":"type","value":{"type":"String","value":"A.f8d6e0586b0a20c7.NFTMoment.NFT"}},
+				{"name":"id","value":{"type":"UInt64","value":"42"}},
+				{"name":"from","value":{"type":"Optional","value":null}}]}}`,
+			target: &WithdrawnEvent{},
+			want: &WithdrawnEvent{
+				Type: "A.f8d6e0586b0a20c7.NFTMoment.NFT",
+				ID:   42,
+			},
+		},
+		{
+			name: "NFTStorefrontV2.ListingAvailable",
+			payload: `{"type":"Event","value":{"id":"A.2d55b98eb200daef.NFTStorefrontV2.ListingAvailable","fields":[
+				{"name":"storefrontAddress","value":{"type":"Address","value":"0x01cf0e2f2f715450"}},
+				{"name":"listingResourceID","value":{"type":"UInt64","value":"99"}},
+				{"name":"nftType","value":{"type":"Type","value":{"staticType":{"kind":"Resource","typeID":"A.f8d6e0586b0a20c7.NFTMoment.NFT","fields":[],"initializers":[],"type":""}}}},
+				{"name":"nftID","value":{"type":"UInt64","value":"42"}},
+				{"name":"salePaymentVaultType","value":{"type":"Type","value":{"staticType":{"kind":"Resource","typeID":"A.7e60df042a9c0868.FlowToken.Vault","fields":[],"initializers":[],"type":""}}}},
+				{"name":"salePrice","value":{"type":"UFix64","value":"12.50000000"}},
+				{"name":"expiry","value":{"type":"UInt64","value":"1700000000"}}]}}`,
+			target: &ListingAvailableEvent{},
+			check: func(t *testing.T, got any) {
+				data := got.(*ListingAvailableEvent)
+				if data.StorefrontAddress != recipient {
+					t.Errorf("storefrontAddress = %s", data.StorefrontAddress)
+				}
+				if data.ListingResourceID != 99 || data.NFTID != 42 || data.Expiry != 1700000000 {
+					t.Errorf("ID listing/NFT/expiry salah: %+v", data)
+				}
+				if id := typeID(data.NFTType); id != "A.f8d6e0586b0a20c7.NFTMoment.NFT" {
+					t.Errorf("nftType = %s", id)
+				}
+				if price := ufix64ToFloat(data.SalePrice); price != 12.5 {
+					t.Errorf("salePrice = %v", price)
+				}
+			},
+		},
+		{
+			name: "AccessoryEquipped tanpa aksesori ditolak Validate",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.AccessoryEquipped","fields":[
+				{"name":"NftMomentId","value":{"type":"UInt64","value":"42"}},
+				{"name":"NftAccessoryId","value":{"type":"Optional","value":null}},
+				{"name":"prevNFTAccessoryId","value":{"type":"Optional","value":null}}]}}`,
+			target:  &AccessoryEquippedEvent{},
+			wantErr: "NftAccessoryId kosong",
+		},
+		{
+			name: "field address hilang",
+			payload: `{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.UserProfile.UserVerified","fields":[
+				{"name":"user","value":{"type":"Address","value":"0x01cf0e2f2f715450"}}]}}`,
+			target:  &UserVerifiedEvent{},
+			wantErr: "address field not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := decodeEventFields(eventFromJSON(t, tt.payload), tt.target)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, ingin mengandung %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decode gagal: %v", err)
+			}
+			if tt.check != nil {
+				tt.check(t, tt.target)
+				return
+			}
+			if !reflect.DeepEqual(tt.target, tt.want) {
+				t.Errorf("hasil decode\n got: %+v\nwant: %+v", tt.target, tt.want)
+			}
+		})
+	}
+}
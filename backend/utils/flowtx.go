@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// TxLookup mengambil transaksi Flow berdasarkan ID-nya.
+// Dipakai jika event tidak membawa semua data yang dibutuhkan, misal:
+//   - AccessoryPackOpened tidak membawa alamat pembuka (authorizer)
+//   - NFTMoment.Minted tidak membawa ID EventPass yang dipakai (argumen transaksi)
+type TxLookup func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error)
+
+var txLookup TxLookup
+
+// SetTxLookup mengatur lookup transaksi (biasanya lewat access node).
+// Jika tidak diatur atau gagal, data tersebut dilewati (pembuka receipt diisi
+// saat reveal, momen disimpan tanpa tautan EventPass).
+func SetTxLookup(lookup TxLookup) {
+	txLookup = lookup
+}
+
+// Event yang handler-nya membaca transaksinya lewat lookupTransaction
+var txLookupEvents = []string{
+	"NFTMoment.Minted",
+	"AccessoryPack.AccessoryPackOpened",
+}
+
+type prefetchedTxKey struct{}
+
+// prefetchedTx adalah hasil satu lookup transaksi, termasuk jika gagal.
+type prefetchedTx struct {
+	tx  *flow.Transaction
+	err error
+}
+
+// PrefetchTransactions mengambil transaksi untuk event di txLookupEvents
+// SEBELUM transaksi DB block dibuka, supaya transaksi DB tidak tertahan oleh
+// network. Lookup yang gagal juga disimpan: handler melewati data tersebut
+// tanpa menghubungi access node lagi.
+func PrefetchTransactions(ctx context.Context, network *Network, events []flow.Event) context.Context {
+	if txLookup == nil {
+		return ctx
+	}
+
+	wanted := make(map[string]bool, len(txLookupEvents))
+	for _, suffix := range txLookupEvents {
+		if qualified, err := network.QualifiedType(suffix); err == nil {
+			wanted[qualified] = true
+		}
+	}
+
+	fetched := make(map[flow.Identifier]prefetchedTx)
+	for _, ev := range events {
+		if !wanted[ev.Type] {
+			continue
+		}
+		if _, ok := fetched[ev.TransactionID]; ok {
+			continue
+		}
+		tx, err := txLookup(ctx, ev.TransactionID)
+		if err != nil {
+			err = fmt.Errorf("gagal mengambil transaksi %s: %w", ev.TransactionID, err)
+			log.Printf("Peringatan: %v", err)
+		}
+		fetched[ev.TransactionID] = prefetchedTx{tx: tx, err: err}
+	}
+	if len(fetched) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, prefetchedTxKey{}, fetched)
+}
+
+// lookupTransaction mengambil transaksi dari hasil PrefetchTransactions, atau
+// lewat txLookup jika tidak ada (reindex, retry dead letter, event pending).
+// Mengembalikan nil tanpa error jika lookup tidak diatur.
+func lookupTransaction(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+	if fetched, ok := ctx.Value(prefetchedTxKey{}).(map[flow.Identifier]prefetchedTx); ok {
+		if result, ok := fetched[txID]; ok {
+			return result.tx, result.err
+		}
+	}
+	if txLookup == nil {
+		return nil, nil
+	}
+	tx, err := txLookup(ctx, txID)
+	if err != nil {
+		return nil, fmt.Errorf("gagal mengambil transaksi %s: %w", txID, err)
+	}
+	return tx, nil
+}
+
+// txParamsPattern mengambil daftar parameter 'transaction(...)' dari script
+var txParamsPattern = regexp.MustCompile(`transaction\s*\(([^)]*)\)`)
+
+// txArgument mengembalikan argumen transaksi dengan nama parameter 'name'.
+// ok bernilai false jika script tidak punya parameter tersebut.
+func txArgument(tx *flow.Transaction, name string) (value cadence.Value, ok bool, err error) {
+	match := txParamsPattern.FindSubmatch(tx.Script)
+	if match == nil {
+		return nil, false, nil
+	}
+
+	for i, param := range strings.Split(string(match[1]), ",") {
+		paramName, _, _ := strings.Cut(param, ":")
+		if strings.TrimSpace(paramName) != name {
+			continue
+		}
+		if i >= len(tx.Arguments) {
+			return nil, false, fmt.Errorf("transaksi %s tidak punya argumen '%s'", tx.ID(), name)
+		}
+		value, err := jsoncdc.Decode(nil, tx.Arguments[i])
+		if err != nil {
+			return nil, false, fmt.Errorf("gagal decode argumen '%s' transaksi %s: %w", name, tx.ID(), err)
+		}
+		return value, true, nil
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestPrefetchTransactions(t *testing.T) {
+	network := testNetwork()
+	t.Cleanup(func() { SetTxLookup(nil) })
+
+	mintTx := flow.HexToID("01")
+	otherTx := flow.HexToID("02")
+	events := []flow.Event{
+		{Type: "A.f8d6e0586b0a20c7.NFTMoment.Minted", TransactionID: mintTx},
+		{Type: "A.f8d6e0586b0a20c7.NFTMoment.Minted", TransactionID: mintTx},
+		{Type: "A.f8d6e0586b0a20c7.NFTMoment.Withdrawn", TransactionID: otherTx},
+	}
+
+	t.Run("hanya transaksi mint yang diambil, sekali per transaksi", func(t *testing.T) {
+		var calls []flow.Identifier
+		SetTxLookup(func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+			calls = append(calls, txID)
+			return &flow.Transaction{}, nil
+		})
+
+		ctx := PrefetchTransactions(context.Background(), network, events)
+		if len(calls) != 1 || calls[0] != mintTx {
+			t.Fatalf("lookup dipanggil untuk %v, ingin hanya %s", calls, mintTx)
+		}
+
+		// Transaksi yang sudah diambil tidak menghubungi access node lagi
+		if _, err := lookupTransaction(ctx, mintTx); err != nil {
+			t.Fatal(err)
+		}
+		if len(calls) != 1 {
+			t.Fatalf("lookup dipanggil %d kali, ingin 1", len(calls))
+		}
+
+		// Di luar prefetch (misal retry dead letter) tetap lewat lookup
+		if _, err := lookupTransaction(ctx, otherTx); err != nil {
+			t.Fatal(err)
+		}
+		if len(calls) != 2 {
+			t.Fatalf("lookup dipanggil %d kali, ingin 2", len(calls))
+		}
+	})
+
+	t.Run("gagal lookup disimpan, tidak diulang di handler", func(t *testing.T) {
+		unavailable := errors.New("unavailable")
+		calls := 0
+		SetTxLookup(func(ctx context.Context, txID flow.Identifier) (*flow.Transaction, error) {
+			calls++
+			return nil, unavailable
+		})
+
+		ctx := PrefetchTransactions(context.Background(), network, events)
+		if _, err := lookupTransaction(ctx, mintTx); !errors.Is(err, unavailable) {
+			t.Fatalf("error = %v, ingin %v", err, unavailable)
+		}
+		if calls != 1 {
+			t.Fatalf("lookup dipanggil %d kali, ingin 1", calls)
+		}
+	})
+
+	t.Run("tanpa lookup tidak ada yang diambil", func(t *testing.T) {
+		SetTxLookup(nil)
+
+		ctx := PrefetchTransactions(context.Background(), network, events)
+		tx, err := lookupTransaction(ctx, mintTx)
+		if tx != nil || err != nil {
+			t.Fatalf("lookupTransaction = %v, %v; ingin nil, nil", tx, err)
+		}
+	})
+}
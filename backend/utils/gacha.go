@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/gachareceipt"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("AccessoryPack.AccessoryPackOpened", AccessoryPackOpened))
+	RegisterHandler(newHandler("AccessoryPack.AccessoryPackRevealed", AccessoryPackRevealed))
+}
+
+// AccessoryPackOpenedEvent: AccessoryPack.AccessoryPackOpened
+type AccessoryPackOpenedEvent struct {
+	CommitBlock uint64 `cadence:"commitBlock"`
+	ReceiptID   uint64 `cadence:"receiptID"`
+}
+
+// AccessoryPackOpened mencatat receipt gacha baru (tahap commit).
+func AccessoryPackOpened(ctx context.Context, client *ent.Client, ev flow.Event, data *AccessoryPackOpenedEvent) error {
+	// 1. Cek duplikat
+	exists, err := client.GachaReceipt.Query().
+		Where(gachareceipt.ReceiptIDEQ(data.ReceiptID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query receipt %d: %w", data.ReceiptID, err)
+	}
+	if exists {
+		log.Printf("Receipt %d sudah ada di database, dilewati.", data.ReceiptID)
+		return nil
+	}
+
+	block, _ := BlockFromContext(ctx)
+	create := client.GachaReceipt.Create().
+		SetReceiptID(data.ReceiptID).
+		SetCommitBlock(data.CommitBlock).
+		SetOpenTxID(ev.TransactionID.String()).
+		SetOpenBlock(block.Height).
+		SetCreatedAt(blockTime(ctx))
+
+	// 2. Cari pembuka dari authorizer transaksi, karena event tidak membawa
+	//    alamat pembuka. Gagal lookup tidak fatal: pembuka diisi lagi saat reveal.
+	tx, err := lookupTransaction(ctx, ev.TransactionID)
+	switch {
+	case err != nil:
+		log.Printf("Gagal mencari pembuka receipt %d: %v", data.ReceiptID, err)
+	case tx == nil:
+	case len(tx.Authorizers) == 0:
+		log.Printf("Transaksi %s tidak punya authorizer (receipt %d)", ev.TransactionID, data.ReceiptID)
+	default:
+		create.SetOpener(tx.Authorizers[0].HexWithPrefix())
+	}
+
+	// 3. Simpan receipt
+	receipt, err := create.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan receipt %d: %w", data.ReceiptID, err)
+	}
+	log.Printf("Receipt gacha %d dibuka oleh %s (commit block %d)", receipt.ReceiptID, receipt.Opener, receipt.CommitBlock)
+	markIndexed(ctx, entityReceipt, data.ReceiptID)
+	return nil
+}
+
+// AccessoryPackRevealedEvent: AccessoryPack.AccessoryPackRevealed
+type AccessoryPackRevealedEvent struct {
+	Rarity      uint8  `cadence:"rarity"`
+	CommitBlock uint64 `cadence:"commitBlock"`
+	ReceiptID   uint64 `cadence:"receiptID"`
+}
+
+// AccessoryPackRevealed menandai receipt sudah di-reveal beserta rarity-nya.
+// Aksesori hasilnya ditautkan oleh AccessoryDistributed di transaksi yang sama.
+func AccessoryPackRevealed(ctx context.Context, client *ent.Client, ev flow.Event, data *AccessoryPackRevealedEvent) error {
+	receipt, err := client.GachaReceipt.Query().
+		Where(gachareceipt.ReceiptIDEQ(data.ReceiptID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return missingDependency(entityReceipt, data.ReceiptID)
+		}
+		return fmt.Errorf("error query receipt %d: %w", data.ReceiptID, err)
+	}
+
+	block, _ := BlockFromContext(ctx)
+	_, err = receipt.Update().
+		SetRarity(data.Rarity).
+		SetRevealTxID(ev.TransactionID.String()).
+		SetRevealBlock(block.Height).
+		SetRevealedAt(blockTime(ctx)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengupdate receipt %d: %w", data.ReceiptID, err)
+	}
+	log.Printf("Receipt gacha %d di-reveal (rarity %d)", data.ReceiptID, data.Rarity)
+	return nil
+}
+
+// linkGachaAccessory menautkan aksesori hasil AccessoryDistributed ke receipt
+// yang di-reveal di transaksi yang sama. Penerima aksesori adalah pembuka receipt.
+func linkGachaAccessory(ctx context.Context, client *ent.Client, ev flow.Event, accessory *ent.NFTAccessory, recipient string) error {
+	receipt, err := client.GachaReceipt.Query().
+		Where(gachareceipt.RevealTxIDEQ(ev.TransactionID.String())).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Aksesori tidak berasal dari reveal gacha
+			return nil
+		}
+		return fmt.Errorf("error query receipt untuk tx %s: %w", ev.TransactionID, err)
+	}
+
+	update := receipt.Update().SetAccessory(accessory)
+	if receipt.Opener == "" {
+		update.SetOpener(recipient)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("gagal menautkan aksesori %d ke receipt %d: %w", accessory.NftID, receipt.ReceiptID, err)
+	}
+	log.Printf("Aksesori %d ditautkan ke receipt gacha %d", accessory.NftID, receipt.ReceiptID)
+	return nil
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"backend/ent"
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// typedHandler menghubungkan struct event dengan fungsi penyimpanannya.
+// Keduanya ditulis di file yang sama dan didaftarkan di init() file tersebut
+// (misal gacha.go). Decode dan Apply terpisah sehingga masing-masing bisa
+// diuji sendiri.
+type typedHandler[T any] struct {
+	suffix string
+	apply  func(ctx context.Context, client *ent.Client, ev flow.Event, data *T) error
+}
+
+// newHandler membuat EventHandler untuk event bertipe T.
+func newHandler[T any](suffix string, apply func(ctx context.Context, client *ent.Client, ev flow.Event, data *T) error) EventHandler {
+	return typedHandler[T]{suffix: suffix, apply: apply}
+}
+
+func (h typedHandler[T]) EventSuffix() string {
+	return h.suffix
+}
+
+// Decode mengisi struct T dari field event lewat tag `cadence`.
+func (h typedHandler[T]) Decode(ev flow.Event) (any, error) {
+	data := new(T)
+	if err := decodeEventFields(ev, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (h typedHandler[T]) Apply(ctx context.Context, client *ent.Client, ev flow.Event, data any) error {
+	typed, ok := data.(*T)
+	if !ok {
+		return fmt.Errorf("data %T bukan untuk handler %s", data, h.suffix)
+	}
+	return h.apply(ctx, client, ev, typed)
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Semua kontrak di test memakai alamat emulator
+const testContractAddress = "f8d6e0586b0a20c7"
+
+const (
+	alice = "0x01cf0e2f2f715450"
+	bob   = "0x179b6b1cb6755e31"
+)
+
+// Tipe NFT lengkap di test (lihat nftKindOf)
+const (
+	testMomentType    = "A." + testContractAddress + ".NFTMoment.NFT"
+	testAccessoryType = "A." + testContractAddress + ".NFTAccessory.NFT"
+)
+
+// testNetwork adalah profil jaringan test: semua kontrak di testContractAddress.
+func testNetwork() *Network {
+	network := &Network{Name: "test", Contracts: make(map[string]string)}
+	for _, contract := range []string{
+		"NFTMoment", "NFTAccessory", "AccessoryPack", "EventManager",
+		"EventPass", "UserProfile", "NFTStorefrontV2", "NonFungibleToken",
+	} {
+		network.Contracts[contract] = testContractAddress
+	}
+	return network
+}
+
+// testContext adalah context handler dengan profil jaringan test,
+// seperti yang diisi Registry.Handle.
+func testContext() context.Context {
+	return withNetwork(context.Background(), testNetwork())
+}
+
+// newTestRegistry membangun registry dengan semua handler terdaftar.
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	registry, err := NewRegistry(testNetwork())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+// eventFromJSON membangun flow.Event dari payload JSON-CDC (format yang
+// dikirim access node).
+func eventFromJSON(t *testing.T, payload string) flow.Event {
+	t.Helper()
+	value, err := jsoncdc.Decode(nil, []byte(payload))
+	if err != nil {
+		t.Fatalf("payload JSON-CDC tidak valid: %v", err)
+	}
+	event, ok := value.(cadence.Event)
+	if !ok {
+		t.Fatalf("payload bukan event: %T", value)
+	}
+	return flow.Event{Type: event.EventType.ID(), Value: event}
+}
+
+// testEvent membangun event dari payload JSON-CDC di transaksi 'txID'.
+func testEvent(t *testing.T, txID string, eventIndex int, payload string) flow.Event {
+	t.Helper()
+	ev := eventFromJSON(t, payload)
+	ev.TransactionID = flow.HexToID(txID)
+	ev.EventIndex = eventIndex
+	return ev
+}
+
+// seedUser mengambil user 'address', atau membuatnya jika belum ada.
+func seedUser(t *testing.T, client *ent.Client, address string) *ent.User {
+	t.Helper()
+	ctx := context.Background()
+	if u, err := client.User.Query().Where(user.AddressEQ(address)).Only(ctx); err == nil {
+		return u
+	}
+	return client.User.Create().SetAddress(address).SaveX(ctx)
+}
+
+// seedMoment menyimpan momen 'nftID' milik 'owner'.
+func seedMoment(t *testing.T, client *ent.Client, nftID uint64, owner string) *ent.NFTMoment {
+	t.Helper()
+	return client.NFTMoment.Create().
+		SetNftID(nftID).
+		SetName("Sunset").
+		SetDescription("Pantai").
+		SetThumbnail("ipfs://moment").
+		SetOwner(seedUser(t, client, owner)).
+		SaveX(context.Background())
+}
+
+// seedAccessory menyimpan aksesori 'nftID' milik 'owner'.
+func seedAccessory(t *testing.T, client *ent.Client, nftID uint64, owner string) *ent.NFTAccessory {
+	t.Helper()
+	return client.NFTAccessory.Create().
+		SetNftID(nftID).
+		SetName("Frame").
+		SetDescription("Bingkai").
+		SetThumbnail("ipfs://accessory").
+		SetEquipmentType("frame").
+		SetOwner(seedUser(t, client, owner)).
+		SaveX(context.Background())
+}
+
+// typeValue membangun nilai Type Cadence untuk resource 'id' (misal tipe NFT di listing).
+func typeValue(t *testing.T, id string) cadence.TypeValue {
+	t.Helper()
+	payload := fmt.Sprintf(`{"type":"Type","value":{"staticType":{"kind":"Resource","typeID":"%s","fields":[],"initializers":[],"type":""}}}`, id)
+	value, err := jsoncdc.Decode(nil, []byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value.(cadence.TypeValue)
+}
+
+func mustAddress(hex string) cadence.Address {
+	return cadence.NewAddress(flow.HexToAddress(hex))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// momentMintedPayload adalah payload JSON-CDC NFTMoment.Minted.
+func momentMintedPayload(nftID uint64, recipient string) string {
+	return fmt.Sprintf(`{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.Minted","fields":[
+		{"name":"recipient","value":{"type":"Address","value":"%s"}},
+		{"name":"id","value":{"type":"UInt64","value":"%d"}},
+		{"name":"name","value":{"type":"String","value":"Sunset"}},
+		{"name":"description","value":{"type":"String","value":"Pantai"}},
+		{"name":"thumbnail","value":{"type":"String","value":"ipfs://moment"}}]}}`, recipient, nftID)
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/eventcount"
+	"backend/ent/rawevent"
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// JournalEvent menyimpan event mentah ke tabel 'raw_events'.
+// Mengembalikan false jika event ini (tx ID + event index) sudah pernah dijurnal,
+// artinya event tersebut sudah diproses sebelumnya.
+func JournalEvent(ctx context.Context, client *ent.Client, block Block, ev flow.Event) (bool, error) {
+	txID := ev.TransactionID.String()
+
+	// 1. Cek duplikat
+	exists, err := client.RawEvent.Query().
+		Where(
+			rawevent.TxIDEQ(txID),
+			rawevent.EventIndexEQ(ev.EventIndex),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("gagal cek jurnal event %s #%d: %w", txID, ev.EventIndex, err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	// 2. Encode payload ke JSON-CDC (apa pun encoding dari access node)
+	payload, err := encodePayload(ev)
+	if err != nil {
+		return false, err
+	}
+
+	// 3. Simpan
+	_, err = client.RawEvent.Create().
+		SetBlockHeight(block.Height).
+		SetBlockID(block.ID.String()).
+		SetBlockTime(block.Timestamp).
+		SetTxID(txID).
+		SetTxIndex(ev.TransactionIndex).
+		SetEventIndex(ev.EventIndex).
+		SetType(ev.Type).
+		SetPayload(payload).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("gagal menyimpan jurnal event %s #%d: %w", txID, ev.EventIndex, err)
+	}
+	return true, nil
+}
+
+// CountEvents menambah jumlah event per tipe di tabel 'event_counts'
+// dengan event yang baru dijurnal. Dipanggil di transaksi block yang sama
+// dengan JournalEvent supaya jumlahnya selalu sama dengan isi jurnal.
+func CountEvents(ctx context.Context, client *ent.Client, counts map[string]int) error {
+	for eventType, n := range counts {
+		err := client.EventCount.Create().
+			SetType(eventType).
+			SetCount(n).
+			OnConflictColumns(eventcount.FieldType).
+			AddCount(n).
+			UpdateUpdatedAt().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("gagal menambah jumlah event %s: %w", eventType, err)
+		}
+	}
+	return nil
+}
+
+// encodePayload meng-encode isi event ke JSON-CDC.
+func encodePayload(ev flow.Event) ([]byte, error) {
+	payload, err := jsoncdc.Encode(ev.Value)
+	if err != nil {
+		return nil, fmt.Errorf("gagal encode payload event %s #%d: %w", ev.TransactionID, ev.EventIndex, err)
+	}
+	return payload, nil
+}
+
+// decodeEvent membangun kembali flow.Event dari payload JSON-CDC yang tersimpan.
+func decodeEvent(eventType string, txID string, txIndex int, eventIndex int, payload []byte) (flow.Event, error) {
+	value, err := jsoncdc.Decode(nil, payload)
+	if err != nil {
+		return flow.Event{}, fmt.Errorf("gagal decode payload event %s #%d: %w", txID, eventIndex, err)
+	}
+
+	cadenceEvent, ok := value.(cadence.Event)
+	if !ok {
+		return flow.Event{}, fmt.Errorf("payload event %s #%d bukan event Cadence (tipe: %T)", txID, eventIndex, value)
+	}
+
+	return flow.Event{
+		Type:             eventType,
+		TransactionID:    flow.HexToID(txID),
+		TransactionIndex: txIndex,
+		EventIndex:       eventIndex,
+		Value:            cadenceEvent,
+		Payload:          payload,
+	}, nil
+}
+
+// RawEventBlock mengembalikan info block dari baris jurnal.
+func RawEventBlock(raw *ent.RawEvent) Block {
+	return Block{
+		Height:    raw.BlockHeight,
+		ID:        flow.HexToID(raw.BlockID),
+		Timestamp: raw.BlockTime,
+	}
+}
+
+// RawEventToFlowEvent mengubah baris jurnal kembali menjadi flow.Event
+// supaya bisa diproses ulang oleh handler yang sama.
+func RawEventToFlowEvent(raw *ent.RawEvent) (flow.Event, error) {
+	return decodeEvent(raw.Type, raw.TxID, raw.TxIndex, raw.EventIndex, raw.Payload)
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"backend/ent/eventcount"
+	"backend/testdb"
+	"context"
+	"testing"
+)
+
+func TestCountEvents(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+
+	// Jurnal lama sebelum ada 'event_counts'
+	for i, payload := range []string{momentMintedPayload(1, alice), momentMintedPayload(2, alice)} {
+		ev := testEvent(t, "0a", i, payload)
+		if _, err := JournalEvent(ctx, client, Block{Height: 10}, ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := seedEventCounts(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	// Sudah terisi, tidak dihitung ulang
+	if err := seedEventCounts(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	minted := testEvent(t, "0a", 0, momentMintedPayload(1, alice)).Type
+	if err := CountEvents(ctx, client, map[string]int{minted: 3, "A.0.Other.Event": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{minted: 5, "A.0.Other.Event": 1}
+	for eventType, n := range want {
+		got := client.EventCount.Query().Where(eventcount.TypeEQ(eventType)).OnlyX(ctx)
+		if got.Count != n {
+			t.Errorf("jumlah %s = %d, ingin %d", eventType, got.Count, n)
+		}
+	}
+}
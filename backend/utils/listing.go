@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/listing"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("NFTStorefrontV2.ListingAvailable", ListingAvailable))
+	RegisterHandler(newHandler("NFTStorefrontV2.ListingCompleted", ListingCompleted))
+}
+
+// ListingAvailableEvent: NFTStorefrontV2.ListingAvailable
+type ListingAvailableEvent struct {
+	StorefrontAddress    cadence.Address   `address:"storefrontAddress"`
+	ListingResourceID    uint64            `cadence:"listingResourceID"`
+	NFTType              cadence.TypeValue `cadence:"nftType"`
+	NFTID                uint64            `cadence:"nftID"`
+	SalePaymentVaultType cadence.TypeValue `cadence:"salePaymentVaultType"`
+	SalePrice            cadence.UFix64    `cadence:"salePrice"`
+	Expiry               uint64            `cadence:"expiry"`
+}
+
+func ListingAvailable(ctx context.Context, client *ent.Client, ev flow.Event, data *ListingAvailableEvent) error {
+	log.Println("Memproses event ListingAvailable...")
+
+	// --- 1. Konversi Tipe Go ---
+	listingID := data.ListingResourceID
+	nftID := data.NFTID
+	sellerAddress := data.StorefrontAddress.String()
+
+	// Hanya NFTMoment & NFTAccessory yang di-indeks
+	kind := nftKindOf(ctx, typeID(data.NFTType))
+	if kind == "" {
+		log.Printf("Tipe NFT %s bukan NFTMoment/NFTAccessory, dilewati.", data.NFTType)
+		return nil
+	}
+
+	// Tipe vault disimpan sebagai string tipe Cadence
+	vaultType := data.SalePaymentVaultType.String()
+
+	price := ufix64ToFloat(data.SalePrice)
+	expiryTime := time.Unix(int64(data.Expiry), 0)
+
+	// --- 2. Cek Duplikat ---
+	_, err := client.Listing.Query().
+		Where(listing.ListingIDEQ(listingID)).
+		Only(ctx)
+	if err == nil {
+		log.Printf("Listing ID %d sudah ada di database, dilewati.", listingID)
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("error saat query Listing %d: %w", listingID, err)
+	}
+
+	// --- 3. Dapatkan Relasi (Seller & NFT) ---
+
+	// Dapatkan 'User' (Penjual)
+	sellerUser, err := getOrCreateUser(ctx, client, sellerAddress)
+	if err != nil {
+		return err
+	}
+
+	// --- 4. Buat 'Listing' Baru ---
+	create := client.Listing.Create().
+		SetListingID(listingID).
+		SetPrice(price).
+		SetExpiry(expiryTime).
+		SetPaymentVaultType(vaultType). // Simpan string tipe vault
+		SetSeller(sellerUser)
+
+	// Tautkan ke NFT sesuai jenisnya (aksesori atau momen)
+	switch kind {
+	case transfer.NftTypeAccessory:
+		nft, err := client.NFTAccessory.Query().Where(nftaccessory.NftIDEQ(nftID)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return missingDependency(entityAccessory, nftID)
+			}
+			return fmt.Errorf("error query NFTAccessory %d: %w", nftID, err)
+		}
+		create.SetNftType(listing.NftTypeAccessory).SetNftAccessory(nft)
+
+	case transfer.NftTypeMoment:
+		nft, err := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(nftID)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return missingDependency(entityMoment, nftID)
+			}
+			return fmt.Errorf("error query NFTMoment %d: %w", nftID, err)
+		}
+		create.SetNftType(listing.NftTypeMoment).SetNftMoment(nft)
+	}
+
+	newListing, createErr := create.Save(ctx)
+	if createErr != nil {
+		return fmt.Errorf("gagal menyimpan 'Listing' baru (ID: %d): %w", listingID, createErr)
+	}
+	log.Printf("Berhasil mengindeks 'Listing' baru (ID: %d) untuk %s %d", newListing.ListingID, kind, nftID)
+	markIndexed(ctx, entityListing, listingID)
+	return nil
+}
+
+// ListingCompletedEvent: NFTStorefrontV2.ListingCompleted
+type ListingCompletedEvent struct {
+	ListingResourceID    uint64            `cadence:"listingResourceID"`
+	Purchased            bool              `cadence:"purchased"`
+	NFTType              cadence.TypeValue `cadence:"nftType"`
+	NFTID                uint64            `cadence:"nftID"`
+	SalePaymentVaultType cadence.TypeValue `cadence:"salePaymentVaultType"`
+	SalePrice            cadence.UFix64    `cadence:"salePrice"`
+}
+
+func ListingCompleted(ctx context.Context, client *ent.Client, ev flow.Event, data *ListingCompletedEvent) error {
+	log.Println("Memproses event ListingCompleted...")
+
+	// --- 1. Konversi Tipe Go ---
+	listingID := data.ListingResourceID
+	wasPurchased := data.Purchased
+
+	// --- 2. Temukan 'Listing' di DB ---
+	listingRecord, err := client.Listing.Query().
+		Where(listing.ListingIDEQ(listingID)).
+		WithSeller().
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Listing NFT lain memang tidak pernah di-indeks;
+			// listing NFT kita berarti ListingAvailable-nya belum diproses
+			if nftKindOf(ctx, typeID(data.NFTType)) == "" {
+				log.Printf("Listing ID %d tidak ada di database, dilewati.", listingID)
+				return nil
+			}
+			return missingDependency(entityListing, listingID)
+		}
+		return fmt.Errorf("error query 'Listing' %d: %w", listingID, err)
+	}
+
+	// Listing yang sudah selesai tidak diproses dua kali
+	// (listing 'ghost'/'expired' masih bisa selesai on-chain)
+	if listingRecord.Status == listing.StatusSold || listingRecord.Status == listing.StatusCancelled {
+		log.Printf("Listing ID %d sudah berstatus %s, dilewati.", listingID, listingRecord.Status)
+		return nil
+	}
+
+	// --- 3. Logika Bisnis ---
+
+	// 'ListingCompleted' juga di-emit saat 'unlist' (membatalkan penjualan)
+	// dan saat listing kedaluwarsa dibersihkan
+	if !wasPurchased {
+		status := listing.StatusCancelled
+		if !blockTime(ctx).Before(listingRecord.Expiry) {
+			status = listing.StatusExpired
+		}
+		if _, err := listingRecord.Update().SetStatus(status).Save(ctx); err != nil {
+			return fmt.Errorf("gagal mengupdate status 'Listing' ID %d: %w", listingID, err)
+		}
+		log.Printf("Listing ID %d selesai tanpa pembelian (%s).", listingID, status)
+		return nil
+	}
+
+	// 4. Terjual: tandai listing & catat penjualannya
+	if err := recordSale(ctx, client, ev, listingRecord, data); err != nil {
+		return err
+	}
+	if _, err := listingRecord.Update().SetStatus(listing.StatusSold).Save(ctx); err != nil {
+		return fmt.Errorf("gagal mengupdate status 'Listing' ID %d: %w", listingID, err)
+	}
+	log.Printf("Listing ID %d terjual.", listingID)
+	return nil
+}
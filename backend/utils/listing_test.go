@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"backend/ent/listing"
+	"backend/ent/sale"
+	"backend/testdb"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+var listingExpiry = time.Unix(1700003600, 0)
+
+// listingAvailable adalah data ListingAvailable untuk NFT 'nftType' milik 'seller'.
+func listingAvailable(t *testing.T, listingID uint64, nftType string, nftID uint64, seller string) *ListingAvailableEvent {
+	t.Helper()
+	return &ListingAvailableEvent{
+		StorefrontAddress:    mustAddress(seller),
+		ListingResourceID:    listingID,
+		NFTType:              typeValue(t, nftType),
+		NFTID:                nftID,
+		SalePaymentVaultType: typeValue(t, "A.7e60df042a9c0868.FlowToken.Vault"),
+		SalePrice:            cadence.UFix64(1250000000),
+		Expiry:               uint64(listingExpiry.Unix()),
+	}
+}
+
+// listingCompleted adalah data ListingCompleted untuk NFT 'nftType'.
+func listingCompleted(t *testing.T, listingID uint64, nftType string, nftID uint64, purchased bool) *ListingCompletedEvent {
+	t.Helper()
+	return &ListingCompletedEvent{
+		ListingResourceID:    listingID,
+		Purchased:            purchased,
+		NFTType:              typeValue(t, nftType),
+		NFTID:                nftID,
+		SalePaymentVaultType: typeValue(t, "A.7e60df042a9c0868.FlowToken.Vault"),
+		SalePrice:            cadence.UFix64(1250000000),
+	}
+}
+
+func TestListingAvailable(t *testing.T) {
+	ctx := WithBlock(testContext(), Block{Height: 20})
+	client := testdb.Open(t)
+	seedAccessory(t, client, 2, alice)
+
+	data := listingAvailable(t, 1, testAccessoryType, 2, alice)
+	for i := 0; i < 2; i++ { // event yang sama diterapkan dua kali
+		if err := ListingAvailable(ctx, client, flow.Event{}, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := client.Listing.Query().WithSeller().WithNftAccessory().OnlyX(ctx)
+	if got.Status != listing.StatusActive || got.Price != 12.5 || !got.Expiry.Equal(listingExpiry) {
+		t.Errorf("listing = %+v", got)
+	}
+	if got.Edges.Seller.Address != alice || got.Edges.NftAccessory.NftID != 2 {
+		t.Errorf("penjual = %s, aksesori = %d; ingin %s, 2", got.Edges.Seller.Address, got.Edges.NftAccessory.NftID, alice)
+	}
+}
+
+func TestListingAvailableNFTType(t *testing.T) {
+	ctx := WithBlock(testContext(), Block{Height: 20})
+	client := testdb.Open(t)
+	seedMoment(t, client, 1, alice)
+
+	if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 1, testMomentType, 1, alice)); err != nil {
+		t.Fatal(err)
+	}
+	// NFT dari koleksi lain tidak di-indeks
+	other := "A.0b2a3299cc857e29.TopShot.NFT"
+	if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 2, other, 1, alice)); err != nil {
+		t.Fatal(err)
+	}
+
+	got := client.Listing.Query().WithNftMoment().WithNftAccessory().OnlyX(ctx)
+	if got.NftType != listing.NftTypeMoment || got.Edges.NftMoment == nil || got.Edges.NftMoment.NftID != 1 {
+		t.Errorf("listing = %+v, ingin listing momen 1", got)
+	}
+	if got.Edges.NftAccessory != nil {
+		t.Errorf("listing momen juga menunjuk aksesori %d", got.Edges.NftAccessory.NftID)
+	}
+}
+
+func TestListingCompleted(t *testing.T) {
+	beforeExpiry := Block{Height: 30, Timestamp: listingExpiry.Add(-time.Hour)}
+	afterExpiry := Block{Height: 40, Timestamp: listingExpiry.Add(time.Hour)}
+
+	tests := []struct {
+		name      string
+		block     Block
+		purchased bool
+		want      listing.Status
+	}{
+		{name: "unlist sebelum expiry dibatalkan", block: beforeExpiry, want: listing.StatusCancelled},
+		{name: "dibersihkan setelah expiry kedaluwarsa", block: afterExpiry, want: listing.StatusExpired},
+		{name: "dibeli terjual", block: beforeExpiry, purchased: true, want: listing.StatusSold},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testdb.Open(t)
+			seedAccessory(t, client, 2, alice)
+			if err := ListingAvailable(testContext(), client, flow.Event{}, listingAvailable(t, 1, testAccessoryType, 2, alice)); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := WithBlock(testContext(), tt.block)
+			ev := flow.Event{TransactionID: flow.HexToID("0a")}
+			if err := ListingCompleted(ctx, client, ev, listingCompleted(t, 1, testAccessoryType, 2, tt.purchased)); err != nil {
+				t.Fatal(err)
+			}
+
+			got := client.Listing.Query().OnlyX(ctx)
+			if got.Status != tt.want {
+				t.Errorf("status = %s, ingin %s", got.Status, tt.want)
+			}
+			wantSales := 0
+			if tt.purchased {
+				wantSales = 1
+			}
+			if n := client.Sale.Query().CountX(ctx); n != wantSales {
+				t.Errorf("%d sale tercatat, ingin %d", n, wantSales)
+			}
+		})
+	}
+}
+
+func TestSaleBuyer(t *testing.T) {
+	block := Block{Height: 30, Timestamp: listingExpiry.Add(-time.Hour)}
+	ctx := WithBlock(testContext(), block)
+	client := testdb.Open(t)
+	seedAccessory(t, client, 2, alice)
+	seedUser(t, client, bob)
+	if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 1, testAccessoryType, 2, alice)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pembelian: ListingCompleted lalu Deposited ke pembeli di transaksi yang sama
+	txID := flow.HexToID("0b")
+	if err := ListingCompleted(ctx, client, flow.Event{TransactionID: txID, EventIndex: 0}, listingCompleted(t, 1, testAccessoryType, 2, true)); err != nil {
+		t.Fatal(err)
+	}
+	buyer := mustAddress(bob)
+	if err := NFTDeposited(ctx, client, flow.Event{TransactionID: txID, EventIndex: 1}, &DepositedEvent{Type: testAccessoryType, ID: 2, To: &buyer}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Event selesai yang sama lagi tidak mencatat penjualan kedua
+	if err := ListingCompleted(ctx, client, flow.Event{TransactionID: txID, EventIndex: 0}, listingCompleted(t, 1, testAccessoryType, 2, true)); err != nil {
+		t.Fatal(err)
+	}
+
+	got := client.Sale.Query().WithSeller().WithBuyer().OnlyX(ctx)
+	if got.NftType != sale.NftTypeAccessory || got.NftID != 2 || got.Price != 12.5 || got.TxID != txID.String() || got.BlockHeight != 30 {
+		t.Errorf("sale = %+v", got)
+	}
+	if got.Edges.Seller == nil || got.Edges.Seller.Address != alice {
+		t.Errorf("penjual = %v, ingin %s", got.Edges.Seller, alice)
+	}
+	if got.Edges.Buyer == nil || got.Edges.Buyer.Address != bob {
+		t.Errorf("pembeli = %v, ingin %s", got.Edges.Buyer, bob)
+	}
+}
+
+func TestRelistAfterSale(t *testing.T) {
+	ctx := WithBlock(testContext(), Block{Height: 30, Timestamp: listingExpiry.Add(-time.Hour)})
+	client := testdb.Open(t)
+	seedAccessory(t, client, 2, alice)
+	seedUser(t, client, bob)
+
+	// alice menjual aksesori 2 ke bob, lalu bob me-list ulang aksesori yang sama
+	if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 1, testAccessoryType, 2, alice)); err != nil {
+		t.Fatal(err)
+	}
+	txID := flow.HexToID("0c")
+	if err := ListingCompleted(ctx, client, flow.Event{TransactionID: txID, EventIndex: 0}, listingCompleted(t, 1, testAccessoryType, 2, true)); err != nil {
+		t.Fatal(err)
+	}
+	buyer := mustAddress(bob)
+	if err := NFTDeposited(ctx, client, flow.Event{TransactionID: txID, EventIndex: 1}, &DepositedEvent{Type: testAccessoryType, ID: 2, To: &buyer}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 2, testAccessoryType, 2, bob)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[uint64]struct {
+		status listing.Status
+		seller string
+	}{
+		1: {listing.StatusSold, alice},
+		2: {listing.StatusActive, bob},
+	}
+	listings := client.Listing.Query().WithSeller().WithNftAccessory().AllX(ctx)
+	if len(listings) != len(want) {
+		t.Fatalf("%d listing, ingin %d", len(listings), len(want))
+	}
+	for _, l := range listings {
+		w := want[l.ListingID]
+		if l.Status != w.status || l.Edges.Seller.Address != w.seller {
+			t.Errorf("listing %d = %s oleh %s, ingin %s oleh %s", l.ListingID, l.Status, l.Edges.Seller.Address, w.status, w.seller)
+		}
+		// Listing lama tetap menunjuk aksesorinya setelah di-list ulang
+		if l.Edges.NftAccessory == nil || l.Edges.NftAccessory.NftID != 2 {
+			t.Errorf("listing %d tidak menunjuk aksesori 2", l.ListingID)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/listing"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/predicate"
+	"backend/ent/transfer"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// ExpireListings menandai listing aktif (atau ghost) yang sudah lewat expiry sebagai 'expired'.
+func ExpireListings(ctx context.Context, client *ent.Client, now time.Time) (int, error) {
+	n, err := client.Listing.Update().
+		Where(
+			listing.StatusIn(listing.StatusActive, listing.StatusGhost),
+			listing.ExpiryLT(now),
+		).
+		SetStatus(listing.StatusExpired).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("gagal menandai listing kedaluwarsa: %w", err)
+	}
+	return n, nil
+}
+
+// listingsOfNFT adalah predikat listing untuk satu NFT (aksesori atau momen).
+func listingsOfNFT(kind transfer.NftType, nftID uint64) predicate.Listing {
+	if kind == transfer.NftTypeMoment {
+		return listing.HasNftMomentWith(nftmoment.NftIDEQ(nftID))
+	}
+	return listing.HasNftAccessoryWith(nftaccessory.NftIDEQ(nftID))
+}
+
+// syncListingsOnDeposit menyesuaikan listing sebuah NFT setelah NFT tersebut
+// masuk ke koleksi 'owner':
+//   - listing aktif milik penjual lain menjadi 'ghost' (NFT sudah pindah)
+//   - listing 'ghost' milik 'owner' aktif lagi (NFT kembali, misal setelah unequip)
+func syncListingsOnDeposit(ctx context.Context, client *ent.Client, kind transfer.NftType, nftID uint64, owner *ent.User) error {
+	ghosted, err := client.Listing.Update().
+		Where(
+			listingsOfNFT(kind, nftID),
+			listing.StatusEQ(listing.StatusActive),
+			listing.Not(listing.HasSellerWith(user.IDEQ(owner.ID))),
+		).
+		SetStatus(listing.StatusGhost).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menandai listing ghost untuk %s %d: %w", kind, nftID, err)
+	}
+
+	restored, err := client.Listing.Update().
+		Where(
+			listingsOfNFT(kind, nftID),
+			listing.StatusEQ(listing.StatusGhost),
+			listing.HasSellerWith(user.IDEQ(owner.ID)),
+			listing.ExpiryGT(blockTime(ctx)),
+		).
+		SetStatus(listing.StatusActive).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengaktifkan ulang listing untuk %s %d: %w", kind, nftID, err)
+	}
+
+	if ghosted > 0 || restored > 0 {
+		log.Printf("Listing %s %d: %d jadi ghost, %d aktif lagi", kind, nftID, ghosted, restored)
+	}
+	return nil
+}
+
+// ghostListingsOfNFT menandai semua listing aktif sebuah NFT sebagai 'ghost',
+// misal saat aksesori di-equip ke momen (keluar dari koleksi penjual).
+func ghostListingsOfNFT(ctx context.Context, client *ent.Client, kind transfer.NftType, nftID uint64) error {
+	n, err := client.Listing.Update().
+		Where(
+			listingsOfNFT(kind, nftID),
+			listing.StatusEQ(listing.StatusActive),
+		).
+		SetStatus(listing.StatusGhost).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menandai listing ghost untuk %s %d: %w", kind, nftID, err)
+	}
+	if n > 0 {
+		log.Printf("Listing %s %d: %d jadi ghost", kind, nftID, n)
+	}
+	return nil
+}
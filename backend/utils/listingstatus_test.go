@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/listing"
+	"backend/testdb"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestListingStatus(t *testing.T) {
+	beforeExpiry := Block{Height: 30, Timestamp: listingExpiry.Add(-time.Hour)}
+	afterExpiry := Block{Height: 40, Timestamp: listingExpiry.Add(time.Hour)}
+
+	// step adalah satu event yang diterapkan pada listing 1 (momen 1 milik alice)
+	type apply func(ctx context.Context, t *testing.T, client *ent.Client, ev flow.Event) error
+	type step struct {
+		block Block
+		apply apply
+	}
+	deposit := func(owner string) apply {
+		return func(ctx context.Context, t *testing.T, client *ent.Client, ev flow.Event) error {
+			to := mustAddress(owner)
+			return NFTDeposited(ctx, client, ev, &DepositedEvent{Type: testMomentType, ID: 1, To: &to})
+		}
+	}
+	complete := func(purchased bool) apply {
+		return func(ctx context.Context, t *testing.T, client *ent.Client, ev flow.Event) error {
+			return ListingCompleted(ctx, client, ev, listingCompleted(t, 1, testMomentType, 1, purchased))
+		}
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+		want  listing.Status
+	}{
+		{
+			name: "listing baru aktif",
+			want: listing.StatusActive,
+		},
+		{
+			name:  "NFT pindah ke user lain jadi ghost",
+			steps: []step{{beforeExpiry, deposit(bob)}},
+			want:  listing.StatusGhost,
+		},
+		{
+			name:  "NFT kembali ke penjual aktif lagi",
+			steps: []step{{beforeExpiry, deposit(bob)}, {beforeExpiry, deposit(alice)}},
+			want:  listing.StatusActive,
+		},
+		{
+			name:  "NFT kembali setelah expiry tetap ghost",
+			steps: []step{{beforeExpiry, deposit(bob)}, {afterExpiry, deposit(alice)}},
+			want:  listing.StatusGhost,
+		},
+		{
+			name:  "listing ghost yang dibersihkan kedaluwarsa",
+			steps: []step{{beforeExpiry, deposit(bob)}, {afterExpiry, complete(false)}},
+			want:  listing.StatusExpired,
+		},
+		{
+			name:  "listing terjual tidak berubah lagi",
+			steps: []step{{beforeExpiry, complete(true)}, {afterExpiry, complete(false)}},
+			want:  listing.StatusSold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testContext()
+			client := testdb.Open(t)
+			seedMoment(t, client, 1, alice)
+			seedUser(t, client, bob)
+			if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, 1, testMomentType, 1, alice)); err != nil {
+				t.Fatal(err)
+			}
+
+			for i, s := range tt.steps {
+				ev := flow.Event{TransactionID: flow.HexToID(fmt.Sprintf("1%d", i))}
+				if err := s.apply(WithBlock(ctx, s.block), t, client, ev); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got := client.Listing.Query().Where(listing.ListingIDEQ(1)).OnlyX(ctx)
+			if got.Status != tt.want {
+				t.Errorf("status = %s, ingin %s", got.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpireListings(t *testing.T) {
+	ctx := testContext()
+	client := testdb.Open(t)
+
+	for id := uint64(1); id <= 2; id++ {
+		seedMoment(t, client, id, alice)
+		if err := ListingAvailable(ctx, client, flow.Event{}, listingAvailable(t, id, testMomentType, id, alice)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Listing 2 sudah terjual: tidak ikut kedaluwarsa
+	sold := WithBlock(ctx, Block{Height: 21, Timestamp: listingExpiry.Add(-time.Hour)})
+	if err := ListingCompleted(sold, client, flow.Event{TransactionID: flow.HexToID("02")}, listingCompleted(t, 2, testMomentType, 2, true)); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := ExpireListings(ctx, client, listingExpiry.Add(-time.Minute)); err != nil || n != 0 {
+		t.Fatalf("ExpireListings sebelum expiry = %d, %v; ingin 0, nil", n, err)
+	}
+	if n, err := ExpireListings(ctx, client, listingExpiry.Add(time.Minute)); err != nil || n != 1 {
+		t.Fatalf("ExpireListings setelah expiry = %d, %v; ingin 1, nil", n, err)
+	}
+
+	want := map[uint64]listing.Status{1: listing.StatusExpired, 2: listing.StatusSold}
+	for _, l := range client.Listing.Query().AllX(ctx) {
+		if l.Status != want[l.ListingID] {
+			t.Errorf("status listing %d = %s, ingin %s", l.ListingID, l.Status, want[l.ListingID])
+		}
+	}
+}
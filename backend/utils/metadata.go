@@ -0,0 +1,277 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// Script MetadataViews per jenis NFT (folder cadence/scripts)
+var nftViewScripts = map[transfer.NftType]string{
+	transfer.NftTypeMoment:    "get_nft_moment_view.cdc",
+	transfer.NftTypeAccessory: "get_nft_accessory_view.cdc",
+}
+
+// MetadataLookup mengembalikan Traits (MetadataViews) sebuah NFT milik 'owner'
+// pada block 'height' (0 = block sealed terbaru), per nama trait.
+// Event Minted hanya membawa name/description/thumbnail; data lain
+// (tier, mintedBlock, rarity, ...) hanya ada di MetadataViews.
+type MetadataLookup func(ctx context.Context, kind transfer.NftType, owner flow.Address, nftID uint64, height uint64) (map[string]cadence.Value, error)
+
+var metadataLookup MetadataLookup
+
+// SetMetadataLookup mengatur lookup MetadataViews (biasanya lewat access node).
+// Jika tidak diatur, NFT disimpan tanpa data tambahan.
+func SetMetadataLookup(lookup MetadataLookup) {
+	metadataLookup = lookup
+}
+
+// NewScriptMetadataLookup membuat MetadataLookup yang menjalankan script
+// get_nft_*_view.cdc di block yang diminta.
+func NewScriptMetadataLookup(flowClient access.Client, network *Network) (MetadataLookup, error) {
+	scripts := make(map[transfer.NftType][]byte)
+	for kind, name := range nftViewScripts {
+		script, err := LoadScript(network, name)
+		if err != nil {
+			return nil, err
+		}
+		scripts[kind] = script
+	}
+
+	return func(ctx context.Context, kind transfer.NftType, owner flow.Address, nftID uint64, height uint64) (map[string]cadence.Value, error) {
+		args := []cadence.Value{
+			cadence.NewAddress(owner),
+			cadence.NewUInt64(nftID),
+		}
+		var value cadence.Value
+		var err error
+		if height == 0 {
+			value, err = flowClient.ExecuteScriptAtLatestBlock(ctx, scripts[kind], args)
+		} else {
+			value, err = flowClient.ExecuteScriptAtBlockHeight(ctx, height, scripts[kind], args)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return traitsOf(value)
+	}, nil
+}
+
+// traitsOf mengambil isi field 'traits' (MetadataViews.Traits) dari hasil script.
+func traitsOf(view cadence.Value) (map[string]cadence.Value, error) {
+	viewStruct, ok := view.(cadence.Composite)
+	if !ok {
+		return nil, fmt.Errorf("hasil view bukan struct (tipe: %T)", view)
+	}
+	traitsStruct, ok := cadence.SearchFieldByName(viewStruct, "traits").(cadence.Composite)
+	if !ok {
+		return nil, fmt.Errorf("view tidak punya field 'traits'")
+	}
+	list, ok := cadence.SearchFieldByName(traitsStruct, "traits").(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("field 'traits' bukan array")
+	}
+
+	traits := make(map[string]cadence.Value, len(list.Values))
+	for _, item := range list.Values {
+		trait, ok := item.(cadence.Composite)
+		if !ok {
+			continue
+		}
+		name, ok := cadence.SearchFieldByName(trait, "name").(cadence.String)
+		if !ok {
+			continue
+		}
+		traits[string(name)] = unwrapOptional(cadence.SearchFieldByName(trait, "value"))
+	}
+	return traits, nil
+}
+
+// unwrapOptional melepas pembungkus Optional (bisa bertingkat).
+func unwrapOptional(value cadence.Value) cadence.Value {
+	for {
+		optional, ok := value.(cadence.Optional)
+		if !ok {
+			return value
+		}
+		value = optional.Value
+	}
+}
+
+// enrichment adalah satu NFT yang MetadataViews-nya perlu dibaca.
+type enrichment struct {
+	kind   transfer.NftType
+	nftID  uint64
+	owner  flow.Address
+	height uint64
+}
+
+// EnrichQueue menampung NFT yang di-mint selama transaksi DB sebuah block.
+// Script MetadataViews baru dijalankan lewat Run setelah commit, supaya
+// transaksi DB tidak tertahan oleh access node.
+type EnrichQueue struct {
+	items []enrichment
+}
+
+type enrichQueueKey struct{}
+
+// WithEnrichQueue memasang EnrichQueue baru di context.
+func WithEnrichQueue(ctx context.Context) (context.Context, *EnrichQueue) {
+	queue := &EnrichQueue{}
+	return context.WithValue(ctx, enrichQueueKey{}, queue), queue
+}
+
+// queueEnrichment dipanggil handler mint setelah NFT disimpan.
+// Script dijalankan di block mint (bukan block terbaru), karena saat backfill
+// atau reindex NFT-nya mungkin sudah pindah dari 'owner'.
+// Di luar WithEnrichQueue tidak melakukan apa-apa (diisi oleh EnrichMissing).
+func queueEnrichment(ctx context.Context, kind transfer.NftType, nftID uint64, owner cadence.Address) {
+	queue, ok := ctx.Value(enrichQueueKey{}).(*EnrichQueue)
+	if !ok || metadataLookup == nil {
+		return
+	}
+	block, _ := BlockFromContext(ctx)
+	queue.items = append(queue.items, enrichment{
+		kind:   kind,
+		nftID:  nftID,
+		owner:  flow.BytesToAddress(owner.Bytes()),
+		height: block.Height,
+	})
+}
+
+// Run menjalankan enrichment yang diantrikan. Harus dipanggil setelah
+// transaksi DB di-commit; jika transaksi gagal, antriannya dibuang saja.
+// Gagal enrichment tidak fatal: kolomnya tetap kosong dan diisi ulang oleh EnrichMissing.
+func (q *EnrichQueue) Run(ctx context.Context, client *ent.Client) {
+	for _, item := range q.items {
+		if err := enrich(ctx, client, item); err != nil {
+			log.Printf("Gagal membaca MetadataViews %s %d (block %d): %v", item.kind, item.nftID, item.height, err)
+		}
+	}
+	q.items = nil
+}
+
+// EnrichMissing mengisi ulang metadata NFT yang enrichment-nya gagal
+// (momen tanpa mintedBlock, aksesori tanpa rarity), di block terbaru dengan
+// pemilik menurut DB. Aksesori yang sedang dipakai dilewati karena berada
+// di dalam momen, bukan di koleksi pemiliknya.
+func EnrichMissing(ctx context.Context, client *ent.Client) (enriched int, failed int, err error) {
+	if metadataLookup == nil {
+		return 0, 0, nil
+	}
+
+	var items []enrichment
+	moments, err := client.NFTMoment.Query().
+		Where(nftmoment.MintedBlockIsNil()).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gagal query momen tanpa metadata: %w", err)
+	}
+	for _, moment := range moments {
+		if moment.Edges.Owner != nil {
+			items = append(items, enrichment{
+				kind:  transfer.NftTypeMoment,
+				nftID: moment.NftID,
+				owner: flow.HexToAddress(moment.Edges.Owner.Address),
+			})
+		}
+	}
+
+	accessories, err := client.NFTAccessory.Query().
+		Where(
+			nftaccessory.RarityDescriptionIsNil(),
+			nftaccessory.Not(nftaccessory.HasEquippedOnMoment()),
+		).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gagal query aksesori tanpa rarity: %w", err)
+	}
+	for _, accessory := range accessories {
+		if accessory.Edges.Owner != nil {
+			items = append(items, enrichment{
+				kind:  transfer.NftTypeAccessory,
+				nftID: accessory.NftID,
+				owner: flow.HexToAddress(accessory.Edges.Owner.Address),
+			})
+		}
+	}
+
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return enriched, failed, err
+		}
+		if err := enrich(ctx, client, item); err != nil {
+			log.Printf("Gagal membaca ulang MetadataViews %s %d: %v", item.kind, item.nftID, err)
+			failed++
+			continue
+		}
+		enriched++
+	}
+	return enriched, failed, nil
+}
+
+// enrich membaca MetadataViews satu NFT dan menyimpannya.
+func enrich(ctx context.Context, client *ent.Client, item enrichment) error {
+	traits, err := metadataLookup(ctx, item.kind, item.owner, item.nftID, item.height)
+	if err != nil {
+		return err
+	}
+	switch item.kind {
+	case transfer.NftTypeMoment:
+		return saveMomentTraits(ctx, client, item.nftID, traits)
+	case transfer.NftTypeAccessory:
+		return saveAccessoryTraits(ctx, client, item.nftID, traits)
+	}
+	return fmt.Errorf("jenis NFT tidak dikenal: %s", item.kind)
+}
+
+// saveMomentTraits mengisi tier, mintedBlock & mintedTime momen dari MetadataViews.
+func saveMomentTraits(ctx context.Context, client *ent.Client, nftID uint64, traits map[string]cadence.Value) error {
+	update := client.NFTMoment.Update().Where(nftmoment.NftIDEQ(nftID))
+	if tier, ok := traits["tier"].(cadence.String); ok {
+		update.SetTier(string(tier))
+	}
+	if block, ok := traits["mintedBlock"].(cadence.UInt64); ok {
+		update.SetMintedBlock(uint64(block))
+	}
+	if minted, ok := traits["mintedTime"].(cadence.UFix64); ok {
+		update.SetMintedTime(ufix64ToTime(minted))
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("gagal menyimpan metadata momen %d: %w", nftID, err)
+	}
+	return nil
+}
+
+// saveAccessoryTraits mengisi rarity aksesori (MetadataViews.Rarity) dari trait 'rarity'.
+func saveAccessoryTraits(ctx context.Context, client *ent.Client, nftID uint64, traits map[string]cadence.Value) error {
+	rarity, ok := traits["rarity"].(cadence.Composite)
+	if !ok {
+		return fmt.Errorf("aksesori %d tidak punya trait 'rarity'", nftID)
+	}
+
+	update := client.NFTAccessory.Update().Where(nftaccessory.NftIDEQ(nftID))
+	if score, ok := unwrapOptional(cadence.SearchFieldByName(rarity, "score")).(cadence.UFix64); ok {
+		update.SetRarityScore(ufix64ToFloat(score))
+	}
+	if maxScore, ok := unwrapOptional(cadence.SearchFieldByName(rarity, "max")).(cadence.UFix64); ok {
+		update.SetRarityMax(ufix64ToFloat(maxScore))
+	}
+	if description, ok := unwrapOptional(cadence.SearchFieldByName(rarity, "description")).(cadence.String); ok {
+		update.SetRarityDescription(string(description))
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("gagal menyimpan rarity aksesori %d: %w", nftID, err)
+	}
+	return nil
+}
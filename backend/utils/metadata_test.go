@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"backend/testdb"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// lookupCall mencatat argumen satu panggilan MetadataLookup.
+type lookupCall struct {
+	owner  flow.Address
+	height uint64
+}
+
+func TestEnrichment(t *testing.T) {
+	ctx := context.Background()
+	recipient := mustAddress("0x01cf0e2f2f715450")
+	newOwner := mustAddress("0x179b6b1cb6755e31")
+	traits := map[string]cadence.Value{
+		"tier":        cadence.String("gold"),
+		"mintedBlock": cadence.UInt64(10),
+		"mintedTime":  cadence.UFix64(170000000000000000),
+	}
+
+	client := testdb.Open(t)
+	var calls []lookupCall
+	var lookupErr error
+	SetMetadataLookup(func(ctx context.Context, kind transfer.NftType, owner flow.Address, nftID uint64, height uint64) (map[string]cadence.Value, error) {
+		calls = append(calls, lookupCall{owner: owner, height: height})
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		return traits, nil
+	})
+	t.Cleanup(func() { SetMetadataLookup(nil) })
+
+	mint := func(t *testing.T, nftID uint64) *EnrichQueue {
+		t.Helper()
+		blockCtx := WithBlock(ctx, Block{Height: 10})
+		blockCtx, queue := WithEnrichQueue(blockCtx)
+		err := NFTMomentMinted(blockCtx, client, flow.Event{}, &NFTMomentMintedEvent{
+			Recipient: recipient,
+			ID:        nftID,
+			Name:      "Sunset",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return queue
+	}
+
+	t.Run("script dijalankan setelah commit di block mint", func(t *testing.T) {
+		calls = nil
+		queue := mint(t, 1)
+		if len(calls) != 0 {
+			t.Fatal("script tidak boleh dijalankan di dalam handler")
+		}
+
+		queue.Run(ctx, client)
+		want := lookupCall{owner: flow.BytesToAddress(recipient.Bytes()), height: 10}
+		if len(calls) != 1 || calls[0] != want {
+			t.Fatalf("lookup = %+v, ingin [%+v]", calls, want)
+		}
+		moment := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(1)).OnlyX(ctx)
+		if moment.Tier != "gold" || moment.MintedBlock == nil || *moment.MintedBlock != 10 {
+			t.Fatalf("metadata momen tidak terisi: %+v", moment)
+		}
+	})
+
+	t.Run("gagal enrichment diisi ulang dengan pemilik terbaru", func(t *testing.T) {
+		lookupErr = errors.New("could not borrow resolver")
+		mint(t, 2).Run(ctx, client)
+		moment := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(2)).OnlyX(ctx)
+		if moment.Tier != "" {
+			t.Fatalf("tier = %s, ingin kosong", moment.Tier)
+		}
+
+		// NFT sudah pindah tangan sejak mint
+		owner, err := getOrCreateUser(ctx, client, newOwner.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		moment.Update().SetOwnerID(owner.ID).ExecX(ctx)
+
+		lookupErr = nil
+		calls = nil
+		enriched, failed, err := EnrichMissing(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enriched != 1 || failed != 0 {
+			t.Fatalf("EnrichMissing = %d berhasil, %d gagal; ingin 1, 0", enriched, failed)
+		}
+		want := lookupCall{owner: flow.BytesToAddress(newOwner.Bytes()), height: 0}
+		if len(calls) != 1 || calls[0] != want {
+			t.Fatalf("lookup = %+v, ingin [%+v]", calls, want)
+		}
+		moment = client.NFTMoment.Query().Where(nftmoment.NftIDEQ(2)).OnlyX(ctx)
+		if moment.Tier != "gold" {
+			t.Fatalf("metadata momen tidak diisi ulang: %+v", moment)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrik per handler event; diekspos oleh indexer di /metrics.
+// Label 'type' adalah tipe event lengkap (misal "A.xxx.NFTMoment.Minted").
+var (
+	eventsProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "indexer_events_processed_total",
+		Help: "Jumlah event yang diteruskan ke handler, per tipe event.",
+	}, []string{"type"})
+
+	handlerErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "indexer_handler_errors_total",
+		Help: "Jumlah handler yang mengembalikan error, per tipe event.",
+	}, []string{"type"})
+
+	handlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "indexer_handler_duration_seconds",
+		Help:    "Lama decode + apply satu event, per tipe event.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"type"})
+)
+
+func init() {
+	prometheus.MustRegister(eventsProcessed, handlerErrors, handlerDuration)
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/rawevent"
+	"context"
+	"fmt"
+	"log"
+)
+
+// Migrate membuat/menyesuaikan skema (auto-migration ent), lalu menjalankan
+// migrasi data yang tidak bisa dilakukan auto-migration.
+func Migrate(ctx context.Context, client *ent.Client) error {
+	if err := client.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("gagal membuat skema: %w", err)
+	}
+	if err := migrateListingAccessory(ctx, client); err != nil {
+		return err
+	}
+	return seedEventCounts(ctx, client)
+}
+
+// migrateListingAccessory memindahkan tautan listing -> aksesori dari
+// nft_accessories.listing_nft_accessory (relasi lama satu-ke-satu) ke
+// listings.listing_nft_accessory (banyak-ke-satu, supaya aksesori bisa
+// di-list ulang), lalu menghapus kolom lama. Auto-migration hanya menambah
+// kolom baru, jadi tanpa ini listing lama kehilangan aksesorinya.
+func migrateListingAccessory(ctx context.Context, client *ent.Client) error {
+	rows, err := client.QueryContext(ctx, `
+		SELECT 1 FROM information_schema.columns
+		WHERE table_name = 'nft_accessories' AND column_name = 'listing_nft_accessory'`)
+	if err != nil {
+		return fmt.Errorf("gagal memeriksa kolom nft_accessories.listing_nft_accessory: %w", err)
+	}
+	exists := rows.Next()
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	return WithTx(ctx, client, func(tx *ent.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE listings SET listing_nft_accessory = nft_accessories.id
+			FROM nft_accessories
+			WHERE nft_accessories.listing_nft_accessory = listings.id
+			  AND listings.listing_nft_accessory IS NULL`)
+		if err != nil {
+			return fmt.Errorf("gagal menyalin tautan aksesori listing: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `ALTER TABLE nft_accessories DROP COLUMN listing_nft_accessory`); err != nil {
+			return fmt.Errorf("gagal menghapus kolom nft_accessories.listing_nft_accessory: %w", err)
+		}
+
+		copied, _ := result.RowsAffected()
+		log.Printf("Migrasi: %d tautan listing -> aksesori dipindah ke listings.listing_nft_accessory", copied)
+		return nil
+	})
+}
+
+// seedEventCounts mengisi 'event_counts' dari jurnal yang sudah ada, sekali
+// saja saat tabelnya masih kosong. Setelah itu jumlahnya ditambah indexer
+// setiap block (lihat CountEvents).
+func seedEventCounts(ctx context.Context, client *ent.Client) error {
+	seeded, err := client.EventCount.Query().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal memeriksa event_counts: %w", err)
+	}
+	if seeded {
+		return nil
+	}
+
+	var rows []struct {
+		Type  string `json:"type"`
+		Count int    `json:"count"`
+	}
+	if err := client.RawEvent.Query().
+		GroupBy(rawevent.FieldType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return fmt.Errorf("gagal menghitung jurnal per tipe: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Type] = row.Count
+	}
+	log.Printf("Migrasi: jumlah event %d tipe diisi dari jurnal", len(counts))
+	return CountEvents(ctx, client, counts)
+}
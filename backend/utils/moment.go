@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/eventpass"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("NFTMoment.Minted", NFTMomentMinted))
+	RegisterHandler(newHandler("NFTMoment.AccessoryEquipped", NFTMomentEquipAccessory))
+	RegisterHandler(newHandler("NFTMoment.AccessoryUnequipped", NFTMomentUnequipAccessory))
+}
+
+// NFTMomentMintedEvent: NFTMoment.Minted
+type NFTMomentMintedEvent struct {
+	Recipient   cadence.Address `address:"recipient"`
+	ID          uint64          `cadence:"id"`
+	Name        string          `cadence:"name"`
+	Description string          `cadence:"description"`
+	Thumbnail   string          `cadence:"thumbnail"`
+}
+
+func NFTMomentMinted(ctx context.Context, client *ent.Client, ev flow.Event, data *NFTMomentMintedEvent) error {
+	ownerAddress := data.Recipient.String()
+
+	// Replay (reindex tanpa truncate): momen yang sudah ada dilewati,
+	// pemiliknya mungkin sudah berubah sejak mint
+	exists, err := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(data.ID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query NFTMoment %d: %w", data.ID, err)
+	}
+	if exists {
+		log.Printf("NFTMoment %d sudah ada di database, dilewati.", data.ID)
+		markIndexed(ctx, entityMoment, data.ID)
+		return nil
+	}
+
+	isUserFound, err := getOrCreateUser(ctx, client, ownerAddress)
+	if err != nil {
+		return err
+	}
+
+	// EventPass yang dipakai (jika di-mint lewat mintNFTWithEventPass)
+	passID := mintedPassID(ctx, ev, data.ID)
+
+	log.Println("User found", isUserFound)
+	nftMinted, err := client.NFTMoment.Create().
+		SetName(data.Name).
+		SetDescription(data.Description).
+		SetThumbnail(data.Thumbnail).
+		SetNftID(data.ID).
+		SetOwnerID(isUserFound.ID).
+		SetNillablePassID(passID).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("error when create insert NFT: %w", err)
+	}
+	log.Println("nft minted", nftMinted)
+	markIndexed(ctx, entityMoment, data.ID)
+
+	// Pass yang belum terindeks (misal di-mint sebelum start height)
+	// ditautkan nanti oleh EventPassMinted
+	if passID != nil {
+		pass, err := client.EventPass.Query().Where(eventpass.PassIDEQ(*passID)).Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			log.Printf("EventPass %d momen %d belum terindeks, ditautkan saat pass-nya terindeks", *passID, data.ID)
+		case err != nil:
+			return fmt.Errorf("error query EventPass %d: %w", *passID, err)
+		default:
+			if err := linkMomentPass(ctx, client, nftMinted, pass); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Tier & waktu mint hanya ada di MetadataViews, dibaca setelah commit
+	queueEnrichment(ctx, transfer.NftTypeMoment, data.ID, data.Recipient)
+	return nil
+}
+
+// mintedPassID mengambil ID EventPass yang dipakai untuk me-mint momen.
+// Event Minted tidak membawa ID pass, jadi ID diambil dari argumen
+// 'eventPassID' transaksinya. Mengembalikan nil jika momen di-mint tanpa pass,
+// lookup transaksi tidak diatur, atau transaksinya gagal dibaca: momen tetap
+// disimpan, hanya tanpa tautan pass.
+func mintedPassID(ctx context.Context, ev flow.Event, momentID uint64) *uint64 {
+	tx, err := lookupTransaction(ctx, ev.TransactionID)
+	if err != nil {
+		log.Printf("Peringatan: momen %d disimpan tanpa tautan EventPass: %v", momentID, err)
+		return nil
+	}
+	if tx == nil {
+		return nil
+	}
+
+	arg, ok, err := txArgument(tx, "eventPassID")
+	if err != nil {
+		log.Printf("Peringatan: momen %d disimpan tanpa tautan EventPass: %v", momentID, err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	passID, ok := arg.(cadence.UInt64)
+	if !ok {
+		log.Printf("Peringatan: argumen eventPassID momen %d bukan UInt64 (tipe: %T)", momentID, arg)
+		return nil
+	}
+	id := uint64(passID)
+	return &id
+}
+
+// linkMomentPass menautkan momen ke EventPass yang dipakai me-mint-nya
+// dan menandai pass tersebut terpakai.
+func linkMomentPass(ctx context.Context, client *ent.Client, moment *ent.NFTMoment, pass *ent.EventPass) error {
+	// Kontrak tidak menolak pass yang sudah terpakai, tapi skema hanya
+	// mengizinkan satu momen per pass: tautan pertama yang dipertahankan.
+	linked, err := pass.QueryMoment().Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query momen EventPass %d: %w", pass.PassID, err)
+	}
+	if linked {
+		log.Printf("EventPass %d sudah dipakai momen lain, momen %d tidak ditautkan", pass.PassID, moment.NftID)
+		return nil
+	}
+
+	if err := moment.Update().SetMintedWithPass(pass).Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menautkan momen %d ke EventPass %d: %w", moment.NftID, pass.PassID, err)
+	}
+	if err := pass.Update().SetIsUsed(true).Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menandai EventPass %d terpakai: %w", pass.PassID, err)
+	}
+	log.Printf("Momen %d di-mint dengan EventPass %d", moment.NftID, pass.PassID)
+	return nil
+}
+
+// AccessoryEquippedEvent: NFTMoment.AccessoryEquipped
+type AccessoryEquippedEvent struct {
+	MomentID        uint64  `cadence:"NftMomentId"`
+	AccessoryID     *uint64 `cadence:"NftAccessoryId"`
+	PrevAccessoryID *uint64 `cadence:"prevNFTAccessoryId"`
+}
+
+// Validate: kontrak selalu mengisi aksesori yang baru dipasang.
+func (e *AccessoryEquippedEvent) Validate() error {
+	if e.AccessoryID == nil {
+		return fmt.Errorf("NftAccessoryId kosong (moment %d)", e.MomentID)
+	}
+	return nil
+}
+
+func NFTMomentEquipAccessory(ctx context.Context, client *ent.Client, ev flow.Event, data *AccessoryEquippedEvent) error {
+	nftMoment, err := client.NFTMoment.Query().
+		Where(
+			nftmoment.NftIDEQ(data.MomentID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return missingDependency(entityMoment, data.MomentID)
+		}
+		return fmt.Errorf("error query nftmoment %d: %w", data.MomentID, err)
+	}
+
+	// AccessoryID dijamin tidak nil oleh Validate()
+	accessoryExists, err := client.NFTAccessory.Query().
+		Where(nftaccessory.NftIDEQ(*data.AccessoryID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query accessory %d: %w", *data.AccessoryID, err)
+	}
+	if !accessoryExists {
+		return missingDependency(entityAccessory, *data.AccessoryID)
+	}
+
+	// Lepas aksesori sebelumnya lebih dulu, supaya tidak menimpa aksesori baru
+	// jika keduanya sama
+	if data.PrevAccessoryID != nil {
+		_, err = client.NFTAccessory.Update().Where(
+			nftaccessory.NftIDEQ(*data.PrevAccessoryID),
+		).ClearEquippedOnMoment().Save(ctx)
+		if err != nil {
+			return fmt.Errorf("gagal unequip accessory %d: %w", *data.PrevAccessoryID, err)
+		}
+		log.Println("success unequip accessory", *data.PrevAccessoryID)
+	}
+
+	_, err = client.NFTAccessory.Update().Where(
+		nftaccessory.NftIDEQ(*data.AccessoryID),
+	).SetEquippedOnMoment(nftMoment).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal equip accessory %d: %w", *data.AccessoryID, err)
+	}
+
+	log.Println("success equip accessory", *data.AccessoryID)
+
+	// Aksesori yang di-equip keluar dari koleksi, listing-nya tidak bisa dibeli
+	return ghostListingsOfNFT(ctx, client, transfer.NftTypeAccessory, *data.AccessoryID)
+}
+
+// AccessoryUnequippedEvent: NFTMoment.AccessoryUnequipped
+type AccessoryUnequippedEvent struct {
+	MomentID    uint64  `cadence:"NftMomentId"`
+	AccessoryID *uint64 `cadence:"NftAccessoryId"`
+}
+
+// Validate: kontrak hanya mengizinkan unequip jika ada aksesori terpasang.
+func (e *AccessoryUnequippedEvent) Validate() error {
+	if e.AccessoryID == nil {
+		return fmt.Errorf("NftAccessoryId kosong (moment %d)", e.MomentID)
+	}
+	return nil
+}
+
+func NFTMomentUnequipAccessory(ctx context.Context, client *ent.Client, ev flow.Event, data *AccessoryUnequippedEvent) error {
+	affected, err := client.NFTAccessory.Update().Where(
+		nftaccessory.NftIDEQ(*data.AccessoryID),
+	).ClearEquippedOnMoment().Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal unequip accessory %d: %w", *data.AccessoryID, err)
+	}
+	if affected == 0 {
+		// Aksesori belum terindeks: tunggu mint-nya
+		return missingDependency(entityAccessory, *data.AccessoryID)
+	}
+	log.Println("success unequip accessory", *data.AccessoryID)
+	return nil
+}
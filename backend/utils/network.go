@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// DefaultNetwork dipakai jika FLOW_NETWORK tidak di-set
+const DefaultNetwork = "testnet"
+
+// Network adalah profil satu jaringan Flow (emulator/testnet/mainnet)
+// yang dibaca dari flow.json: host access node dan alamat setiap kontrak.
+type Network struct {
+	Name string
+
+	// Host gRPC access node (dari bagian "networks")
+	AccessHost string
+
+	// Alamat kontrak (tanpa '0x') per nama kontrak
+	Contracts map[string]string
+}
+
+// flowJSON hanya memuat bagian flow.json yang kita butuhkan
+type flowJSON struct {
+	Contracts    map[string]flowContract `json:"contracts"`
+	Dependencies map[string]flowContract `json:"dependencies"`
+	Networks     map[string]string       `json:"networks"`
+	Accounts     map[string]struct {
+		Address string `json:"address"`
+	} `json:"accounts"`
+	Deployments map[string]map[string][]string `json:"deployments"`
+}
+
+type flowContract struct {
+	Aliases map[string]string `json:"aliases"`
+}
+
+// flowJSONPath mencari flow.json: env FLOW_JSON, lalu folder saat ini,
+// lalu folder induk (binary biasanya dijalankan dari folder 'backend').
+func flowJSONPath() string {
+	if path := os.Getenv("FLOW_JSON"); path != "" {
+		return path
+	}
+	for _, path := range []string{"flow.json", "../flow.json"} {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return "flow.json"
+}
+
+// LoadNetwork membaca profil jaringan dari env FLOW_NETWORK (default: testnet).
+//
+// Alamat kontrak bisa ditimpa lewat env FLOW_CONTRACTS, misal:
+// FLOW_CONTRACTS="NFTMoment=0x1bb6b1e0a5170088,NFTStorefrontV2=0x2d55b98eb200daef"
+func LoadNetwork() (*Network, error) {
+	name := os.Getenv("FLOW_NETWORK")
+	if name == "" {
+		name = DefaultNetwork
+	}
+	network, err := LoadNetworkFromFile(flowJSONPath(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	if contracts := os.Getenv("FLOW_CONTRACTS"); contracts != "" {
+		if err := network.overrideContracts(contracts); err != nil {
+			return nil, err
+		}
+	}
+	return network, nil
+}
+
+// overrideContracts menimpa alamat kontrak dari "Nama=0xalamat,Nama2=0xalamat2".
+func (n *Network) overrideContracts(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		name, address, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" || address == "" {
+			return fmt.Errorf("FLOW_CONTRACTS tidak valid: '%s'", pair)
+		}
+		n.Contracts[name] = strings.TrimPrefix(address, "0x")
+		log.Printf("Alamat kontrak %s ditimpa: %s", name, address)
+	}
+	return nil
+}
+
+// LoadNetworkFromFile membaca profil jaringan 'name' dari file flow.json di 'path'.
+//
+// Alamat kontrak diambil dari 'aliases' lebih dulu (kontrak yang sudah ada di jaringan),
+// lalu dari 'deployments' (kontrak yang kita deploy sendiri ke akun tertentu).
+func LoadNetworkFromFile(path string, name string) (*Network, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca %s: %w", path, err)
+	}
+
+	var cfg flowJSON
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("gagal parsing %s: %w", path, err)
+	}
+
+	host, ok := cfg.Networks[name]
+	if !ok {
+		return nil, fmt.Errorf("jaringan '%s' tidak ada di %s", name, path)
+	}
+
+	network := &Network{
+		Name:       name,
+		AccessHost: host,
+		Contracts:  make(map[string]string),
+	}
+
+	// 1. Aliases (dependencies & kontrak lokal)
+	for _, group := range []map[string]flowContract{cfg.Dependencies, cfg.Contracts} {
+		for contract, c := range group {
+			if address, ok := c.Aliases[name]; ok {
+				network.Contracts[contract] = strings.TrimPrefix(address, "0x")
+			}
+		}
+	}
+
+	// 2. Deployments (hanya jika belum ada alias)
+	for account, contracts := range cfg.Deployments[name] {
+		acc, ok := cfg.Accounts[account]
+		if !ok {
+			return nil, fmt.Errorf("akun deployment '%s' tidak ada di %s", account, path)
+		}
+		for _, contract := range contracts {
+			if _, exists := network.Contracts[contract]; !exists {
+				network.Contracts[contract] = strings.TrimPrefix(acc.Address, "0x")
+			}
+		}
+	}
+
+	log.Printf("Jaringan Flow: %s (%s), %d kontrak", network.Name, network.AccessHost, len(network.Contracts))
+	return network, nil
+}
+
+// ContractAddress mengembalikan alamat kontrak (tanpa '0x') di jaringan ini.
+func (n *Network) ContractAddress(name string) (string, error) {
+	address, ok := n.Contracts[name]
+	if !ok {
+		return "", fmt.Errorf("kontrak %s tidak punya alamat di jaringan %s", name, n.Name)
+	}
+	return address, nil
+}
+
+// QualifiedType mengubah suffix event menjadi tipe lengkap di jaringan ini,
+// misal "NFTMoment.Minted" -> "A.1bb6b1e0a5170088.NFTMoment.Minted".
+func (n *Network) QualifiedType(suffix string) (string, error) {
+	if strings.HasPrefix(suffix, "flow.") {
+		return suffix, nil
+	}
+
+	contract, _, ok := strings.Cut(suffix, ".")
+	if !ok {
+		return "", fmt.Errorf("suffix event tidak valid: %s", suffix)
+	}
+	address, err := n.ContractAddress(contract)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("A.%s.%s", address, suffix), nil
+}
+
+type networkKey struct{}
+
+// withNetwork menyimpan profil jaringan di context handler
+// (diisi oleh Registry.Handle).
+func withNetwork(ctx context.Context, network *Network) context.Context {
+	return context.WithValue(ctx, networkKey{}, network)
+}
+
+// qualifiedType adalah QualifiedType dengan jaringan dari context handler.
+func qualifiedType(ctx context.Context, suffix string) (string, error) {
+	network, ok := ctx.Value(networkKey{}).(*Network)
+	if !ok {
+		return "", fmt.Errorf("profil jaringan tidak ada di context (tipe %s)", suffix)
+	}
+	return network.QualifiedType(suffix)
+}
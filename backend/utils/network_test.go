@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadNetworkContractOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "flow.json")
+	flowJSON := `{
+		"networks": {"emulator": "127.0.0.1:3569"},
+		"accounts": {"emulator-account": {"address": "f8d6e0586b0a20c7"}},
+		"deployments": {"emulator": {"emulator-account": ["NFTMoment", "NFTAccessory"]}}
+	}`
+	if err := os.WriteFile(path, []byte(flowJSON), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("FLOW_JSON", path)
+	t.Setenv("FLOW_NETWORK", "emulator")
+	t.Setenv("FLOW_CONTRACTS", "NFTMoment=0x1bb6b1e0a5170088, NFTStorefrontV2=0x2d55b98eb200daef")
+
+	network, err := LoadNetwork()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"NFTMoment":       "1bb6b1e0a5170088",
+		"NFTAccessory":    "f8d6e0586b0a20c7",
+		"NFTStorefrontV2": "2d55b98eb200daef",
+	}
+	for contract, address := range want {
+		if got := network.Contracts[contract]; got != address {
+			t.Errorf("alamat %s = %q, ingin %q", contract, got, address)
+		}
+	}
+	if got, _ := network.QualifiedType("NFTMoment.Minted"); got != "A.1bb6b1e0a5170088.NFTMoment.Minted" {
+		t.Errorf("QualifiedType = %s", got)
+	}
+
+	t.Setenv("FLOW_CONTRACTS", "NFTMoment")
+	if _, err := LoadNetwork(); err == nil {
+		t.Error("FLOW_CONTRACTS tidak valid diterima")
+	}
+}
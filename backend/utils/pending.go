@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/pendingevent"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// Jenis baris yang bisa ditunggu oleh event lain
+const (
+	entityEvent      = "event"
+	entityMoment     = "moment"
+	entityAccessory  = "accessory"
+	entityReceipt    = "receipt"
+	entityListing    = "listing"
+	entityAttendance = "attendance"
+)
+
+// dependency adalah satu baris yang baru saja terindeks oleh sebuah handler.
+type dependency struct {
+	entity string
+	key    string
+}
+
+type indexedKey struct{}
+
+// markIndexed dipanggil handler setelah menyimpan baris yang mungkin
+// ditunggu event lain. Di luar ApplyEvent tidak melakukan apa-apa.
+func markIndexed(ctx context.Context, entity string, key any) {
+	if indexed, ok := ctx.Value(indexedKey{}).(*[]dependency); ok {
+		*indexed = append(*indexed, dependency{entity: entity, key: fmt.Sprint(key)})
+	}
+}
+
+// ApplyEvent menerapkan satu event lewat registry:
+//   - jika event menunggu baris yang belum ada, event diparkir di 'pending_events'
+//   - jika berhasil, event yang menunggu baris hasil event ini diterapkan ulang
+//
+// Error ErrInvalidEvent (selain dependensi) tetap dikembalikan ke pemanggil.
+func ApplyEvent(ctx context.Context, client *ent.Client, registry *Registry, block Block, ev flow.Event) error {
+	var indexed []dependency
+	handlerCtx := context.WithValue(WithBlock(ctx, block), indexedKey{}, &indexed)
+
+	err := registry.Handle(handlerCtx, client, ev)
+	var missing *DependencyError
+	if errors.As(err, &missing) {
+		return parkEvent(ctx, client, block, ev, missing)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, dep := range indexed {
+		if err := releasePending(ctx, client, registry, dep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parkEvent menyimpan event ke 'pending_events' sampai dependensinya terindeks.
+func parkEvent(ctx context.Context, client *ent.Client, block Block, ev flow.Event, missing *DependencyError) error {
+	payload, err := encodePayload(ev)
+	if err != nil {
+		return err
+	}
+
+	err = client.PendingEvent.Create().
+		SetEntity(missing.Entity).
+		SetEntityKey(missing.Key).
+		SetBlockHeight(block.Height).
+		SetBlockID(block.ID.String()).
+		SetBlockTime(block.Timestamp).
+		SetTxID(ev.TransactionID.String()).
+		SetTxIndex(ev.TransactionIndex).
+		SetEventIndex(ev.EventIndex).
+		SetType(ev.Type).
+		SetPayload(payload).
+		OnConflictColumns(pendingevent.FieldTxID, pendingevent.FieldEventIndex).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal memarkir event %s #%d: %w", ev.TransactionID, ev.EventIndex, err)
+	}
+
+	log.Printf("Event %s (tx %s #%d) diparkir, menunggu %s %s", ev.Type, ev.TransactionID, ev.EventIndex, missing.Entity, missing.Key)
+	return nil
+}
+
+// releasePending menerapkan ulang event yang menunggu 'dep', sesuai urutan di chain.
+// Event yang masih gagal karena datanya masuk dead letter.
+func releasePending(ctx context.Context, client *ent.Client, registry *Registry, dep dependency) error {
+	waiting, err := client.PendingEvent.Query().
+		Where(
+			pendingevent.EntityEQ(dep.entity),
+			pendingevent.EntityKeyEQ(dep.key),
+		).
+		Order(
+			ent.Asc(pendingevent.FieldBlockHeight),
+			ent.Asc(pendingevent.FieldTxIndex),
+			ent.Asc(pendingevent.FieldEventIndex),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal query pending event untuk %s %s: %w", dep.entity, dep.key, err)
+	}
+
+	for _, p := range waiting {
+		if err := client.PendingEvent.DeleteOneID(p.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("gagal menghapus pending event %d: %w", p.ID, err)
+		}
+
+		ev, err := decodeEvent(p.Type, p.TxID, p.TxIndex, p.EventIndex, p.Payload)
+		if err != nil {
+			return err
+		}
+		block := Block{
+			Height:    p.BlockHeight,
+			ID:        flow.HexToID(p.BlockID),
+			Timestamp: p.BlockTime,
+		}
+
+		log.Printf("Menerapkan ulang %s (tx %s #%d) setelah %s %s terindeks", p.Type, p.TxID, p.EventIndex, dep.entity, dep.key)
+		if err := ApplyEvent(ctx, client, registry, block, ev); err != nil {
+			if !errors.Is(err, ErrInvalidEvent) {
+				return err
+			}
+			if err := DeadLetterEvent(ctx, client, block, ev, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// attendanceKey adalah key dependensi untuk pendaftaran user ke sebuah event.
+func attendanceKey(eventID uint64, address string) string {
+	return fmt.Sprintf("%d/%s", eventID, address)
+}
@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/testdb"
+	"context"
+	"fmt"
+	"testing"
+)
+
+// equippedPayload adalah payload JSON-CDC NFTMoment.AccessoryEquipped.
+func equippedPayload(momentID, accessoryID uint64) string {
+	return fmt.Sprintf(`{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NFTMoment.AccessoryEquipped","fields":[
+		{"name":"NftMomentId","value":{"type":"UInt64","value":"%d"}},
+		{"name":"NftAccessoryId","value":{"type":"Optional","value":{"type":"UInt64","value":"%d"}}},
+		{"name":"prevNFTAccessoryId","value":{"type":"Optional","value":null}}]}}`, momentID, accessoryID)
+}
+
+// depositedPayload adalah payload JSON-CDC NonFungibleToken.Deposited untuk NFTMoment.
+func depositedPayload(nftID uint64, to string) string {
+	return fmt.Sprintf(`{"type":"Event","value":{"id":"A.f8d6e0586b0a20c7.NonFungibleToken.Deposited","fields":[
+		{"name":"type","value":{"type":"String","value":"A.f8d6e0586b0a20c7.NFTMoment.NFT"}},
+		{"name":"id","value":{"type":"UInt64","value":"%d"}},
+		{"name":"to","value":{"type":"Optional","value":{"type":"Address","value":"%s"}}}]}}`, nftID, to)
+}
+
+func TestApplyEventParksUntilDependency(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+	registry := newTestRegistry(t)
+
+	// Equip diterima sebelum momennya ter-mint: diparkir
+	equip := testEvent(t, "0d", 1, equippedPayload(3, 4))
+	if err := ApplyEvent(ctx, client, registry, Block{Height: 12}, equip); err != nil {
+		t.Fatal(err)
+	}
+	pending := client.PendingEvent.Query().AllX(ctx)
+	if len(pending) != 1 || pending[0].Entity != entityMoment || pending[0].EntityKey != "3" {
+		t.Fatalf("pending = %+v, ingin satu event menunggu momen 3", pending)
+	}
+	if pending[0].BlockHeight != 12 {
+		t.Errorf("block height pending = %d, ingin 12", pending[0].BlockHeight)
+	}
+
+	// Momen lain tidak merilis apa pun
+	if err := releasePending(ctx, client, registry, dependency{entity: entityMoment, key: "5"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.PendingEvent.Query().CountX(ctx); n != 1 {
+		t.Fatalf("%d pending event setelah momen lain terindeks, ingin 1", n)
+	}
+
+	// Momen 3 (dan aksesori 4) terindeks: equip yang menunggu diterapkan ulang
+	seedMoment(t, client, 3, alice)
+	seedAccessory(t, client, 4, alice)
+	if err := releasePending(ctx, client, registry, dependency{entity: entityMoment, key: "3"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.PendingEvent.Query().CountX(ctx); n != 0 {
+		t.Fatalf("%d pending event tersisa, ingin 0", n)
+	}
+	equipped := client.NFTAccessory.Query().Where(nftaccessory.NftIDEQ(4)).QueryEquippedOnMoment().OnlyX(ctx)
+	if equipped.NftID != 3 {
+		t.Errorf("aksesori 4 terpasang di momen %d, ingin 3", equipped.NftID)
+	}
+}
+
+func TestApplyEventReleasesDeposits(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+	registry := newTestRegistry(t)
+
+	// Dua deposit diterima sebelum mint-nya: keduanya diparkir
+	for i, to := range []string{bob, alice} {
+		ev := testEvent(t, fmt.Sprintf("1%d", i), 1, depositedPayload(4, to))
+		if err := ApplyEvent(ctx, client, registry, Block{Height: uint64(20 + i)}, ev); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := client.PendingEvent.Query().CountX(ctx); n != 2 {
+		t.Fatalf("%d pending event, ingin 2", n)
+	}
+
+	// Mint ke alice terindeks: deposit yang menunggu diterapkan ulang sesuai urutan
+	mint := testEvent(t, "0f", 0, momentMintedPayload(4, alice))
+	if err := ApplyEvent(ctx, client, registry, Block{Height: 6}, mint); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.PendingEvent.Query().CountX(ctx); n != 0 {
+		t.Fatalf("%d pending event tersisa, ingin 0", n)
+	}
+	// Deposit terakhir (block 21, ke alice) yang menentukan pemilik
+	owner := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(4)).QueryOwner().OnlyX(ctx)
+	if owner.Address != alice {
+		t.Errorf("pemilik momen 4 = %s, ingin %s", owner.Address, alice)
+	}
+}
@@ -0,0 +1,389 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// Script yang dipakai untuk membaca kepemilikan on-chain (folder cadence/scripts)
+const (
+	momentIDsScript       = "get_nft_moment_ids.cdc"
+	accessoryIDsScript    = "get_nft_accessory_ids.cdc"
+	momentEquipmentScript = "get_moment_equipment.cdc"
+)
+
+// Jenis ketidaksesuaian antara chain dan database
+const (
+	MismatchMissing   = "missing"   // NFT ada on-chain tapi tidak ada di DB
+	MismatchOwner     = "owner"     // pemilik di DB berbeda dengan on-chain
+	MismatchStale     = "stale"     // DB mencatat NFT di alamat ini, on-chain tidak
+	MismatchEquipment = "equipment" // aksesori yang terpasang di momen berbeda
+)
+
+// Mismatch adalah satu perbedaan antara state on-chain dan database.
+type Mismatch struct {
+	Kind     string // MismatchMissing, MismatchOwner, ...
+	NFTType  string // "moment" atau "accessory"
+	NFTID    uint64
+	Address  string // alamat yang diperiksa
+	Chain    string // nilai on-chain
+	DB       string // nilai di database
+	Repaired bool
+}
+
+// Reconciler membandingkan kepemilikan & equipment on-chain dengan database.
+type Reconciler struct {
+	DB      *ent.Client
+	Flow    access.Client
+	Network *Network
+
+	// Jika true, ketidaksesuaian yang bisa dipastikan diperbaiki di DB
+	Repair bool
+
+	// Block height tempat script dijalankan, 0 = block sealed terbaru.
+	// Reconcile terjadwal memakai checkpoint indexer supaya chain dibandingkan
+	// dengan state yang sudah di-indeks, bukan block yang belum diproses.
+	BlockHeight uint64
+
+	scripts map[string][]byte
+}
+
+// chainState adalah hasil script on-chain untuk semua alamat yang diperiksa.
+type chainState struct {
+	momentOwner    map[uint64]string
+	accessoryOwner map[uint64]string
+	// Aksesori yang terpasang per momen (nil jika tidak ada)
+	equipped map[uint64]*uint64
+}
+
+// Reconcile memeriksa 'addresses' (semua user jika kosong) dan mengembalikan
+// semua ketidaksesuaian yang ditemukan.
+func (r *Reconciler) Reconcile(ctx context.Context, addresses []string) ([]Mismatch, error) {
+	if len(addresses) == 0 {
+		all, err := r.DB.User.Query().Select(user.FieldAddress).Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("gagal query alamat user: %w", err)
+		}
+		addresses = all
+	}
+
+	// Samakan format dengan yang disimpan handler (0x + 16 digit hex)
+	for i, address := range addresses {
+		addresses[i] = cadence.NewAddress(flow.HexToAddress(address)).String()
+	}
+
+	// 1. Baca state on-chain untuk semua alamat
+	state := chainState{
+		momentOwner:    make(map[uint64]string),
+		accessoryOwner: make(map[uint64]string),
+		equipped:       make(map[uint64]*uint64),
+	}
+	for _, address := range addresses {
+		if err := r.readChain(ctx, address, &state); err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Bandingkan dengan DB
+	var mismatches []Mismatch
+	for _, check := range []func(context.Context, []string, *chainState) ([]Mismatch, error){
+		r.reconcileMoments,
+		r.reconcileAccessories,
+		r.reconcileEquipment,
+	} {
+		found, err := check(ctx, addresses, &state)
+		if err != nil {
+			return mismatches, err
+		}
+		mismatches = append(mismatches, found...)
+	}
+	return mismatches, nil
+}
+
+// readChain menjalankan script on-chain untuk satu alamat.
+func (r *Reconciler) readChain(ctx context.Context, address string, state *chainState) error {
+	addressArg := cadence.NewAddress(flow.HexToAddress(address))
+
+	momentIDs, err := r.runIDsScript(ctx, momentIDsScript, addressArg)
+	if err != nil {
+		return fmt.Errorf("gagal membaca momen %s: %w", address, err)
+	}
+	for _, id := range momentIDs {
+		state.momentOwner[id] = address
+
+		value, err := r.runScript(ctx, momentEquipmentScript, addressArg, cadence.NewUInt64(id))
+		if err != nil {
+			return fmt.Errorf("gagal membaca equipment momen %d: %w", id, err)
+		}
+		state.equipped[id] = equippedAccessoryID(value)
+	}
+
+	accessoryIDs, err := r.runIDsScript(ctx, accessoryIDsScript, addressArg)
+	if err != nil {
+		return fmt.Errorf("gagal membaca aksesori %s: %w", address, err)
+	}
+	for _, id := range accessoryIDs {
+		state.accessoryOwner[id] = address
+	}
+	return nil
+}
+
+// reconcileMoments membandingkan pemilik momen.
+func (r *Reconciler) reconcileMoments(ctx context.Context, addresses []string, state *chainState) ([]Mismatch, error) {
+	var mismatches []Mismatch
+
+	// On-chain -> DB
+	for id, owner := range state.momentOwner {
+		moment, err := r.DB.NFTMoment.Query().
+			Where(nftmoment.NftIDEQ(id)).
+			WithOwner().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			mismatches = append(mismatches, Mismatch{Kind: MismatchMissing, NFTType: "moment", NFTID: id, Address: owner, Chain: owner})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error query momen %d: %w", id, err)
+		}
+		if moment.Edges.Owner.Address == owner {
+			continue
+		}
+
+		m := Mismatch{Kind: MismatchOwner, NFTType: "moment", NFTID: id, Address: owner, Chain: owner, DB: moment.Edges.Owner.Address}
+		if r.Repair {
+			newOwner, err := getOrCreateUser(ctx, r.DB, owner)
+			if err != nil {
+				return nil, err
+			}
+			if err := moment.Update().SetOwner(newOwner).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("gagal memperbaiki pemilik momen %d: %w", id, err)
+			}
+			m.Repaired = true
+		}
+		mismatches = append(mismatches, m)
+	}
+
+	// DB -> on-chain: momen yang menurut DB ada di alamat ini, tapi tidak on-chain.
+	// Pemilik sebenarnya tidak diketahui, jadi hanya dilaporkan.
+	stale, err := r.DB.NFTMoment.Query().
+		Where(nftmoment.HasOwnerWith(user.AddressIn(addresses...))).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error query momen di DB: %w", err)
+	}
+	for _, moment := range stale {
+		if _, ok := state.momentOwner[moment.NftID]; ok {
+			continue
+		}
+		mismatches = append(mismatches, Mismatch{Kind: MismatchStale, NFTType: "moment", NFTID: moment.NftID, Address: moment.Edges.Owner.Address, DB: moment.Edges.Owner.Address})
+	}
+	return mismatches, nil
+}
+
+// reconcileAccessories membandingkan pemilik aksesori yang ada di koleksi.
+// Aksesori yang terpasang ada di dalam momen, bukan di koleksi.
+func (r *Reconciler) reconcileAccessories(ctx context.Context, addresses []string, state *chainState) ([]Mismatch, error) {
+	var mismatches []Mismatch
+
+	// On-chain -> DB
+	for id, owner := range state.accessoryOwner {
+		accessory, err := r.DB.NFTAccessory.Query().
+			Where(nftaccessory.NftIDEQ(id)).
+			WithOwner().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			mismatches = append(mismatches, Mismatch{Kind: MismatchMissing, NFTType: "accessory", NFTID: id, Address: owner, Chain: owner})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error query aksesori %d: %w", id, err)
+		}
+		if accessory.Edges.Owner.Address == owner {
+			continue
+		}
+
+		m := Mismatch{Kind: MismatchOwner, NFTType: "accessory", NFTID: id, Address: owner, Chain: owner, DB: accessory.Edges.Owner.Address}
+		if r.Repair {
+			newOwner, err := getOrCreateUser(ctx, r.DB, owner)
+			if err != nil {
+				return nil, err
+			}
+			if err := accessory.Update().SetOwner(newOwner).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("gagal memperbaiki pemilik aksesori %d: %w", id, err)
+			}
+			m.Repaired = true
+		}
+		mismatches = append(mismatches, m)
+	}
+
+	// DB -> on-chain (hanya dilaporkan)
+	equippedOnChain := make(map[uint64]bool)
+	for _, accessoryID := range state.equipped {
+		if accessoryID != nil {
+			equippedOnChain[*accessoryID] = true
+		}
+	}
+	stale, err := r.DB.NFTAccessory.Query().
+		Where(nftaccessory.HasOwnerWith(user.AddressIn(addresses...))).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error query aksesori di DB: %w", err)
+	}
+	for _, accessory := range stale {
+		if _, ok := state.accessoryOwner[accessory.NftID]; ok || equippedOnChain[accessory.NftID] {
+			continue
+		}
+		mismatches = append(mismatches, Mismatch{Kind: MismatchStale, NFTType: "accessory", NFTID: accessory.NftID, Address: accessory.Edges.Owner.Address, DB: accessory.Edges.Owner.Address})
+	}
+	return mismatches, nil
+}
+
+// reconcileEquipment membandingkan aksesori yang terpasang di setiap momen on-chain.
+func (r *Reconciler) reconcileEquipment(ctx context.Context, addresses []string, state *chainState) ([]Mismatch, error) {
+	var mismatches []Mismatch
+
+	for momentID, chainAccessory := range state.equipped {
+		moment, err := r.DB.NFTMoment.Query().
+			Where(nftmoment.NftIDEQ(momentID)).
+			WithEquippedAccessories().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			// Sudah dilaporkan sebagai 'missing' oleh reconcileMoments
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error query momen %d: %w", momentID, err)
+		}
+
+		var dbAccessories []uint64
+		for _, accessory := range moment.Edges.EquippedAccessories {
+			dbAccessories = append(dbAccessories, accessory.NftID)
+		}
+		var chainAccessories []uint64
+		if chainAccessory != nil {
+			chainAccessories = []uint64{*chainAccessory}
+		}
+		if slices.Equal(dbAccessories, chainAccessories) {
+			continue
+		}
+
+		m := Mismatch{
+			Kind:    MismatchEquipment,
+			NFTType: "moment",
+			NFTID:   momentID,
+			Address: state.momentOwner[momentID],
+			Chain:   formatIDs(chainAccessories),
+			DB:      formatIDs(dbAccessories),
+		}
+		if r.Repair {
+			if err := moment.Update().ClearEquippedAccessories().Exec(ctx); err != nil {
+				return nil, fmt.Errorf("gagal melepas aksesori momen %d: %w", momentID, err)
+			}
+			if chainAccessory != nil {
+				_, err := r.DB.NFTAccessory.Update().
+					Where(nftaccessory.NftIDEQ(*chainAccessory)).
+					SetEquippedOnMoment(moment).
+					Save(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("gagal memasang aksesori %d ke momen %d: %w", *chainAccessory, momentID, err)
+				}
+			}
+			m.Repaired = true
+		}
+		mismatches = append(mismatches, m)
+	}
+	return mismatches, nil
+}
+
+// runScript menjalankan script dari folder cadence/scripts di r.BlockHeight
+// (atau block sealed terbaru jika 0).
+func (r *Reconciler) runScript(ctx context.Context, name string, args ...cadence.Value) (cadence.Value, error) {
+	if r.scripts == nil {
+		r.scripts = make(map[string][]byte)
+	}
+	script, ok := r.scripts[name]
+	if !ok {
+		loaded, err := LoadScript(r.Network, name)
+		if err != nil {
+			return nil, err
+		}
+		script = loaded
+		r.scripts[name] = script
+	}
+	if r.BlockHeight > 0 {
+		return r.Flow.ExecuteScriptAtBlockHeight(ctx, r.BlockHeight, script, args)
+	}
+	return r.Flow.ExecuteScriptAtLatestBlock(ctx, script, args)
+}
+
+// runIDsScript menjalankan script yang mengembalikan [UInt64]?.
+// Alamat tanpa koleksi (nil) dianggap tidak punya NFT.
+func (r *Reconciler) runIDsScript(ctx context.Context, name string, address cadence.Address) ([]uint64, error) {
+	value, err := r.runScript(ctx, name, address)
+	if err != nil {
+		return nil, err
+	}
+	if optional, ok := value.(cadence.Optional); ok {
+		if optional.Value == nil {
+			return nil, nil
+		}
+		value = optional.Value
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("hasil %s bukan array (tipe: %T)", name, value)
+	}
+	ids := make([]uint64, 0, len(array.Values))
+	for _, v := range array.Values {
+		id, ok := v.(cadence.UInt64)
+		if !ok {
+			return nil, fmt.Errorf("hasil %s berisi %T, bukan UInt64", name, v)
+		}
+		ids = append(ids, uint64(id))
+	}
+	return ids, nil
+}
+
+// equippedAccessoryID mengambil ID dari hasil get_moment_equipment.cdc (&NFTAccessory.NFT?).
+func equippedAccessoryID(value cadence.Value) *uint64 {
+	if optional, ok := value.(cadence.Optional); ok {
+		value = optional.Value
+	}
+	composite, ok := value.(cadence.Composite)
+	if !ok {
+		return nil
+	}
+	id, ok := cadence.SearchFieldByName(composite, "id").(cadence.UInt64)
+	if !ok {
+		log.Printf("Hasil equipment tanpa field 'id': %s", value)
+		return nil
+	}
+	nftID := uint64(id)
+	return &nftID
+}
+
+// formatIDs menampilkan daftar ID untuk laporan, "-" jika kosong.
+func formatIDs(ids []uint64) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprint(id)
+	}
+	return strings.Join(parts, ",")
+}
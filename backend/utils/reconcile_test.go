@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"backend/ent/nftmoment"
+	"backend/testdb"
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk/access"
+)
+
+// fakeChain menjawab script reconcile dari state on-chain di memori.
+type fakeChain struct {
+	access.Client
+	// ID NFT per script per alamat; alamat tanpa entri tidak punya koleksi
+	ids map[string]map[string][]uint64
+	// Height yang diminta script terakhir, 0 = block sealed terbaru
+	height uint64
+}
+
+func (f *fakeChain) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, args []cadence.Value) (cadence.Value, error) {
+	f.height = 0
+	return f.execute(script, args), nil
+}
+
+func (f *fakeChain) ExecuteScriptAtBlockHeight(ctx context.Context, height uint64, script []byte, args []cadence.Value) (cadence.Value, error) {
+	f.height = height
+	return f.execute(script, args), nil
+}
+
+func (f *fakeChain) execute(script []byte, args []cadence.Value) cadence.Value {
+	name, address := string(script), args[0].String()
+	if name == momentEquipmentScript {
+		return cadence.NewOptional(nil)
+	}
+	ids, ok := f.ids[name][address]
+	if !ok {
+		return cadence.NewOptional(nil)
+	}
+	values := make([]cadence.Value, len(ids))
+	for i, id := range ids {
+		values[i] = cadence.NewUInt64(id)
+	}
+	return cadence.NewOptional(cadence.NewArray(values))
+}
+
+// newTestReconciler membuat Reconciler dengan script yang tidak perlu dibaca dari disk.
+func newTestReconciler(t *testing.T, chain *fakeChain, repair bool) *Reconciler {
+	r := &Reconciler{DB: testdb.Open(t), Flow: chain, Repair: repair, scripts: make(map[string][]byte)}
+	for _, name := range []string{momentIDsScript, accessoryIDsScript, momentEquipmentScript} {
+		r.scripts[name] = []byte(name)
+	}
+	return r
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	chain := &fakeChain{ids: map[string]map[string][]uint64{
+		momentIDsScript:    {alice: {}, bob: {1}},
+		accessoryIDsScript: {alice: {3}},
+	}}
+
+	for _, repair := range []bool{false, true} {
+		t.Run(fmt.Sprintf("repair=%t", repair), func(t *testing.T) {
+			r := newTestReconciler(t, chain, repair)
+			r.BlockHeight = 42
+			// DB: momen 1 & 2 milik alice; on-chain momen 1 milik bob, momen 2 tidak ada
+			seedMoment(t, r.DB, 1, alice)
+			seedMoment(t, r.DB, 2, alice)
+			seedUser(t, r.DB, bob)
+
+			mismatches, err := r.Reconcile(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := []Mismatch{
+				{Kind: MismatchOwner, NFTType: "moment", NFTID: 1, Address: bob, Chain: bob, DB: alice, Repaired: repair},
+				{Kind: MismatchStale, NFTType: "moment", NFTID: 2, Address: alice, DB: alice},
+				{Kind: MismatchMissing, NFTType: "accessory", NFTID: 3, Address: alice, Chain: alice},
+			}
+			if !slices.Equal(mismatches, want) {
+				t.Errorf("mismatch = %+v\ningin %+v", mismatches, want)
+			}
+
+			owner := r.DB.NFTMoment.Query().Where(nftmoment.NftIDEQ(1)).QueryOwner().OnlyX(ctx)
+			wantOwner := alice
+			if repair {
+				wantOwner = bob
+			}
+			if owner.Address != wantOwner {
+				t.Errorf("pemilik momen 1 = %s, ingin %s", owner.Address, wantOwner)
+			}
+			if chain.height != r.BlockHeight {
+				t.Errorf("script dijalankan di block %d, ingin %d", chain.height, r.BlockHeight)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"backend/ent"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EventHandler adalah satu handler untuk satu tipe event kontrak.
+// Untuk mendukung event baru cukup buat satu file yang
+// mengimplementasikan interface ini lalu memanggil RegisterHandler di init().
+type EventHandler interface {
+	// EventSuffix adalah tipe event TANPA alamat kontrak,
+	// misal "NFTMoment.Minted". Alamatnya diisi dari konfigurasi.
+	// Event bawaan Flow ditulis lengkap, misal "flow.StorageCapabilityControllerIssued".
+	EventSuffix() string
+
+	// Decode mengambil & memvalidasi data dari event.
+	Decode(ev flow.Event) (any, error)
+
+	// Apply menyimpan data hasil Decode ke database.
+	Apply(ctx context.Context, client *ent.Client, ev flow.Event, data any) error
+}
+
+var (
+	handlersMu sync.Mutex
+	handlers   []EventHandler
+)
+
+// RegisterHandler mendaftarkan handler ke registry global.
+// Biasanya dipanggil dari fungsi init() di file handler.
+func RegisterHandler(h EventHandler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers = append(handlers, h)
+}
+
+// Registry memetakan tipe event lengkap ke handler-nya.
+type Registry struct {
+	network *Network
+	byType  map[string]EventHandler
+	types   []string
+}
+
+// NewRegistry membangun registry dari semua handler yang terdaftar,
+// dengan alamat kontrak dari profil jaringan 'network'.
+func NewRegistry(network *Network) (*Registry, error) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+
+	r := &Registry{network: network, byType: make(map[string]EventHandler)}
+	for _, h := range handlers {
+		eventType, err := network.QualifiedType(h.EventSuffix())
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := r.byType[eventType]; exists {
+			return nil, fmt.Errorf("handler untuk %s terdaftar lebih dari sekali", eventType)
+		}
+		r.byType[eventType] = h
+		r.types = append(r.types, eventType)
+	}
+	return r, nil
+}
+
+// EventTypes mengembalikan semua tipe event yang harus di-subscribe.
+func (r *Registry) EventTypes() []string {
+	return append([]string(nil), r.types...)
+}
+
+// Handle meneruskan event ke handler-nya (decode lalu apply).
+// Event tanpa handler diabaikan.
+func (r *Registry) Handle(ctx context.Context, client *ent.Client, ev flow.Event) error {
+	h, ok := r.byType[ev.Type]
+	if !ok {
+		log.Printf("Tidak ada handler untuk event %s, dilewati.", ev.Type)
+		return nil
+	}
+
+	timer := prometheus.NewTimer(handlerDuration.WithLabelValues(ev.Type))
+	defer timer.ObserveDuration()
+	eventsProcessed.WithLabelValues(ev.Type).Inc()
+
+	// Event yang diparkir karena dependensi bukan error handler
+	err := r.decodeAndApply(withNetwork(ctx, r.network), client, ev, h)
+	if err != nil && !errors.As(err, new(*DependencyError)) {
+		handlerErrors.WithLabelValues(ev.Type).Inc()
+	}
+	return err
+}
+
+func (r *Registry) decodeAndApply(ctx context.Context, client *ent.Client, ev flow.Event, h EventHandler) error {
+	data, err := h.Decode(ev)
+	if err != nil {
+		return invalidEvent("gagal decode %s: %v", ev.Type, err)
+	}
+	return h.Apply(ctx, client, ev, data)
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/sale"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// recordSale mencatat penjualan dari listing yang terjual.
+// Pembeli belum diketahui di sini; diisi oleh Deposited di transaksi yang sama.
+func recordSale(ctx context.Context, client *ent.Client, ev flow.Event, listingRecord *ent.Listing, data *ListingCompletedEvent) error {
+	kind := nftKindOf(ctx, typeID(data.NFTType))
+	if kind == "" {
+		return invalidEvent("tipe NFT listing %d tidak dikenal: %s", data.ListingResourceID, typeID(data.NFTType))
+	}
+
+	block, _ := BlockFromContext(ctx)
+	_, err := client.Sale.Create().
+		SetListing(listingRecord).
+		SetSeller(listingRecord.Edges.Seller).
+		SetPrice(ufix64ToFloat(data.SalePrice)).
+		SetPaymentVaultType(data.SalePaymentVaultType.String()).
+		SetNftType(sale.NftType(kind)).
+		SetNftID(data.NFTID).
+		SetTxID(ev.TransactionID.String()).
+		SetBlockHeight(block.Height).
+		SetTimestamp(blockTime(ctx)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal menyimpan 'Sale' untuk listing %d: %w", data.ListingResourceID, err)
+	}
+	return nil
+}
+
+// fillSaleBuyer mengisi pembeli pada penjualan di transaksi yang sama
+// dengan deposit NFT tersebut.
+func fillSaleBuyer(ctx context.Context, client *ent.Client, ev flow.Event, kind transfer.NftType, nftID uint64, buyer *ent.User) error {
+	n, err := client.Sale.Update().
+		Where(
+			sale.TxIDEQ(ev.TransactionID.String()),
+			sale.NftTypeEQ(sale.NftType(kind)),
+			sale.NftIDEQ(nftID),
+			sale.Not(sale.HasBuyer()),
+		).
+		SetBuyer(buyer).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengisi pembeli untuk %s %d: %w", kind, nftID, err)
+	}
+	if n > 0 {
+		log.Printf("Pembeli %s %d: %s", kind, nftID, buyer.Address)
+	}
+	return nil
+}
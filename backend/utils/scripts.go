@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// importPattern mencocokkan import gaya flow.json, misal: import "NFTMoment"
+var importPattern = regexp.MustCompile(`import\s+"(\w+)"`)
+
+// scriptsDir mencari folder script Cadence: env CADENCE_SCRIPTS_DIR, lalu
+// folder saat ini, lalu folder induk (sama seperti flow.json).
+func scriptsDir() string {
+	if dir := os.Getenv("CADENCE_SCRIPTS_DIR"); dir != "" {
+		return dir
+	}
+	for _, dir := range []string{"cadence/scripts", "../cadence/scripts"} {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+	return "cadence/scripts"
+}
+
+// LoadScript membaca script Cadence (misal "get_nft_moment_ids.cdc") dan
+// me-resolve import-nya lewat ResolveImports.
+func LoadScript(network *Network, name string) ([]byte, error) {
+	path := filepath.Join(scriptsDir(), name)
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("gagal membaca script %s: %w", path, err)
+	}
+
+	resolved, err := ResolveImports(network, source)
+	if err != nil {
+		return nil, fmt.Errorf("script %s: %w", name, err)
+	}
+	return resolved, nil
+}
+
+// ResolveImports mengganti setiap `import "Kontrak"` dengan alamat kontrak
+// di jaringan ini, karena access node tidak mengenal import gaya flow.json.
+func ResolveImports(network *Network, source []byte) ([]byte, error) {
+	var missing error
+	resolved := importPattern.ReplaceAllFunc(source, func(match []byte) []byte {
+		contract := string(importPattern.FindSubmatch(match)[1])
+		address, err := network.ContractAddress(contract)
+		if err != nil {
+			missing = err
+			return match
+		}
+		return fmt.Appendf(nil, "import %s from 0x%s", contract, address)
+	})
+	if missing != nil {
+		return nil, missing
+	}
+	return resolved, nil
+}
@@ -0,0 +1,241 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/nftaccessory"
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("NonFungibleToken.Deposited", NFTDeposited))
+	RegisterHandler(newHandler("NonFungibleToken.Withdrawn", NFTWithdrawn))
+}
+
+// nftKindOf memetakan tipe NFT lengkap (misal "A.xxx.NFTMoment.NFT")
+// ke jenis NFT di tabel transfers. String kosong jika bukan NFT kita.
+func nftKindOf(ctx context.Context, nftType string) transfer.NftType {
+	for contract, kind := range map[string]transfer.NftType{
+		"NFTMoment.NFT":    transfer.NftTypeMoment,
+		"NFTAccessory.NFT": transfer.NftTypeAccessory,
+	} {
+		if qualified, err := qualifiedType(ctx, contract); err == nil && qualified == nftType {
+			return kind
+		}
+	}
+	return ""
+}
+
+// DepositedEvent: NonFungibleToken.Deposited
+type DepositedEvent struct {
+	Type string           `cadence:"type"`
+	ID   uint64           `cadence:"id"`
+	To   *cadence.Address `address:"to"`
+}
+
+func NFTDeposited(ctx context.Context, client *ent.Client, ev flow.Event, data *DepositedEvent) error {
+	log.Println("Memproses event NonFungibleToken.Deposited...")
+
+	// --- 1. Validasi Event ---
+
+	// Abaikan jika bukan tipe NFT yang kita pedulikan
+	// (event 'Deposited' di-emit untuk SEMUA koleksi NFT di jaringan)
+	kind := nftKindOf(ctx, data.Type)
+	if kind == "" {
+		return nil
+	}
+
+	// 'to' adalah opsional ((Address)?)
+	if data.To == nil {
+		// Kita tidak bisa update owner jika tidak tahu siapa 'to'
+		log.Println("'to' adalah nil, dilewati.")
+		return nil
+	}
+
+	// --- 2. Konversi Tipe Go ---
+	nftID := data.ID
+	newOwnerAddress := data.To.String()
+
+	// --- 3. Pastikan NFT sudah terindeks ---
+	// Cadence meng-emit Deposited SETELAH Minted, jadi NFT yang belum ada di DB
+	// berarti event datang tidak berurutan: parkir sampai mint-nya terindeks.
+	// Dicek sebelum menulis apa pun, supaya replay tidak mencatat dua kali.
+	var (
+		accessory *ent.NFTAccessory
+		moment    *ent.NFTMoment
+		err       error
+	)
+	switch kind {
+	case transfer.NftTypeAccessory:
+		accessory, err = client.NFTAccessory.Query().
+			Where(nftaccessory.NftIDEQ(nftID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return missingDependency(entityAccessory, nftID)
+		}
+		if err != nil {
+			return fmt.Errorf("error query NFTAccessory %d: %w", nftID, err)
+		}
+	case transfer.NftTypeMoment:
+		moment, err = client.NFTMoment.Query().
+			Where(nftmoment.NftIDEQ(nftID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return missingDependency(entityMoment, nftID)
+		}
+		if err != nil {
+			return fmt.Errorf("error query NFTMoment %d: %w", nftID, err)
+		}
+	}
+
+	// --- 4. Dapatkan 'User' (Pemilik Baru) ---
+	// Gunakan pola Get-or-Create
+	newOwner, err := getOrCreateUser(ctx, client, newOwnerAddress)
+	if err != nil {
+		return err
+	}
+
+	// --- 5. Catat Riwayat Transfer (& pembeli jika ini hasil penjualan) ---
+	if err := recordDeposit(ctx, client, ev, kind, nftID, newOwnerAddress); err != nil {
+		return err
+	}
+	if err := fillSaleBuyer(ctx, client, ev, kind, nftID, newOwner); err != nil {
+		return err
+	}
+
+	// Listing di storefront pemilik lama tidak bisa dibeli lagi (ghost)
+	if err := syncListingsOnDeposit(ctx, client, kind, nftID, newOwner); err != nil {
+		return err
+	}
+
+	// --- 6. Update Owner ---
+	if accessory != nil {
+		_, err = accessory.Update().SetOwner(newOwner).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("gagal update owner untuk NFTAccessory %d: %w", nftID, err)
+		}
+		log.Printf("Berhasil transfer NFTAccessory %d ke %s", nftID, newOwnerAddress)
+	}
+	if moment != nil {
+		_, err = moment.Update().SetOwner(newOwner).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("gagal update owner untuk NFTMoment %d: %w", nftID, err)
+		}
+		log.Printf("Berhasil transfer NFTMoment %d ke %s", nftID, newOwnerAddress)
+	}
+	// (Abaikan jika bukan tipe NFT yang kita pedulikan)
+	return nil
+}
+
+// WithdrawnEvent: NonFungibleToken.Withdrawn
+type WithdrawnEvent struct {
+	Type string           `cadence:"type"`
+	ID   uint64           `cadence:"id"`
+	From *cadence.Address `address:"from"`
+}
+
+// NFTWithdrawn mencatat awal sebuah transfer: NFT keluar dari koleksi 'from'.
+// Penerimanya diisi oleh event Deposited di transaksi yang sama.
+func NFTWithdrawn(ctx context.Context, client *ent.Client, ev flow.Event, data *WithdrawnEvent) error {
+	kind := nftKindOf(ctx, data.Type)
+	if kind == "" || data.From == nil {
+		return nil
+	}
+
+	// Replay (reindex tanpa truncate): transfer dari event ini sudah tercatat
+	if recorded, err := transferRecorded(ctx, client, ev); err != nil || recorded {
+		return err
+	}
+
+	if err := createTransfer(ctx, client, ev, kind, data.ID).
+		SetFrom(data.From.String()).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menyimpan transfer %s %d: %w", kind, data.ID, err)
+	}
+	return nil
+}
+
+// recordDeposit melengkapi transfer dari Withdrawn di transaksi yang sama,
+// atau membuat transfer baru tanpa pengirim (mint) jika tidak ada.
+func recordDeposit(ctx context.Context, client *ent.Client, ev flow.Event, kind transfer.NftType, nftID uint64, to string) error {
+	// Replay (reindex tanpa truncate): deposit ini sudah melengkapi transfer
+	// di transaksi yang sama, atau tercatat sebagai mint
+	recorded, err := client.Transfer.Query().
+		Where(
+			transfer.TxIDEQ(ev.TransactionID.String()),
+			transfer.NftTypeEQ(kind),
+			transfer.NftIDEQ(nftID),
+			transfer.ToEQ(to),
+			transfer.EventIndexLTE(ev.EventIndex),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("error query transfer %s %d: %w", kind, nftID, err)
+	}
+	if recorded {
+		return nil
+	}
+
+	pending, err := client.Transfer.Query().
+		Where(
+			transfer.TxIDEQ(ev.TransactionID.String()),
+			transfer.NftTypeEQ(kind),
+			transfer.NftIDEQ(nftID),
+			transfer.ToIsNil(),
+		).
+		Order(ent.Desc(transfer.FieldEventIndex)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("error query transfer %s %d: %w", kind, nftID, err)
+	}
+
+	if pending != nil {
+		if _, err := pending.Update().SetTo(to).Save(ctx); err != nil {
+			return fmt.Errorf("gagal mengupdate transfer %s %d: %w", kind, nftID, err)
+		}
+		log.Printf("Transfer %s %d: %s -> %s", kind, nftID, *pending.From, to)
+		return nil
+	}
+
+	if err := createTransfer(ctx, client, ev, kind, nftID).
+		SetTo(to).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menyimpan transfer %s %d: %w", kind, nftID, err)
+	}
+	log.Printf("Transfer %s %d: mint -> %s", kind, nftID, to)
+	return nil
+}
+
+// transferRecorded mengecek apakah event ini sudah tercatat di tabel transfers.
+func transferRecorded(ctx context.Context, client *ent.Client, ev flow.Event) (bool, error) {
+	recorded, err := client.Transfer.Query().
+		Where(
+			transfer.TxIDEQ(ev.TransactionID.String()),
+			transfer.EventIndexEQ(ev.EventIndex),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error query transfer tx %s: %w", ev.TransactionID, err)
+	}
+	return recorded, nil
+}
+
+// createTransfer menyiapkan baris transfer dengan posisi event & block-nya.
+func createTransfer(ctx context.Context, client *ent.Client, ev flow.Event, kind transfer.NftType, nftID uint64) *ent.TransferCreate {
+	create := client.Transfer.Create().
+		SetNftType(kind).
+		SetNftID(nftID).
+		SetTxID(ev.TransactionID.String()).
+		SetEventIndex(ev.EventIndex)
+
+	block, _ := BlockFromContext(ctx)
+	return create.
+		SetBlockHeight(block.Height).
+		SetTimestamp(blockTime(ctx))
+}
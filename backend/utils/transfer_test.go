@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"backend/ent/nftmoment"
+	"backend/ent/transfer"
+	"backend/testdb"
+	"testing"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestTransferHistory(t *testing.T) {
+	block := Block{Height: 10, Timestamp: time.Unix(1700000000, 0)}
+	ctx := WithBlock(testContext(), block)
+	from, to := mustAddress(alice), mustAddress(bob)
+
+	t.Run("withdraw & deposit di transaksi yang sama jadi satu transfer", func(t *testing.T) {
+		client := testdb.Open(t)
+		seedMoment(t, client, 1, alice)
+		seedUser(t, client, bob)
+
+		txID := flow.HexToID("0a")
+		withdrawn := flow.Event{TransactionID: txID, EventIndex: 0}
+		if err := NFTWithdrawn(ctx, client, withdrawn, &WithdrawnEvent{Type: testMomentType, ID: 1, From: &from}); err != nil {
+			t.Fatal(err)
+		}
+		deposited := flow.Event{TransactionID: txID, EventIndex: 1}
+		if err := NFTDeposited(ctx, client, deposited, &DepositedEvent{Type: testMomentType, ID: 1, To: &to}); err != nil {
+			t.Fatal(err)
+		}
+
+		got := client.Transfer.Query().OnlyX(ctx)
+		if got.From == nil || *got.From != alice || got.To == nil || *got.To != bob {
+			t.Errorf("transfer = %v -> %v, ingin %s -> %s", got.From, got.To, alice, bob)
+		}
+		if got.NftType != transfer.NftTypeMoment || got.NftID != 1 || got.BlockHeight != 10 || !got.Timestamp.Equal(block.Timestamp) {
+			t.Errorf("transfer = %+v", got)
+		}
+		owner := client.NFTMoment.Query().Where(nftmoment.NftIDEQ(1)).QueryOwner().OnlyX(ctx)
+		if owner.Address != bob {
+			t.Errorf("pemilik momen 1 = %s, ingin %s", owner.Address, bob)
+		}
+	})
+
+	t.Run("deposit tanpa withdraw dicatat sebagai mint", func(t *testing.T) {
+		client := testdb.Open(t)
+		seedMoment(t, client, 1, bob)
+
+		deposited := flow.Event{TransactionID: flow.HexToID("0b"), EventIndex: 1}
+		if err := NFTDeposited(ctx, client, deposited, &DepositedEvent{Type: testMomentType, ID: 1, To: &to}); err != nil {
+			t.Fatal(err)
+		}
+
+		got := client.Transfer.Query().OnlyX(ctx)
+		if got.From != nil || got.To == nil || *got.To != bob {
+			t.Errorf("transfer = %v -> %v, ingin mint -> %s", got.From, got.To, bob)
+		}
+	})
+
+	t.Run("NFT koleksi lain tidak dicatat", func(t *testing.T) {
+		client := testdb.Open(t)
+
+		other := "A.0b2a3299cc857e29.TopShot.NFT"
+		withdrawn := flow.Event{TransactionID: flow.HexToID("0c"), EventIndex: 0}
+		if err := NFTWithdrawn(ctx, client, withdrawn, &WithdrawnEvent{Type: other, ID: 1, From: &from}); err != nil {
+			t.Fatal(err)
+		}
+		deposited := flow.Event{TransactionID: flow.HexToID("0c"), EventIndex: 1}
+		if err := NFTDeposited(ctx, client, deposited, &DepositedEvent{Type: other, ID: 1, To: &to}); err != nil {
+			t.Fatal(err)
+		}
+		if n := client.Transfer.Query().CountX(ctx); n != 0 {
+			t.Errorf("%d transfer tercatat, ingin 0", n)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"backend/ent"
+	"context"
+	"fmt"
+)
+
+// WithTx menjalankan 'fn' di dalam satu transaksi database.
+// Jika 'fn' mengembalikan error (atau panic), transaksi di-rollback;
+// jika tidak, transaksi di-commit.
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal memulai transaksi: %w", err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: gagal rollback: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("gagal commit transaksi: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"backend/ent"
+	"backend/ent/user"
+	"context"
+	"fmt"
+)
+
+// getOrCreateUser mengambil user berdasarkan alamat, atau membuatnya jika belum ada.
+// Memakai INSERT ... ON CONFLICT (address) agar aman dipanggil bersamaan
+// (misal live indexer & reindex memproses alamat yang sama).
+func getOrCreateUser(ctx context.Context, client *ent.Client, address string) (*ent.User, error) {
+	id, err := client.User.Create().
+		SetAddress(address).
+		OnConflictColumns(user.FieldAddress).
+		Ignore().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gagal menyimpan user %s: %w", address, err)
+	}
+
+	found, err := client.User.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error query user %s: %w", address, err)
+	}
+	return found, nil
+}
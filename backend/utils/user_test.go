@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"backend/ent/user"
+	"backend/testdb"
+	"context"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+func TestGetOrCreateUser(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+	existing := seedUser(t, client, alice)
+
+	got, err := getOrCreateUser(ctx, client, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != existing.ID {
+		t.Errorf("user %s = ID %d, ingin ID yang sudah ada %d", alice, got.ID, existing.ID)
+	}
+
+	created, err := getOrCreateUser(ctx, client, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Address != bob || created.ID == existing.ID {
+		t.Errorf("user baru = %+v", created)
+	}
+	if n := client.User.Query().CountX(ctx); n != 2 {
+		t.Errorf("%d user tersimpan, ingin 2", n)
+	}
+}
+
+func TestProfileUpdatedCreatesUser(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+
+	// ProfileUpdated sebelum CapabilityIssued tetap diterapkan
+	nickname := "alice"
+	data := &ProfileUpdatedEvent{Address: mustAddress(alice), Nickname: &nickname, Bio: "halo"}
+	if err := ProfileUpdated(ctx, client, flow.Event{}, data); err != nil {
+		t.Fatal(err)
+	}
+
+	got := client.User.Query().Where(user.AddressEQ(alice)).OnlyX(ctx)
+	if got.Nickname != nickname || got.Bio != "halo" {
+		t.Errorf("user = %+v", got)
+	}
+}
+
+func TestUserVerified(t *testing.T) {
+	ctx := context.Background()
+	client := testdb.Open(t)
+	seedUser(t, client, alice)
+
+	for _, address := range []string{alice, bob} { // bob belum pernah terindeks
+		if err := UserVerified(ctx, client, flow.Event{}, &UserVerifiedEvent{Address: mustAddress(address)}); err != nil {
+			t.Fatal(err)
+		}
+		got := client.User.Query().Where(user.AddressEQ(address)).OnlyX(ctx)
+		if !got.IsVerified {
+			t.Errorf("user %s belum terverifikasi", address)
+		}
+	}
+}
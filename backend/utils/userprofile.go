@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"backend/ent"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+)
+
+func init() {
+	RegisterHandler(newHandler("flow.StorageCapabilityControllerIssued", HandleCapabilityIssued))
+	RegisterHandler(newHandler("UserProfile.ProfileUpdated", ProfileUpdated))
+	RegisterHandler(newHandler("UserProfile.UserVerified", UserVerified))
+}
+
+// CapabilityIssuedEvent: flow.StorageCapabilityControllerIssued
+type CapabilityIssuedEvent struct {
+	ID      uint64            `cadence:"id"`
+	Address cadence.Address   `address:"address"`
+	Type    cadence.TypeValue `cadence:"type"`
+}
+
+func HandleCapabilityIssued(ctx context.Context, client *ent.Client, ev flow.Event, data *CapabilityIssuedEvent) error {
+	cadenceTypeString := data.Type.String()
+
+	// Alamat kontrak UserProfile diambil dari konfigurasi
+	profileType, err := qualifiedType(ctx, "UserProfile.Profile")
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(cadenceTypeString, "&"+profileType) {
+		return nil
+	}
+
+	// 4. CEK ANDA: Apakah ini event untuk UserProfile?
+	//    Kita cek apakah string-nya mengandung ".UserProfile."
+
+	log.Println("Event UserProfile terdeteksi. Memproses...")
+
+	// 5. Dapatkan alamat sebagai string (misal: "0xf8d6e0586b0a20c7")
+	userAddress := data.Address.String()
+
+	// 6. Pola "Get-or-Create" (Sangat Penting)
+	existingUser, err := getOrCreateUser(ctx, client, userAddress)
+	if err != nil {
+		return err
+	}
+	log.Printf("User %s tersimpan di database. (ID: %d)", existingUser.Address, existingUser.ID)
+	return nil
+}
+
+// ProfileUpdatedEvent: UserProfile.ProfileUpdated
+type ProfileUpdatedEvent struct {
+	Address                 cadence.Address   `address:"address"`
+	Nickname                *string           `cadence:"nickname"`
+	Bio                     string            `cadence:"bio"`
+	Socials                 map[string]string `cadence:"socials"`
+	Pfp                     *string           `cadence:"pfp"`
+	ShortDescription        *string           `cadence:"shortDescription"`
+	BgImage                 *string           `cadence:"bgImage"`
+	HighlightedEventPassIDs []*uint64         `cadence:"highlightedEventPassIds"`
+	HighlightedMomentID     *uint64           `cadence:"highlightedMomentID"`
+}
+
+func ProfileUpdated(ctx context.Context, client *ent.Client, ev flow.Event, data *ProfileUpdatedEvent) error {
+	log.Println("Memproses event ProfileUpdated...")
+
+	// --- 1. Konversi Tipe Go ---
+	userAddress := data.Address.String()
+
+	// --- 2. Temukan User yang Akan Di-update ---
+	// Biasanya 'User' sudah dibuat oleh CapabilityIssued, tapi jangan bergantung pada urutan itu.
+	user, err := getOrCreateUser(ctx, client, userAddress)
+	if err != nil {
+		return err
+	}
+
+	// --- 3. Buat 'Updater' ---
+	// Kita akan membangun query 'update' secara bertahap
+	updater := user.Update()
+
+	// --- 4. Set Field (Satu per Satu) ---
+
+	// bio (String)
+	updater.SetBio(data.Bio)
+
+	// nickname, pfp, shortDescription, bgImage ((String)?)
+	// nil berarti tidak diubah
+	if data.Nickname != nil {
+		updater.SetNickname(*data.Nickname)
+	}
+	if data.Pfp != nil {
+		updater.SetPfp(*data.Pfp)
+	}
+	if data.ShortDescription != nil {
+		updater.SetShortDescription(*data.ShortDescription)
+	}
+	if data.BgImage != nil {
+		updater.SetBgImage(*data.BgImage)
+	}
+
+	// socials ({String:String})
+	updater.SetSocials(data.Socials)
+
+	// highlightedEventPassIds ([(UInt64)?])
+	updater.SetHighlightedEventPassIds(derefUint64s(data.HighlightedEventPassIDs))
+
+	// highlightedMomentID ((UInt64)?)
+	if data.HighlightedMomentID != nil {
+		updater.SetHighlightedMomentID(*data.HighlightedMomentID)
+	} else {
+		updater.ClearHighlightedMomentID()
+	}
+
+	// --- 5. Jalankan Query Update ---
+	_, err = updater.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("gagal mengupdate profil untuk user %s: %w", userAddress, err)
+	}
+	log.Printf("Berhasil mengupdate profil untuk user %s", userAddress)
+	return nil
+}
+
+// derefUint64s mengambil isi array [UInt64?] tanpa elemen nil
+func derefUint64s(values []*uint64) []uint64 {
+	result := make([]uint64, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			result = append(result, *v)
+		}
+	}
+	return result
+}
+
+// UserVerifiedEvent: UserProfile.UserVerified
+type UserVerifiedEvent struct {
+	Address cadence.Address `address:"address"`
+}
+
+// UserVerified menandai user sebagai terverifikasi (dilakukan admin di kontrak).
+func UserVerified(ctx context.Context, client *ent.Client, ev flow.Event, data *UserVerifiedEvent) error {
+	userAddress := data.Address.String()
+
+	user, err := getOrCreateUser(ctx, client, userAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := user.Update().SetIsVerified(true).Exec(ctx); err != nil {
+		return fmt.Errorf("gagal menandai user %s terverifikasi: %w", userAddress, err)
+	}
+	log.Printf("User %s terverifikasi", userAddress)
+	return nil
+}